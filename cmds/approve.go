@@ -0,0 +1,99 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type ApproveCommand struct {
+	*BaseCommand
+	OperationFlags
+	Owner    AddressFlag    `arg:"" name:"owner" help:"owner address" required:""`
+	Spender  AddressFlag    `arg:"" name:"spender" help:"spender address" required:""`
+	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Big      BigFlag        `arg:"" name:"big" help:"allowance; zero revokes the allowance" required:""`
+	owner    base.Address
+	spender  base.Address
+}
+
+func NewApproveCommand() ApproveCommand {
+	return ApproveCommand{
+		BaseCommand: NewBaseCommand("approve-operation"),
+	}
+}
+
+func (cmd *ApproveCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *ApproveCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Owner.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid owner format, %q: %w", cmd.Owner.String(), err)
+	} else {
+		cmd.owner = a
+	}
+
+	if a, err := cmd.Spender.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid spender format, %q: %w", cmd.Spender.String(), err)
+	} else {
+		cmd.spender = a
+	}
+
+	return nil
+}
+
+func (cmd *ApproveCommand) createOperation() (operation.Operation, error) {
+	am := currency.NewAmount(cmd.Big.Big, cmd.Currency.CID)
+	if err := am.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewApproveFact([]byte(cmd.Token), cmd.owner, cmd.spender, am)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.Bytes()); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewApprove(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create approve operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
@@ -0,0 +1,118 @@
+package cmds
+
+import (
+	"bytes"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/seal"
+	"github.com/spikeekips/mitum/util"
+
+	currency "github.com/spikeekips/mitum-currency/currency"
+)
+
+type BurnCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
+	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Big      BigFlag        `arg:"" name:"big" help:"big to burn" required:""`
+	Seal     FileLoad       `help:"seal" optional:""`
+	sender   base.Address
+}
+
+func NewBurnCommand() BurnCommand {
+	return BurnCommand{
+		BaseCommand: NewBaseCommand("burn-operation"),
+	}
+}
+
+func (cmd *BurnCommand) Run(version util.Version) error {
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if sl, err := loadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.Bytes(),
+		op,
+	); err != nil {
+		return err
+	} else {
+		cmd.pretty(cmd.Pretty, sl)
+	}
+
+	return nil
+}
+
+func (cmd *BurnCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if sender, err := cmd.Sender.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
+	} else {
+		cmd.sender = sender
+	}
+
+	return nil
+}
+
+func (cmd *BurnCommand) createOperation() (operation.Operation, error) {
+	var amounts []currency.Amount
+	if len(bytes.TrimSpace(cmd.Seal.Bytes())) > 0 {
+		var sl seal.Seal
+		if s, err := loadSeal(cmd.Seal.Bytes(), cmd.NetworkID.Bytes()); err != nil {
+			return nil, err
+		} else if so, ok := s.(operation.Seal); !ok {
+			return nil, xerrors.Errorf("seal is not operation.Seal, %T", s)
+		} else if _, ok := so.(operation.SealUpdater); !ok {
+			return nil, xerrors.Errorf("seal is not operation.SealUpdater, %T", s)
+		} else {
+			sl = so
+		}
+
+		for _, op := range sl.(operation.Seal).Operations() {
+			if t, ok := op.(currency.Burn); ok {
+				amounts = t.Fact().(currency.BurnFact).Amounts()
+			}
+		}
+	}
+
+	am := currency.NewAmount(cmd.Big.Big, cmd.Currency.CID)
+	if err := am.IsValid(nil); err != nil {
+		return nil, err
+	} else {
+		amounts = append(amounts, am)
+	}
+
+	fact := currency.NewBurnFact([]byte(cmd.Token), cmd.sender, amounts)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.Bytes()); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewBurn(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create burn operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
@@ -0,0 +1,85 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type CancelRecoveryCommand struct {
+	*BaseCommand
+	OperationFlags
+	Target   AddressFlag    `arg:"" name:"target" help:"target address" required:""`
+	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	target   base.Address
+}
+
+func NewCancelRecoveryCommand() CancelRecoveryCommand {
+	return CancelRecoveryCommand{
+		BaseCommand: NewBaseCommand("cancel-recovery-operation"),
+	}
+}
+
+func (cmd *CancelRecoveryCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *CancelRecoveryCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Target.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid target format, %q: %w", cmd.Target.String(), err)
+	} else {
+		cmd.target = a
+	}
+
+	return nil
+}
+
+func (cmd *CancelRecoveryCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewCancelRecoveryFact([]byte(cmd.Token), cmd.target, cmd.Currency.CID)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, []byte(cmd.NetworkID)); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewCancelRecovery(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create cancel-recovery operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
@@ -0,0 +1,85 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type CancelStandingOrderCommand struct {
+	*BaseCommand
+	OperationFlags
+	Payer AddressFlag `arg:"" name:"payer" help:"payer(payer of standing order) address" required:""`
+	Order HashFlag    `arg:"" name:"order" help:"standing order id" required:""`
+	payer base.Address
+}
+
+func NewCancelStandingOrderCommand() CancelStandingOrderCommand {
+	return CancelStandingOrderCommand{
+		BaseCommand: NewBaseCommand("cancel-standing-order-operation"),
+	}
+}
+
+func (cmd *CancelStandingOrderCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *CancelStandingOrderCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Payer.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid payer format, %q: %w", cmd.Payer.String(), err)
+	} else {
+		cmd.payer = a
+	}
+
+	return nil
+}
+
+func (cmd *CancelStandingOrderCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewCancelStandingOrderFact([]byte(cmd.Token), cmd.payer, cmd.Order.HS)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.Bytes()); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewCancelStandingOrder(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create cancel-standing-order operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
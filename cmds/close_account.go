@@ -0,0 +1,92 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type CloseAccountCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender      AddressFlag `arg:"" name:"sender" help:"sender address" required:""`
+	Beneficiary AddressFlag `arg:"" name:"beneficiary" help:"beneficiary address" required:""`
+	sender      base.Address
+	beneficiary base.Address
+}
+
+func NewCloseAccountCommand() CloseAccountCommand {
+	return CloseAccountCommand{
+		BaseCommand: NewBaseCommand("close-account-operation"),
+	}
+}
+
+func (cmd *CloseAccountCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *CloseAccountCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Beneficiary.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid beneficiary format, %q: %w", cmd.Beneficiary.String(), err)
+	} else {
+		cmd.beneficiary = a
+	}
+
+	return nil
+}
+
+func (cmd *CloseAccountCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewCloseAccountFact([]byte(cmd.Token), cmd.sender, cmd.beneficiary)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, []byte(cmd.NetworkID)); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewCloseAccount(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create close-account operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
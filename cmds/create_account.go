@@ -17,14 +17,19 @@ import (
 type CreateAccountCommand struct {
 	*BaseCommand
 	OperationFlags
-	Sender    AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
-	Currency  CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
-	Big       BigFlag        `arg:"" name:"big" help:"big to send" required:""`
-	Threshold uint           `help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
-	Keys      []KeyFlag      `name:"key" help:"key for new account (ex: \"<public key>,<weight>\")" sep:"@"`
-	Seal      FileLoad       `help:"seal" optional:""`
-	sender    base.Address
-	keys      currency.Keys
+	Sender      AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
+	Currency    CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Big         BigFlag        `arg:"" name:"big" help:"big to send" required:""`
+	Threshold   uint           `help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
+	Keys        []KeyFlag      `name:"key" help:"key for new account (ex: \"<public key>,<weight>\")" sep:"@"`
+	Seal        FileLoad       `help:"seal" optional:""`
+	FeePayer    AddressFlag    `name:"fee-payer" help:"fee payer address" optional:""`
+	FeeCurrency CurrencyIDFlag `name:"fee-currency" help:"currency id for paying fee" optional:""`
+	Sequence    uint64         `help:"sequence of sender" required:""`
+	Until       int64          `name:"valid-until" help:"last height to process operation" optional:""`
+	sender      base.Address
+	keys        currency.Keys
+	feePayer    base.Address
 }
 
 func NewCreateAccountCommand() CreateAccountCommand {
@@ -74,6 +79,12 @@ func (cmd *CreateAccountCommand) parseFlags() error {
 		cmd.sender = a
 	}
 
+	if a, err := cmd.FeePayer.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid fee payer format, %q: %w", cmd.FeePayer.String(), err)
+	} else {
+		cmd.feePayer = a
+	}
+
 	if len(cmd.Keys) < 1 {
 		return xerrors.Errorf("--key must be given at least one")
 	}
@@ -122,14 +133,17 @@ func (cmd *CreateAccountCommand) createOperation() (operation.Operation, error)
 		return nil, err
 	}
 
-	item := currency.NewCreateAccountsItemSingleAmount(cmd.keys, am)
+	item := currency.NewCreateAccountsItemSingleAmount(cmd.keys, am).SetFeeCurrency(cmd.FeeCurrency.CID)
 	if err := item.IsValid(nil); err != nil {
 		return nil, err
 	} else {
 		items = append(items, item)
 	}
 
-	fact := currency.NewCreateAccountsFact([]byte(cmd.Token), cmd.sender, items)
+	fact := currency.NewCreateAccountsFact([]byte(cmd.Token), cmd.sender, items).
+		SetFeePayer(cmd.feePayer).
+		SetSequence(cmd.Sequence).
+		SetValidUntil(base.Height(cmd.Until))
 
 	var fs []operation.FactSign
 	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, []byte(cmd.NetworkID)); err != nil {
@@ -0,0 +1,108 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type CreateStandingOrderCommand struct {
+	*BaseCommand
+	OperationFlags
+	Payer       AddressFlag    `arg:"" name:"payer" help:"payer address" required:""`
+	Payee       AddressFlag    `arg:"" name:"payee" help:"payee address" required:""`
+	Currency    CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Big         BigFlag        `arg:"" name:"big" help:"big to pay at every round" required:""`
+	Interval    int64          `arg:"" name:"interval" help:"interval of rounds in height" required:""`
+	Repetitions uint           `arg:"" name:"repetitions" help:"number of rounds" required:""`
+	payer       base.Address
+	payee       base.Address
+}
+
+func NewCreateStandingOrderCommand() CreateStandingOrderCommand {
+	return CreateStandingOrderCommand{
+		BaseCommand: NewBaseCommand("create-standing-order-operation"),
+	}
+}
+
+func (cmd *CreateStandingOrderCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *CreateStandingOrderCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Payer.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid payer format, %q: %w", cmd.Payer.String(), err)
+	} else {
+		cmd.payer = a
+	}
+
+	if a, err := cmd.Payee.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid payee format, %q: %w", cmd.Payee.String(), err)
+	} else {
+		cmd.payee = a
+	}
+
+	return nil
+}
+
+func (cmd *CreateStandingOrderCommand) createOperation() (operation.Operation, error) {
+	am := currency.NewAmount(cmd.Big.Big, cmd.Currency.CID)
+	if err := am.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewCreateStandingOrderFact(
+		[]byte(cmd.Token),
+		cmd.payer,
+		cmd.payee,
+		am,
+		base.Height(cmd.Interval),
+		cmd.Repetitions,
+	)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.Bytes()); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewCreateStandingOrder(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create create-standing-order operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
@@ -0,0 +1,133 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type CreateVestingAccountCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender    AddressFlag          `arg:"" name:"sender" help:"sender address" required:""`
+	Currency  CurrencyIDFlag       `arg:"" name:"currency" help:"currency id" required:""`
+	Big       BigFlag              `arg:"" name:"big" help:"big to send" required:""`
+	Threshold uint                 `help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
+	Keys      []KeyFlag            `name:"key" help:"key for new account (ex: \"<public key>,<weight>\")" sep:"@"`
+	Releases  []VestingReleaseFlag `name:"release" help:"release of locked big (ex: \"<height>,<big>\")" sep:"@"`
+	Seal      FileLoad             `help:"seal" optional:""`
+	sender    base.Address
+	keys      currency.Keys
+}
+
+func NewCreateVestingAccountCommand() CreateVestingAccountCommand {
+	return CreateVestingAccountCommand{
+		BaseCommand: NewBaseCommand("create-vesting-account-operation"),
+	}
+}
+
+func (cmd *CreateVestingAccountCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if sl, err := loadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.Bytes(),
+		op,
+	); err != nil {
+		return err
+	} else {
+		cmd.pretty(cmd.Pretty, sl)
+	}
+
+	return nil
+}
+
+func (cmd *CreateVestingAccountCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
+	} else {
+		cmd.sender = a
+	}
+
+	if len(cmd.Keys) < 1 {
+		return xerrors.Errorf("--key must be given at least one")
+	}
+
+	if len(cmd.Releases) < 1 {
+		return xerrors.Errorf("--release must be given at least one")
+	}
+
+	{
+		ks := make([]currency.Key, len(cmd.Keys))
+		for i := range cmd.Keys {
+			ks[i] = cmd.Keys[i].Key
+		}
+
+		if kys, err := currency.NewKeys(ks, cmd.Threshold); err != nil {
+			return err
+		} else if err := kys.IsValid(nil); err != nil {
+			return err
+		} else {
+			cmd.keys = kys
+		}
+	}
+
+	return nil
+}
+
+func (cmd *CreateVestingAccountCommand) createOperation() (operation.Operation, error) {
+	releases := make([]currency.VestingRelease, len(cmd.Releases))
+	for i := range cmd.Releases {
+		releases[i] = cmd.Releases[i].Release
+	}
+
+	item := currency.NewCreateVestingAccountsItem(
+		cmd.keys,
+		currency.NewAmount(cmd.Big.Big, cmd.Currency.CID),
+		releases,
+	)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewCreateVestingAccountsFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		[]currency.CreateVestingAccountsItem{item},
+	)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, []byte(cmd.NetworkID)); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewCreateVestingAccounts(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create create-vesting-account operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
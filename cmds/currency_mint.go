@@ -0,0 +1,127 @@
+package cmds
+
+import (
+	"bytes"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/seal"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type CurrencyMintCommand struct {
+	*BaseCommand
+	OperationFlags
+	Receiver AddressFlag    `arg:"" name:"receiver" help:"receiver address" required:""`
+	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Big      BigFlag        `arg:"" name:"big" help:"big to mint" required:""`
+	Seal     FileLoad       `help:"seal" optional:""`
+	receiver base.Address
+}
+
+func NewCurrencyMintCommand() CurrencyMintCommand {
+	return CurrencyMintCommand{
+		BaseCommand: NewBaseCommand("currency-mint-operation"),
+	}
+}
+
+func (cmd *CurrencyMintCommand) Run(version util.Version) error {
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if i, err := cmd.createOperation(); err != nil {
+		return xerrors.Errorf("failed to create currency-mint operation: %w", err)
+	} else if err := i.IsValid([]byte(cmd.OperationFlags.NetworkID)); err != nil {
+		return xerrors.Errorf("invalid currency-mint operation: %w", err)
+	} else {
+		cmd.Log().Debug().Interface("operation", i).Msg("operation loaded")
+
+		op = i
+	}
+
+	if sl, err := loadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.Bytes(),
+		op,
+	); err != nil {
+		return err
+	} else {
+		cmd.pretty(cmd.Pretty, sl)
+	}
+
+	return nil
+}
+
+func (cmd *CurrencyMintCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if receiver, err := cmd.Receiver.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid receiver format, %q: %w", cmd.Receiver.String(), err)
+	} else {
+		cmd.receiver = receiver
+	}
+
+	return nil
+}
+
+func (cmd *CurrencyMintCommand) createOperation() (operation.Operation, error) {
+	var items []currency.MintItem
+	if len(bytes.TrimSpace(cmd.Seal.Bytes())) > 0 {
+		var sl seal.Seal
+		if s, err := loadSeal(cmd.Seal.Bytes(), cmd.NetworkID.Bytes()); err != nil {
+			return nil, err
+		} else if so, ok := s.(operation.Seal); !ok {
+			return nil, xerrors.Errorf("seal is not operation.Seal, %T", s)
+		} else if _, ok := so.(operation.SealUpdater); !ok {
+			return nil, xerrors.Errorf("seal is not operation.SealUpdater, %T", s)
+		} else {
+			sl = so
+		}
+
+		for _, op := range sl.(operation.Seal).Operations() {
+			if t, ok := op.(currency.CurrencyMint); ok {
+				items = t.Fact().(currency.CurrencyMintFact).Items()
+			}
+		}
+	}
+
+	am := currency.NewAmount(cmd.Big.Big, cmd.Currency.CID)
+	if err := am.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	item := currency.NewMintItem(cmd.receiver, am)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	} else {
+		items = append(items, item)
+	}
+
+	fact := currency.NewCurrencyMintFact([]byte(cmd.Token), items)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.Bytes()); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewCurrencyMint(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create currency-mint operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
@@ -91,8 +91,9 @@ func (cmd *CurrencyPolicyUpdaterCommand) parseFlags() error {
 		return err
 	}
 
-	po := currency.NewCurrencyPolicy(cmd.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
-	if err := po.IsValid(nil); err != nil {
+	if po, err := cmd.CurrencyPolicyFlags.policy(feeer); err != nil {
+		return err
+	} else if err := po.IsValid(nil); err != nil {
 		return err
 	} else {
 		cmd.po = po
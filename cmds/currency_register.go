@@ -1,10 +1,14 @@
 package cmds
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
 	"golang.org/x/xerrors"
 )
 
@@ -71,13 +75,142 @@ func (fl *CurrencyRatioFeeerFlags) IsValid([]byte) error {
 }
 
 type CurrencyPolicyFlags struct {
-	NewAccountMinBalance BigFlag `name:"new-account-min-balance" help:"minimum balance for new account"` // nolint lll
+	NewAccountMinBalance BigFlag           `name:"new-account-min-balance" help:"minimum balance for new account"` // nolint lll
+	MaxSupply            BigFlag           `name:"max-supply" help:"maximum supply; empty or 0 means no limit" optional:""`
+	OperationFeeers      map[string]string `name:"operation-feeer" help:"feeer by operation, <operation>=nil|fixed,<amount>|ratio,<ratio>,<min>[,<max>]; operation, {transfers, create-accounts, key-updater, burn}" optional:""` // nolint lll
+	FeeShares            []string          `name:"fee-share" help:"fee share, <receiver address>=<weight>; the remainder goes to the first" optional:""`                                                                          // nolint lll
+	FeeRates             map[string]string `name:"fee-rate" help:"fee conversion rate, <currency id>=<rate>; fee in <currency id> is fee * <rate>" optional:""`                                                                   // nolint lll
+	TransferRestriction  string            `name:"transfer-restriction" help:"transfer restriction, {open, allowlist, denylist}" optional:""`                                                                                     // nolint lll
 }
 
 func (fl *CurrencyPolicyFlags) IsValid([]byte) error {
+	if len(fl.TransferRestriction) > 0 {
+		if err := currency.TransferRestriction(fl.TransferRestriction).IsValid(nil); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func (fl *CurrencyPolicyFlags) policy(feeer currency.Feeer) (currency.CurrencyPolicy, error) {
+	po := currency.NewCurrencyPolicy(fl.NewAccountMinBalance.Big, feeer)
+	if fl.MaxSupply.Int != nil {
+		po = po.SetMaxSupply(fl.MaxSupply.Big)
+	}
+
+	if len(fl.TransferRestriction) > 0 {
+		po = po.SetTransferRestriction(currency.TransferRestriction(fl.TransferRestriction))
+	}
+
+	if len(fl.FeeShares) > 0 {
+		shares := make([]currency.FeeShare, len(fl.FeeShares))
+		for i := range fl.FeeShares {
+			if sh, err := parseFeeShare(fl.FeeShares[i]); err != nil {
+				return currency.CurrencyPolicy{}, err
+			} else {
+				shares[i] = sh
+			}
+		}
+
+		po = po.SetFeeShares(shares)
+	}
+
+	if len(fl.FeeRates) > 0 {
+		rates := map[currency.CurrencyID]float64{}
+		for cid := range fl.FeeRates {
+			if r, err := strconv.ParseFloat(strings.TrimSpace(fl.FeeRates[cid]), 64); err != nil {
+				return currency.CurrencyPolicy{}, xerrors.Errorf("invalid fee rate of %q: %w", cid, err)
+			} else {
+				rates[currency.CurrencyID(cid)] = r
+			}
+		}
+
+		po = po.SetFeeRates(rates)
+	}
+
+	if len(fl.OperationFeeers) < 1 {
+		return po, nil
+	}
+
+	ofs := map[hint.Type]currency.Feeer{}
+	for name := range fl.OperationFeeers {
+		if t, found := operationFeeerTypes[name]; !found {
+			return currency.CurrencyPolicy{}, xerrors.Errorf("unknown operation, %q for operation feeer", name)
+		} else if j, err := parseOperationFeeer(fl.OperationFeeers[name], feeer.Receiver()); err != nil {
+			return currency.CurrencyPolicy{}, xerrors.Errorf("invalid feeer for operation, %q: %w", name, err)
+		} else {
+			ofs[t] = j
+		}
+	}
+
+	return po.SetOperationFeeers(ofs), nil
+}
+
+func parseFeeShare(s string) (currency.FeeShare, error) {
+	l := strings.SplitN(s, "=", 2)
+	if len(l) != 2 {
+		return currency.FeeShare{}, xerrors.Errorf("invalid fee share, %q", s)
+	}
+
+	var receiver base.Address
+	var af AddressFlag
+	if err := af.UnmarshalText([]byte(strings.TrimSpace(l[0]))); err != nil {
+		return currency.FeeShare{}, xerrors.Errorf("invalid receiver format of fee share, %q: %w", s, err)
+	} else if a, err := af.Encode(jenc); err != nil {
+		return currency.FeeShare{}, xerrors.Errorf("invalid receiver format of fee share, %q: %w", s, err)
+	} else {
+		receiver = a
+	}
+
+	if w, err := strconv.ParseUint(strings.TrimSpace(l[1]), 10, 64); err != nil {
+		return currency.FeeShare{}, xerrors.Errorf("invalid weight of fee share, %q: %w", s, err)
+	} else {
+		return currency.NewFeeShare(receiver, uint(w)), nil
+	}
+}
+
+// parseOperationFeeer parses the operation feeer flag value; the receiver of
+// operation feeer is same with the receiver of feeer.
+func parseOperationFeeer(s string, receiver base.Address) (currency.Feeer, error) {
+	l := strings.Split(s, ",")
+	switch t := strings.TrimSpace(l[0]); {
+	case t == currency.FeeerNil:
+		return currency.NewNilFeeer(), nil
+	case receiver == nil:
+		return nil, xerrors.Errorf("operation feeer needs the receiver of feeer")
+	case t == currency.FeeerFixed && len(l) == 2:
+		if amount, err := currency.NewBigFromString(strings.TrimSpace(l[1])); err != nil {
+			return nil, err
+		} else {
+			return currency.NewFixedFeeer(receiver, amount), nil
+		}
+	case t == currency.FeeerRatio && (len(l) == 3 || len(l) == 4):
+		ratio, err := strconv.ParseFloat(strings.TrimSpace(l[1]), 64)
+		if err != nil {
+			return nil, err
+		}
+
+		min, err := currency.NewBigFromString(strings.TrimSpace(l[2]))
+		if err != nil {
+			return nil, err
+		}
+
+		max := currency.UnlimitedMaxFeeAmount
+		if len(l) == 4 {
+			if i, err := currency.NewBigFromString(strings.TrimSpace(l[3])); err != nil {
+				return nil, err
+			} else {
+				max = i
+			}
+		}
+
+		return currency.NewRatioFeeer(receiver, ratio, min, max), nil
+	default:
+		return nil, xerrors.Errorf("invalid operation feeer, %q", s)
+	}
+}
+
 type CurrencyDesignFlags struct {
 	Currency                CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:""`
 	GenesisAmount           BigFlag        `arg:"" name:"genesis-amount" help:"genesis amount" required:""`
@@ -116,8 +249,10 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		return err
 	}
 
-	po := currency.NewCurrencyPolicy(fl.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
-	if err := po.IsValid(nil); err != nil {
+	po, err := fl.CurrencyPolicyFlags.policy(feeer)
+	if err != nil {
+		return err
+	} else if err := po.IsValid(nil); err != nil {
 		return err
 	}
 
@@ -14,6 +14,7 @@ import (
 	yamlconfig "github.com/spikeekips/mitum/launch/config/yaml"
 	"github.com/spikeekips/mitum/util/encoder"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
 
 	"github.com/spikeekips/mitum-currency/currency"
 )
@@ -113,12 +114,20 @@ func (de *GenesisCurrenciesDesign) IsValid([]byte) error {
 }
 
 type CurrencyDesign struct {
-	CurrencyString             *string         `yaml:"currency"`
-	BalanceString              *string         `yaml:"balance"`
-	NewAccountMinBalanceString *string         `yaml:"new-account-min-balance"`
-	Feeer                      *FeeerDesign    `yaml:"feeer"`
-	Balance                    currency.Amount `yaml:"-"`
-	NewAccountMinBalance       currency.Big    `yaml:"-"`
+	CurrencyString             *string                         `yaml:"currency"`
+	BalanceString              *string                         `yaml:"balance"`
+	NewAccountMinBalanceString *string                         `yaml:"new-account-min-balance"`
+	MaxSupplyString            *string                         `yaml:"max-supply"`
+	Feeer                      *FeeerDesign                    `yaml:"feeer"`
+	OperationFeeersYAML        map[string]*FeeerDesign         `yaml:"operation-feeers"`
+	FeeRatesYAML               map[string]float64              `yaml:"fee-rates"`
+	TransferRestrictionString  *string                         `yaml:"transfer-restriction"`
+	Balance                    currency.Amount                 `yaml:"-"`
+	NewAccountMinBalance       currency.Big                    `yaml:"-"`
+	MaxSupply                  currency.Big                    `yaml:"-"`
+	OperationFeeers            map[hint.Type]*FeeerDesign      `yaml:"-"`
+	FeeRates                   map[currency.CurrencyID]float64 `yaml:"-"`
+	TransferRestriction        currency.TransferRestriction    `yaml:"-"`
 }
 
 func (de *CurrencyDesign) IsValid([]byte) error {
@@ -153,15 +162,97 @@ func (de *CurrencyDesign) IsValid([]byte) error {
 		}
 	}
 
+	if de.MaxSupplyString == nil {
+		de.MaxSupply = currency.ZeroBig
+	} else {
+		if b, err := currency.NewBigFromString(*de.MaxSupplyString); err != nil {
+			return err
+		} else if !b.OverNil() {
+			return xerrors.Errorf("max-supply under zero")
+		} else {
+			de.MaxSupply = b
+		}
+	}
+
 	if de.Feeer == nil {
 		de.Feeer = &FeeerDesign{}
 	} else if err := de.Feeer.IsValid(nil); err != nil {
 		return err
 	}
 
+	de.OperationFeeers = map[hint.Type]*FeeerDesign{}
+	for name := range de.OperationFeeersYAML {
+		var t hint.Type
+		if i, found := operationFeeerTypes[name]; !found {
+			return xerrors.Errorf("unknown operation, %q for operation feeer", name)
+		} else {
+			t = i
+		}
+
+		fd := de.OperationFeeersYAML[name]
+		if fd == nil {
+			return xerrors.Errorf("empty feeer for operation, %q", name)
+		} else if err := fd.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid feeer for operation, %q: %w", name, err)
+		}
+
+		de.OperationFeeers[t] = fd
+	}
+
+	de.FeeRates = map[currency.CurrencyID]float64{}
+	for i := range de.FeeRatesYAML {
+		fcid := currency.CurrencyID(i)
+		if err := fcid.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid currency of fee rate, %q: %w", i, err)
+		} else if fcid == cid {
+			return xerrors.Errorf("fee rate of own currency, %q", i)
+		}
+
+		de.FeeRates[fcid] = de.FeeRatesYAML[i]
+	}
+
+	if de.TransferRestrictionString == nil {
+		de.TransferRestriction = currency.TransferRestrictionOpen
+	} else {
+		tr := currency.TransferRestriction(*de.TransferRestrictionString)
+		if err := tr.IsValid(nil); err != nil {
+			return err
+		}
+
+		de.TransferRestriction = tr
+	}
+
 	return nil
 }
 
+// operationFeeerTypes is the operation names, which can have it's own feeer in
+// currency policy.
+var operationFeeerTypes = map[string]hint.Type{
+	"transfers":               currency.TransfersType,
+	"create-accounts":         currency.CreateAccountsType,
+	"key-updater":             currency.KeyUpdaterType,
+	"burn":                    currency.BurnType,
+	"approve":                 currency.ApproveType,
+	"transfer-from":           currency.TransferFromType,
+	"lock-transfer":           currency.LockTransferType,
+	"claim-transfer":          currency.ClaimTransferType,
+	"refund-transfer":         currency.RefundTransferType,
+	"create-vesting-accounts": currency.CreateVestingAccountsType,
+	"recovery-updater":        currency.RecoveryUpdaterType,
+	"recover-account":         currency.RecoverAccountType,
+	"cancel-recovery":         currency.CancelRecoveryType,
+	"register-alias":          currency.RegisterAliasType,
+	"transfer-alias":          currency.TransferAliasType,
+	"release-alias":           currency.ReleaseAliasType,
+	"close-account":           currency.CloseAccountType,
+	"exchange":                currency.ExchangeType,
+	"set-spending-limit":      currency.SetSpendingLimitType,
+	"schedule-transfer":       currency.ScheduleTransferType,
+	"cancel-schedule":         currency.CancelScheduledTransferType,
+	"create-standing-order":   currency.CreateStandingOrderType,
+	"cancel-standing-order":   currency.CancelStandingOrderType,
+}
+
 // FeeerDesign is used for genesis currencies and naturally it's receiver is genesis account
 type FeeerDesign struct {
 	Type   string
@@ -179,6 +270,10 @@ func (no *FeeerDesign) IsValid([]byte) error {
 		if err := no.checkRatio(no.Extras); err != nil {
 			return err
 		}
+	case currency.FeeerTiered:
+		if err := no.checkTiered(no.Extras); err != nil {
+			return err
+		}
 	default:
 		return xerrors.Errorf("unknown type of feeer, %v", t)
 	}
@@ -228,6 +323,69 @@ func (no FeeerDesign) checkRatio(c map[string]interface{}) error {
 	return nil
 }
 
+func (no FeeerDesign) checkTiered(c map[string]interface{}) error {
+	var l []interface{}
+	if a, found := c["tiers"]; !found {
+		return xerrors.Errorf("tiered needs `tiers`")
+	} else if i, ok := a.([]interface{}); !ok {
+		return xerrors.Errorf("invalid tiers value type, %T of tiered; should be list", a)
+	} else {
+		l = i
+	}
+
+	tiers := make([]currency.FeeTier, len(l))
+	for i := range l {
+		if t, err := no.checkTier(l[i]); err != nil {
+			return xerrors.Errorf("invalid tier, %d of tiered: %w", i, err)
+		} else {
+			tiers[i] = t
+		}
+	}
+
+	no.Extras["tiered_tiers"] = tiers
+
+	return nil
+}
+
+func (no FeeerDesign) checkTier(a interface{}) (currency.FeeTier, error) {
+	c, ok := a.(map[string]interface{})
+	if !ok {
+		return currency.FeeTier{}, xerrors.Errorf("invalid tier value type, %T; should be map", a)
+	}
+
+	max := currency.UnlimitedMaxFeeAmount
+	if a, found := c["max"]; found {
+		if n, err := currency.NewBigFromInterface(a); err != nil {
+			return currency.FeeTier{}, xerrors.Errorf("invalid max value, %v: %w", a, err)
+		} else {
+			max = n
+		}
+	}
+
+	fixed := currency.ZeroBig
+	if a, found := c["fixed"]; found {
+		if n, err := currency.NewBigFromInterface(a); err != nil {
+			return currency.FeeTier{}, xerrors.Errorf("invalid fixed value, %v: %w", a, err)
+		} else {
+			fixed = n
+		}
+	}
+
+	var ratio float64
+	if a, found := c["ratio"]; found {
+		switch f := a.(type) {
+		case float64:
+			ratio = f
+		case int:
+			ratio = float64(f)
+		default:
+			return currency.FeeTier{}, xerrors.Errorf("invalid ratio value type, %T; should be float64", a)
+		}
+	}
+
+	return currency.NewFeeTier(max, fixed, ratio), nil
+}
+
 type DigestDesign struct {
 	NetworkYAML     *yamlconfig.LocalNetwork `yaml:"network,omitempty"`
 	CacheYAML       *string                  `yaml:"cache,omitempty"`
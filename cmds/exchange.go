@@ -0,0 +1,108 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type ExchangeCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender          AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
+	Counterparty    AddressFlag    `arg:"" name:"counterparty" help:"counterparty address" required:""`
+	Currency        CurrencyIDFlag `arg:"" name:"currency" help:"currency id of sender" required:""`
+	Big             BigFlag        `arg:"" name:"big" help:"big to send to counterparty" required:""`
+	CounterCurrency CurrencyIDFlag `arg:"" name:"counter-currency" help:"currency id of counterparty" required:""`
+	CounterBig      BigFlag        `arg:"" name:"counter-big" help:"big to receive from counterparty" required:""`
+	sender          base.Address
+	counterparty    base.Address
+}
+
+func NewExchangeCommand() ExchangeCommand {
+	return ExchangeCommand{
+		BaseCommand: NewBaseCommand("exchange-operation"),
+	}
+}
+
+func (cmd *ExchangeCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *ExchangeCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Counterparty.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid counterparty format, %q: %w", cmd.Counterparty.String(), err)
+	} else {
+		cmd.counterparty = a
+	}
+
+	return nil
+}
+
+// createOperation creates Exchange, which is signed only by the given
+// privatekey; the counterparty should add it's sign by "sign-fact".
+func (cmd *ExchangeCommand) createOperation() (operation.Operation, error) {
+	am := currency.NewAmount(cmd.Big.Big, cmd.Currency.CID)
+	if err := am.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	cam := currency.NewAmount(cmd.CounterBig.Big, cmd.CounterCurrency.CID)
+	if err := cam.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewExchangeFact([]byte(cmd.Token), cmd.sender, cmd.counterparty, am, cam)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.Bytes()); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewExchange(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create exchange operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
@@ -2,6 +2,7 @@ package cmds
 
 import (
 	"bytes"
+	"encoding/hex"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
 	"github.com/spikeekips/mitum/util/encoder"
 	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
 
 	"github.com/spikeekips/mitum-currency/currency"
 )
@@ -212,3 +214,72 @@ func (v *CurrencyIDFlag) UnmarshalText(b []byte) error {
 func (v *CurrencyIDFlag) String() string {
 	return v.CID.String()
 }
+
+type HashFlag struct {
+	HS valuehash.Hash
+}
+
+func (v *HashFlag) UnmarshalText(b []byte) error {
+	h := valuehash.NewBytesFromString(string(b))
+	if err := h.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid hash string, %q: %w", string(b), err)
+	} else {
+		v.HS = h
+
+		return nil
+	}
+}
+
+func (v *HashFlag) String() string {
+	return v.HS.String()
+}
+
+type HexFlag []byte
+
+func (v *HexFlag) UnmarshalText(b []byte) error {
+	if i, err := hex.DecodeString(string(b)); err != nil {
+		return xerrors.Errorf("invalid hex string, %q: %w", string(b), err)
+	} else {
+		*v = i
+
+		return nil
+	}
+}
+
+func (v HexFlag) Bytes() []byte {
+	return []byte(v)
+}
+
+type VestingReleaseFlag struct {
+	Release currency.VestingRelease
+}
+
+func (v *VestingReleaseFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 2)
+	if len(l) != 2 {
+		return xerrors.Errorf(`wrong formatted; "<int64 height>,<big>"`)
+	}
+
+	var height base.Height
+	if i, err := strconv.ParseInt(l[0], 10, 64); err != nil {
+		return xerrors.Errorf("invalid height, %q for --release: %w", l[0], err)
+	} else {
+		height = base.Height(i)
+	}
+
+	var big currency.Big
+	if i, err := currency.NewBigFromString(l[1]); err != nil {
+		return xerrors.Errorf("invalid big, %q for --release: %w", l[1], err)
+	} else {
+		big = i
+	}
+
+	vr := currency.NewVestingRelease(height, big)
+	if err := vr.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid release string: %w", err)
+	} else {
+		v.Release = vr
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type FreezeAccountCommand struct {
+	*BaseCommand
+	OperationFlags
+	Target    AddressFlag    `arg:"" name:"target" help:"target address" required:""`
+	Currency  CurrencyIDFlag `name:"currency" help:"currency id; empty means all currencies" optional:""`
+	Receiving bool           `name:"receiving" help:"also reject receiving" optional:""`
+	target    base.Address
+}
+
+func NewFreezeAccountCommand() FreezeAccountCommand {
+	return FreezeAccountCommand{
+		BaseCommand: NewBaseCommand("freeze-account-operation"),
+	}
+}
+
+func (cmd *FreezeAccountCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *FreezeAccountCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Target.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid target format, %q: %w", cmd.Target.String(), err)
+	} else {
+		cmd.target = a
+	}
+
+	return nil
+}
+
+func (cmd *FreezeAccountCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewFreezeAccountFact([]byte(cmd.Token), cmd.target, cmd.Currency.CID, cmd.Receiving)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, []byte(cmd.NetworkID)); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewFreezeAccount(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create freeze-account operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
+
+type UnfreezeAccountCommand struct {
+	*BaseCommand
+	OperationFlags
+	Target   AddressFlag    `arg:"" name:"target" help:"target address" required:""`
+	Currency CurrencyIDFlag `name:"currency" help:"currency id; empty means all currencies" optional:""`
+	target   base.Address
+}
+
+func NewUnfreezeAccountCommand() UnfreezeAccountCommand {
+	return UnfreezeAccountCommand{
+		BaseCommand: NewBaseCommand("unfreeze-account-operation"),
+	}
+}
+
+func (cmd *UnfreezeAccountCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *UnfreezeAccountCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Target.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid target format, %q: %w", cmd.Target.String(), err)
+	} else {
+		cmd.target = a
+	}
+
+	return nil
+}
+
+func (cmd *UnfreezeAccountCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewUnfreezeAccountFact([]byte(cmd.Token), cmd.target, cmd.Currency.CID)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, []byte(cmd.NetworkID)); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewUnfreezeAccount(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create unfreeze-account operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
@@ -13,21 +13,57 @@ var Hinters []hint.Hinter
 func init() {
 	currencyHinters := []hint.Hinter{
 		currency.Account{},
+		currency.AccountAlias{},
 		currency.Address(""),
+		currency.Alias(""),
+		currency.Allowance{},
 		currency.AmountState{},
 		currency.Amount{},
+		currency.ApproveFact{},
+		currency.ApproveOperationFact{},
+		currency.ApproveOperation{},
+		currency.Approve{},
+		currency.BurnFact{},
+		currency.Burn{},
+		currency.CancelRecoveryFact{},
+		currency.CancelRecovery{},
+		currency.CancelScheduledTransferFact{},
+		currency.CancelScheduledTransfer{},
+		currency.CancelStandingOrderFact{},
+		currency.CancelStandingOrder{},
+		currency.ClaimTransferFact{},
+		currency.ClaimTransfer{},
+		currency.CloseAccountFact{},
+		currency.CloseAccount{},
+		currency.Commitments{},
 		currency.CreateAccountsFact{},
 		currency.CreateAccountsItemMultiAmountsHinter,
 		currency.CreateAccountsItemSingleAmountHinter,
 		currency.CreateAccounts{},
+		currency.CreateStandingOrderFact{},
+		currency.CreateStandingOrder{},
+		currency.CreateVestingAccountsFact{},
+		currency.CreateVestingAccountsItem{},
+		currency.CreateVestingAccounts{},
+		currency.CurrencyDesignState{},
 		currency.CurrencyDesign{},
+		currency.CurrencyMintFact{},
+		currency.CurrencyMint{},
 		currency.CurrencyPolicyUpdaterFact{},
 		currency.CurrencyPolicyUpdater{},
 		currency.CurrencyPolicy{},
 		currency.CurrencyRegisterFact{},
 		currency.CurrencyRegister{},
+		currency.CurrencySupply{},
+		currency.ExchangeFact{},
+		currency.Exchange{},
+		currency.FreezeAccountFact{},
+		currency.FreezeAccount{},
+		currency.Freeze{},
 		currency.FeeOperationFact{},
 		currency.FeeOperation{},
+		currency.FeePayout{},
+		currency.FeeShare{},
 		currency.FixedFeeer{},
 		currency.GenesisCurrenciesFact{},
 		currency.GenesisCurrencies{},
@@ -35,12 +71,57 @@ func init() {
 		currency.KeyUpdater{},
 		currency.Keys{},
 		currency.Key{},
+		currency.LockTransferFact{},
+		currency.LockTransfer{},
+		currency.Lock{},
+		currency.MintItem{},
 		currency.NilFeeer{},
+		currency.Proposal{},
+		currency.ProposeOperationFact{},
+		currency.ProposeOperation{},
 		currency.RatioFeeer{},
+		currency.RecoverAccountFact{},
+		currency.RecoverAccount{},
+		currency.RecoveryConfig{},
+		currency.RecoveryUpdaterFact{},
+		currency.RecoveryUpdater{},
+		currency.Recovery{},
+		currency.RefundTransferFact{},
+		currency.RefundTransfer{},
+		currency.RegisterAliasFact{},
+		currency.RegisterAlias{},
+		currency.ReleaseAliasFact{},
+		currency.ReleaseAlias{},
+		currency.ScheduleItem{},
+		currency.ScheduleQueue{},
+		currency.ScheduleTransferFact{},
+		currency.ScheduleTransfer{},
+		currency.ScheduledTransferOperationFact{},
+		currency.ScheduledTransferOperation{},
+		currency.ScheduledTransfer{},
+		currency.Sequence{},
+		currency.SetSpendingLimitFact{},
+		currency.SetSpendingLimit{},
+		currency.SpendingLimit{},
+		currency.StandingOrderOperationFact{},
+		currency.StandingOrderOperation{},
+		currency.StandingOrder{},
+		currency.TieredFeeer{},
+		currency.TransferAliasFact{},
+		currency.TransferAlias{},
+		currency.TransferFromFact{},
+		currency.TransferFrom{},
+		currency.TransferListMember{},
+		currency.TransferListUpdaterFact{},
+		currency.TransferListUpdater{},
 		currency.TransfersFact{},
 		currency.TransfersItemMultiAmountsHinter,
 		currency.TransfersItemSingleAmountHinter,
 		currency.Transfers{},
+		currency.UnfreezeAccountFact{},
+		currency.UnfreezeAccount{},
+		currency.VestingRelease{},
+		currency.Vesting{},
 		digest.AccountValue{},
 		digest.BaseHal{},
 		digest.NodeInfo{},
@@ -137,9 +137,28 @@ func loadCurrencyDesign(de CurrencyDesign, ga base.Address) (currency.CurrencyDe
 	if j, err := loadGenesisCurrenciesFeeer(*de.Feeer, ga); err != nil {
 		return currency.CurrencyDesign{}, err
 	} else {
-		po = currency.NewCurrencyPolicy(de.NewAccountMinBalance, j)
+		po = currency.NewCurrencyPolicy(de.NewAccountMinBalance, j).SetMaxSupply(de.MaxSupply)
 	}
 
+	if len(de.OperationFeeers) > 0 {
+		ofs := map[hint.Type]currency.Feeer{}
+		for t := range de.OperationFeeers {
+			if j, err := loadGenesisCurrenciesFeeer(*de.OperationFeeers[t], ga); err != nil {
+				return currency.CurrencyDesign{}, err
+			} else {
+				ofs[t] = j
+			}
+		}
+
+		po = po.SetOperationFeeers(ofs)
+	}
+
+	if len(de.FeeRates) > 0 {
+		po = po.SetFeeRates(de.FeeRates)
+	}
+
+	po = po.SetTransferRestriction(de.TransferRestriction)
+
 	cd := currency.NewCurrencyDesign(de.Balance, nil, po)
 	if err := cd.IsValid(nil); err != nil {
 		return currency.CurrencyDesign{}, err
@@ -169,6 +188,8 @@ func loadGenesisCurrenciesFeeer(de FeeerDesign, ga base.Address) (currency.Feeer
 			de.Extras["ratio_min"].(currency.Big),
 			max,
 		)
+	case currency.FeeerTiered:
+		feeer = currency.NewTieredFeeer(ga, de.Extras["tiered_tiers"].([]currency.FeeTier))
 	default:
 		return nil, xerrors.Errorf("unknown type of feeer, %q", de.Type)
 	}
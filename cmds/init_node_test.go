@@ -55,9 +55,18 @@ currencies:
   - currency: SHOW*ME
     balance: "9999999999999999999999999999999999"
     new-account-min-balance: "33"
+    max-supply: "99999999999999999999999999999999999"
     feeer:
       type: fixed
       amount: 33
+    operation-feeers:
+      key-updater:
+        type: fixed
+        amount: 44
+      burn:
+        type: nil
+    fee-rates:
+      FEE: 0.5
 `, pub.String())
 
 	var m map[string]interface{}
@@ -82,10 +91,81 @@ currencies:
 	t.Nil(fact.Currencies()[0].GenesisAccount())
 	t.Equal("9999999999999999999999999999999999", fact.Currencies()[0].Big().String())
 
+	t.Equal("99999999999999999999999999999999999", fact.Currencies()[0].Policy().MaxSupply().String())
+
 	feeer := fact.Currencies()[0].Policy().Feeer()
 	t.Equal(currency.FixedFeeerType, feeer.Hint().Type())
 	t.Equal("33", feeer.Min().String())
 	t.True(genesisAccount.Equal(feeer.Receiver()))
+
+	ofs := fact.Currencies()[0].Policy().OperationFeeers()
+	t.Equal(2, len(ofs))
+	t.Equal("44", ofs[currency.KeyUpdaterType].Min().String())
+	t.True(genesisAccount.Equal(ofs[currency.KeyUpdaterType].Receiver()))
+	t.Equal(currency.NilFeeerType, ofs[currency.BurnType].Hint().Type())
+
+	rates := fact.Currencies()[0].Policy().FeeRates()
+	t.Equal(1, len(rates))
+	t.Equal(0.5, rates[currency.CurrencyID("FEE")])
+}
+
+func (t *testGenesisCurrencies) TestLoadTieredFeeer() {
+	encs := encoder.NewEncoders()
+	encs.AddHinter(key.BTCPrivatekeyHinter)
+	encs.AddHinter(key.BTCPublickeyHinter)
+
+	enc := jsonenc.NewEncoder()
+	encs.AddEncoder(enc)
+
+	conf := config.NewBaseLocalNode(enc, nil)
+
+	pub := key.MustNewBTCPrivatekey().Publickey()
+
+	t.NoError(conf.SetPrivatekey(key.MustNewBTCPrivatekey().String()))
+	t.NoError(conf.SetNetworkID("Fri 29 Jan 2001 12:00:02 AM KST"))
+
+	ctx := context.WithValue(context.Background(), config.ContextValueConfig, conf)
+
+	y := fmt.Sprintf(`
+account-keys:
+  keys:
+    - publickey: %s
+      weight: 100
+  threshold: 100
+
+currencies:
+  - currency: SHOW*ME
+    balance: "9999999999999999999999999999999999"
+    new-account-min-balance: "33"
+    feeer:
+      type: tiered
+      tiers:
+        - max: 1000
+          fixed: 1
+        - max: 100000
+          ratio: 0.001
+        - fixed: 100
+`, pub.String())
+
+	var m map[string]interface{}
+	t.NoError(yaml.Unmarshal([]byte(y), &m))
+
+	op, err := genesisOperationsHandlerGenesisCurrencies(ctx, m)
+	t.NoError(err)
+	t.NoError(op.IsValid(conf.NetworkID()))
+
+	fact := op.Fact().(currency.GenesisCurrenciesFact)
+
+	feeer := fact.Currencies()[0].Policy().Feeer()
+	t.Equal(currency.TieredFeeerType, feeer.Hint().Type())
+
+	tiers := feeer.(currency.TieredFeeer).Tiers()
+	t.Equal(3, len(tiers))
+	t.Equal("1000", tiers[0].Max().String())
+	t.Equal("1", tiers[0].Fixed().String())
+	t.Equal(0.001, tiers[1].Ratio())
+	t.True(tiers[2].IsUnlimited())
+	t.Equal("100", tiers[2].Fixed().String())
 }
 
 func TestGenesisCurrencies(t *testing.T) {
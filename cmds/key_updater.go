@@ -17,8 +17,12 @@ type KeyUpdaterCommand struct {
 	Currency  CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
 	Threshold uint           `help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
 	Keys      []KeyFlag      `name:"key" help:"key for account (ex: \"<public key>,<weight>\")" sep:"@"`
+	FeePayer  AddressFlag    `name:"fee-payer" help:"fee payer address" optional:""`
+	Sequence  uint64         `help:"sequence of target" required:""`
+	Until     int64          `name:"valid-until" help:"last height to process operation" optional:""`
 	target    base.Address
 	keys      currency.Keys
+	feePayer  base.Address
 }
 
 func NewKeyUpdaterCommand() KeyUpdaterCommand {
@@ -71,6 +75,12 @@ func (cmd *KeyUpdaterCommand) parseFlags() error {
 		cmd.target = a
 	}
 
+	if a, err := cmd.FeePayer.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid fee payer format, %q: %w", cmd.FeePayer.String(), err)
+	} else {
+		cmd.feePayer = a
+	}
+
 	{
 		ks := make([]currency.Key, len(cmd.Keys))
 		for i := range cmd.Keys {
@@ -95,7 +105,9 @@ func (cmd *KeyUpdaterCommand) createOperation() (operation.Operation, error) {
 		cmd.target,
 		cmd.keys,
 		cmd.Currency.CID,
-	)
+	).SetFeePayer(cmd.feePayer).
+		SetSequence(cmd.Sequence).
+		SetValidUntil(base.Height(cmd.Until))
 
 	var fs []operation.FactSign
 	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, []byte(cmd.NetworkID)); err != nil {
@@ -0,0 +1,98 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type ProposeOperationCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender AddressFlag `arg:"" name:"sender" help:"sender(account of proposed operation) address" required:""`
+	Seal   FileLoad    `help:"seal of operation to propose" required:""`
+	sender base.Address
+	fact   operation.OperationFact
+}
+
+func NewProposeOperationCommand() ProposeOperationCommand {
+	return ProposeOperationCommand{
+		BaseCommand: NewBaseCommand("propose-operation-operation"),
+	}
+}
+
+func (cmd *ProposeOperationCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *ProposeOperationCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
+	} else {
+		cmd.sender = a
+	}
+
+	if s, err := loadSeal(cmd.Seal.Bytes(), cmd.NetworkID.Bytes()); err != nil {
+		return err
+	} else if sl, ok := s.(operation.Seal); !ok {
+		return xerrors.Errorf("seal is not operation.Seal, %T", s)
+	} else if len(sl.Operations()) != 1 {
+		return xerrors.Errorf("seal should have only one operation, not %d", len(sl.Operations()))
+	} else if fact, ok := sl.Operations()[0].Fact().(operation.OperationFact); !ok {
+		return xerrors.Errorf("fact is not operation.OperationFact, %T", sl.Operations()[0].Fact())
+	} else {
+		cmd.fact = fact
+	}
+
+	return nil
+}
+
+func (cmd *ProposeOperationCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewProposeOperationFact([]byte(cmd.Token), cmd.sender, cmd.fact)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.Bytes()); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewProposeOperation(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create propose-operation operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
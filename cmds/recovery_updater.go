@@ -0,0 +1,113 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type RecoveryUpdaterCommand struct {
+	*BaseCommand
+	OperationFlags
+	Target    AddressFlag    `arg:"" name:"target" help:"target address" required:""`
+	Currency  CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Guardians []AddressFlag  `name:"guardian" help:"guardian address" sep:"@"`
+	Quorum    uint           `help:"number of guardians to recover account" default:"1"`
+	Delay     uint64         `help:"delay in blocks until recovered" default:"0"`
+	target    base.Address
+	config    currency.RecoveryConfig
+}
+
+func NewRecoveryUpdaterCommand() RecoveryUpdaterCommand {
+	return RecoveryUpdaterCommand{
+		BaseCommand: NewBaseCommand("recovery-updater-operation"),
+	}
+}
+
+func (cmd *RecoveryUpdaterCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *RecoveryUpdaterCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(cmd.Guardians) < 1 {
+		return xerrors.Errorf("--guardian must be given at least one")
+	}
+
+	if a, err := cmd.Target.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid target format, %q: %w", cmd.Target.String(), err)
+	} else {
+		cmd.target = a
+	}
+
+	gs := make([]base.Address, len(cmd.Guardians))
+	for i := range cmd.Guardians {
+		if a, err := cmd.Guardians[i].Encode(jenc); err != nil {
+			return xerrors.Errorf("invalid guardian format, %q: %w", cmd.Guardians[i].String(), err)
+		} else {
+			gs[i] = a
+		}
+	}
+
+	config := currency.NewRecoveryConfig(gs, cmd.Quorum, base.Height(cmd.Delay))
+	if err := config.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.config = config
+
+	return nil
+}
+
+func (cmd *RecoveryUpdaterCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewRecoveryUpdaterFact(
+		[]byte(cmd.Token),
+		cmd.target,
+		cmd.config,
+		cmd.Currency.CID,
+	)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, []byte(cmd.NetworkID)); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewRecoveryUpdater(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create recovery-updater operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
@@ -0,0 +1,85 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type RefundTransferCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender AddressFlag `arg:"" name:"sender" help:"sender(sender of lock) address" required:""`
+	Lock   HashFlag    `arg:"" name:"lock" help:"lock id" required:""`
+	sender base.Address
+}
+
+func NewRefundTransferCommand() RefundTransferCommand {
+	return RefundTransferCommand{
+		BaseCommand: NewBaseCommand("refund-transfer-operation"),
+	}
+}
+
+func (cmd *RefundTransferCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *RefundTransferCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
+	} else {
+		cmd.sender = a
+	}
+
+	return nil
+}
+
+func (cmd *RefundTransferCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewRefundTransferFact([]byte(cmd.Token), cmd.sender, cmd.Lock.HS)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.Bytes()); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewRefundTransfer(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create refund-transfer operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
@@ -0,0 +1,94 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type ReleaseAliasCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
+	Alias    string         `arg:"" name:"alias" help:"alias" required:""`
+	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	sender   base.Address
+	alias    currency.Alias
+}
+
+func NewReleaseAliasCommand() ReleaseAliasCommand {
+	return ReleaseAliasCommand{
+		BaseCommand: NewBaseCommand("release-alias-operation"),
+	}
+}
+
+func (cmd *ReleaseAliasCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *ReleaseAliasCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
+	} else {
+		cmd.sender = a
+	}
+
+	al := currency.Alias(cmd.Alias)
+	if err := al.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid alias, %q: %w", cmd.Alias, err)
+	}
+
+	cmd.alias = al
+
+	return nil
+}
+
+func (cmd *ReleaseAliasCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewReleaseAliasFact([]byte(cmd.Token), cmd.sender, cmd.alias, cmd.Currency.CID)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, []byte(cmd.NetworkID)); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewReleaseAlias(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create release-alias operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
@@ -238,6 +238,62 @@ func (cmd *RunCommand) attachProposalProcessor(
 		return nil, err
 	} else if _, err := opr.SetProcessor(currency.Transfers{}, currency.NewTransfersProcessor(cp)); err != nil {
 		return nil, err
+	} else if _, err := opr.SetProcessor(currency.Burn{}, currency.NewBurnProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.Approve{}, currency.NewApproveProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.TransferFrom{}, currency.NewTransferFromProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.LockTransfer{}, currency.NewLockTransferProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.ClaimTransfer{}, currency.NewClaimTransferProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.RefundTransfer{}, currency.NewRefundTransferProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(
+		currency.CreateVestingAccounts{},
+		currency.NewCreateVestingAccountsProcessor(cp),
+	); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.ProposeOperation{}, currency.NewProposeOperationProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.ApproveOperation{}, currency.NewApproveOperationProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.RecoveryUpdater{}, currency.NewRecoveryUpdaterProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.RecoverAccount{}, currency.NewRecoverAccountProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.CancelRecovery{}, currency.NewCancelRecoveryProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.RegisterAlias{}, currency.NewRegisterAliasProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.TransferAlias{}, currency.NewTransferAliasProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.ReleaseAlias{}, currency.NewReleaseAliasProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.CloseAccount{}, currency.NewCloseAccountProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.Exchange{}, currency.NewExchangeProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.SetSpendingLimit{}, currency.NewSetSpendingLimitProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.ScheduleTransfer{}, currency.NewScheduleTransferProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(
+		currency.CancelScheduledTransfer{},
+		currency.NewCancelScheduledTransferProcessor(cp),
+	); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(
+		currency.CreateStandingOrder{},
+		currency.NewCreateStandingOrderProcessor(cp),
+	); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(
+		currency.CancelStandingOrder{},
+		currency.NewCancelStandingOrderProcessor(cp),
+	); err != nil {
+		return nil, err
 	}
 
 	var threshold base.Threshold
@@ -269,6 +325,30 @@ func (cmd *RunCommand) attachProposalProcessor(
 		return nil, err
 	}
 
+	if _, err := opr.SetProcessor(currency.CurrencyMint{},
+		currency.NewCurrencyMintProcessor(cp, pubs, threshold),
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := opr.SetProcessor(currency.FreezeAccount{},
+		currency.NewFreezeAccountProcessor(cp, pubs, threshold),
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := opr.SetProcessor(currency.UnfreezeAccount{},
+		currency.NewUnfreezeAccountProcessor(pubs, threshold),
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := opr.SetProcessor(currency.TransferListUpdater{},
+		currency.NewTransferListUpdaterProcessor(cp, pubs, threshold),
+	); err != nil {
+		return nil, err
+	}
+
 	return opr, nil
 }
 
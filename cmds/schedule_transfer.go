@@ -0,0 +1,106 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type ScheduleTransferCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
+	Receiver AddressFlag    `arg:"" name:"receiver" help:"receiver address" required:""`
+	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Big      BigFlag        `arg:"" name:"big" help:"big to transfer" required:""`
+	Height   int64          `arg:"" name:"height" help:"target height" required:""`
+	sender   base.Address
+	receiver base.Address
+}
+
+func NewScheduleTransferCommand() ScheduleTransferCommand {
+	return ScheduleTransferCommand{
+		BaseCommand: NewBaseCommand("schedule-transfer-operation"),
+	}
+}
+
+func (cmd *ScheduleTransferCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *ScheduleTransferCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Receiver.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid receiver format, %q: %w", cmd.Receiver.String(), err)
+	} else {
+		cmd.receiver = a
+	}
+
+	return nil
+}
+
+func (cmd *ScheduleTransferCommand) createOperation() (operation.Operation, error) {
+	am := currency.NewAmount(cmd.Big.Big, cmd.Currency.CID)
+	if err := am.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewScheduleTransferFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.receiver,
+		am,
+		base.Height(cmd.Height),
+	)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.Bytes()); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewScheduleTransfer(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create schedule-transfer operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
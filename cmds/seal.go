@@ -7,6 +7,32 @@ type SealCommand struct {
 	KeyUpdater            KeyUpdaterCommand            `cmd:"" name:"key-updater" help:"update keys"`
 	CurrencyRegister      CurrencyRegisterCommand      `cmd:"" name:"currency-register" help:"register new currency"`
 	CurrencyPolicyUpdater CurrencyPolicyUpdaterCommand `cmd:"" name:"currency-policy-updater" help:"update currency policy"` // nolint:lll
+	CurrencyMint          CurrencyMintCommand          `cmd:"" name:"currency-mint" help:"mint currency"`
+	Burn                  BurnCommand                  `cmd:"" name:"burn" help:"burn currency"`
+	Approve               ApproveCommand               `cmd:"" name:"approve" help:"approve allowance to spender"`
+	TransferFrom          TransferFromCommand          `cmd:"" name:"transfer-from" help:"transfer big from owner within allowance"` // nolint:lll
+	LockTransfer          LockTransferCommand          `cmd:"" name:"lock-transfer" help:"lock big for receiver under hashlock"`     // nolint:lll
+	ClaimTransfer         ClaimTransferCommand         `cmd:"" name:"claim-transfer" help:"claim locked big by preimage"`            // nolint:lll
+	RefundTransfer        RefundTransferCommand        `cmd:"" name:"refund-transfer" help:"refund expired locked big"`
+	CreateVestingAccount  CreateVestingAccountCommand  `cmd:"" name:"create-vesting-account" help:"create new account with vesting"` // nolint:lll
+	ProposeOperation      ProposeOperationCommand      `cmd:"" name:"propose-operation" help:"propose operation to be approved"`     // nolint:lll
+	ApproveOperation      ApproveOperationCommand      `cmd:"" name:"approve-operation" help:"approve proposed operation"`
+	RecoveryUpdater       RecoveryUpdaterCommand       `cmd:"" name:"recovery-updater" help:"update guardians to recover account"` // nolint:lll
+	RecoverAccount        RecoverAccountCommand        `cmd:"" name:"recover-account" help:"recover account keys by guardian"`
+	CancelRecovery        CancelRecoveryCommand        `cmd:"" name:"cancel-recovery" help:"cancel pending recovery"`
+	RegisterAlias         RegisterAliasCommand         `cmd:"" name:"register-alias" help:"register alias of account"`
+	TransferAlias         TransferAliasCommand         `cmd:"" name:"transfer-alias" help:"transfer alias to receiver"`
+	ReleaseAlias          ReleaseAliasCommand          `cmd:"" name:"release-alias" help:"release alias of account"`
+	CloseAccount          CloseAccountCommand          `cmd:"" name:"close-account" help:"close account and sweep balances"`
+	Exchange              ExchangeCommand              `cmd:"" name:"exchange" help:"exchange amounts with counterparty"`
+	FreezeAccount         FreezeAccountCommand         `cmd:"" name:"freeze-account" help:"freeze account by suffrage"`
+	UnfreezeAccount       UnfreezeAccountCommand       `cmd:"" name:"unfreeze-account" help:"unfreeze account by suffrage"`
+	TransferListUpdater   TransferListUpdaterCommand   `cmd:"" name:"transfer-list-updater" help:"update transfer list of currency by suffrage"` // nolint:lll
+	SetSpendingLimit      SetSpendingLimitCommand      `cmd:"" name:"set-spending-limit" help:"set spending limit of account"`
+	ScheduleTransfer      ScheduleTransferCommand      `cmd:"" name:"schedule-transfer" help:"transfer big at height"`
+	CancelSchedule        CancelScheduleCommand        `cmd:"" name:"cancel-schedule" help:"cancel scheduled transfer"`
+	CreateStandingOrder   CreateStandingOrderCommand   `cmd:"" name:"create-standing-order" help:"pay big by interval"`
+	CancelStandingOrder   CancelStandingOrderCommand   `cmd:"" name:"cancel-standing-order" help:"cancel standing order"`
 	Sign                  SignSealCommand              `cmd:"" name:"sign" help:"sign seal"`
 	SignFact              SignFactCommand              `cmd:"" name:"sign-fact" help:"sign facts of operation seal"`
 }
@@ -19,6 +45,32 @@ func NewSealCommand() SealCommand {
 		KeyUpdater:            NewKeyUpdaterCommand(),
 		CurrencyRegister:      NewCurrencyRegisterCommand(),
 		CurrencyPolicyUpdater: NewCurrencyPolicyUpdaterCommand(),
+		CurrencyMint:          NewCurrencyMintCommand(),
+		Burn:                  NewBurnCommand(),
+		Approve:               NewApproveCommand(),
+		TransferFrom:          NewTransferFromCommand(),
+		LockTransfer:          NewLockTransferCommand(),
+		ClaimTransfer:         NewClaimTransferCommand(),
+		RefundTransfer:        NewRefundTransferCommand(),
+		CreateVestingAccount:  NewCreateVestingAccountCommand(),
+		ProposeOperation:      NewProposeOperationCommand(),
+		ApproveOperation:      NewApproveOperationCommand(),
+		RecoveryUpdater:       NewRecoveryUpdaterCommand(),
+		RecoverAccount:        NewRecoverAccountCommand(),
+		CancelRecovery:        NewCancelRecoveryCommand(),
+		RegisterAlias:         NewRegisterAliasCommand(),
+		TransferAlias:         NewTransferAliasCommand(),
+		ReleaseAlias:          NewReleaseAliasCommand(),
+		CloseAccount:          NewCloseAccountCommand(),
+		Exchange:              NewExchangeCommand(),
+		FreezeAccount:         NewFreezeAccountCommand(),
+		UnfreezeAccount:       NewUnfreezeAccountCommand(),
+		TransferListUpdater:   NewTransferListUpdaterCommand(),
+		SetSpendingLimit:      NewSetSpendingLimitCommand(),
+		ScheduleTransfer:      NewScheduleTransferCommand(),
+		CancelSchedule:        NewCancelScheduleCommand(),
+		CreateStandingOrder:   NewCreateStandingOrderCommand(),
+		CancelStandingOrder:   NewCancelStandingOrderCommand(),
 		Sign:                  NewSignSealCommand(),
 		SignFact:              NewSignFactCommand(),
 	}
@@ -0,0 +1,100 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type SetSpendingLimitCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
+	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Big      BigFlag        `arg:"" name:"big" help:"max big to send in window" required:""`
+	Window   uint64         `help:"window in blocks" default:"1"`
+	Delay    uint64         `help:"delay in blocks until looser limit takes effect" default:"0"`
+	sender   base.Address
+	rule     currency.SpendingLimitRule
+}
+
+func NewSetSpendingLimitCommand() SetSpendingLimitCommand {
+	return SetSpendingLimitCommand{
+		BaseCommand: NewBaseCommand("set-spending-limit-operation"),
+	}
+}
+
+func (cmd *SetSpendingLimitCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *SetSpendingLimitCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
+	} else {
+		cmd.sender = a
+	}
+
+	rule := currency.NewSpendingLimitRule(cmd.Big.Big, base.Height(cmd.Window), base.Height(cmd.Delay))
+	if err := rule.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.rule = rule
+
+	return nil
+}
+
+func (cmd *SetSpendingLimitCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewSetSpendingLimitFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.Currency.CID,
+		cmd.rule,
+	)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, []byte(cmd.NetworkID)); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewSetSpendingLimit(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create set-spending-limit operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
@@ -16,13 +16,18 @@ import (
 type TransferCommand struct {
 	*BaseCommand
 	OperationFlags
-	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
-	Receiver AddressFlag    `arg:"" name:"receiver" help:"receiver address" required:""`
-	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
-	Big      BigFlag        `arg:"" name:"big" help:"big to send" required:""`
-	Seal     FileLoad       `help:"seal" optional:""`
-	sender   base.Address
-	receiver base.Address
+	Sender      AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
+	Receiver    AddressFlag    `arg:"" name:"receiver" help:"receiver address" required:""`
+	Currency    CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Big         BigFlag        `arg:"" name:"big" help:"big to send" required:""`
+	Seal        FileLoad       `help:"seal" optional:""`
+	FeePayer    AddressFlag    `name:"fee-payer" help:"fee payer address" optional:""`
+	FeeCurrency CurrencyIDFlag `name:"fee-currency" help:"currency id for paying fee" optional:""`
+	Sequence    uint64         `help:"sequence of sender" required:""`
+	Until       int64          `name:"valid-until" help:"last height to process operation" optional:""`
+	sender      base.Address
+	receiver    base.Address
+	feePayer    base.Address
 }
 
 func NewTransferCommand() TransferCommand {
@@ -75,6 +80,12 @@ func (cmd *TransferCommand) parseFlags() error {
 		cmd.receiver = receiver
 	}
 
+	if a, err := cmd.FeePayer.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid fee payer format, %q: %w", cmd.FeePayer.String(), err)
+	} else {
+		cmd.feePayer = a
+	}
+
 	return nil
 }
 
@@ -104,14 +115,17 @@ func (cmd *TransferCommand) createOperation() (operation.Operation, error) {
 		return nil, err
 	}
 
-	item := currency.NewTransfersItemSingleAmount(cmd.receiver, am)
+	item := currency.NewTransfersItemSingleAmount(cmd.receiver, am).SetFeeCurrency(cmd.FeeCurrency.CID)
 	if err := item.IsValid(nil); err != nil {
 		return nil, err
 	} else {
 		items = append(items, item)
 	}
 
-	fact := currency.NewTransfersFact([]byte(cmd.Token), cmd.sender, items)
+	fact := currency.NewTransfersFact([]byte(cmd.Token), cmd.sender, items).
+		SetFeePayer(cmd.feePayer).
+		SetSequence(cmd.Sequence).
+		SetValidUntil(base.Height(cmd.Until))
 
 	var fs []operation.FactSign
 	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.Bytes()); err != nil {
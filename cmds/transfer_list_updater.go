@@ -0,0 +1,95 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type TransferListUpdaterCommand struct {
+	*BaseCommand
+	OperationFlags
+	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Accounts []AddressFlag  `name:"account" help:"account address" sep:"@"`
+	Remove   bool           `name:"remove" help:"remove accounts from transfer list" optional:""`
+	accounts []base.Address
+}
+
+func NewTransferListUpdaterCommand() TransferListUpdaterCommand {
+	return TransferListUpdaterCommand{
+		BaseCommand: NewBaseCommand("transfer-list-updater-operation"),
+	}
+}
+
+func (cmd *TransferListUpdaterCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if o, err := cmd.createOperation(); err != nil {
+		return err
+	} else {
+		op = o
+	}
+
+	if bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.Bytes(),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.pretty(cmd.Pretty, bs)
+	}
+
+	return nil
+}
+
+func (cmd *TransferListUpdaterCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(cmd.Accounts) < 1 {
+		return xerrors.Errorf("empty accounts")
+	}
+
+	as := make([]base.Address, len(cmd.Accounts))
+	for i := range cmd.Accounts {
+		if a, err := cmd.Accounts[i].Encode(jenc); err != nil {
+			return xerrors.Errorf("invalid account format, %q: %w", cmd.Accounts[i].String(), err)
+		} else {
+			as[i] = a
+		}
+	}
+
+	cmd.accounts = as
+
+	return nil
+}
+
+func (cmd *TransferListUpdaterCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewTransferListUpdaterFact([]byte(cmd.Token), cmd.Currency.CID, cmd.accounts, !cmd.Remove)
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, []byte(cmd.NetworkID)); err != nil {
+		return nil, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+	}
+
+	if op, err := currency.NewTransferListUpdater(fact, fs, cmd.Memo); err != nil {
+		return nil, xerrors.Errorf("failed to create transfer-list-updater operation: %w", err)
+	} else {
+		return op, nil
+	}
+}
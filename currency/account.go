@@ -16,6 +16,7 @@ type Account struct {
 	h       valuehash.Hash
 	address base.Address
 	keys    Keys
+	closed  bool
 }
 
 func NewAccount(address base.Address, keys Keys) (Account, error) {
@@ -47,9 +48,17 @@ func (ac Account) Hint() hint.Hint {
 }
 
 func (ac Account) Bytes() []byte {
+	if !ac.closed {
+		return util.ConcatBytesSlice(
+			ac.address.Bytes(),
+			ac.keys.Bytes(),
+		)
+	}
+
 	return util.ConcatBytesSlice(
 		ac.address.Bytes(),
 		ac.keys.Bytes(),
+		util.BoolToBytes(ac.closed),
 	)
 }
 
@@ -79,6 +88,19 @@ func (ac Account) SetKeys(keys Keys) (Account, error) {
 	return ac, nil
 }
 
+// IsClosed returns true when the account is closed by CloseAccount. The closed
+// account can not sign operations and can not receive amounts.
+func (ac Account) IsClosed() bool {
+	return ac.closed
+}
+
+func (ac Account) Close() Account {
+	ac.closed = true
+	ac.h = ac.GenerateHash()
+
+	return ac
+}
+
 func (ac Account) IsEmpty() bool {
 	return ac.h == nil || ac.h.Empty()
 }
@@ -15,6 +15,7 @@ func (ac Account) MarshalBSON() ([]byte, error) {
 			"hash":    ac.h,
 			"address": ac.address,
 			"keys":    ac.keys,
+			"closed":  ac.closed,
 		},
 	))
 }
@@ -23,6 +24,7 @@ type AccountBSONUnpacker struct {
 	H  valuehash.Bytes     `bson:"hash"`
 	AD base.AddressDecoder `bson:"address"`
 	KS bson.Raw            `bson:"keys"`
+	CL bool                `bson:"closed"`
 }
 
 func (ac *Account) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -31,5 +33,5 @@ func (ac *Account) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return ac.unpack(enc, uac.H, uac.AD, uac.KS)
+	return ac.unpack(enc, uac.H, uac.AD, uac.KS, uac.CL)
 }
@@ -8,7 +8,13 @@ import (
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
-func (ac *Account) unpack(enc encoder.Encoder, h valuehash.Hash, bad base.AddressDecoder, bks []byte) error {
+func (ac *Account) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	bad base.AddressDecoder,
+	bks []byte,
+	closed bool,
+) error {
 	if a, err := bad.Encode(enc); err != nil {
 		return err
 	} else {
@@ -24,6 +30,7 @@ func (ac *Account) unpack(enc encoder.Encoder, h valuehash.Hash, bad base.Addres
 	}
 
 	ac.h = h
+	ac.closed = closed
 
 	return nil
 }
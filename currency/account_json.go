@@ -13,6 +13,7 @@ type AccountPackerJSON struct {
 	H  valuehash.Hash `json:"hash"`
 	AD base.Address   `json:"address"`
 	KS Keys           `json:"keys"`
+	CL bool           `json:"closed"`
 }
 
 func (ac Account) PackerJSON() AccountPackerJSON {
@@ -21,6 +22,7 @@ func (ac Account) PackerJSON() AccountPackerJSON {
 		H:          ac.h,
 		AD:         ac.address,
 		KS:         ac.keys,
+		CL:         ac.closed,
 	}
 }
 
@@ -32,6 +34,7 @@ type AccountJSONUnpacker struct {
 	H  valuehash.Bytes     `json:"hash"`
 	AD base.AddressDecoder `json:"address"`
 	KS json.RawMessage     `json:"keys"`
+	CL bool                `json:"closed"`
 }
 
 func (ac *Account) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -40,5 +43,5 @@ func (ac *Account) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return ac.unpack(enc, uac.H, uac.AD, uac.KS)
+	return ac.unpack(enc, uac.H, uac.AD, uac.KS, uac.CL)
 }
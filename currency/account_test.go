@@ -50,6 +50,24 @@ func (t *testAccount) TestNewFromKeys() {
 	t.True(ac.Keys().Equal(keys))
 }
 
+func (t *testAccount) TestClose() {
+	priv := key.MustNewBTCPrivatekey()
+	key, err := NewKey(priv.Publickey(), 100)
+	t.NoError(err)
+	keys, err := NewKeys([]Key{key}, 100)
+	t.NoError(err)
+
+	ac, err := NewAccountFromKeys(keys)
+	t.NoError(err)
+	t.False(ac.IsClosed())
+
+	closed := ac.Close()
+	t.True(closed.IsClosed())
+	t.True(closed.Address().Equal(ac.Address()))
+	t.False(closed.Hash().Equal(ac.Hash()))
+	t.False(ac.IsClosed())
+}
+
 func TestAccount(t *testing.T) {
 	suite.Run(t, new(testAccount))
 }
@@ -77,6 +95,7 @@ func testAccountEncode(enc encoder.Encoder) suite.TestingSuite {
 
 		t.True(ca.Address().Equal(cb.Address()))
 		t.True(ca.Keys().Equal(cb.Keys()))
+		t.Equal(ca.IsClosed(), cb.IsClosed())
 	}
 
 	return t
@@ -89,3 +108,22 @@ func TestAccountEncodeJSON(t *testing.T) {
 func TestAccountEncodeBSON(t *testing.T) {
 	suite.Run(t, testAccountEncode(bsonenc.NewEncoder()))
 }
+
+func testClosedAccountEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := testAccountEncode(enc).(*baseTestEncode)
+
+	newObject := t.newObject
+	t.newObject = func() interface{} {
+		return newObject().(Account).Close()
+	}
+
+	return t
+}
+
+func TestClosedAccountEncodeJSON(t *testing.T) {
+	suite.Run(t, testClosedAccountEncode(jsonenc.NewEncoder()))
+}
+
+func TestClosedAccountEncodeBSON(t *testing.T) {
+	suite.Run(t, testClosedAccountEncode(bsonenc.NewEncoder()))
+}
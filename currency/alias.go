@@ -0,0 +1,222 @@
+package currency
+
+import (
+	"regexp"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/logging"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AliasType        = hint.MustNewType(0xa0, 0x5f, "mitum-currency-alias")
+	AliasHint        = hint.MustHint(AliasType, "0.0.1")
+	AccountAliasType = hint.MustNewType(0xa0, 0x60, "mitum-currency-account-alias")
+	AccountAliasHint = hint.MustHint(AccountAliasType, "0.0.1")
+)
+
+var (
+	MinLengthAlias int = 3
+	MaxLengthAlias int = 64
+	ReValidAlias       = regexp.MustCompile(`^[a-z0-9][a-z0-9\-]*[a-z0-9]$`)
+)
+
+// Alias is the human readable name of account. Alias also works as
+// base.Address, so the receiver of TransfersItem can be Alias; it is resolved
+// to the account address of alias in processing.
+type Alias string
+
+func (al Alias) Raw() string {
+	return string(al)
+}
+
+func (al Alias) String() string {
+	return hint.HintedString(al.Hint(), string(al))
+}
+
+func (al Alias) Hint() hint.Hint {
+	return AliasHint
+}
+
+func (al Alias) IsValid([]byte) error {
+	if l := len(al); l < MinLengthAlias || l > MaxLengthAlias {
+		return xerrors.Errorf("invalid length of alias, %d <= %d <= %d", MinLengthAlias, l, MaxLengthAlias)
+	} else if !ReValidAlias.Match([]byte(al)) {
+		return xerrors.Errorf("wrong alias, %q", al)
+	}
+
+	return nil
+}
+
+func (al Alias) Equal(a base.Address) bool {
+	if al.Hint().Type() != a.Hint().Type() {
+		return false
+	}
+
+	return al == a.(Alias)
+}
+
+func (al Alias) Bytes() []byte {
+	return []byte(al.String())
+}
+
+func (al Alias) MarshalText() ([]byte, error) {
+	return []byte(al.String()), nil
+}
+
+func (al *Alias) UnmarshalText(b []byte) error {
+	a := Alias(string(b))
+	if err := a.IsValid(nil); err != nil {
+		return err
+	}
+
+	*al = a
+
+	return nil
+}
+
+func (al Alias) MarshalLog(key string, e logging.Emitter, _ bool) logging.Emitter {
+	return e.Str(key, al.String())
+}
+
+type AliasStatus string
+
+const (
+	AliasStatusRegistered AliasStatus = "registered"
+	AliasStatusReleased   AliasStatus = "released"
+)
+
+func (as AliasStatus) Bytes() []byte {
+	return []byte(as)
+}
+
+func (as AliasStatus) String() string {
+	return string(as)
+}
+
+func (as AliasStatus) IsValid([]byte) error {
+	switch as {
+	case AliasStatusRegistered, AliasStatusReleased:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown alias status, %q", as)
+	}
+}
+
+// AccountAlias binds alias to account. It is stored in both the state of alias
+// and the state of account. After released, the alias can be registered again
+// and the account can have another alias.
+type AccountAlias struct {
+	alias   Alias
+	account base.Address
+	status  AliasStatus
+}
+
+func NewAccountAlias(alias Alias, account base.Address) AccountAlias {
+	return AccountAlias{alias: alias, account: account, status: AliasStatusRegistered}
+}
+
+func (aa AccountAlias) Hint() hint.Hint {
+	return AccountAliasHint
+}
+
+func (aa AccountAlias) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		aa.alias.Bytes(),
+		aa.account.Bytes(),
+		aa.status.Bytes(),
+	)
+}
+
+func (aa AccountAlias) Hash() valuehash.Hash {
+	return aa.GenerateHash()
+}
+
+func (aa AccountAlias) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(aa.Bytes())
+}
+
+func (aa AccountAlias) IsValid([]byte) error {
+	if err := isvalid.Check([]isvalid.IsValider{
+		aa.alias,
+		aa.account,
+		aa.status,
+	}, nil, false); err != nil {
+		return xerrors.Errorf("invalid AccountAlias: %w", err)
+	}
+
+	if _, ok := aa.account.(Alias); ok {
+		return xerrors.Errorf("account of AccountAlias should not be alias, %q", aa.account)
+	}
+
+	return nil
+}
+
+func (aa AccountAlias) Alias() Alias {
+	return aa.alias
+}
+
+func (aa AccountAlias) Account() base.Address {
+	return aa.account
+}
+
+func (aa AccountAlias) Status() AliasStatus {
+	return aa.status
+}
+
+func (aa AccountAlias) Registered() bool {
+	return aa.status == AliasStatusRegistered
+}
+
+func (aa AccountAlias) Release() AccountAlias {
+	aa.status = AliasStatusReleased
+
+	return aa
+}
+
+// loadAccountAlias returns the registered AccountAlias from the state of key.
+// If not found or released, the returned bool is false.
+func loadAccountAlias(
+	key string,
+	getState func(key string) (state.State, bool, error),
+) (state.State, AccountAlias, bool, error) {
+	switch st, found, err := getState(key); {
+	case err != nil:
+		return nil, AccountAlias{}, false, err
+	case !found:
+		return st, AccountAlias{}, false, nil
+	default:
+		if aa, err := StateAccountAliasValue(st); err != nil {
+			return nil, AccountAlias{}, false, util.IgnoreError.Wrap(err)
+		} else {
+			return st, aa, aa.Registered(), nil
+		}
+	}
+}
+
+// resolveAlias returns the account address of alias. The address, which is not
+// Alias, is returned as it is.
+func resolveAlias(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) (base.Address, error) {
+	al, ok := a.(Alias)
+	if !ok {
+		return a, nil
+	}
+
+	switch _, aa, found, err := loadAccountAlias(StateKeyAlias(al), getState); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, util.IgnoreError.Errorf("alias, %q not registered", al.Raw())
+	default:
+		return aa.Account(), nil
+	}
+}
@@ -0,0 +1,40 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (al Alias) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bsontype.String, bsoncore.AppendString(nil, al.String()), nil
+}
+
+func (aa AccountAlias) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(aa.Hint()),
+		bson.M{
+			"alias":   aa.alias.Raw(),
+			"account": aa.account,
+			"status":  aa.status,
+		}),
+	)
+}
+
+type AccountAliasBSONUnpacker struct {
+	AL string              `bson:"alias"`
+	AC base.AddressDecoder `bson:"account"`
+	ST AliasStatus         `bson:"status"`
+}
+
+func (aa *AccountAlias) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uaa AccountAliasBSONUnpacker
+	if err := enc.Unmarshal(b, &uaa); err != nil {
+		return err
+	}
+
+	return aa.unpack(enc, uaa.AL, uaa.AC, uaa.ST)
+}
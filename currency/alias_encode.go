@@ -0,0 +1,24 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (aa *AccountAlias) unpack(
+	enc encoder.Encoder,
+	alias string,
+	bAccount base.AddressDecoder,
+	status AliasStatus,
+) error {
+	if a, err := bAccount.Encode(enc); err != nil {
+		return err
+	} else {
+		aa.account = a
+	}
+
+	aa.alias = Alias(alias)
+	aa.status = status
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type AccountAliasJSONPacker struct {
+	jsonenc.HintedHead
+	AL string       `json:"alias"`
+	AC base.Address `json:"account"`
+	ST AliasStatus  `json:"status"`
+}
+
+func (aa AccountAlias) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountAliasJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(aa.Hint()),
+		AL:         aa.alias.Raw(),
+		AC:         aa.account,
+		ST:         aa.status,
+	})
+}
+
+type AccountAliasJSONUnpacker struct {
+	AL string              `json:"alias"`
+	AC base.AddressDecoder `json:"account"`
+	ST AliasStatus         `json:"status"`
+}
+
+func (aa *AccountAlias) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uaa AccountAliasJSONUnpacker
+	if err := enc.Unmarshal(b, &uaa); err != nil {
+		return err
+	}
+
+	return aa.unpack(enc, uaa.AL, uaa.AC, uaa.ST)
+}
@@ -0,0 +1,117 @@
+package currency
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testAlias struct {
+	suite.Suite
+}
+
+func (t *testAlias) TestNew() {
+	for _, s := range []string{"abc", "show-me", "0findme9", strings.Repeat("a", MaxLengthAlias)} {
+		t.NoError(Alias(s).IsValid(nil), s)
+	}
+}
+
+func (t *testAlias) TestInvalid() {
+	for _, s := range []string{
+		"",
+		"ab",
+		strings.Repeat("a", MaxLengthAlias+1),
+		"ShowMe",
+		"-showme",
+		"showme-",
+		"show_me",
+		"show.me",
+		"show:me",
+		"show me",
+	} {
+		t.Error(Alias(s).IsValid(nil), s)
+	}
+}
+
+func (t *testAlias) TestAsAddress() {
+	al := Alias("showme")
+
+	t.Implements((*base.Address)(nil), al)
+	t.Equal("showme", al.Raw())
+	t.True(al.Equal(Alias("showme")))
+	t.False(al.Equal(Alias("findme")))
+	t.False(al.Equal(Address("showme")))
+	t.NotEqual(StateAddressKeyPrefix(al), StateAddressKeyPrefix(Address("showme")))
+}
+
+func (t *testAlias) TestDecodeFromString() {
+	encs := encoder.NewEncoders()
+	enc := jsonenc.NewEncoder()
+	t.NoError(encs.AddEncoder(enc))
+	t.NoError(encs.AddHinter(Address("")))
+	t.NoError(encs.AddHinter(Alias("")))
+
+	al := Alias("show-me")
+
+	a, err := base.DecodeAddressFromString(enc, al.String())
+	t.NoError(err)
+	t.IsType(Alias(""), a)
+	t.True(al.Equal(a))
+}
+
+func (t *testAlias) TestAccountAlias() {
+	aa := NewAccountAlias(Alias("showme"), NewTestAddress())
+	t.NoError(aa.IsValid(nil))
+	t.True(aa.Registered())
+
+	raa := aa.Release()
+	t.NoError(raa.IsValid(nil))
+	t.False(raa.Registered())
+	t.Equal(AliasStatusReleased, raa.Status())
+}
+
+func (t *testAlias) TestAccountAliasWithAlias() {
+	err := NewAccountAlias(Alias("showme"), Alias("findme")).IsValid(nil)
+	t.Contains(err.Error(), "should not be alias")
+}
+
+func TestAlias(t *testing.T) {
+	suite.Run(t, new(testAlias))
+}
+
+func testAccountAliasEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		aa := NewAccountAlias(Alias("showme"), NewTestAddress()).Release()
+		t.NoError(aa.IsValid(nil))
+
+		return aa
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(AccountAlias)
+		tb := b.(AccountAlias)
+
+		t.Equal(ta.Alias(), tb.Alias())
+		t.True(ta.Account().Equal(tb.Account()))
+		t.Equal(ta.Status(), tb.Status())
+	}
+
+	return t
+}
+
+func TestAccountAliasEncodeJSON(t *testing.T) {
+	suite.Run(t, testAccountAliasEncode(jsonenc.NewEncoder()))
+}
+
+func TestAccountAliasEncodeBSON(t *testing.T) {
+	suite.Run(t, testAccountAliasEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,90 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AllowanceType = hint.MustNewType(0xa0, 0x41, "mitum-currency-allowance")
+	AllowanceHint = hint.MustHint(AllowanceType, "0.0.1")
+)
+
+// Allowance is the amount, which spender can transfer from the balance of
+// owner.
+type Allowance struct {
+	owner   base.Address
+	spender base.Address
+	amount  Amount
+}
+
+func NewAllowance(owner, spender base.Address, amount Amount) Allowance {
+	return Allowance{
+		owner:   owner,
+		spender: spender,
+		amount:  amount,
+	}
+}
+
+func (al Allowance) Hint() hint.Hint {
+	return AllowanceHint
+}
+
+func (al Allowance) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		al.owner.Bytes(),
+		al.spender.Bytes(),
+		al.amount.Bytes(),
+	)
+}
+
+func (al Allowance) Hash() valuehash.Hash {
+	return al.GenerateHash()
+}
+
+func (al Allowance) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(al.Bytes())
+}
+
+func (al Allowance) IsValid([]byte) error {
+	if err := isvalid.Check([]isvalid.IsValider{al.owner, al.spender, al.amount}, nil, false); err != nil {
+		return xerrors.Errorf("invalid Allowance: %w", err)
+	}
+
+	if al.owner.Equal(al.spender) {
+		return xerrors.Errorf("spender is same with owner, %q", al.owner)
+	}
+
+	if !al.amount.Big().OverNil() {
+		return xerrors.Errorf("allowance should be over nil")
+	}
+
+	return nil
+}
+
+func (al Allowance) Owner() base.Address {
+	return al.owner
+}
+
+func (al Allowance) Spender() base.Address {
+	return al.spender
+}
+
+func (al Allowance) Amount() Amount {
+	return al.amount
+}
+
+func (al Allowance) Currency() CurrencyID {
+	return al.amount.Currency()
+}
+
+func (al Allowance) WithBig(big Big) Allowance {
+	al.amount = al.amount.WithBig(big)
+
+	return al
+}
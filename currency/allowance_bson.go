@@ -0,0 +1,34 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (al Allowance) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(al.Hint()),
+		bson.M{
+			"owner":   al.owner,
+			"spender": al.spender,
+			"amount":  al.amount,
+		}),
+	)
+}
+
+type AllowanceBSONUnpacker struct {
+	OW base.AddressDecoder `bson:"owner"`
+	SP base.AddressDecoder `bson:"spender"`
+	AM bson.Raw            `bson:"amount"`
+}
+
+func (al *Allowance) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ual AllowanceBSONUnpacker
+	if err := enc.Unmarshal(b, &ual); err != nil {
+		return err
+	}
+
+	return al.unpack(enc, ual.OW, ual.SP, ual.AM)
+}
@@ -0,0 +1,33 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (al *Allowance) unpack(
+	enc encoder.Encoder,
+	bOwner base.AddressDecoder,
+	bSpender base.AddressDecoder,
+	bam []byte,
+) error {
+	if a, err := bOwner.Encode(enc); err != nil {
+		return err
+	} else {
+		al.owner = a
+	}
+
+	if a, err := bSpender.Encode(enc); err != nil {
+		return err
+	} else {
+		al.spender = a
+	}
+
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		al.amount = am
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testAllowance struct {
+	suite.Suite
+}
+
+func (t *testAllowance) TestNew() {
+	owner := NewTestAddress()
+	spender := NewTestAddress()
+
+	al := NewAllowance(owner, spender, NewAmount(NewBig(10), CurrencyID("SHOWME")))
+	t.NoError(al.IsValid(nil))
+
+	t.True(owner.Equal(al.Owner()))
+	t.True(spender.Equal(al.Spender()))
+	t.Equal(CurrencyID("SHOWME"), al.Currency())
+
+	al = al.WithBig(NewBig(3))
+	t.True(al.Amount().Big().Equal(NewBig(3)))
+}
+
+func (t *testAllowance) TestSameSpender() {
+	owner := NewTestAddress()
+
+	err := NewAllowance(owner, owner, NewAmount(NewBig(10), CurrencyID("SHOWME"))).IsValid(nil)
+	t.Contains(err.Error(), "spender is same with owner")
+}
+
+func (t *testAllowance) TestUnderZero() {
+	err := NewAllowance(NewTestAddress(), NewTestAddress(), NewAmount(NewBig(-1), CurrencyID("SHOWME"))).IsValid(nil)
+	t.Contains(err.Error(), "allowance should be over nil")
+}
+
+func TestAllowance(t *testing.T) {
+	suite.Run(t, new(testAllowance))
+}
+
+func testAllowanceEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		al := NewAllowance(NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), CurrencyID("SHOWME")))
+		t.NoError(al.IsValid(nil))
+
+		return al
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Allowance)
+		tb := b.(Allowance)
+
+		t.True(ta.Owner().Equal(tb.Owner()))
+		t.True(ta.Spender().Equal(tb.Spender()))
+		t.True(ta.Amount().Equal(tb.Amount()))
+	}
+
+	return t
+}
+
+func TestAllowanceEncodeJSON(t *testing.T) {
+	suite.Run(t, testAllowanceEncode(jsonenc.NewEncoder()))
+}
+
+func TestAllowanceEncodeBSON(t *testing.T) {
+	suite.Run(t, testAllowanceEncode(bsonenc.NewEncoder()))
+}
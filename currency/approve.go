@@ -0,0 +1,172 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ApproveFactType = hint.MustNewType(0xa0, 0x42, "mitum-currency-approve-operation-fact")
+	ApproveFactHint = hint.MustHint(ApproveFactType, "0.0.1")
+	ApproveType     = hint.MustNewType(0xa0, 0x43, "mitum-currency-approve-operation")
+	ApproveHint     = hint.MustHint(ApproveType, "0.0.1")
+)
+
+// ApproveFact sets the allowance of spender over the balance of owner. The
+// existing allowance is overwritten and zero amount revokes it.
+type ApproveFact struct {
+	h       valuehash.Hash
+	token   []byte
+	owner   base.Address
+	spender base.Address
+	amount  Amount
+}
+
+func NewApproveFact(token []byte, owner, spender base.Address, amount Amount) ApproveFact {
+	fact := ApproveFact{
+		token:   token,
+		owner:   owner,
+		spender: spender,
+		amount:  amount,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ApproveFact) Hint() hint.Hint {
+	return ApproveFactHint
+}
+
+func (fact ApproveFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ApproveFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ApproveFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.owner.Bytes(),
+		fact.spender.Bytes(),
+		fact.amount.Bytes(),
+	)
+}
+
+func (fact ApproveFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for ApproveFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.owner,
+		fact.spender,
+		fact.amount,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.owner.Equal(fact.spender) {
+		return xerrors.Errorf("spender is same with owner, %q", fact.owner)
+	}
+
+	if !fact.amount.Big().OverNil() {
+		return xerrors.Errorf("amount should be over nil")
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ApproveFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact ApproveFact) Spender() base.Address {
+	return fact.spender
+}
+
+func (fact ApproveFact) Amount() Amount {
+	return fact.amount
+}
+
+func (fact ApproveFact) Rebuild() ApproveFact {
+	fact.amount = fact.amount.WithBig(fact.amount.Big())
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ApproveFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.owner, fact.spender}, nil
+}
+
+type Approve struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewApprove(fact ApproveFact, fs []operation.FactSign, memo string) (Approve, error) {
+	if bo, err := operation.NewBaseOperationFromFact(ApproveHint, fact, fs); err != nil {
+		return Approve{}, err
+	} else {
+		op := Approve{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op Approve) Hint() hint.Hint {
+	return ApproveHint
+}
+
+func (op Approve) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op Approve) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op Approve) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
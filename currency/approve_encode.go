@@ -0,0 +1,44 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *ApproveFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bOwner base.AddressDecoder,
+	bSpender base.AddressDecoder,
+	bam []byte,
+) error {
+	var owner, spender base.Address
+	if a, err := bOwner.Encode(enc); err != nil {
+		return err
+	} else {
+		owner = a
+	}
+
+	if a, err := bSpender.Encode(enc); err != nil {
+		return err
+	} else {
+		spender = a
+	}
+
+	var amount Amount
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		amount = am
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.owner = owner
+	fact.spender = spender
+	fact.amount = amount
+
+	return nil
+}
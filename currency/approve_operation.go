@@ -0,0 +1,153 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ApproveOperationFactType = hint.MustNewType(0xa0, 0x55, "mitum-currency-approve-proposal-operation-fact")
+	ApproveOperationFactHint = hint.MustHint(ApproveOperationFactType, "0.0.1")
+	ApproveOperationType     = hint.MustNewType(0xa0, 0x56, "mitum-currency-approve-proposal-operation")
+	ApproveOperationHint     = hint.MustHint(ApproveOperationType, "0.0.1")
+)
+
+// ApproveOperationFact adds the signs of the keys of sender account to the
+// pending Proposal.
+type ApproveOperationFact struct {
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	proposal valuehash.Hash
+}
+
+func NewApproveOperationFact(
+	token []byte,
+	sender base.Address,
+	proposal valuehash.Hash,
+) ApproveOperationFact {
+	fact := ApproveOperationFact{
+		token:    token,
+		sender:   sender,
+		proposal: proposal,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ApproveOperationFact) Hint() hint.Hint {
+	return ApproveOperationFactHint
+}
+
+func (fact ApproveOperationFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ApproveOperationFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveOperationFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ApproveOperationFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.proposal.Bytes(),
+	)
+}
+
+func (fact ApproveOperationFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for ApproveOperationFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.proposal,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ApproveOperationFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveOperationFact) Proposal() valuehash.Hash {
+	return fact.proposal
+}
+
+func (fact ApproveOperationFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type ApproveOperation struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewApproveOperation(fact ApproveOperationFact, fs []operation.FactSign, memo string) (ApproveOperation, error) {
+	if bo, err := operation.NewBaseOperationFromFact(ApproveOperationHint, fact, fs); err != nil {
+		return ApproveOperation{}, err
+	} else {
+		op := ApproveOperation{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op ApproveOperation) Hint() hint.Hint {
+	return ApproveOperationHint
+}
+
+func (op ApproveOperation) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op ApproveOperation) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op ApproveOperation) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,89 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op ApproveOperation) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type ApproveOperationProcessor struct {
+	ApproveOperation
+	proposalExecutor
+}
+
+func NewApproveOperationProcessor(_ *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(ApproveOperation); !ok {
+			return nil, xerrors.Errorf("not ApproveOperation, %T", op)
+		} else {
+			return &ApproveOperationProcessor{
+				ApproveOperation: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *ApproveOperationProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ApproveOperationFact)
+
+	var keys Keys
+	if i, err := loadProposalKeys(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, err
+	} else {
+		keys = i
+	}
+
+	var st state.State
+	var pr Proposal
+	if i, err := existsState(StateKeyProposal(fact.proposal), "proposal", getState); err != nil {
+		return nil, err
+	} else if j, err := StateProposalValue(i); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else {
+		st = i
+		pr = j
+	}
+
+	switch {
+	case pr.Status() != ProposalStatusPending:
+		return nil, util.IgnoreError.Errorf("proposal already %s", pr.Status())
+	case !pr.Account().Equal(fact.sender):
+		return nil, util.IgnoreError.Errorf("sender is not account of proposal, %q", fact.sender)
+	}
+
+	for i := range opp.Signs() {
+		if pr.Signed(opp.Signs()[i].Signer()) {
+			return nil, util.IgnoreError.Errorf("already signed by %s", opp.Signs()[i].Signer())
+		}
+	}
+
+	if err := opp.prepare(st, pr.Approve(opp.Signs()...), keys); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	}
+
+	return opp, nil
+}
+
+func (opp *ApproveOperationProcessor) Process(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	if sts, err := opp.process(getState); err != nil {
+		return err
+	} else {
+		return setState(opp.Fact().Hash(), sts...)
+	}
+}
@@ -0,0 +1,236 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testApproveOperationOperations struct {
+	testProposeOperationOperations
+}
+
+func (t *testApproveOperationOperations) newApproveOperation(
+	sender base.Address,
+	keys []key.Privatekey,
+	proposal valuehash.Hash,
+) ApproveOperation {
+	token := util.UUID().Bytes()
+	fact := NewApproveOperationFact(token, sender, proposal)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewApproveOperation(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testApproveOperationOperations) newProposal(
+	sender base.Address,
+	keys []key.Privatekey,
+	fact operation.OperationFact,
+) Proposal {
+	op := t.newProposeOperation(sender, keys, fact)
+
+	return NewProposal(op.Fact().Hash(), sender, fact, op.Signs())
+}
+
+func (t *testApproveOperationOperations) TestExecute() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{50, 50}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	pr := t.newProposal(sa, privs[:1], t.newTransfersFact(sa, ra.Address, NewBig(10)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newProposalState(pr)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApproveOperation(sa, privs[1:], pr.ID())
+	t.NoError(opr.Process(op))
+
+	var pst, sst, rst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyProposal(pr.ID()):
+			pst = st.GetState()
+		case StateKeyBalance(sa, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		}
+	}
+
+	upr, err := StateProposalValue(pst)
+	t.NoError(err)
+	t.Equal(ProposalStatusExecuted, upr.Status())
+	t.Equal(2, len(upr.Signs()))
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(23)))
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(NewBig(10)))
+}
+
+func (t *testApproveOperationOperations) TestStillPending() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{34, 33, 33}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	pr := t.newProposal(sa, privs[:1], t.newTransfersFact(sa, ra.Address, NewBig(10)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newProposalState(pr)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApproveOperation(sa, privs[1:2], pr.ID())
+	t.NoError(opr.Process(op))
+
+	var pst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyProposal(pr.ID()):
+			pst = st.GetState()
+		case StateKeyBalance(sa, t.cid), StateKeyBalance(ra.Address, t.cid):
+			t.Fail("balance should not be updated")
+		}
+	}
+
+	upr, err := StateProposalValue(pst)
+	t.NoError(err)
+	t.Equal(ProposalStatusPending, upr.Status())
+	t.Equal(2, len(upr.Signs()))
+}
+
+func (t *testApproveOperationOperations) TestAlreadySigned() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{50, 50}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	pr := t.newProposal(sa, privs[:1], t.newTransfersFact(sa, ra.Address, NewBig(10)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newProposalState(pr)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApproveOperation(sa, privs[:1], pr.ID())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "already signed")
+}
+
+func (t *testApproveOperationOperations) TestAlreadyExecuted() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{50, 50}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	pr := t.newProposal(sa, privs[:1], t.newTransfersFact(sa, ra.Address, NewBig(10))).Execute()
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newProposalState(pr)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApproveOperation(sa, privs[1:], pr.ID())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "proposal already executed")
+}
+
+func (t *testApproveOperationOperations) TestNotAccountOfProposal() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{50, 50}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	pr := t.newProposal(sa, privs[:1], t.newTransfersFact(sa, ra.Address, NewBig(10)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newProposalState(pr)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApproveOperation(ra.Address, ra.Privs(), pr.ID())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "sender is not account of proposal")
+}
+
+func (t *testApproveOperationOperations) TestProposedFailed() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{50, 50}, 100, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	pr := t.newProposal(sa, privs[:1], t.newTransfersFact(sa, ra.Address, NewBig(10)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newProposalState(pr)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApproveOperation(sa, privs[1:], pr.ID())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testApproveOperationOperations) TestDuplicatedApprove() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{34, 33, 33}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	pr := t.newProposal(sa, privs[:1], t.newTransfersFact(sa, ra.Address, NewBig(10)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newProposalState(pr)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newApproveOperation(sa, privs[1:2], pr.ID())))
+
+	err := opr.Process(t.newApproveOperation(sa, privs[2:], pr.ID()))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "duplication found")
+}
+
+func TestApproveOperationOperations(t *testing.T) {
+	suite.Run(t, new(testApproveOperationOperations))
+}
@@ -0,0 +1,89 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"github.com/stretchr/testify/suite"
+)
+
+type testApproveOperation struct {
+	baseTest
+}
+
+func (t *testApproveOperation) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewApproveOperationFact(token, NewTestAddress(), valuehash.RandomSHA256())
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewApproveOperation(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func TestApproveOperation(t *testing.T) {
+	suite.Run(t, new(testApproveOperation))
+}
+
+func testApproveOperationEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewApproveOperationFact(token, NewTestAddress(), valuehash.RandomSHA256())
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewApproveOperation(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(ApproveOperation)
+		tb := b.(ApproveOperation)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(ApproveOperationFact)
+		ufact := tb.Fact().(ApproveOperationFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.proposal.Equal(ufact.proposal))
+	}
+
+	return t
+}
+
+func TestApproveOperationEncodeJSON(t *testing.T) {
+	suite.Run(t, testApproveOperationEncode(jsonenc.NewEncoder()))
+}
+
+func TestApproveOperationEncodeBSON(t *testing.T) {
+	suite.Run(t, testApproveOperationEncode(bsonenc.NewEncoder()))
+}
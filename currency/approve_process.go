@@ -0,0 +1,117 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op Approve) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type ApproveProcessor struct {
+	cp *CurrencyPool
+	Approve
+	proposable
+	height base.Height
+	sa     state.State
+	sb     AmountState
+	fee    Big
+	sc     state.State
+	cm     Commitments
+}
+
+func NewApproveProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(Approve); !ok {
+			return nil, xerrors.Errorf("not Approve, %T", op)
+		} else {
+			return &ApproveProcessor{
+				cp:      cp,
+				Approve: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *ApproveProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *ApproveProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ApproveFact)
+	cid := fact.amount.Currency()
+
+	if _, err := existsAccountState(fact.owner, "owner", getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsAccountState(fact.spender, "spender", getState); err != nil {
+		return nil, err
+	}
+
+	if st, _, err := getState(StateKeyAllowance(fact.owner, fact.spender, cid)); err != nil {
+		return nil, err
+	} else {
+		opp.sa = st
+	}
+
+	if st, cm, err := loadCommitments(fact.owner, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sc = st
+		opp.cm = cm
+
+		if fact.amount.Big().OverZero() {
+			opp.cm = cm.Add(StateKeyAllowance(fact.owner, fact.spender, cid))
+		}
+	}
+
+	if err := opp.checkFactSigns(fact.owner, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	if sb, fee, err := loadOperationFee(opp.cp, fact.owner, cid, ApproveType, opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sb = sb
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *ApproveProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(ApproveFact)
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+
+	var sts []state.State
+	if st, err := SetStateAllowanceValue(opp.sa, NewAllowance(fact.owner, fact.spender, fact.amount)); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	if st, err := SetStateCommitmentsValue(opp.sc, opp.cm); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	return setState(fact.Hash(), append(sts, opp.sb)...)
+}
@@ -0,0 +1,239 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testApproveOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testApproveOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testApproveOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(Approve{}, NewApproveProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testApproveOperations) newApprove(owner, spender base.Address, keys []key.Privatekey, amount Amount) Approve {
+	token := util.UUID().Bytes()
+	fact := NewApproveFact(token, owner, spender, amount)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewApprove(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testApproveOperations) allowance(pool *storage.Statepool, owner, spender base.Address) Allowance {
+	for _, st := range pool.Updates() {
+		if st.Key() != StateKeyAllowance(owner, spender, t.cid) {
+			continue
+		}
+
+		al, err := StateAllowanceValue(st.GetState())
+		t.NoError(err)
+
+		return al
+	}
+
+	t.Fail("allowance not found")
+
+	return Allowance{}
+}
+
+func (t *testApproveOperations) TestNew() {
+	fa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	oa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st2 := t.newAccount(true, nil)
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApprove(oa.Address, sa.Address, oa.Privs(), NewAmount(NewBig(100), t.cid))
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	al := t.allowance(pool, oa.Address, sa.Address)
+	t.True(al.Owner().Equal(oa.Address))
+	t.True(al.Spender().Equal(sa.Address))
+	t.True(al.Amount().Big().Equal(NewBig(100)))
+
+	var ost, cst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(oa.Address, t.cid):
+			ost = st.GetState()
+		case StateKeyCommitments(oa.Address):
+			cst = st.GetState()
+		}
+	}
+
+	ostv, _ := StateBalanceValue(ost)
+	t.True(ostv.Big().Equal(NewBig(33).Sub(fee)))
+
+	cm, err := StateCommitmentsValue(cst)
+	t.NoError(err)
+	t.Equal([]string{StateKeyAllowance(oa.Address, sa.Address, t.cid)}, cm.Keys())
+}
+
+func (t *testApproveOperations) TestOverwrite() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, nil)
+
+	ast := t.newAllowanceState(NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(100), t.cid)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{ast, dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApprove(oa.Address, sa.Address, oa.Privs(), NewZeroAmount(t.cid))
+	t.NoError(opr.Process(op))
+
+	al := t.allowance(pool, oa.Address, sa.Address)
+	t.True(al.Amount().Big().IsZero())
+}
+
+func (t *testApproveOperations) TestSpenderNotExist() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, _ := t.newAccount(false, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApprove(oa.Address, sa.Address, oa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "spender does not exist")
+}
+
+func (t *testApproveOperations) TestInsufficientBalanceWithFee() {
+	fa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	oa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	sa, st2 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(2)))
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApprove(oa.Address, sa.Address, oa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance with fee")
+}
+
+func (t *testApproveOperations) TestVestingLockedWithFee() {
+	fa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	oa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	sa, st2 := t.newAccount(true, nil)
+
+	vs := NewVesting(t.cid, []VestingRelease{NewVestingRelease(t.height()+1, NewBig(2))})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(2)))
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst, t.newVestingState(oa.Address, vs)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApprove(oa.Address, sa.Address, oa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient vested balance with fee")
+}
+
+func (t *testApproveOperations) TestOwnerFrozen() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newFreezeState(NewFreeze(oa.Address, "", false))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApprove(oa.Address, sa.Address, oa.Privs(), NewAmount(NewBig(100), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testApproveOperations) TestNotSignedByOwner() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newApprove(oa.Address, sa.Address, sa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func TestApproveOperations(t *testing.T) {
+	suite.Run(t, new(testApproveOperations))
+}
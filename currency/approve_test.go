@@ -0,0 +1,123 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testApprove struct {
+	baseTest
+}
+
+func (t *testApprove) newOperation(owner, spender base.Address, amount Amount) Approve {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewApproveFact(token, owner, spender, amount)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewApprove(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testApprove) TestNew() {
+	owner := NewTestAddress()
+	spender := NewTestAddress()
+	op := t.newOperation(owner, spender, NewAmount(NewBig(33), CurrencyID("SHOWME")))
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	as, err := op.Fact().(ApproveFact).Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+	t.True(owner.Equal(as[0]))
+	t.True(spender.Equal(as[1]))
+}
+
+func (t *testApprove) TestZeroAmount() {
+	op := t.newOperation(NewTestAddress(), NewTestAddress(), NewZeroAmount(CurrencyID("SHOWME")))
+	t.NoError(op.IsValid(nil))
+}
+
+func (t *testApprove) TestUnderZeroAmount() {
+	op := t.newOperation(NewTestAddress(), NewTestAddress(), NewAmount(NewBig(-1), CurrencyID("SHOWME")))
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "amount should be over nil")
+}
+
+func (t *testApprove) TestSameSpender() {
+	owner := NewTestAddress()
+	op := t.newOperation(owner, owner, NewAmount(NewBig(33), CurrencyID("SHOWME")))
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "spender is same with owner")
+}
+
+func TestApprove(t *testing.T) {
+	suite.Run(t, new(testApprove))
+}
+
+func testApproveEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewApproveFact(token, NewTestAddress(), NewTestAddress(), NewAmount(NewBig(33), CurrencyID("SHOWME")))
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewApprove(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Approve)
+		tb := b.(Approve)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(ApproveFact)
+		ufact := tb.Fact().(ApproveFact)
+
+		t.True(fact.owner.Equal(ufact.owner))
+		t.True(fact.spender.Equal(ufact.spender))
+		t.True(fact.amount.Equal(ufact.amount))
+	}
+
+	return t
+}
+
+func TestApproveEncodeJSON(t *testing.T) {
+	suite.Run(t, testApproveEncode(jsonenc.NewEncoder()))
+}
+
+func TestApproveEncodeBSON(t *testing.T) {
+	suite.Run(t, testApproveEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,153 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	BurnFactType = hint.MustNewType(0xa0, 0x3b, "mitum-currency-burn-operation-fact")
+	BurnFactHint = hint.MustHint(BurnFactType, "0.0.1")
+	BurnType     = hint.MustNewType(0xa0, 0x3c, "mitum-currency-burn-operation")
+	BurnHint     = hint.MustHint(BurnType, "0.0.1")
+)
+
+type BurnFact struct {
+	h       valuehash.Hash
+	token   []byte
+	sender  base.Address
+	amounts []Amount
+}
+
+func NewBurnFact(token []byte, sender base.Address, amounts []Amount) BurnFact {
+	fact := BurnFact{
+		token:   token,
+		sender:  sender,
+		amounts: amounts,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact BurnFact) Hint() hint.Hint {
+	return BurnFactHint
+}
+
+func (fact BurnFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact BurnFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BurnFact) Token() []byte {
+	return fact.token
+}
+
+func (fact BurnFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.amounts)+2)
+	bs[0] = fact.token
+	bs[1] = fact.sender.Bytes()
+
+	for i := range fact.amounts {
+		bs[i+2] = fact.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fact BurnFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for BurnFact")
+	} else if len(fact.amounts) < 1 {
+		return xerrors.Errorf("empty amounts")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{fact.h, fact.sender}, nil, false); err != nil {
+		return err
+	}
+
+	founds := map[CurrencyID]struct{}{}
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+		if _, found := founds[am.Currency()]; found {
+			return xerrors.Errorf("duplicated currency found, %q", am.Currency())
+		} else {
+			founds[am.Currency()] = struct{}{}
+		}
+
+		if err := am.IsValid(nil); err != nil {
+			return err
+		} else if !am.Big().OverZero() {
+			return xerrors.Errorf("amount should be over zero")
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact BurnFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact BurnFact) Amounts() []Amount {
+	return fact.amounts
+}
+
+func (fact BurnFact) Rebuild() BurnFact {
+	ams := make([]Amount, len(fact.amounts))
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+		ams[i] = am.WithBig(am.Big())
+	}
+
+	fact.amounts = ams
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact BurnFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type Burn struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewBurn(fact BurnFact, fs []operation.FactSign, memo string) (Burn, error) {
+	if bo, err := operation.NewBaseOperationFromFact(BurnHint, fact, fs); err != nil {
+		return Burn{}, err
+	} else {
+		op := Burn{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op Burn) Hint() hint.Hint {
+	return BurnHint
+}
+
+func (op Burn) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
@@ -0,0 +1,38 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *BurnFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	bam [][]byte,
+) error {
+	var sender base.Address
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		sender = a
+	}
+
+	amounts := make([]Amount, len(bam))
+	for i := range bam {
+		if j, err := DecodeAmount(enc, bam[i]); err != nil {
+			return err
+		} else {
+			amounts[i] = j
+		}
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.sender = sender
+	fact.amounts = amounts
+
+	return nil
+}
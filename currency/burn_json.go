@@ -0,0 +1,74 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type BurnFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	AM []Amount       `json:"amounts"`
+}
+
+func (fact BurnFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(BurnFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		AM:         fact.amounts,
+	})
+}
+
+type BurnFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	AM []json.RawMessage   `json:"amounts"`
+}
+
+func (fact *BurnFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact BurnFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	bam := make([][]byte, len(ufact.AM))
+	for i := range ufact.AM {
+		bam[i] = ufact.AM[i]
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, bam)
+}
+
+func (op Burn) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *Burn) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = Burn{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op Burn) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type BurnProcessor struct {
+	cp *CurrencyPool
+	Burn
+	proposable
+	height   base.Height
+	sb       map[CurrencyID]AmountState
+	de       map[CurrencyID]CurrencyDesignState
+	required map[CurrencyID][2]Big
+	sl       []spendingLimitState
+}
+
+func NewBurnProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(Burn); !ok {
+			return nil, xerrors.Errorf("not Burn, %T", op)
+		} else {
+			return &BurnProcessor{
+				cp:   cp,
+				Burn: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *BurnProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *BurnProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(BurnFact)
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if required, err := CalculateItemsFee(opp.cp, BurnType, []AmountsItem{fact}); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else if err := checkNotFrozenByRequired(fact.sender, nil, required, getState); err != nil {
+		return nil, err
+	} else if sb, err := CheckEnoughBalance(fact.sender, required, opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	if sl, err := checkSpendingLimit(fact.sender, requiredOutflow(opp.required), opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sl = sl
+	}
+
+	de := map[CurrencyID]CurrencyDesignState{}
+	for i := range fact.amounts {
+		cid := fact.amounts[i].Currency()
+
+		if st, err := existsState(StateKeyCurrencyDesign(cid), "currency design", getState); err != nil {
+			return nil, err
+		} else {
+			de[cid] = NewCurrencyDesignState(st, cid)
+		}
+	}
+
+	if err := opp.checkFactSigns(fact.sender, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	opp.de = de
+
+	return opp, nil
+}
+
+func (opp *BurnProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(BurnFact)
+
+	sts := make([]state.State, len(fact.amounts)*2)
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+		rq := opp.required[am.Currency()]
+
+		sts[i*2] = opp.sb[am.Currency()].Sub(rq[0]).AddFee(rq[1])
+		sts[i*2+1] = opp.de[am.Currency()].Sub(am.Big())
+	}
+
+	if sls, err := setSpendingLimitStates(opp.sl); err != nil {
+		return err
+	} else {
+		sts = append(sts, sls...)
+	}
+
+	return setState(fact.Hash(), sts...)
+}
@@ -0,0 +1,298 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testBurnOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testBurnOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testBurnOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(Burn{}, NewBurnProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testBurnOperations) newBurn(sender base.Address, keys []key.Privatekey, amounts []Amount) Burn {
+	token := util.UUID().Bytes()
+	fact := NewBurnFact(token, sender, amounts)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewBurn(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testBurnOperations) TestSenderNotExist() {
+	sa, _ := t.newAccount(false, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool([]state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newBurn(sa.Address, sa.Privs(), []Amount{NewAmount(NewBig(3), t.cid)})
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "does not exist")
+}
+
+func (t *testBurnOperations) TestInsufficientBalance() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(sts, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newBurn(sa.Address, sa.Privs(), []Amount{NewAmount(NewBig(11), t.cid)})
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testBurnOperations) TestSenderFrozen() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(sts, []state.State{dst, t.newFreezeState(NewFreeze(sa.Address, t.cid, false))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newBurn(sa.Address, sa.Privs(), []Amount{NewAmount(NewBig(3), t.cid)})
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testBurnOperations) TestSpendingLimit() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(0)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), NewBig(1)))
+	pool, _ := t.statepool(sts, []state.State{dst, t.newSpendingLimitState(sl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	// NOTE the fee is not counted
+	t.NoError(opr.Process(t.newBurn(sa.Address, sa.Privs(), []Amount{NewAmount(NewBig(5), t.cid)})))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySpendingLimit(sa.Address, t.cid) {
+			sst = st.GetState()
+		}
+	}
+
+	usl, err := StateSpendingLimitValue(sst)
+	t.NoError(err)
+	t.True(usl.Spent().Equal(NewBig(5)))
+	t.Equal(pool.Height(), usl.Start())
+}
+
+func (t *testBurnOperations) TestSpendingLimitExceeded() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(0)))
+	sl, err := sl.Spend(NewBig(3), t.height()-1)
+	t.NoError(err)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(sts, []state.State{dst, t.newSpendingLimitState(sl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	err = opr.Process(t.newBurn(sa.Address, sa.Privs(), []Amount{NewAmount(NewBig(3), t.cid)}))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "spending limit exceeded")
+}
+
+func (t *testBurnOperations) TestInsufficientBalanceWithFee() {
+	fa, fsts := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(2)))
+	pool, _ := t.statepool(fsts, sts, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newBurn(sa.Address, sa.Privs(), []Amount{NewAmount(NewBig(9), t.cid)})
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testBurnOperations) TestUnknownKey() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(sts, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newBurn(sa.Address, []key.Privatekey{key.MustNewBTCPrivatekey()}, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func (t *testBurnOperations) TestNew() {
+	faBalance := NewAmount(NewBig(22), t.cid)
+	saBalance := NewAmount(NewBig(33), t.cid)
+	fa, st0 := t.newAccount(true, []Amount{faBalance})
+	sa, st1 := t.newAccount(true, []Amount{saBalance})
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	burned := NewBig(10)
+	op := t.newBurn(sa.Address, sa.Privs(), []Amount{NewAmount(burned, t.cid)})
+
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	var sst, fst, dest state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(fa.Address, t.cid):
+			fst = st.GetState()
+		case StateKeyCurrencyDesign(t.cid):
+			dest = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(saBalance.Big().Sub(burned).Sub(fee)))
+
+	fstv, _ := StateBalanceValue(fst)
+	t.True(fstv.Big().Equal(faBalance.Big().Add(fee)))
+
+	de, err := StateCurrencyDesignValue(dest)
+	t.NoError(err)
+	t.True(de.Big().Equal(NewBig(99).Sub(burned)))
+}
+
+func (t *testBurnOperations) TestMultipleBurnsWithPolicyUpdater() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sb, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	priv := key.MustNewBTCPrivatekey()
+	threshold, err := base.NewThreshold(1, 100)
+	t.NoError(err)
+
+	copr, err := NewOperationProcessor(cp).SetProcessor(Burn{}, NewBurnProcessor(cp))
+	t.NoError(err)
+	copr, err = copr.(*OperationProcessor).SetProcessor(
+		CurrencyPolicyUpdater{},
+		NewCurrencyPolicyUpdaterProcessor(cp, []key.Publickey{priv.Publickey()}, threshold),
+	)
+	t.NoError(err)
+
+	opr := copr.New(pool)
+
+	po := NewCurrencyPolicy(NewBig(3), NewNilFeeer())
+
+	var pu CurrencyPolicyUpdater
+	{
+		fact := NewCurrencyPolicyUpdaterFact(util.UUID().Bytes(), t.cid, po)
+		sig, err := operation.NewFactSignature(priv, fact, nil)
+		t.NoError(err)
+
+		pu, err = NewCurrencyPolicyUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(priv.Publickey(), sig)}, "")
+		t.NoError(err)
+	}
+
+	t.NoError(opr.Process(t.newBurn(sa.Address, sa.Privs(), []Amount{NewAmount(NewBig(10), t.cid)})))
+	t.NoError(opr.Process(pu))
+	t.NoError(opr.Process(t.newBurn(sb.Address, sb.Privs(), []Amount{NewAmount(NewBig(20), t.cid)})))
+
+	var dest state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyCurrencyDesign(t.cid) {
+			dest = st.GetState()
+		}
+	}
+
+	de, err := StateCurrencyDesignValue(dest)
+	t.NoError(err)
+	t.True(de.Big().Equal(NewBig(69)))
+	t.Equal(po, de.Policy())
+}
+
+func TestBurnOperations(t *testing.T) {
+	suite.Run(t, new(testBurnOperations))
+}
@@ -0,0 +1,133 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testBurn struct {
+	baseTest
+}
+
+func (t *testBurn) newOperation(sender base.Address, amounts []Amount) Burn {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewBurnFact(token, sender, amounts)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewBurn(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testBurn) TestNew() {
+	sender := NewTestAddress()
+	op := t.newOperation(sender, []Amount{
+		NewAmount(NewBig(33), CurrencyID("SHOWME")),
+		NewAmount(NewBig(44), CurrencyID("FINDME")),
+	})
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	as, err := op.Fact().(BurnFact).Addresses()
+	t.NoError(err)
+	t.Equal(1, len(as))
+	t.True(sender.Equal(as[0]))
+}
+
+func (t *testBurn) TestEmptyAmounts() {
+	op := t.newOperation(NewTestAddress(), nil)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "empty amounts")
+}
+
+func (t *testBurn) TestZeroAmount() {
+	op := t.newOperation(NewTestAddress(), []Amount{NewAmount(NewBig(0), CurrencyID("SHOWME"))})
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "amount should be over zero")
+}
+
+func (t *testBurn) TestDuplicatedCurrency() {
+	op := t.newOperation(NewTestAddress(), []Amount{
+		NewAmount(NewBig(33), CurrencyID("SHOWME")),
+		NewAmount(NewBig(44), CurrencyID("SHOWME")),
+	})
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "duplicated currency found")
+}
+
+func TestBurn(t *testing.T) {
+	suite.Run(t, new(testBurn))
+}
+
+func testBurnEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewBurnFact(token, NewTestAddress(), []Amount{
+			NewAmount(NewBig(33), CurrencyID("SHOWME")),
+			NewAmount(NewBig(44), CurrencyID("FINDME")),
+		})
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewBurn(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Burn)
+		tb := b.(Burn)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(BurnFact)
+		ufact := tb.Fact().(BurnFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.Equal(len(fact.amounts), len(ufact.amounts))
+		for i := range fact.amounts {
+			t.True(fact.amounts[i].Equal(ufact.amounts[i]))
+		}
+	}
+
+	return t
+}
+
+func TestBurnEncodeJSON(t *testing.T) {
+	suite.Run(t, testBurnEncode(jsonenc.NewEncoder()))
+}
+
+func TestBurnEncodeBSON(t *testing.T) {
+	suite.Run(t, testBurnEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,149 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CancelRecoveryFactType = hint.MustNewType(0xa0, 0x5d, "mitum-currency-cancel-recovery-operation-fact")
+	CancelRecoveryFactHint = hint.MustHint(CancelRecoveryFactType, "0.0.1")
+	CancelRecoveryType     = hint.MustNewType(0xa0, 0x5e, "mitum-currency-cancel-recovery-operation")
+	CancelRecoveryHint     = hint.MustHint(CancelRecoveryType, "0.0.1")
+)
+
+// CancelRecoveryFact cancels the pending Recovery of target. It should be
+// signed by the current keys of target. The fee is charged to target.
+type CancelRecoveryFact struct {
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	currency CurrencyID
+}
+
+func NewCancelRecoveryFact(token []byte, target base.Address, currency CurrencyID) CancelRecoveryFact {
+	fact := CancelRecoveryFact{
+		token:    token,
+		target:   target,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CancelRecoveryFact) Hint() hint.Hint {
+	return CancelRecoveryFactHint
+}
+
+func (fact CancelRecoveryFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CancelRecoveryFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CancelRecoveryFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CancelRecoveryFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CancelRecoveryFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for CancelRecoveryFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.target,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact CancelRecoveryFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact CancelRecoveryFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact CancelRecoveryFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type CancelRecovery struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewCancelRecovery(fact CancelRecoveryFact, fs []operation.FactSign, memo string) (CancelRecovery, error) {
+	if bo, err := operation.NewBaseOperationFromFact(CancelRecoveryHint, fact, fs); err != nil {
+		return CancelRecovery{}, err
+	} else {
+		op := CancelRecovery{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op CancelRecovery) Hint() hint.Hint {
+	return CancelRecoveryHint
+}
+
+func (op CancelRecovery) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op CancelRecovery) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op CancelRecovery) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
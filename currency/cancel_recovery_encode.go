@@ -0,0 +1,27 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *CancelRecoveryFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bTarget base.AddressDecoder,
+	cr string,
+) error {
+	if a, err := bTarget.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.target = a
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.currency = CurrencyID(cr)
+
+	return nil
+}
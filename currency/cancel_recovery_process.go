@@ -0,0 +1,97 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op CancelRecovery) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type CancelRecoveryProcessor struct {
+	cp *CurrencyPool
+	CancelRecovery
+	proposable
+	height base.Height
+	sr     state.State
+	rv     Recovery
+	sb     AmountState
+	fee    Big
+}
+
+func NewCancelRecoveryProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(CancelRecovery); !ok {
+			return nil, xerrors.Errorf("not CancelRecovery, %T", op)
+		} else {
+			return &CancelRecoveryProcessor{
+				cp:             cp,
+				CancelRecovery: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *CancelRecoveryProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *CancelRecoveryProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(CancelRecoveryFact)
+
+	if _, err := existsAccountState(fact.target, "target", getState); err != nil {
+		return nil, err
+	}
+
+	if st, err := existsState(StateKeyRecovery(fact.target), "recovery of target", getState); err != nil {
+		return nil, err
+	} else if rv, err := StateRecoveryValue(st); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else if rv.Status() != RecoveryStatusPending {
+		return nil, util.IgnoreError.Errorf("recovery already %s", rv.Status())
+	} else {
+		opp.sr = st
+		opp.rv = rv
+	}
+
+	if err := opp.checkFactSigns(fact.target, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	if sb, fee, err := loadOperationFee(
+		opp.cp, fact.target, fact.currency, CancelRecoveryType, opp.height, getState,
+	); err != nil {
+		return nil, err
+	} else {
+		opp.sb = sb
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *CancelRecoveryProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CancelRecoveryFact)
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+	if st, err := SetStateRecoveryValue(opp.sr, opp.rv.Cancel()); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), st, opp.sb)
+	}
+}
@@ -0,0 +1,152 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testCancelRecoveryOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testCancelRecoveryOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testCancelRecoveryOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(CancelRecovery{}, NewCancelRecoveryProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testCancelRecoveryOperations) newCancelRecovery(target base.Address, keys []key.Privatekey) CancelRecovery {
+	token := util.UUID().Bytes()
+	fact := NewCancelRecoveryFact(token, target, t.cid)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewCancelRecovery(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testCancelRecoveryOperations) TestNew() {
+	ta, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	rv := NewRecovery(ta.Address, newTestRecoveryKeys(), NewTestAddress(), base.Height(33))
+	pool, _ := t.statepool(st0, []state.State{dst, t.newRecoveryState(rv)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCancelRecovery(ta.Address, ta.Privs())
+	t.NoError(opr.Process(op))
+
+	var rst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyRecovery(ta.Address) {
+			rst = st.GetState()
+		}
+	}
+
+	urv, err := StateRecoveryValue(rst)
+	t.NoError(err)
+	t.Equal(RecoveryStatusCancelled, urv.Status())
+}
+
+func (t *testCancelRecoveryOperations) TestNoRecovery() {
+	ta, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCancelRecovery(ta.Address, ta.Privs())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "recovery of target does not exist")
+}
+
+func (t *testCancelRecoveryOperations) TestAlreadyRecovered() {
+	ta, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	rv := NewRecovery(ta.Address, newTestRecoveryKeys(), NewTestAddress(), base.Height(33)).Recover()
+	pool, _ := t.statepool(st0, []state.State{dst, t.newRecoveryState(rv)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCancelRecovery(ta.Address, ta.Privs())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "recovery already recovered")
+}
+
+func (t *testCancelRecoveryOperations) TestSignedByGuardian() {
+	ta, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ga, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	rv := NewRecovery(ta.Address, newTestRecoveryKeys(), ga.Address, base.Height(33))
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newRecoveryState(rv)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCancelRecovery(ta.Address, ga.Privs())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func TestCancelRecoveryOperations(t *testing.T) {
+	suite.Run(t, new(testCancelRecoveryOperations))
+}
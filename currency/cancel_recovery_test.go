@@ -0,0 +1,95 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCancelRecovery struct {
+	baseTest
+}
+
+func (t *testCancelRecovery) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewCancelRecoveryFact(token, NewTestAddress(), t.cid)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewCancelRecovery(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testCancelRecovery) TestEmptyToken() {
+	fact := NewCancelRecoveryFact(nil, NewTestAddress(), t.cid)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty token")
+}
+
+func TestCancelRecovery(t *testing.T) {
+	suite.Run(t, new(testCancelRecovery))
+}
+
+func testCancelRecoveryEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewCancelRecoveryFact(token, NewTestAddress(), CurrencyID("SHOWME"))
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewCancelRecovery(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(CancelRecovery)
+		tb := b.(CancelRecovery)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(CancelRecoveryFact)
+		ufact := tb.Fact().(CancelRecoveryFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestCancelRecoveryEncodeJSON(t *testing.T) {
+	suite.Run(t, testCancelRecoveryEncode(jsonenc.NewEncoder()))
+}
+
+func TestCancelRecoveryEncodeBSON(t *testing.T) {
+	suite.Run(t, testCancelRecoveryEncode(bsonenc.NewEncoder()))
+}
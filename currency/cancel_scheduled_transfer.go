@@ -0,0 +1,153 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CancelScheduledTransferFactType = hint.MustNewType(0xa0, 0x7b, "mitum-currency-cancel-scheduled-transfer-operation-fact")
+	CancelScheduledTransferFactHint = hint.MustHint(CancelScheduledTransferFactType, "0.0.1")
+	CancelScheduledTransferType     = hint.MustNewType(0xa0, 0x7c, "mitum-currency-cancel-scheduled-transfer-operation")
+	CancelScheduledTransferHint     = hint.MustHint(CancelScheduledTransferType, "0.0.1")
+)
+
+// CancelScheduledTransferFact cancels the pending ScheduledTransfer before it's
+// target height; the reserved amount is refunded to sender.
+type CancelScheduledTransferFact struct {
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	transfer valuehash.Hash
+}
+
+func NewCancelScheduledTransferFact(
+	token []byte,
+	sender base.Address,
+	transfer valuehash.Hash,
+) CancelScheduledTransferFact {
+	fact := CancelScheduledTransferFact{
+		token:    token,
+		sender:   sender,
+		transfer: transfer,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CancelScheduledTransferFact) Hint() hint.Hint {
+	return CancelScheduledTransferFactHint
+}
+
+func (fact CancelScheduledTransferFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CancelScheduledTransferFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CancelScheduledTransferFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CancelScheduledTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.transfer.Bytes(),
+	)
+}
+
+func (fact CancelScheduledTransferFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for CancelScheduledTransferFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.transfer,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact CancelScheduledTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CancelScheduledTransferFact) Transfer() valuehash.Hash {
+	return fact.transfer
+}
+
+func (fact CancelScheduledTransferFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type CancelScheduledTransfer struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewCancelScheduledTransfer(fact CancelScheduledTransferFact, fs []operation.FactSign, memo string) (CancelScheduledTransfer, error) {
+	if bo, err := operation.NewBaseOperationFromFact(CancelScheduledTransferHint, fact, fs); err != nil {
+		return CancelScheduledTransfer{}, err
+	} else {
+		op := CancelScheduledTransfer{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op CancelScheduledTransfer) Hint() hint.Hint {
+	return CancelScheduledTransferHint
+}
+
+func (op CancelScheduledTransfer) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op CancelScheduledTransfer) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op CancelScheduledTransfer) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,27 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *CancelScheduledTransferFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	transfer valuehash.Hash,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.sender = a
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.transfer = transfer
+
+	return nil
+}
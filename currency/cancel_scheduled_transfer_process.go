@@ -0,0 +1,125 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op CancelScheduledTransfer) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type CancelScheduledTransferProcessor struct {
+	cp *CurrencyPool
+	CancelScheduledTransfer
+	height base.Height
+	ss     state.State
+	sc     ScheduledTransfer
+	sb     AmountState
+	fee    Big
+}
+
+func NewCancelScheduledTransferProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(CancelScheduledTransfer); !ok {
+			return nil, xerrors.Errorf("not CancelScheduledTransfer, %T", op)
+		} else {
+			return &CancelScheduledTransferProcessor{
+				cp:                      cp,
+				CancelScheduledTransfer: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *CancelScheduledTransferProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *CancelScheduledTransferProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(CancelScheduledTransferFact)
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if st, sc, err := loadPendingScheduledTransfer(fact.transfer, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = st
+		opp.sc = sc
+	}
+
+	switch {
+	case !opp.sc.Sender().Equal(fact.sender):
+		return nil, util.IgnoreError.Errorf("sender is not sender of scheduled transfer, %q", fact.sender)
+	case opp.height >= opp.sc.Height():
+		return nil, util.IgnoreError.Errorf("scheduled transfer already reached height, %v", opp.sc.Height())
+	}
+
+	if fee, err := cancelScheduledTransferFee(opp.cp, opp.sc); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else {
+		opp.fee = fee
+	}
+
+	if st, _, err := getState(StateKeyBalance(fact.sender, opp.sc.Currency())); err != nil {
+		return nil, err
+	} else {
+		opp.sb = NewAmountState(st, opp.sc.Currency())
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *CancelScheduledTransferProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CancelScheduledTransferFact)
+
+	if st, err := SetStateScheduledTransferValue(opp.ss, opp.sc.Cancel()); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), st, opp.sb.Add(opp.sc.Amount().Big().Sub(opp.fee)).AddFee(opp.fee))
+	}
+}
+
+// cancelScheduledTransferFee returns the fee of CancelScheduledTransfer; like
+// RefundTransfer, the fee is charged to the reserved amount.
+func cancelScheduledTransferFee(cp *CurrencyPool, sc ScheduledTransfer) (Big, error) {
+	if cp == nil {
+		return ZeroBig, nil
+	}
+
+	var feeer Feeer
+	if i, found := cp.OperationFeeer(sc.Currency(), CancelScheduledTransferType); !found {
+		return ZeroBig, xerrors.Errorf("unknown currency id found, %q", sc.Currency())
+	} else {
+		feeer = i
+	}
+
+	switch fee, err := feeer.Fee(sc.Amount().Big()); {
+	case err != nil:
+		return ZeroBig, err
+	case sc.Amount().Big().Compare(fee) < 0:
+		return ZeroBig, xerrors.Errorf("insufficient scheduled amount with fee")
+	default:
+		return fee, nil
+	}
+}
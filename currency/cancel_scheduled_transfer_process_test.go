@@ -0,0 +1,187 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testCancelScheduledTransferOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testCancelScheduledTransferOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testCancelScheduledTransferOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(CancelScheduledTransfer{}, NewCancelScheduledTransferProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testCancelScheduledTransferOperations) newCancelScheduledTransfer(
+	sender base.Address,
+	keys []key.Privatekey,
+	transfer valuehash.Hash,
+) CancelScheduledTransfer {
+	token := util.UUID().Bytes()
+	fact := NewCancelScheduledTransferFact(token, sender, transfer)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewCancelScheduledTransfer(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testCancelScheduledTransferOperations) newScheduledTransfer(
+	sender, receiver base.Address,
+	height base.Height,
+) ScheduledTransfer {
+	return NewScheduledTransfer(valuehash.RandomSHA256(), sender, receiver, NewAmount(NewBig(10), t.cid), height)
+}
+
+func (t *testCancelScheduledTransferOperations) TestNew() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	fee := NewBig(1)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+
+	sc := t.newScheduledTransfer(sa.Address, ra.Address, t.height()+1)
+	sq := NewScheduleQueue([]ScheduleItem{NewScheduleItem(sc.Height(), sc.ID())})
+	pool, _ := t.statepool(st0, st1, st2, []state.State{
+		dst, t.newScheduledTransferState(sc), t.newScheduleQueueState(sq),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCancelScheduledTransfer(sa.Address, sa.Privs(), sc.ID())
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	var sst, cst, qst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyScheduledTransfer(sc.ID()):
+			cst = st.GetState()
+		case StateKeyScheduleQueue:
+			qst = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(3).Add(sc.Amount().Big()).Sub(fee)))
+	t.True(sst.(AmountState).Fee().Equal(fee))
+
+	usc, err := StateScheduledTransferValue(cst)
+	t.NoError(err)
+	t.Equal(ScheduledTransferStatusCancelled, usc.Status())
+
+	usq, err := StateScheduleQueueValue(qst)
+	t.NoError(err)
+	t.True(usq.IsEmpty())
+}
+
+func (t *testCancelScheduledTransferOperations) TestReachedHeight() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	sc := t.newScheduledTransfer(sa.Address, ra.Address, t.height())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newScheduledTransferState(sc)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCancelScheduledTransfer(sa.Address, sa.Privs(), sc.ID())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "already reached height")
+}
+
+func (t *testCancelScheduledTransferOperations) TestNotSender() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	sc := t.newScheduledTransfer(sa.Address, ra.Address, t.height()+1)
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newScheduledTransferState(sc)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCancelScheduledTransfer(ra.Address, ra.Privs(), sc.ID())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "sender is not sender of scheduled transfer")
+}
+
+func (t *testCancelScheduledTransferOperations) TestAlreadyCancelled() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	sc := t.newScheduledTransfer(sa.Address, ra.Address, t.height()+1).Cancel()
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newScheduledTransferState(sc)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCancelScheduledTransfer(sa.Address, sa.Privs(), sc.ID())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "scheduled transfer already cancelled")
+}
+
+func TestCancelScheduledTransferOperations(t *testing.T) {
+	suite.Run(t, new(testCancelScheduledTransferOperations))
+}
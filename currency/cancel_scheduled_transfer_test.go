@@ -0,0 +1,89 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCancelScheduledTransfer struct {
+	baseTest
+}
+
+func (t *testCancelScheduledTransfer) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewCancelScheduledTransferFact(token, NewTestAddress(), valuehash.RandomSHA256())
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewCancelScheduledTransfer(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func TestCancelScheduledTransfer(t *testing.T) {
+	suite.Run(t, new(testCancelScheduledTransfer))
+}
+
+func testCancelScheduledTransferEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewCancelScheduledTransferFact(token, NewTestAddress(), valuehash.RandomSHA256())
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewCancelScheduledTransfer(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(CancelScheduledTransfer)
+		tb := b.(CancelScheduledTransfer)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(CancelScheduledTransferFact)
+		ufact := tb.Fact().(CancelScheduledTransferFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.transfer.Equal(ufact.transfer))
+	}
+
+	return t
+}
+
+func TestCancelScheduledTransferEncodeJSON(t *testing.T) {
+	suite.Run(t, testCancelScheduledTransferEncode(jsonenc.NewEncoder()))
+}
+
+func TestCancelScheduledTransferEncodeBSON(t *testing.T) {
+	suite.Run(t, testCancelScheduledTransferEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,157 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CancelStandingOrderFactType = hint.MustNewType(0xa0, 0x82, "mitum-currency-cancel-standing-order-operation-fact")
+	CancelStandingOrderFactHint = hint.MustHint(CancelStandingOrderFactType, "0.0.1")
+	CancelStandingOrderType     = hint.MustNewType(0xa0, 0x83, "mitum-currency-cancel-standing-order-operation")
+	CancelStandingOrderHint     = hint.MustHint(CancelStandingOrderType, "0.0.1")
+)
+
+// CancelStandingOrderFact cancels the active StandingOrder; the remaining rounds
+// are not paid. Only payer can cancel it.
+type CancelStandingOrderFact struct {
+	h     valuehash.Hash
+	token []byte
+	payer base.Address
+	order valuehash.Hash
+}
+
+func NewCancelStandingOrderFact(
+	token []byte,
+	payer base.Address,
+	order valuehash.Hash,
+) CancelStandingOrderFact {
+	fact := CancelStandingOrderFact{
+		token: token,
+		payer: payer,
+		order: order,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CancelStandingOrderFact) Hint() hint.Hint {
+	return CancelStandingOrderFactHint
+}
+
+func (fact CancelStandingOrderFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CancelStandingOrderFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CancelStandingOrderFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CancelStandingOrderFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.payer.Bytes(),
+		fact.order.Bytes(),
+	)
+}
+
+func (fact CancelStandingOrderFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for CancelStandingOrderFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.payer,
+		fact.order,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact CancelStandingOrderFact) Payer() base.Address {
+	return fact.payer
+}
+
+func (fact CancelStandingOrderFact) Order() valuehash.Hash {
+	return fact.order
+}
+
+func (fact CancelStandingOrderFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.payer}, nil
+}
+
+type CancelStandingOrder struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewCancelStandingOrder(
+	fact CancelStandingOrderFact,
+	fs []operation.FactSign,
+	memo string,
+) (CancelStandingOrder, error) {
+	if bo, err := operation.NewBaseOperationFromFact(CancelStandingOrderHint, fact, fs); err != nil {
+		return CancelStandingOrder{}, err
+	} else {
+		op := CancelStandingOrder{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op CancelStandingOrder) Hint() hint.Hint {
+	return CancelStandingOrderHint
+}
+
+func (op CancelStandingOrder) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op CancelStandingOrder) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op CancelStandingOrder) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,27 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *CancelStandingOrderFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bPayer base.AddressDecoder,
+	order valuehash.Hash,
+) error {
+	if a, err := bPayer.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.payer = a
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.order = order
+
+	return nil
+}
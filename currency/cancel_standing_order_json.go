@@ -0,0 +1,67 @@
+package currency // nolint: dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type CancelStandingOrderFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	PR base.Address   `json:"payer"`
+	OR valuehash.Hash `json:"order"`
+}
+
+func (fact CancelStandingOrderFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(CancelStandingOrderFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		PR:         fact.payer,
+		OR:         fact.order,
+	})
+}
+
+type CancelStandingOrderFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	PR base.AddressDecoder `json:"payer"`
+	OR valuehash.Bytes     `json:"order"`
+}
+
+func (fact *CancelStandingOrderFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact CancelStandingOrderFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.PR, ufact.OR)
+}
+
+func (op CancelStandingOrder) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *CancelStandingOrder) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = CancelStandingOrder{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op CancelStandingOrder) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type CancelStandingOrderProcessor struct {
+	cp *CurrencyPool
+	CancelStandingOrder
+	height base.Height
+	ss     state.State
+	so     StandingOrder
+	sb     AmountState
+	fee    Big
+}
+
+func NewCancelStandingOrderProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(CancelStandingOrder); !ok {
+			return nil, xerrors.Errorf("not CancelStandingOrder, %T", op)
+		} else {
+			return &CancelStandingOrderProcessor{
+				cp:                  cp,
+				CancelStandingOrder: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *CancelStandingOrderProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *CancelStandingOrderProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(CancelStandingOrderFact)
+
+	if _, err := existsAccountState(fact.payer, "payer", getState); err != nil {
+		return nil, err
+	}
+
+	if st, so, err := loadActiveStandingOrder(fact.order, getState); err != nil {
+		return nil, err
+	} else if !so.Payer().Equal(fact.payer) {
+		return nil, util.IgnoreError.Errorf("payer is not payer of standing order, %q", fact.payer)
+	} else {
+		opp.ss = st
+		opp.so = so
+	}
+
+	if err := checkFactSignsByState(fact.payer, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	if sb, fee, err := loadOperationFee(
+		opp.cp, fact.payer, opp.so.Currency(), CancelStandingOrderType, opp.height, getState,
+	); err != nil {
+		return nil, err
+	} else {
+		opp.sb = sb
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *CancelStandingOrderProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CancelStandingOrderFact)
+
+	if st, err := SetStateStandingOrderValue(opp.ss, opp.so.Cancel()); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), st, opp.sb.Sub(opp.fee).AddFee(opp.fee))
+	}
+}
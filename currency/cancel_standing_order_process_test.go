@@ -0,0 +1,165 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testCancelStandingOrderOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testCancelStandingOrderOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testCancelStandingOrderOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(CancelStandingOrder{}, NewCancelStandingOrderProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testCancelStandingOrderOperations) newCancelStandingOrder(
+	payer base.Address,
+	keys []key.Privatekey,
+	order valuehash.Hash,
+) CancelStandingOrder {
+	token := util.UUID().Bytes()
+	fact := NewCancelStandingOrderFact(token, payer, order)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewCancelStandingOrder(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testCancelStandingOrderOperations) newStandingOrder(payer, payee base.Address) StandingOrder {
+	return NewStandingOrder(
+		valuehash.RandomSHA256(), payer, payee, NewAmount(NewBig(10), t.cid), base.Height(5), 3, t.height()+1,
+	)
+}
+
+func (t *testCancelStandingOrderOperations) TestNew() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	fee := NewBig(1)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+
+	so := t.newStandingOrder(sa.Address, ra.Address)
+	sq := NewScheduleQueue([]ScheduleItem{NewScheduleItem(so.Next(), so.ID())})
+	pool, _ := t.statepool(st0, st1, st2, []state.State{
+		dst, t.newStandingOrderState(so), t.newStandingOrderQueueState(sq),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCancelStandingOrder(sa.Address, sa.Privs(), so.ID())
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	var sst, ost, qst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyStandingOrder(so.ID()):
+			ost = st.GetState()
+		case StateKeyStandingOrderQueue:
+			qst = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(3).Sub(fee)))
+	t.True(sst.(AmountState).Fee().Equal(fee))
+
+	uso, err := StateStandingOrderValue(ost)
+	t.NoError(err)
+	t.Equal(StandingOrderStatusCancelled, uso.Status())
+
+	usq, err := StateScheduleQueueValue(qst)
+	t.NoError(err)
+	t.True(usq.IsEmpty())
+}
+
+func (t *testCancelStandingOrderOperations) TestNotPayer() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	so := t.newStandingOrder(sa.Address, ra.Address)
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newStandingOrderState(so)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCancelStandingOrder(ra.Address, ra.Privs(), so.ID())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "payer is not payer of standing order")
+}
+
+func (t *testCancelStandingOrderOperations) TestAlreadyCompleted() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	so := t.newStandingOrder(sa.Address, ra.Address).Pay().Skip().Pay()
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newStandingOrderState(so)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCancelStandingOrder(sa.Address, sa.Privs(), so.ID())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "standing order already completed")
+}
+
+func TestCancelStandingOrderOperations(t *testing.T) {
+	suite.Run(t, new(testCancelStandingOrderOperations))
+}
@@ -0,0 +1,89 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCancelStandingOrder struct {
+	baseTest
+}
+
+func (t *testCancelStandingOrder) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewCancelStandingOrderFact(token, NewTestAddress(), valuehash.RandomSHA256())
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewCancelStandingOrder(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func TestCancelStandingOrder(t *testing.T) {
+	suite.Run(t, new(testCancelStandingOrder))
+}
+
+func testCancelStandingOrderEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewCancelStandingOrderFact(token, NewTestAddress(), valuehash.RandomSHA256())
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewCancelStandingOrder(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(CancelStandingOrder)
+		tb := b.(CancelStandingOrder)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(CancelStandingOrderFact)
+		ufact := tb.Fact().(CancelStandingOrderFact)
+
+		t.True(fact.payer.Equal(ufact.payer))
+		t.True(fact.order.Equal(ufact.order))
+	}
+
+	return t
+}
+
+func TestCancelStandingOrderEncodeJSON(t *testing.T) {
+	suite.Run(t, testCancelStandingOrderEncode(jsonenc.NewEncoder()))
+}
+
+func TestCancelStandingOrderEncodeBSON(t *testing.T) {
+	suite.Run(t, testCancelStandingOrderEncode(bsonenc.NewEncoder()))
+}
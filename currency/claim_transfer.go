@@ -0,0 +1,165 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ClaimTransferFactType = hint.MustNewType(0xa0, 0x49, "mitum-currency-claim-transfer-operation-fact")
+	ClaimTransferFactHint = hint.MustHint(ClaimTransferFactType, "0.0.1")
+	ClaimTransferType     = hint.MustNewType(0xa0, 0x4a, "mitum-currency-claim-transfer-operation")
+	ClaimTransferHint     = hint.MustHint(ClaimTransferType, "0.0.1")
+)
+
+// ClaimTransferFact claims the Lock by revealing the preimage of hashlock. The
+// sender is the receiver of Lock.
+type ClaimTransferFact struct {
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	lock     valuehash.Hash
+	preimage []byte
+}
+
+func NewClaimTransferFact(
+	token []byte,
+	sender base.Address,
+	lock valuehash.Hash,
+	preimage []byte,
+) ClaimTransferFact {
+	fact := ClaimTransferFact{
+		token:    token,
+		sender:   sender,
+		lock:     lock,
+		preimage: preimage,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ClaimTransferFact) Hint() hint.Hint {
+	return ClaimTransferFactHint
+}
+
+func (fact ClaimTransferFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ClaimTransferFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ClaimTransferFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ClaimTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.lock.Bytes(),
+		fact.preimage,
+	)
+}
+
+func (fact ClaimTransferFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for ClaimTransferFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.lock,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if len(fact.preimage) < 1 {
+		return xerrors.Errorf("empty preimage")
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ClaimTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ClaimTransferFact) Lock() valuehash.Hash {
+	return fact.lock
+}
+
+func (fact ClaimTransferFact) Preimage() []byte {
+	return fact.preimage
+}
+
+func (fact ClaimTransferFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type ClaimTransfer struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewClaimTransfer(fact ClaimTransferFact, fs []operation.FactSign, memo string) (ClaimTransfer, error) {
+	if bo, err := operation.NewBaseOperationFromFact(ClaimTransferHint, fact, fs); err != nil {
+		return ClaimTransfer{}, err
+	} else {
+		op := ClaimTransfer{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op ClaimTransfer) Hint() hint.Hint {
+	return ClaimTransferHint
+}
+
+func (op ClaimTransfer) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op ClaimTransfer) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op ClaimTransfer) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,29 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *ClaimTransferFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	lock valuehash.Hash,
+	preimage []byte,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.sender = a
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.lock = lock
+	fact.preimage = preimage
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op ClaimTransfer) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type ClaimTransferProcessor struct {
+	cp *CurrencyPool
+	ClaimTransfer
+	proposable
+	height base.Height
+	sl     state.State
+	lk     Lock
+	rb     AmountState
+	fee    Big
+}
+
+func NewClaimTransferProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(ClaimTransfer); !ok {
+			return nil, xerrors.Errorf("not ClaimTransfer, %T", op)
+		} else {
+			return &ClaimTransferProcessor{
+				cp:            cp,
+				ClaimTransfer: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *ClaimTransferProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *ClaimTransferProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ClaimTransferFact)
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if st, lk, err := loadOpenLock(fact.lock, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sl = st
+		opp.lk = lk
+	}
+
+	switch {
+	case !opp.lk.Receiver().Equal(fact.sender):
+		return nil, util.IgnoreError.Errorf("sender is not receiver of lock, %q", fact.sender)
+	case opp.height >= opp.lk.Expiry():
+		return nil, util.IgnoreError.Errorf("lock expired at %v", opp.lk.Expiry())
+	case !opp.lk.Unlock(fact.preimage):
+		return nil, util.IgnoreError.Errorf("wrong preimage")
+	}
+
+	// NOTE the transfer restriction of currency may be changed after lock
+	if err := checkTransferRestriction(opp.cp, opp.lk.Sender(), opp.lk.Currency(), getState); err != nil {
+		return nil, err
+	} else if err := checkTransferRestriction(opp.cp, fact.sender, opp.lk.Currency(), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkNotFrozen(fact.sender, opp.lk.Currency(), true, getState); err != nil {
+		return nil, err
+	}
+
+	if fee, err := releaseLockFee(opp.cp, opp.lk, ClaimTransferType); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else {
+		opp.fee = fee
+	}
+
+	if st, _, err := getState(StateKeyBalance(fact.sender, opp.lk.Currency())); err != nil {
+		return nil, err
+	} else {
+		opp.rb = NewAmountState(st, opp.lk.Currency())
+	}
+
+	if err := opp.checkFactSigns(fact.sender, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *ClaimTransferProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(ClaimTransferFact)
+
+	if st, err := SetStateLockValue(opp.sl, opp.lk.Claim(fact.preimage)); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), st, opp.rb.Add(opp.lk.Amount().Big().Sub(opp.fee)).AddFee(opp.fee))
+	}
+}
@@ -0,0 +1,293 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testClaimTransferOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testClaimTransferOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testClaimTransferOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(ClaimTransfer{}, NewClaimTransferProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testClaimTransferOperations) newClaimTransfer(
+	sender base.Address,
+	keys []key.Privatekey,
+	lock valuehash.Hash,
+	preimage []byte,
+) ClaimTransfer {
+	token := util.UUID().Bytes()
+	fact := NewClaimTransferFact(token, sender, lock, preimage)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewClaimTransfer(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testClaimTransferOperations) newLock(
+	sender, receiver base.Address,
+	amount Amount,
+	expiry base.Height,
+) (Lock, []byte) {
+	hashlock, preimage := newTestHashlock()
+
+	return NewLock(valuehash.RandomSHA256(), sender, receiver, amount, hashlock, expiry), preimage
+}
+
+func (t *testClaimTransferOperations) TestNew() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, nil)
+	ra, st2 := t.newAccount(true, nil)
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	amount := NewAmount(NewBig(10), t.cid)
+	lk, preimage := t.newLock(sa.Address, ra.Address, amount, t.height()+1)
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst, t.newLockState(lk)})
+
+	opr := t.processor(cp, pool)
+
+	op := t.newClaimTransfer(ra.Address, ra.Privs(), lk.ID(), preimage)
+	t.NoError(opr.Process(op))
+
+	var rst, lst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyLock(lk.ID()):
+			lst = st.GetState()
+		}
+	}
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(amount.Big().Sub(fee)))
+	t.True(rst.(AmountState).Fee().Equal(fee))
+
+	ulk, err := StateLockValue(lst)
+	t.NoError(err)
+	t.NoError(ulk.IsValid(nil))
+	t.Equal(LockStatusClaimed, ulk.Status())
+	t.Equal(preimage, ulk.Preimage())
+}
+
+func (t *testClaimTransferOperations) TestDenylistedAfterLock() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetTransferRestriction(TransferRestrictionDenylist)
+	dst := t.newCurrencyDesignStateByPolicy(t.cid, NewBig(99), NewTestAddress(), po)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	lk, preimage := t.newLock(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), t.height()+1)
+
+	for _, a := range []base.Address{sa.Address, ra.Address} {
+		pool, _ := t.statepool(st0, st1, []state.State{
+			dst,
+			t.newLockState(lk),
+			t.newTransferListState(NewTransferListMember(a, t.cid, true)),
+		})
+
+		opr := t.processor(cp, pool)
+
+		err := opr.Process(t.newClaimTransfer(ra.Address, ra.Privs(), lk.ID(), preimage))
+		t.True(xerrors.Is(err, util.IgnoreError))
+		t.Contains(err.Error(), "in denylist")
+	}
+}
+
+func (t *testClaimTransferOperations) TestReceiverClosed() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newClosedAccount(nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	lk, preimage := t.newLock(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), t.height()+1)
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newLockState(lk)})
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newClaimTransfer(ra.Address, ra.Privs(), lk.ID(), preimage))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testClaimTransferOperations) TestReceiverFrozen() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	lk, preimage := t.newLock(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), t.height()+1)
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst,
+		t.newLockState(lk),
+		t.newFreezeState(NewFreeze(ra.Address, "", true)),
+	})
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newClaimTransfer(ra.Address, ra.Privs(), lk.ID(), preimage))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testClaimTransferOperations) TestWrongPreimage() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	lk, _ := t.newLock(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), base.Height(33))
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newLockState(lk)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newClaimTransfer(ra.Address, ra.Privs(), lk.ID(), util.UUID().Bytes())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "wrong preimage")
+}
+
+func (t *testClaimTransferOperations) TestNotReceiver() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	lk, preimage := t.newLock(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), base.Height(33))
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newLockState(lk)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newClaimTransfer(sa.Address, sa.Privs(), lk.ID(), preimage)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "sender is not receiver of lock")
+}
+
+func (t *testClaimTransferOperations) TestExpired() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	lk, preimage := t.newLock(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), t.height())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newLockState(lk)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newClaimTransfer(ra.Address, ra.Privs(), lk.ID(), preimage)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "lock expired")
+}
+
+func (t *testClaimTransferOperations) TestAlreadyClaimed() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	lk, preimage := t.newLock(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), base.Height(33))
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newLockState(lk.Claim(preimage))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newClaimTransfer(ra.Address, ra.Privs(), lk.ID(), preimage)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "lock already claimed")
+}
+
+func (t *testClaimTransferOperations) TestInsufficientLockedAmountWithFee() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, nil)
+	ra, st2 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(11)))
+
+	lk, preimage := t.newLock(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), base.Height(33))
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst, t.newLockState(lk)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newClaimTransfer(ra.Address, ra.Privs(), lk.ID(), preimage)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient locked amount with fee")
+}
+
+func TestClaimTransferOperations(t *testing.T) {
+	suite.Run(t, new(testClaimTransferOperations))
+}
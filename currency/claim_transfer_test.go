@@ -0,0 +1,103 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"github.com/stretchr/testify/suite"
+)
+
+type testClaimTransfer struct {
+	baseTest
+}
+
+func (t *testClaimTransfer) newOperation(sender base.Address, lock valuehash.Hash, preimage []byte) ClaimTransfer {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewClaimTransferFact(token, sender, lock, preimage)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewClaimTransfer(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testClaimTransfer) TestNew() {
+	op := t.newOperation(NewTestAddress(), valuehash.RandomSHA256(), util.UUID().Bytes())
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testClaimTransfer) TestEmptyPreimage() {
+	op := t.newOperation(NewTestAddress(), valuehash.RandomSHA256(), nil)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "empty preimage")
+}
+
+func TestClaimTransfer(t *testing.T) {
+	suite.Run(t, new(testClaimTransfer))
+}
+
+func testClaimTransferEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewClaimTransferFact(token, NewTestAddress(), valuehash.RandomSHA256(), util.UUID().Bytes())
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewClaimTransfer(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(ClaimTransfer)
+		tb := b.(ClaimTransfer)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(ClaimTransferFact)
+		ufact := tb.Fact().(ClaimTransferFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.lock.Equal(ufact.lock))
+		t.Equal(fact.preimage, ufact.preimage)
+	}
+
+	return t
+}
+
+func TestClaimTransferEncodeJSON(t *testing.T) {
+	suite.Run(t, testClaimTransferEncode(jsonenc.NewEncoder()))
+}
+
+func TestClaimTransferEncodeBSON(t *testing.T) {
+	suite.Run(t, testClaimTransferEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,156 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CloseAccountFactType = hint.MustNewType(0xa0, 0x67, "mitum-currency-close-account-operation-fact")
+	CloseAccountFactHint = hint.MustHint(CloseAccountFactType, "0.0.1")
+	CloseAccountType     = hint.MustNewType(0xa0, 0x68, "mitum-currency-close-account-operation")
+	CloseAccountHint     = hint.MustHint(CloseAccountType, "0.0.1")
+)
+
+// CloseAccountFact closes the account of sender. The balances of every
+// currency are transferred to beneficiary except the fee of each currency. The
+// closed account can not sign operations and can not receive amounts.
+type CloseAccountFact struct {
+	h           valuehash.Hash
+	token       []byte
+	sender      base.Address
+	beneficiary base.Address
+}
+
+func NewCloseAccountFact(token []byte, sender, beneficiary base.Address) CloseAccountFact {
+	fact := CloseAccountFact{
+		token:       token,
+		sender:      sender,
+		beneficiary: beneficiary,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CloseAccountFact) Hint() hint.Hint {
+	return CloseAccountFactHint
+}
+
+func (fact CloseAccountFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CloseAccountFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CloseAccountFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CloseAccountFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.beneficiary.Bytes(),
+	)
+}
+
+func (fact CloseAccountFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for CloseAccountFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.beneficiary,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.beneficiary) {
+		return xerrors.Errorf("beneficiary is same with sender, %q", fact.sender)
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact CloseAccountFact) Sender() base.Address {
+	return fact.sender
+}
+
+// Beneficiary is the account, which receives the balances of sender. It can be
+// Alias.
+func (fact CloseAccountFact) Beneficiary() base.Address {
+	return fact.beneficiary
+}
+
+func (fact CloseAccountFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.beneficiary}, nil
+}
+
+type CloseAccount struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewCloseAccount(fact CloseAccountFact, fs []operation.FactSign, memo string) (CloseAccount, error) {
+	if bo, err := operation.NewBaseOperationFromFact(CloseAccountHint, fact, fs); err != nil {
+		return CloseAccount{}, err
+	} else {
+		op := CloseAccount{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op CloseAccount) Hint() hint.Hint {
+	return CloseAccountHint
+}
+
+func (op CloseAccount) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op CloseAccount) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op CloseAccount) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
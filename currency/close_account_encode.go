@@ -0,0 +1,32 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *CloseAccountFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	bBeneficiary base.AddressDecoder,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.sender = a
+	}
+
+	if a, err := bBeneficiary.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.beneficiary = a
+	}
+
+	fact.h = h
+	fact.token = token
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op CloseAccount) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type CloseAccountProcessor struct {
+	cp *CurrencyPool
+	CloseAccount
+	proposable
+	height base.Height
+	sa     state.State // NOTE state of sender account
+	sb     map[CurrencyID]AmountState
+	rb     map[CurrencyID]AmountState
+	cids   []CurrencyID
+	swept  map[CurrencyID][2]Big // NOTE balance and fee
+	sl     []spendingLimitState
+}
+
+func NewCloseAccountProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(CloseAccount); !ok {
+			return nil, xerrors.Errorf("not CloseAccount, %T", op)
+		} else {
+			return &CloseAccountProcessor{
+				cp:           cp,
+				CloseAccount: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *CloseAccountProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *CloseAccountProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(CloseAccountFact)
+
+	if st, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	} else {
+		opp.sa = st
+	}
+
+	if err := checkNotFrozen(fact.sender, "", false, getState); err != nil {
+		return nil, err
+	}
+
+	if err := opp.checkNotFeeReceiver(); err != nil {
+		return nil, err
+	}
+
+	switch _, cm, err := loadCommitments(fact.sender, getState); {
+	case err != nil:
+		return nil, err
+	case len(cm.Keys()) > 0:
+		return nil, util.IgnoreError.Errorf("sender has pending commitments, %q", cm.Keys())
+	}
+
+	var beneficiary base.Address
+	if a, err := resolveAlias(fact.beneficiary, getState); err != nil {
+		return nil, err
+	} else if a.Equal(fact.sender) {
+		return nil, util.IgnoreError.Errorf("beneficiary is same with sender, %q", fact.sender)
+	} else {
+		beneficiary = a
+	}
+
+	if _, err := existsAccountState(beneficiary, "beneficiary", getState); err != nil {
+		return nil, err
+	}
+
+	if err := opp.checkFactSigns(fact.sender, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	opp.sb = map[CurrencyID]AmountState{}
+	opp.rb = map[CurrencyID]AmountState{}
+	opp.swept = map[CurrencyID][2]Big{}
+
+	cids := opp.cp.CIDs()
+	for i := range cids {
+		if err := opp.prepareSweep(cids[i], beneficiary, getState); err != nil {
+			return nil, err
+		}
+	}
+
+	outflow := map[CurrencyID]Big{}
+	for cid := range opp.swept {
+		outflow[cid] = opp.swept[cid][0].Sub(opp.swept[cid][1])
+	}
+
+	if sl, err := checkSpendingLimit(fact.sender, outflow, opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sl = sl
+	}
+
+	return opp, nil
+}
+
+func (opp *CloseAccountProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CloseAccountFact)
+
+	var sts []state.State
+	if ac, err := LoadStateAccountValue(opp.sa); err != nil {
+		return err
+	} else if st, err := SetStateAccountValue(opp.sa, ac.Close()); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	for i := range opp.cids {
+		cid := opp.cids[i]
+		sw := opp.swept[cid]
+
+		sts = append(sts, opp.sb[cid].Sub(sw[0]).AddFee(sw[1]))
+
+		if received := sw[0].Sub(sw[1]); received.OverZero() {
+			sts = append(sts, opp.rb[cid].Add(received))
+		}
+	}
+
+	if sls, err := setSpendingLimitStates(opp.sl); err != nil {
+		return err
+	} else {
+		sts = append(sts, sls...)
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// prepareSweep loads the balance of sender in currency and the fee for it. The
+// fee is limited by the balance, so the small balance is spent only for fee.
+func (opp *CloseAccountProcessor) prepareSweep(
+	cid CurrencyID,
+	beneficiary base.Address,
+	getState func(key string) (state.State, bool, error),
+) error {
+	fact := opp.Fact().(CloseAccountFact)
+
+	var sb state.State
+	var big Big
+	switch st, found, err := getState(StateKeyBalance(fact.sender, cid)); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	default:
+		if am, err := StateBalanceValue(st); err != nil {
+			return util.IgnoreError.Wrap(err)
+		} else if !am.Big().OverZero() {
+			return nil
+		} else {
+			sb = st
+			big = am.Big()
+		}
+	}
+
+	if err := checkNotFrozen(fact.sender, cid, false, getState); err != nil {
+		return err
+	}
+
+	if err := checkTransferRestriction(opp.cp, fact.sender, cid, getState); err != nil {
+		return err
+	} else if err := checkTransferRestriction(opp.cp, beneficiary, cid, getState); err != nil {
+		return err
+	}
+
+	if err := checkNotFrozen(beneficiary, cid, true, getState); err != nil {
+		return err
+	}
+
+	switch locked, err := lockedByVesting(fact.sender, cid, opp.height, getState); {
+	case err != nil:
+		return err
+	case locked.OverZero():
+		return util.IgnoreError.Errorf("balance of %q locked by vesting; locked=%v", cid, locked)
+	}
+
+	var fee Big
+	if feeer, found := opp.cp.OperationFeeer(cid, CloseAccountType); !found {
+		return util.IgnoreError.Errorf("currency, %q not found of %s", cid, CloseAccountType)
+	} else if i, err := feeer.Fee(big); err != nil {
+		return util.IgnoreError.Wrap(err)
+	} else if i.Compare(big) > 0 {
+		fee = big
+	} else {
+		fee = i
+	}
+
+	if st, _, err := getState(StateKeyBalance(beneficiary, cid)); err != nil {
+		return err
+	} else {
+		opp.rb[cid] = NewAmountState(st, cid)
+	}
+
+	opp.sb[cid] = NewAmountState(sb, cid)
+	opp.cids = append(opp.cids, cid)
+	opp.swept[cid] = [2]Big{big, fee}
+
+	return nil
+}
+
+// checkNotFeeReceiver checks the sender is not the fee receiver of any
+// currency; the fee of every block can not be paid to the closed account.
+func (opp *CloseAccountProcessor) checkNotFeeReceiver() error {
+	fact := opp.Fact().(CloseAccountFact)
+
+	cids := opp.cp.CIDs()
+	for i := range cids {
+		po, found := opp.cp.Policy(cids[i])
+		if !found {
+			continue
+		}
+
+		for _, r := range po.FeeReceivers() {
+			if r.Equal(fact.sender) {
+				return util.IgnoreError.Errorf("fee receiver of %q can not be closed, %q", cids[i], fact.sender)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,474 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testCloseAccountOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testCloseAccountOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testCloseAccountOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(CloseAccount{}, NewCloseAccountProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testCloseAccountOperations) newCloseAccount(
+	sender base.Address,
+	keys []key.Privatekey,
+	beneficiary base.Address,
+) CloseAccount {
+	token := util.UUID().Bytes()
+	fact := NewCloseAccountFact(token, sender, beneficiary)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewCloseAccount(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testCloseAccountOperations) TestNew() {
+	cid2 := CurrencyID("FINDME")
+
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid), NewAmount(NewBig(5), cid2)})
+	ba, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	fa, st2 := t.newAccount(true, nil)
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+	dst2 := t.newCurrencyDesignState(cid2, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst, dst2})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+	t.NoError(cp.Set(dst2))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCloseAccount(sa.Address, sa.Privs(), ba.Address)
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	var ast state.State
+	balances := map[string]state.State{}
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyAccount(sa.Address):
+			ast = st.GetState()
+		default:
+			balances[st.Key()] = st.GetState()
+		}
+	}
+
+	ac, err := LoadStateAccountValue(ast)
+	t.NoError(err)
+	t.True(ac.IsClosed())
+
+	for k, expected := range map[string]Big{
+		StateKeyBalance(sa.Address, t.cid): ZeroBig,
+		StateKeyBalance(sa.Address, cid2):  ZeroBig,
+		StateKeyBalance(ba.Address, t.cid): NewBig(1 + 10 - 2),
+		StateKeyBalance(ba.Address, cid2):  NewBig(5),
+		StateKeyBalance(fa.Address, t.cid): fee,
+	} {
+		am, err := StateBalanceValue(balances[k])
+		t.NoError(err)
+		t.True(expected.Equal(am.Big()), "%s: %v != %v", k, expected, am.Big())
+	}
+
+	var ffact FeeOperationFact
+	for _, o := range pool.AddedOperations() {
+		if i, ok := o.Fact().(FeeOperationFact); ok {
+			ffact = i
+		}
+	}
+
+	t.Equal(1, len(ffact.Amounts()))
+	t.Equal(fee.String(), ffact.Amounts()[0].Big().String())
+}
+
+func (t *testCloseAccountOperations) TestFeeOverBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(ba.Address, NewBig(3)))
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCloseAccount(sa.Address, sa.Privs(), ba.Address)
+	t.NoError(opr.Process(op))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(ba.Address, t.cid):
+			t.Fail("nothing should be transferred to beneficiary")
+		}
+	}
+
+	am, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(am.Big().IsZero())
+	t.True(sst.(AmountState).Fee().Equal(NewBig(1)))
+}
+
+func (t *testCloseAccountOperations) TestBeneficiaryAlias() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("showme"), ba.Address)
+	pool, _ := t.statepool(st0, st1, append(t.newAccountAliasStates(aa), dst))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCloseAccount(sa.Address, sa.Privs(), Alias("showme"))
+	t.NoError(opr.Process(op))
+
+	var bst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(ba.Address, t.cid) {
+			bst = st.GetState()
+		}
+	}
+
+	am, err := StateBalanceValue(bst)
+	t.NoError(err)
+	t.True(am.Big().Equal(NewBig(10)))
+}
+
+func (t *testCloseAccountOperations) TestBeneficiaryNotExist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCloseAccount(sa.Address, sa.Privs(), NewTestAddress())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "beneficiary does not exist")
+}
+
+func (t *testCloseAccountOperations) TestBeneficiaryClosed() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newClosedAccount(nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCloseAccount(sa.Address, sa.Privs(), ba.Address)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testCloseAccountOperations) TestBeneficiaryFrozen() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newFreezeState(NewFreeze(ba.Address, t.cid, true))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCloseAccount(sa.Address, sa.Privs(), ba.Address)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testCloseAccountOperations) TestAlreadyClosed() {
+	sa, st0 := t.newClosedAccount([]Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCloseAccount(sa.Address, sa.Privs(), ba.Address)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testCloseAccountOperations) TestVestingLocked() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	vs := NewVesting(t.cid, []VestingRelease{NewVestingRelease(t.height()+1, NewBig(3))})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newVestingState(sa.Address, vs)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCloseAccount(sa.Address, sa.Privs(), ba.Address)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "locked by vesting")
+}
+
+func (t *testCloseAccountOperations) TestPendingCommitments() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	lk := NewLock(valuehash.RandomSHA256(), sa.Address, ba.Address, NewAmount(NewBig(1), t.cid), []byte("showme"), t.height()+1)
+	al := NewAllowance(sa.Address, ba.Address, NewAmount(NewBig(1), t.cid))
+	so := NewStandingOrder(valuehash.RandomSHA256(), sa.Address, ba.Address, NewAmount(NewBig(1), t.cid), 2, 3, t.height()+2)
+
+	for _, st := range []state.State{t.newLockState(lk), t.newAllowanceState(al), t.newStandingOrderState(so)} {
+		cm := NewCommitments(sa.Address).Add(st.Key())
+
+		pool, _ := t.statepool(st0, st1, []state.State{dst, st, t.newCommitmentsState(cm)})
+
+		opr := t.processor(cp, pool)
+
+		err := opr.Process(t.newCloseAccount(sa.Address, sa.Privs(), ba.Address))
+		t.True(xerrors.Is(err, util.IgnoreError))
+		t.Contains(err.Error(), "pending commitments")
+	}
+}
+
+func (t *testCloseAccountOperations) TestDoneCommitments() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	lk := NewLock(valuehash.RandomSHA256(), sa.Address, ba.Address, NewAmount(NewBig(1), t.cid), []byte("showme"), t.height()+1)
+	al := NewAllowance(sa.Address, ba.Address, NewAmount(ZeroBig, t.cid))
+	so := NewStandingOrder(valuehash.RandomSHA256(), sa.Address, ba.Address, NewAmount(NewBig(1), t.cid), 2, 3, t.height()+2)
+
+	sts := []state.State{t.newLockState(lk.Refund()), t.newAllowanceState(al), t.newStandingOrderState(so.Cancel())}
+
+	cm := NewCommitments(sa.Address)
+	for i := range sts {
+		cm = cm.Add(sts[i].Key())
+	}
+
+	pool, _ := t.statepool(st0, st1, sts, []state.State{dst, t.newCommitmentsState(cm)})
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newCloseAccount(sa.Address, sa.Privs(), ba.Address)))
+}
+
+func (t *testCloseAccountOperations) TestSenderFrozen() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	for _, cid := range []CurrencyID{"", t.cid} {
+		pool, _ := t.statepool(st0, st1, []state.State{dst, t.newFreezeState(NewFreeze(sa.Address, cid, false))})
+
+		opr := t.processor(cp, pool)
+
+		err := opr.Process(t.newCloseAccount(sa.Address, sa.Privs(), ba.Address))
+		t.True(xerrors.Is(err, util.IgnoreError))
+		t.Contains(err.Error(), "frozen")
+	}
+}
+
+func (t *testCloseAccountOperations) TestBeneficiaryInDenylist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetTransferRestriction(TransferRestrictionDenylist)
+	dst := t.newCurrencyDesignStateByPolicy(t.cid, NewBig(99), NewTestAddress(), po)
+
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst,
+		t.newTransferListState(NewTransferListMember(ba.Address, t.cid, true)),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newCloseAccount(sa.Address, sa.Privs(), ba.Address))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "in denylist")
+}
+
+func (t *testCloseAccountOperations) TestFeeReceiver() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, NewBig(1)))
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newCloseAccount(sa.Address, sa.Privs(), ba.Address))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "fee receiver")
+}
+
+func (t *testCloseAccountOperations) TestSpendingLimit() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(20), base.Height(10), base.Height(0)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(ba.Address, NewBig(1)))
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newSpendingLimitState(sl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newCloseAccount(sa.Address, sa.Privs(), ba.Address)))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySpendingLimit(sa.Address, t.cid) {
+			sst = st.GetState()
+		}
+	}
+
+	usl, err := StateSpendingLimitValue(sst)
+	t.NoError(err)
+	t.True(usl.Spent().Equal(NewBig(9)))
+}
+
+func (t *testCloseAccountOperations) TestSpendingLimitExceeded() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(0)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newSpendingLimitState(sl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newCloseAccount(sa.Address, sa.Privs(), ba.Address))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "spending limit exceeded")
+}
+
+func (t *testCloseAccountOperations) TestNotSigned() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCloseAccount(sa.Address, ba.Privs(), ba.Address)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func TestCloseAccountOperations(t *testing.T) {
+	suite.Run(t, new(testCloseAccountOperations))
+}
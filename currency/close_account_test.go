@@ -0,0 +1,103 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCloseAccount struct {
+	baseTest
+}
+
+func (t *testCloseAccount) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewCloseAccountFact(token, NewTestAddress(), NewTestAddress())
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewCloseAccount(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testCloseAccount) TestEmptyToken() {
+	fact := NewCloseAccountFact(nil, NewTestAddress(), NewTestAddress())
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty token")
+}
+
+func (t *testCloseAccount) TestSameWithSender() {
+	sender := NewTestAddress()
+	fact := NewCloseAccountFact(util.UUID().Bytes(), sender, sender)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "beneficiary is same with sender")
+}
+
+func TestCloseAccount(t *testing.T) {
+	suite.Run(t, new(testCloseAccount))
+}
+
+func testCloseAccountEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewCloseAccountFact(token, NewTestAddress(), Alias("showme"))
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewCloseAccount(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(CloseAccount)
+		tb := b.(CloseAccount)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(CloseAccountFact)
+		ufact := tb.Fact().(CloseAccountFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.beneficiary.Equal(ufact.beneficiary))
+	}
+
+	return t
+}
+
+func TestCloseAccountEncodeJSON(t *testing.T) {
+	suite.Run(t, testCloseAccountEncode(jsonenc.NewEncoder()))
+}
+
+func TestCloseAccountEncodeBSON(t *testing.T) {
+	suite.Run(t, testCloseAccountEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,173 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CommitmentsType = hint.MustNewType(0xa0, 0x88, "mitum-currency-commitments")
+	CommitmentsHint = hint.MustHint(CommitmentsType, "0.0.1")
+)
+
+// Commitments keeps the state keys, which account is committed to; the open
+// Locks sent by account, the Allowances of account and the active
+// StandingOrders paid by account. The account can not be closed until they are
+// done. Commitments is updated only by the operations of account, so the done
+// ones are removed by the next operation of account.
+type Commitments struct {
+	account base.Address
+	keys    []string
+}
+
+func NewCommitments(a base.Address) Commitments {
+	return Commitments{account: a}
+}
+
+func (cm Commitments) Hint() hint.Hint {
+	return CommitmentsHint
+}
+
+func (cm Commitments) Bytes() []byte {
+	bs := make([][]byte, len(cm.keys)+1)
+	bs[0] = cm.account.Bytes()
+
+	for i := range cm.keys {
+		bs[i+1] = []byte(cm.keys[i])
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (cm Commitments) Hash() valuehash.Hash {
+	return cm.GenerateHash()
+}
+
+func (cm Commitments) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(cm.Bytes())
+}
+
+func (cm Commitments) IsValid([]byte) error {
+	if err := cm.account.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid account: %w", err)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range cm.keys {
+		k := cm.keys[i]
+		if len(k) < 1 {
+			return xerrors.Errorf("empty key of Commitments")
+		}
+
+		if _, found := founds[k]; found {
+			return xerrors.Errorf("duplicated key of Commitments, %q", k)
+		}
+
+		founds[k] = struct{}{}
+	}
+
+	return nil
+}
+
+func (cm Commitments) Account() base.Address {
+	return cm.account
+}
+
+func (cm Commitments) Keys() []string {
+	return cm.keys
+}
+
+func (cm Commitments) Add(key string) Commitments {
+	for i := range cm.keys {
+		if cm.keys[i] == key {
+			return cm
+		}
+	}
+
+	keys := make([]string, len(cm.keys)+1)
+	copy(keys, cm.keys)
+	keys[len(cm.keys)] = key
+
+	cm.keys = keys
+
+	return cm
+}
+
+// loadCommitments loads the Commitments of account and removes the done ones.
+func loadCommitments(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) (state.State, Commitments, error) {
+	var st state.State
+	cm := NewCommitments(a)
+	switch i, found, err := getState(StateKeyCommitments(a)); {
+	case err != nil:
+		return nil, Commitments{}, err
+	case !found:
+		return i, cm, nil
+	default:
+		st = i
+	}
+
+	var keys []string
+	if i, err := StateCommitmentsValue(st); err != nil {
+		return nil, Commitments{}, util.IgnoreError.Wrap(err)
+	} else {
+		keys = i.keys
+	}
+
+	for i := range keys {
+		switch pending, err := pendingCommitment(keys[i], getState); {
+		case err != nil:
+			return nil, Commitments{}, err
+		case pending:
+			cm = cm.Add(keys[i])
+		}
+	}
+
+	return st, cm, nil
+}
+
+// pendingCommitment checks the state of key is not done yet.
+func pendingCommitment(
+	key string,
+	getState func(key string) (state.State, bool, error),
+) (bool, error) {
+	var st state.State
+	switch i, found, err := getState(key); {
+	case err != nil:
+		return false, err
+	case !found:
+		return false, nil
+	default:
+		st = i
+	}
+
+	switch {
+	case IsStateLockKey(key):
+		if lk, err := StateLockValue(st); err != nil {
+			return false, util.IgnoreError.Wrap(err)
+		} else {
+			return lk.Status() == LockStatusLocked, nil
+		}
+	case IsStateAllowanceKey(key):
+		if al, err := StateAllowanceValue(st); err != nil {
+			return false, util.IgnoreError.Wrap(err)
+		} else {
+			return al.Amount().Big().OverZero(), nil
+		}
+	case IsStateStandingOrderKey(key):
+		if so, err := StateStandingOrderValue(st); err != nil {
+			return false, util.IgnoreError.Wrap(err)
+		} else {
+			return so.Status() == StandingOrderStatusActive, nil
+		}
+	default:
+		return false, xerrors.Errorf("unknown key of Commitments, %q", key)
+	}
+}
@@ -0,0 +1,32 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (cm Commitments) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(cm.Hint()),
+		bson.M{
+			"account": cm.account,
+			"keys":    cm.keys,
+		}),
+	)
+}
+
+type CommitmentsBSONUnpacker struct {
+	AC base.AddressDecoder `bson:"account"`
+	KS []string            `bson:"keys"`
+}
+
+func (cm *Commitments) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ucm CommitmentsBSONUnpacker
+	if err := enc.Unmarshal(b, &ucm); err != nil {
+		return err
+	}
+
+	return cm.unpack(enc, ucm.AC, ucm.KS)
+}
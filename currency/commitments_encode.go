@@ -0,0 +1,22 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (cm *Commitments) unpack(
+	enc encoder.Encoder,
+	bAccount base.AddressDecoder,
+	keys []string,
+) error {
+	if a, err := bAccount.Encode(enc); err != nil {
+		return err
+	} else {
+		cm.account = a
+	}
+
+	cm.keys = keys
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type CommitmentsJSONPacker struct {
+	jsonenc.HintedHead
+	AC base.Address `json:"account"`
+	KS []string     `json:"keys"`
+}
+
+func (cm Commitments) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(CommitmentsJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(cm.Hint()),
+		AC:         cm.account,
+		KS:         cm.keys,
+	})
+}
+
+type CommitmentsJSONUnpacker struct {
+	AC base.AddressDecoder `json:"account"`
+	KS []string            `json:"keys"`
+}
+
+func (cm *Commitments) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ucm CommitmentsJSONUnpacker
+	if err := enc.Unmarshal(b, &ucm); err != nil {
+		return err
+	}
+
+	return cm.unpack(enc, ucm.AC, ucm.KS)
+}
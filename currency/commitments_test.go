@@ -0,0 +1,92 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testCommitments struct {
+	suite.Suite
+}
+
+func (t *testCommitments) TestNew() {
+	a := NewTestAddress()
+
+	cm := NewCommitments(a)
+	t.NoError(cm.IsValid(nil))
+
+	t.True(a.Equal(cm.Account()))
+	t.Empty(cm.Keys())
+}
+
+func (t *testCommitments) TestAdd() {
+	cm := NewCommitments(NewTestAddress())
+
+	k := StateKeyLock(valuehash.RandomSHA256())
+
+	ucm := cm.Add(k)
+	t.NoError(ucm.IsValid(nil))
+	t.Equal([]string{k}, ucm.Keys())
+	t.Empty(cm.Keys())
+
+	ucm = ucm.Add(k)
+	t.Equal([]string{k}, ucm.Keys())
+}
+
+func (t *testCommitments) TestDuplicatedKeys() {
+	k := StateKeyLock(valuehash.RandomSHA256())
+
+	cm := Commitments{account: NewTestAddress(), keys: []string{k, k}}
+
+	err := cm.IsValid(nil)
+	t.Contains(err.Error(), "duplicated key of Commitments")
+}
+
+func (t *testCommitments) TestEmptyKey() {
+	cm := Commitments{account: NewTestAddress(), keys: []string{""}}
+
+	err := cm.IsValid(nil)
+	t.Contains(err.Error(), "empty key of Commitments")
+}
+
+func TestCommitments(t *testing.T) {
+	suite.Run(t, new(testCommitments))
+}
+
+func testCommitmentsEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		cm := NewCommitments(NewTestAddress()).
+			Add(StateKeyLock(valuehash.RandomSHA256())).
+			Add(StateKeyStandingOrder(valuehash.RandomSHA256()))
+		t.NoError(cm.IsValid(nil))
+
+		return cm
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Commitments)
+		tb := b.(Commitments)
+
+		t.True(ta.Account().Equal(tb.Account()))
+		t.Equal(ta.Keys(), tb.Keys())
+	}
+
+	return t
+}
+
+func TestCommitmentsEncodeJSON(t *testing.T) {
+	suite.Run(t, testCommitmentsEncode(jsonenc.NewEncoder()))
+}
+
+func TestCommitmentsEncodeBSON(t *testing.T) {
+	suite.Run(t, testCommitmentsEncode(bsonenc.NewEncoder()))
+}
@@ -13,7 +13,7 @@ import (
 
 var (
 	CreateAccountsFactType = hint.MustNewType(0xa0, 0x05, "mitum-currency-create-accounts-operation-fact")
-	CreateAccountsFactHint = hint.MustHint(CreateAccountsFactType, "0.0.1")
+	CreateAccountsFactHint = hint.MustHint(CreateAccountsFactType, "0.0.2")
 	CreateAccountsType     = hint.MustNewType(0xa0, 0x06, "mitum-currency-create-accounts-operation")
 	CreateAccountsHint     = hint.MustHint(CreateAccountsType, "0.0.1")
 )
@@ -31,18 +31,24 @@ type CreateAccountsItem interface {
 	Bytes() []byte
 	Keys() Keys
 	Address() (base.Address, error)
+	FeeCurrency() CurrencyID
 	Rebuild() CreateAccountsItem
 }
 
 type CreateAccountsFact struct {
-	h      valuehash.Hash
-	token  []byte
-	sender base.Address
-	items  []CreateAccountsItem
+	hint             hint.Hint
+	h                valuehash.Hash
+	token            []byte
+	sender           base.Address
+	items            []CreateAccountsItem
+	feePayer         base.Address
+	sequence         uint64
+	validUntilHeight base.Height
 }
 
 func NewCreateAccountsFact(token []byte, sender base.Address, items []CreateAccountsItem) CreateAccountsFact {
 	fact := CreateAccountsFact{
+		hint:   CreateAccountsFactHint,
 		token:  token,
 		sender: sender,
 		items:  items,
@@ -52,8 +58,14 @@ func NewCreateAccountsFact(token []byte, sender base.Address, items []CreateAcco
 	return fact
 }
 
+// Hint returns the hint of fact; the decoded fact of the previous version keeps
+// its hint.
 func (fact CreateAccountsFact) Hint() hint.Hint {
-	return CreateAccountsFactHint
+	if len(fact.hint.Version()) < 1 {
+		return CreateAccountsFactHint
+	}
+
+	return fact.hint
 }
 
 func (fact CreateAccountsFact) Hash() valuehash.Hash {
@@ -74,6 +86,9 @@ func (fact CreateAccountsFact) Bytes() []byte {
 		fact.token,
 		fact.sender.Bytes(),
 		util.ConcatBytesSlice(is...),
+		feePayerBytes(fact.feePayer),
+		sequenceBytes(fact.sequence),
+		validUntilBytes(fact.validUntilHeight),
 	)
 }
 
@@ -93,6 +108,18 @@ func (fact CreateAccountsFact) IsValid([]byte) error {
 		return err
 	}
 
+	if err := isValidFeePayer(fact.sender, fact.feePayer); err != nil {
+		return err
+	}
+
+	if err := isValidSequence(fact.Hint(), fact.sequence); err != nil {
+		return err
+	}
+
+	if err := isValidValidUntil(fact.validUntilHeight); err != nil {
+		return err
+	}
+
 	foundKeys := map[string]struct{}{}
 	for i := range fact.items {
 		if err := fact.items[i].IsValid(nil); err != nil {
@@ -110,6 +137,8 @@ func (fact CreateAccountsFact) IsValid([]byte) error {
 			return err
 		case fact.sender.Equal(a):
 			return xerrors.Errorf("target address is same with sender, %q", fact.sender)
+		case fact.feePayer != nil && fact.feePayer.Equal(a):
+			return xerrors.Errorf("target address is same with fee payer, %q", fact.feePayer)
 		default:
 			foundKeys[k] = struct{}{}
 		}
@@ -134,6 +163,48 @@ func (fact CreateAccountsFact) Items() []CreateAccountsItem {
 	return fact.items
 }
 
+// FeePayer returns the account which pays the fee instead of sender. If nil,
+// sender pays the fee.
+func (fact CreateAccountsFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+// SetFeePayer sets the fee payer and regenerates the fact hash.
+func (fact CreateAccountsFact) SetFeePayer(feePayer base.Address) CreateAccountsFact {
+	fact.feePayer = feePayer
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+// Sequence returns the sequence of sender. If 0, the sequence of sender is not
+// checked and not increased.
+func (fact CreateAccountsFact) Sequence() uint64 {
+	return fact.sequence
+}
+
+// SetSequence sets the sequence and regenerates the fact hash.
+func (fact CreateAccountsFact) SetSequence(s uint64) CreateAccountsFact {
+	fact.sequence = s
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+// ValidUntilHeight returns the last height, at which the fact can be processed.
+// If 0, the fact does not expire by height.
+func (fact CreateAccountsFact) ValidUntilHeight() base.Height {
+	return fact.validUntilHeight
+}
+
+// SetValidUntil sets the valid until height and regenerates the fact hash.
+func (fact CreateAccountsFact) SetValidUntil(height base.Height) CreateAccountsFact {
+	fact.validUntilHeight = height
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
 func (fact CreateAccountsFact) Targets() ([]base.Address, error) {
 	as := make([]base.Address, len(fact.items))
 	for i := range fact.items {
@@ -158,6 +229,10 @@ func (fact CreateAccountsFact) Addresses() ([]base.Address, error) {
 
 	as[len(fact.items)] = fact.Sender()
 
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
 	return as, nil
 }
 
@@ -10,14 +10,26 @@ import (
 )
 
 func (fact CreateAccountsFact) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
-			bson.M{
-				"hash":   fact.h,
-				"token":  fact.token,
-				"sender": fact.sender,
-				"items":  fact.items,
-			}))
+	m := bson.M{
+		"hash":   fact.h,
+		"token":  fact.token,
+		"sender": fact.sender,
+		"items":  fact.items,
+	}
+
+	if fact.feePayer != nil {
+		m["fee_payer"] = fact.feePayer
+	}
+
+	if fact.sequence > 0 {
+		m["sequence"] = fact.sequence
+	}
+
+	if fact.validUntilHeight > 0 {
+		m["valid_until_height"] = fact.validUntilHeight
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
 }
 
 type CreateAccountsFactBSONUnpacker struct {
@@ -25,9 +37,17 @@ type CreateAccountsFactBSONUnpacker struct {
 	TK []byte              `bson:"token"`
 	SD base.AddressDecoder `bson:"sender"`
 	IT []bson.Raw          `bson:"items"`
+	FP base.AddressDecoder `bson:"fee_payer,omitempty"`
+	SQ uint64              `bson:"sequence,omitempty"`
+	VH base.Height         `bson:"valid_until_height,omitempty"`
 }
 
 func (fact *CreateAccountsFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var uca CreateAccountsFactBSONUnpacker
 	if err := bson.Unmarshal(b, &uca); err != nil {
 		return err
@@ -38,7 +58,7 @@ func (fact *CreateAccountsFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error
 		bits[i] = uca.IT[i]
 	}
 
-	return fact.unpack(enc, uca.H, uca.TK, uca.SD, bits)
+	return fact.unpack(enc, ht.H, uca.H, uca.TK, uca.SD, bits, uca.FP, uca.SQ, uca.VH)
 }
 
 func (op CreateAccounts) MarshalBSON() ([]byte, error) {
@@ -3,15 +3,20 @@ package currency
 import (
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 func (fact *CreateAccountsFact) unpack(
 	enc encoder.Encoder,
+	ht hint.Hint,
 	h valuehash.Hash,
 	tk []byte,
 	bSender base.AddressDecoder,
 	bits [][]byte,
+	bFeePayer base.AddressDecoder,
+	sequence uint64,
+	validUntilHeight base.Height,
 ) error {
 	var sender base.Address
 	if a, err := bSender.Encode(enc); err != nil {
@@ -29,6 +34,16 @@ func (fact *CreateAccountsFact) unpack(
 		}
 	}
 
+	if a, err := bFeePayer.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.feePayer = a
+	}
+
+	fact.sequence = sequence
+	fact.validUntilHeight = validUntilHeight
+
+	fact.hint = ht
 	fact.h = h
 	fact.token = tk
 	fact.sender = sender
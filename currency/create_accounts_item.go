@@ -9,9 +9,10 @@ import (
 )
 
 type BaseCreateAccountsItem struct {
-	hint    hint.Hint
-	keys    Keys
-	amounts []Amount
+	hint        hint.Hint
+	keys        Keys
+	amounts     []Amount
+	feeCurrency CurrencyID
 }
 
 func NewBaseCreateAccountsItem(ht hint.Hint, keys Keys, amounts []Amount) BaseCreateAccountsItem {
@@ -34,6 +35,10 @@ func (it BaseCreateAccountsItem) Bytes() []byte {
 		bs[i+1] = it.amounts[i].Bytes()
 	}
 
+	if len(it.feeCurrency) > 0 {
+		bs = append(bs, it.feeCurrency.Bytes())
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -62,6 +67,12 @@ func (it BaseCreateAccountsItem) IsValid([]byte) error {
 		}
 	}
 
+	if len(it.feeCurrency) > 0 {
+		if err := it.feeCurrency.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid fee currency: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -77,6 +88,18 @@ func (it BaseCreateAccountsItem) Amounts() []Amount {
 	return it.amounts
 }
 
+// FeeCurrency returns the currency, which pays the fee of amounts. If empty,
+// the fee is paid by the currency of each amount.
+func (it BaseCreateAccountsItem) FeeCurrency() CurrencyID {
+	return it.feeCurrency
+}
+
+func (it BaseCreateAccountsItem) SetFeeCurrency(cid CurrencyID) CreateAccountsItem {
+	it.feeCurrency = cid
+
+	return it
+}
+
 func (it BaseCreateAccountsItem) Rebuild() CreateAccountsItem {
 	ams := make([]Amount, len(it.amounts))
 	for i := range it.amounts {
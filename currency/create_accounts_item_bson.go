@@ -6,18 +6,22 @@ import (
 )
 
 func (it BaseCreateAccountsItem) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
-			bson.M{
-				"keys":    it.keys,
-				"amounts": it.amounts,
-			}),
-	)
+	m := bson.M{
+		"keys":    it.keys,
+		"amounts": it.amounts,
+	}
+
+	if len(it.feeCurrency) > 0 {
+		m["fee_currency"] = it.feeCurrency
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()), m))
 }
 
 type CreateAccountsItemBSONUnpacker struct {
 	KS bson.Raw   `bson:"keys"`
 	AM []bson.Raw `bson:"amounts"`
+	FC string     `bson:"fee_currency,omitempty"`
 }
 
 func (it *BaseCreateAccountsItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -36,5 +40,5 @@ func (it *BaseCreateAccountsItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) err
 		bam[i] = uca.AM[i]
 	}
 
-	return it.unpack(enc, ht.H, uca.KS, bam)
+	return it.unpack(enc, ht.H, uca.KS, bam, uca.FC)
 }
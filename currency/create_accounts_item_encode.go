@@ -7,7 +7,13 @@ import (
 	"github.com/spikeekips/mitum/util/hint"
 )
 
-func (it *BaseCreateAccountsItem) unpack(enc encoder.Encoder, ht hint.Hint, bks []byte, bas [][]byte) error {
+func (it *BaseCreateAccountsItem) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bks []byte,
+	bas [][]byte,
+	fc string,
+) error {
 	it.hint = ht
 
 	if hinter, err := enc.DecodeByHint(bks); err != nil {
@@ -28,6 +34,7 @@ func (it *BaseCreateAccountsItem) unpack(enc encoder.Encoder, ht hint.Hint, bks
 	}
 
 	it.amounts = amounts
+	it.feeCurrency = CurrencyID(fc)
 
 	return nil
 }
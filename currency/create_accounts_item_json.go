@@ -8,8 +8,9 @@ import (
 
 type CreateAccountsItemJSONPacker struct {
 	jsonenc.HintedHead
-	KS Keys     `json:"keys"`
-	AS []Amount `json:"amounts"`
+	KS Keys       `json:"keys"`
+	AS []Amount   `json:"amounts"`
+	FC CurrencyID `json:"fee_currency,omitempty"`
 }
 
 func (it BaseCreateAccountsItem) MarshalJSON() ([]byte, error) {
@@ -17,12 +18,14 @@ func (it BaseCreateAccountsItem) MarshalJSON() ([]byte, error) {
 		HintedHead: jsonenc.NewHintedHead(it.Hint()),
 		KS:         it.keys,
 		AS:         it.amounts,
+		FC:         it.feeCurrency,
 	})
 }
 
 type CreateAccountsItemJSONUnpacker struct {
 	KS json.RawMessage   `json:"keys"`
 	AM []json.RawMessage `json:"amounts"`
+	FC string            `json:"fee_currency,omitempty"`
 }
 
 func (it *BaseCreateAccountsItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -41,5 +44,5 @@ func (it *BaseCreateAccountsItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) err
 		bam[i] = uca.AM[i]
 	}
 
-	return it.unpack(enc, ht.H, uca.KS, bam)
+	return it.unpack(enc, ht.H, uca.KS, bam, uca.FC)
 }
@@ -15,6 +15,9 @@ type CreateAccountsFactJSONPacker struct {
 	TK []byte               `json:"token"`
 	SD base.Address         `json:"sender"`
 	IT []CreateAccountsItem `json:"items"`
+	FP base.Address         `json:"fee_payer,omitempty"`
+	SQ uint64               `json:"sequence,omitempty"`
+	VH base.Height          `json:"valid_until_height,omitempty"`
 }
 
 func (fact CreateAccountsFact) MarshalJSON() ([]byte, error) {
@@ -24,6 +27,9 @@ func (fact CreateAccountsFact) MarshalJSON() ([]byte, error) {
 		TK:         fact.token,
 		SD:         fact.sender,
 		IT:         fact.items,
+		FP:         fact.feePayer,
+		SQ:         fact.sequence,
+		VH:         fact.validUntilHeight,
 	})
 }
 
@@ -32,9 +38,17 @@ type CreateAccountsFactJSONUnpacker struct {
 	TK []byte              `json:"token"`
 	SD base.AddressDecoder `json:"sender"`
 	IT []json.RawMessage   `json:"items"`
+	FP base.AddressDecoder `json:"fee_payer,omitempty"`
+	SQ uint64              `json:"sequence,omitempty"`
+	VH base.Height         `json:"valid_until_height,omitempty"`
 }
 
 func (fact *CreateAccountsFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var uca CreateAccountsFactJSONUnpacker
 	if err := jsonenc.Unmarshal(b, &uca); err != nil {
 		return err
@@ -45,7 +59,7 @@ func (fact *CreateAccountsFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error
 		bits[i] = uca.IT[i]
 	}
 
-	return fact.unpack(enc, uca.H, uca.TK, uca.SD, bits)
+	return fact.unpack(enc, ht.H, uca.H, uca.TK, uca.SD, bits, uca.FP, uca.SQ, uca.VH)
 }
 
 func (op CreateAccounts) MarshalJSON() ([]byte, error) {
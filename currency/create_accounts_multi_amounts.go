@@ -36,6 +36,12 @@ func (it CreateAccountsItemMultiAmounts) IsValid([]byte) error {
 	return nil
 }
 
+func (it CreateAccountsItemMultiAmounts) SetFeeCurrency(cid CurrencyID) CreateAccountsItem {
+	it.BaseCreateAccountsItem = it.BaseCreateAccountsItem.SetFeeCurrency(cid).(BaseCreateAccountsItem)
+
+	return it
+}
+
 func (it CreateAccountsItemMultiAmounts) Rebuild() CreateAccountsItem {
 	it.BaseCreateAccountsItem = it.BaseCreateAccountsItem.Rebuild().(BaseCreateAccountsItem)
 
@@ -42,7 +42,7 @@ func (t *testCreateAccountsMultiAmounts) TestNew() {
 	}
 
 	item := NewCreateAccountsItemMultiAmounts(skeys, ams)
-	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item})
+	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item}).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -92,7 +92,7 @@ func (t *testCreateAccountsMultiAmounts) TestZeroBig() {
 	err = item.IsValid(nil)
 	t.Contains(err.Error(), "amount should be over zero")
 
-	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item})
+	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item}).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -131,7 +131,7 @@ func (t *testCreateAccountsMultiAmounts) TestEmptyAmounts() {
 	err = item.IsValid(nil)
 	t.Contains(err.Error(), "empty amounts")
 
-	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item})
+	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item}).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -175,7 +175,7 @@ func (t *testCreateAccountsMultiAmounts) TestOverMaxAmounts() {
 	err = item.IsValid(nil)
 	t.Contains(err.Error(), "amounts over allowed")
 
-	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item})
+	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item}).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -219,7 +219,7 @@ func (t *testCreateAccountsMultiAmounts) TestDuplicatedCurrency() {
 	err = item.IsValid(nil)
 	t.Contains(err.Error(), "duplicated currency found")
 
-	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item})
+	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item}).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -265,7 +265,7 @@ func testCreateAccountsMultiAmountsEncode(enc encoder.Encoder) suite.TestingSuit
 		}
 
 		item := NewCreateAccountsItemMultiAmounts(skeys, ams)
-		fact := NewCreateAccountsFact(util.UUID().Bytes(), sender, []CreateAccountsItem{item})
+		fact := NewCreateAccountsFact(util.UUID().Bytes(), sender, []CreateAccountsItem{item}).SetSequence(1)
 
 		var fs []operation.FactSign
 
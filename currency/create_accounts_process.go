@@ -4,6 +4,7 @@ import (
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 	"golang.org/x/xerrors"
 )
@@ -16,11 +17,12 @@ func (op CreateAccounts) Process(
 }
 
 type CreateAccountsItemProcessor struct {
-	cp   *CurrencyPool
-	h    valuehash.Hash
-	item CreateAccountsItem
-	ns   state.State
-	nb   map[CurrencyID]AmountState
+	cp     *CurrencyPool
+	h      valuehash.Hash
+	sender base.Address
+	item   CreateAccountsItem
+	ns     state.State
+	nb     map[CurrencyID]AmountState
 }
 
 func (opp *CreateAccountsItemProcessor) PreProcess(
@@ -60,6 +62,13 @@ func (opp *CreateAccountsItemProcessor) PreProcess(
 	nb := map[CurrencyID]AmountState{}
 	for i := range opp.item.Amounts() {
 		am := opp.item.Amounts()[i]
+
+		if err := checkTransferRestriction(opp.cp, opp.sender, am.Currency(), getState); err != nil {
+			return err
+		} else if err := checkTransferRestriction(opp.cp, target, am.Currency(), getState); err != nil {
+			return err
+		}
+
 		if b, _, err := getState(StateKeyBalance(target, am.Currency())); err != nil {
 			return err
 		} else {
@@ -101,9 +110,14 @@ func (opp *CreateAccountsItemProcessor) Process(
 type CreateAccountsProcessor struct {
 	cp *CurrencyPool
 	CreateAccounts
+	proposable
+	height   base.Height
 	sb       map[CurrencyID]AmountState
+	pb       map[CurrencyID]AmountState
 	ns       []*CreateAccountsItemProcessor
 	required map[CurrencyID][2]Big
+	sl       []spendingLimitState
+	sq       state.State
 }
 
 func NewCreateAccountsProcessor(cp *CurrencyPool) GetNewProcessor {
@@ -119,28 +133,51 @@ func NewCreateAccountsProcessor(cp *CurrencyPool) GetNewProcessor {
 	}
 }
 
+func (opp *CreateAccountsProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
 func (opp *CreateAccountsProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	setState func(valuehash.Hash, ...state.State) error,
 ) (state.Processor, error) {
 	fact := opp.Fact().(CreateAccountsFact)
 
-	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+	if err := checkValidUntil(fact, opp.height); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
 		return nil, err
 	}
 
+	if st, err := checkSequence(fact.Hint(), fact.sender, fact.sequence, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sq = st
+	}
+
 	if required, err := opp.calculateItemsFee(); err != nil {
 		return nil, util.IgnoreError.Errorf("failed to calculate fee: %w", err)
-	} else if sb, err := CheckEnoughBalance(fact.sender, required, getState); err != nil {
+	} else if err := checkNotFrozenByRequired(fact.sender, fact.feePayer, required, getState); err != nil {
+		return nil, err
+	} else if sb, pb, err := CheckEnoughBalanceWithFeePayer(fact.sender, fact.feePayer, required, opp.height, getState); err != nil {
 		return nil, err
 	} else {
 		opp.required = required
 		opp.sb = sb
+		opp.pb = pb
+	}
+
+	if sl, err := checkSpendingLimit(fact.sender, requiredOutflow(opp.required), opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sl = sl
 	}
 
 	ns := make([]*CreateAccountsItemProcessor, len(fact.items))
 	for i := range fact.items {
-		c := &CreateAccountsItemProcessor{cp: opp.cp, h: opp.Hash(), item: fact.items[i]}
+		c := &CreateAccountsItemProcessor{cp: opp.cp, h: opp.Hash(), sender: fact.sender, item: fact.items[i]}
 		if err := c.PreProcess(getState, setState); err != nil {
 			return nil, util.IgnoreError.Wrap(err)
 		}
@@ -148,7 +185,7 @@ func (opp *CreateAccountsProcessor) PreProcess(
 		ns[i] = c
 	}
 
-	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+	if err := opp.checkFactSigns(fact.sender, fact.feePayer, opp.Signs(), getState); err != nil {
 		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
 	}
 
@@ -172,14 +209,33 @@ func (opp *CreateAccountsProcessor) Process(
 		}
 	}
 
-	for k := range opp.required {
-		rq := opp.required[k]
-		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	if pts, err := opp.pendingStates(); err != nil {
+		return err
+	} else {
+		sts = append(sts, pts...)
 	}
 
 	return setState(fact.Hash(), sts...)
 }
 
+// pendingStates returns the balances, SpendingLimits and sequence of sender,
+// which will be set by Process.
+func (opp *CreateAccountsProcessor) pendingStates() ([]state.State, error) {
+	sts := debitRequired(opp.sb, opp.pb, opp.required)
+
+	if sls, err := setSpendingLimitStates(opp.sl); err != nil {
+		return nil, err
+	} else {
+		sts = append(sts, sls...)
+	}
+
+	if opp.sq != nil {
+		sts = append(sts, opp.sq)
+	}
+
+	return sts, nil
+}
+
 func (opp *CreateAccountsProcessor) calculateItemsFee() (map[CurrencyID][2]Big, error) {
 	fact := opp.Fact().(CreateAccountsFact)
 
@@ -188,40 +244,68 @@ func (opp *CreateAccountsProcessor) calculateItemsFee() (map[CurrencyID][2]Big,
 		items[i] = fact.items[i]
 	}
 
-	return CalculateItemsFee(opp.cp, items)
+	return CalculateItemsFee(opp.cp, CreateAccountsType, items)
 }
 
-func CalculateItemsFee(cp *CurrencyPool, items []AmountsItem) (map[CurrencyID][2]Big, error) {
+// FeeCurrencyItem is the AmountsItem, which can pay the fee by the other
+// currency.
+type FeeCurrencyItem interface {
+	FeeCurrency() CurrencyID
+}
+
+// CalculateItemsFee calculates the required amount and fee of items by the
+// Feeer for the given operation type. If item has the fee currency, the fee is
+// converted into the fee currency and required in the fee currency.
+func CalculateItemsFee(cp *CurrencyPool, ht hint.Type, items []AmountsItem) (map[CurrencyID][2]Big, error) {
 	required := map[CurrencyID][2]Big{}
 
+	add := func(cid CurrencyID, a, fee Big) {
+		rq := [2]Big{ZeroBig, ZeroBig}
+		if k, found := required[cid]; found {
+			rq = k
+		}
+
+		required[cid] = [2]Big{rq[0].Add(a), rq[1].Add(fee)}
+	}
+
 	for i := range items {
 		it := items[i]
 
+		var fcid CurrencyID
+		if fi, ok := it.(FeeCurrencyItem); ok {
+			fcid = fi.FeeCurrency()
+		}
+
 		for j := range it.Amounts() {
 			am := it.Amounts()[j]
 
-			var rq [2]Big = [2]Big{ZeroBig, ZeroBig}
-			if k, found := required[am.Currency()]; found {
-				rq = k
-			}
+			add(am.Currency(), am.Big(), ZeroBig)
 
 			if cp == nil {
-				required[am.Currency()] = [2]Big{rq[0].Add(am.Big()), rq[1]}
-
 				continue
 			}
 
-			if feeer, found := cp.Feeer(am.Currency()); !found {
+			var fee Big
+			if feeer, found := cp.OperationFeeer(am.Currency(), ht); !found {
 				return nil, xerrors.Errorf("unknown currency id found, %q", am.Currency())
+			} else if k, err := feeer.Fee(am.Big()); err != nil {
+				return nil, err
+			} else if !k.OverZero() {
+				continue
+			} else {
+				fee = k
+			}
+
+			if len(fcid) < 1 || fcid == am.Currency() {
+				add(am.Currency(), fee, fee)
+
+				continue
+			}
+
+			if k, err := cp.ConvertFee(am.Currency(), fcid, fee); err != nil {
+				return nil, err
 			} else {
-				switch k, err := feeer.Fee(am.Big()); {
-				case err != nil:
-					return nil, err
-				case !k.OverZero():
-					required[am.Currency()] = [2]Big{rq[0].Add(am.Big()), rq[1]}
-				default:
-					required[am.Currency()] = [2]Big{rq[0].Add(am.Big()).Add(k), rq[1].Add(k)}
-				}
+				add(fcid, k, k)
 			}
 		}
 	}
@@ -229,9 +313,13 @@ func CalculateItemsFee(cp *CurrencyPool, items []AmountsItem) (map[CurrencyID][2
 	return required, nil
 }
 
+// CheckEnoughBalance checks the balance of holder has the required amounts. The
+// balance, which is still locked by vesting at the given height, can not be
+// spent.
 func CheckEnoughBalance(
 	holder base.Address,
 	required map[CurrencyID][2]Big,
+	height base.Height,
 	getState func(key string) (state.State, bool, error),
 ) (map[CurrencyID]AmountState, error) {
 	sb := map[CurrencyID]AmountState{}
@@ -255,10 +343,67 @@ func CheckEnoughBalance(
 
 		if am.Big().Compare(rq[0]) < 0 {
 			return nil, util.IgnoreError.Errorf("insufficient balance of sender")
-		} else {
-			sb[cid] = NewAmountState(st, cid)
 		}
+
+		if locked, err := lockedByVesting(holder, cid, height, getState); err != nil {
+			return nil, err
+		} else if am.Big().Sub(locked).Compare(rq[0]) < 0 {
+			return nil, util.IgnoreError.Errorf("insufficient vested balance of sender; locked=%v", locked)
+		}
+
+		sb[cid] = NewAmountState(st, cid)
 	}
 
 	return sb, nil
 }
+
+// loadOperationFee loads the balance of payer and checks the fee of the
+// operation without amounts can be paid by the vested balance. The frozen payer
+// can not pay the fee.
+func loadOperationFee(
+	cp *CurrencyPool,
+	payer base.Address,
+	cid CurrencyID,
+	t hint.Type,
+	height base.Height,
+	getState func(key string) (state.State, bool, error),
+) (AmountState, Big, error) {
+	if err := checkNotFrozen(payer, cid, false, getState); err != nil {
+		return AmountState{}, ZeroBig, err
+	}
+
+	var sb AmountState
+	if st, err := existsState(StateKeyBalance(payer, cid), "balance of fee payer", getState); err != nil {
+		return AmountState{}, ZeroBig, err
+	} else {
+		sb = NewAmountState(st, cid)
+	}
+
+	var feeer Feeer
+	if i, found := cp.OperationFeeer(cid, t); !found {
+		return AmountState{}, ZeroBig, util.IgnoreError.Errorf("currency, %q not found of %s", cid, t)
+	} else {
+		feeer = i
+	}
+
+	fee, err := feeer.Fee(ZeroBig)
+	if err != nil {
+		return AmountState{}, ZeroBig, util.IgnoreError.Wrap(err)
+	}
+
+	locked, err := lockedByVesting(payer, cid, height, getState)
+	if err != nil {
+		return AmountState{}, ZeroBig, err
+	}
+
+	switch b, err := StateBalanceValue(sb); {
+	case err != nil:
+		return AmountState{}, ZeroBig, util.IgnoreError.Wrap(err)
+	case b.Big().Compare(fee) < 0:
+		return AmountState{}, ZeroBig, util.IgnoreError.Errorf("insufficient balance with fee")
+	case b.Big().Sub(locked).Compare(fee) < 0:
+		return AmountState{}, ZeroBig, util.IgnoreError.Errorf("insufficient vested balance with fee; locked=%v", locked)
+	default:
+		return sb, fee, nil
+	}
+}
@@ -32,8 +32,17 @@ func (t *testCreateAccountsOperation) processor(cp *CurrencyPool, pool *storage.
 }
 
 func (t *testCreateAccountsOperation) newOperation(sender base.Address, items []CreateAccountsItem, pks []key.Privatekey) CreateAccounts {
+	return t.newOperationWithSequence(sender, items, pks, 1)
+}
+
+func (t *testCreateAccountsOperation) newOperationWithSequence(
+	sender base.Address,
+	items []CreateAccountsItem,
+	pks []key.Privatekey,
+	sq uint64,
+) CreateAccounts {
 	token := util.UUID().Bytes()
-	fact := NewCreateAccountsFact(token, sender, items)
+	fact := NewCreateAccountsFact(token, sender, items).SetSequence(sq)
 
 	var fs []operation.FactSign
 	for _, pk := range pks {
@@ -153,6 +162,53 @@ func (t *testCreateAccountsOperation) TestSufficientBalance() {
 	t.True(nba1.Big().Equal(ams[1].Big()))
 }
 
+func (t *testCreateAccountsOperation) TestFeeCurrency() {
+	cid := CurrencyID("SHOWME")
+	fcid := CurrencyID("FEE")
+
+	balance := []Amount{NewAmount(NewBig(33), cid), NewAmount(NewBig(33), fcid)}
+
+	sa, st := t.newAccount(true, balance)
+	na, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(3))).
+		SetFeeRates(map[CurrencyID]float64{fcid: 2})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateByPolicy(cid, NewBig(99), sa.Address, po)))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), sa.Address, NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	am := NewAmount(NewBig(11), cid)
+	items := []CreateAccountsItem{NewCreateAccountsItemSingleAmount(na.Keys(), am).SetFeeCurrency(fcid)}
+	ca := t.newOperation(sa.Address, items, sa.Privs())
+
+	t.NoError(opr.Process(ca))
+
+	sb := map[CurrencyID]state.State{}
+	for _, stu := range pool.Updates() {
+		switch stu.Key() {
+		case StateKeyBalance(sa.Address, cid):
+			sb[cid] = stu.GetState()
+		case StateKeyBalance(sa.Address, fcid):
+			sb[fcid] = stu.GetState()
+		}
+	}
+
+	fee := NewBig(6)
+
+	sba, _ := StateBalanceValue(sb[cid])
+	t.True(sba.Big().Equal(balance[0].Big().Sub(am.Big())))
+	t.True(sb[cid].(AmountState).Fee().IsZero())
+
+	fsba, _ := StateBalanceValue(sb[fcid])
+	t.True(fsba.Big().Equal(balance[1].Big().Sub(fee)))
+	t.Equal(fee, sb[fcid].(AmountState).Fee())
+}
+
 func (t *testCreateAccountsOperation) TestMultipleItemsWithFee() {
 	cid0 := CurrencyID("SHOWME")
 	cid1 := CurrencyID("FINDME")
@@ -493,6 +549,97 @@ func (t *testCreateAccountsOperation) TestInsufficientBalance() {
 	t.Contains(err.Error(), "insufficient balance")
 }
 
+func (t *testCreateAccountsOperation) TestSenderFrozen() {
+	cid := CurrencyID("SHOWME")
+
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), cid)})
+	na, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st, []state.State{t.newFreezeState(NewFreeze(sa.Address, cid, false))})
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(cid, NewBig(99), sa.Address, feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(na.Keys(), []Amount{NewAmount(NewBig(10), cid)})}
+	ca := t.newOperation(sa.Address, items, sa.Privs())
+
+	err := opr.Process(ca)
+
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testCreateAccountsOperation) TestAllowlist() {
+	cid := CurrencyID("SHOWME")
+
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), cid)})
+	na, _ := t.newAccount(false, nil)
+	nb, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st, []state.State{
+		t.newTransferListState(NewTransferListMember(sa.Address, cid, true)),
+		t.newTransferListState(NewTransferListMember(na.Address, cid, true)),
+	})
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetTransferRestriction(TransferRestrictionAllowlist)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateByPolicy(cid, NewBig(99), sa.Address, po)))
+
+	opr := t.processor(cp, pool)
+
+	items := []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(na.Keys(), []Amount{NewAmount(NewBig(10), cid)})}
+	t.NoError(opr.Process(t.newOperation(sa.Address, items, sa.Privs())))
+
+	opr = t.processor(cp, pool)
+
+	items = []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(nb.Keys(), []Amount{NewAmount(NewBig(10), cid)})}
+	err := opr.Process(t.newOperation(sa.Address, items, sa.Privs()))
+
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not in allowlist")
+}
+
+func (t *testCreateAccountsOperation) TestSpendingLimit() {
+	cid := CurrencyID("SHOWME")
+
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), cid)})
+	na0, _ := t.newAccount(false, nil)
+	na1, _ := t.newAccount(false, nil)
+
+	sl := NewSpendingLimit(sa.Address, cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(0)))
+
+	pool, _ := t.statepool(st, []state.State{t.newSpendingLimitState(sl)})
+	feeer := NewFixedFeeer(sa.Address, NewBig(1))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(cid, NewBig(99), sa.Address, feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(na0.Keys(), []Amount{NewAmount(NewBig(3), cid)})}
+	t.NoError(opr.Process(t.newOperation(sa.Address, items, sa.Privs())))
+
+	items = []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(na1.Keys(), []Amount{NewAmount(NewBig(3), cid)})}
+	err := opr.Process(t.newOperationWithSequence(sa.Address, items, sa.Privs(), 2))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "spending limit exceeded")
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySpendingLimit(sa.Address, cid) {
+			sst = st.GetState()
+		}
+	}
+
+	usl, err := StateSpendingLimitValue(sst)
+	t.NoError(err)
+	t.True(usl.Spent().Equal(NewBig(3)))
+}
+
 func (t *testCreateAccountsOperation) TestInsufficientBalanceMultipleItems() {
 	cid := CurrencyID("SHOWME")
 
@@ -543,7 +690,7 @@ func (t *testCreateAccountsOperation) TestSameSenders() {
 
 	na1, _ := t.newAccount(false, nil)
 	items = []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(na1.Keys(), []Amount{NewAmount(NewBig(1), cid)})}
-	ca1 := t.newOperation(sa.Address, items, sa.Privs())
+	ca1 := t.newOperationWithSequence(sa.Address, items, sa.Privs(), 2)
 
 	raddresses, err := ca1.Fact().(CreateAccountsFact).Addresses()
 	t.NoError(err)
@@ -554,8 +701,42 @@ func (t *testCreateAccountsOperation) TestSameSenders() {
 		t.True(addresses[i].Equal(raddresses[i]))
 	}
 
-	err = opr.Process(ca1)
-	t.Contains(err.Error(), "violates only one sender")
+	t.NoError(opr.Process(ca1))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(sa.Address, cid) {
+			sst = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(31)))
+}
+
+func (t *testCreateAccountsOperation) TestSameSendersInsufficientBalance() {
+	cid := CurrencyID("SHOWME")
+	balance := []Amount{NewAmount(NewBig(3), cid)}
+
+	sa, st := t.newAccount(true, balance)
+	na0, _ := t.newAccount(false, nil)
+	na1, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(cid, NewBig(99), sa.Address, feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(na0.Keys(), []Amount{NewAmount(NewBig(2), cid)})}
+	t.NoError(opr.Process(t.newOperation(sa.Address, items, sa.Privs())))
+
+	items = []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(na1.Keys(), []Amount{NewAmount(NewBig(2), cid)})}
+	err := opr.Process(t.newOperationWithSequence(sa.Address, items, sa.Privs(), 2))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
 }
 
 func (t *testCreateAccountsOperation) TestSameSendersWithInvalidOperation() {
@@ -590,10 +771,9 @@ func (t *testCreateAccountsOperation) TestSameSendersWithInvalidOperation() {
 
 	na1, _ := t.newAccount(false, nil)
 	items = []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(na1.Keys(), []Amount{NewAmount(NewBig(1), cid)})}
-	ca1 := t.newOperation(sa.Address, items, sa.Privs())
+	ca1 := t.newOperationWithSequence(sa.Address, items, sa.Privs(), 2)
 
-	err := opr.Process(ca1)
-	t.Contains(err.Error(), "violates only one sender")
+	t.NoError(opr.Process(ca1))
 }
 
 func (t *testCreateAccountsOperation) TestSameAddress() {
@@ -34,6 +34,12 @@ func (it CreateAccountsItemSingleAmount) IsValid([]byte) error {
 	return nil
 }
 
+func (it CreateAccountsItemSingleAmount) SetFeeCurrency(cid CurrencyID) CreateAccountsItem {
+	it.BaseCreateAccountsItem = it.BaseCreateAccountsItem.SetFeeCurrency(cid).(BaseCreateAccountsItem)
+
+	return it
+}
+
 func (it CreateAccountsItemSingleAmount) Rebuild() CreateAccountsItem {
 	it.BaseCreateAccountsItem = it.BaseCreateAccountsItem.Rebuild().(BaseCreateAccountsItem)
 
@@ -38,7 +38,7 @@ func (t *testCreateAccountsSingleAmount) TestNew() {
 	am := NewAmount(NewBig(11), CurrencyID("SHOWME"))
 
 	item := NewCreateAccountsItemSingleAmount(skeys, am)
-	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item})
+	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item}).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -85,7 +85,7 @@ func (t *testCreateAccountsSingleAmount) TestZeroBig() {
 	err = item.IsValid(nil)
 	t.Contains(err.Error(), "amount should be over zero")
 
-	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item})
+	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item}).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -127,7 +127,7 @@ func (t *testCreateAccountsSingleAmount) TestEmptyAmounts() {
 	err = item.IsValid(nil)
 	t.Contains(err.Error(), "empty amount")
 
-	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item})
+	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item}).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -173,7 +173,7 @@ func (t *testCreateAccountsSingleAmount) TestTooManyAmounts() {
 	err = item.IsValid(nil)
 	t.Contains(err.Error(), "only one amount allowed")
 
-	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item})
+	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item}).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -216,7 +216,7 @@ func testCreateAccountsSingleAmountEncode(enc encoder.Encoder) suite.TestingSuit
 		am := NewAmount(NewBig(11), CurrencyID("SHOWME"))
 
 		item := NewCreateAccountsItemSingleAmount(skeys, am)
-		fact := NewCreateAccountsFact(util.UUID().Bytes(), sender, []CreateAccountsItem{item})
+		fact := NewCreateAccountsFact(util.UUID().Bytes(), sender, []CreateAccountsItem{item}).SetSequence(1)
 
 		var fs []operation.FactSign
 
@@ -36,7 +36,7 @@ func (t *testCreateAccounts) TestNew() {
 	am := NewAmount(NewBig(11), CurrencyID("SHOWME"))
 
 	item := NewCreateAccountsItemSingleAmount(skeys, am)
-	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item})
+	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item}).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -80,7 +80,7 @@ func (t *testCreateAccounts) TestDuplicatedKeys() {
 
 	keys, _ := NewKeys([]Key{key}, 100)
 	sender, _ := NewAddressFromKeys(keys)
-	fact := NewCreateAccountsFact(token, sender, items)
+	fact := NewCreateAccountsFact(token, sender, items).SetSequence(1)
 
 	sig, err := operation.NewFactSignature(pk, fact, nil)
 	t.NoError(err)
@@ -105,7 +105,7 @@ func (t *testCreateAccounts) TestSameWithSender() {
 
 	token := util.UUID().Bytes()
 	sender, _ := NewAddressFromKeys(keys)
-	fact := NewCreateAccountsFact(token, sender, items)
+	fact := NewCreateAccountsFact(token, sender, items).SetSequence(1)
 
 	sig, err := operation.NewFactSignature(pk, fact, nil)
 	t.NoError(err)
@@ -136,7 +136,7 @@ func (t *testCreateAccounts) TestOverSizeMemo() {
 
 	am := NewAmount(NewBig(11), CurrencyID("SHOWME"))
 	item := NewCreateAccountsItemSingleAmount(skeys, am)
-	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item})
+	fact := NewCreateAccountsFact(token, sender, []CreateAccountsItem{item}).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -0,0 +1,212 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CreateStandingOrderFactType = hint.MustNewType(0xa0, 0x80, "mitum-currency-create-standing-order-operation-fact")
+	CreateStandingOrderFactHint = hint.MustHint(CreateStandingOrderFactType, "0.0.1")
+	CreateStandingOrderType     = hint.MustNewType(0xa0, 0x81, "mitum-currency-create-standing-order-operation")
+	CreateStandingOrderHint     = hint.MustHint(CreateStandingOrderType, "0.0.1")
+)
+
+// CreateStandingOrderFact orders to pay the amount of payer to payee at every
+// interval by the repetitions. Unlike ScheduleTransferFact, the amount is not
+// reserved; it is paid from the balance of payer at each round. Like
+// ScheduleTransferFact, the round is paid by the first block with operations at
+// or after the height of round. Only one round is paid by block and the heights
+// of the next rounds are not shifted, so the missed rounds are paid by the
+// following blocks with operations.
+type CreateStandingOrderFact struct {
+	h           valuehash.Hash
+	token       []byte
+	payer       base.Address
+	payee       base.Address
+	amount      Amount
+	interval    base.Height
+	repetitions uint
+}
+
+func NewCreateStandingOrderFact(
+	token []byte,
+	payer, payee base.Address,
+	amount Amount,
+	interval base.Height,
+	repetitions uint,
+) CreateStandingOrderFact {
+	fact := CreateStandingOrderFact{
+		token:       token,
+		payer:       payer,
+		payee:       payee,
+		amount:      amount,
+		interval:    interval,
+		repetitions: repetitions,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CreateStandingOrderFact) Hint() hint.Hint {
+	return CreateStandingOrderFactHint
+}
+
+func (fact CreateStandingOrderFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CreateStandingOrderFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CreateStandingOrderFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CreateStandingOrderFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.payer.Bytes(),
+		fact.payee.Bytes(),
+		fact.amount.Bytes(),
+		fact.interval.Bytes(),
+		util.UintToBytes(fact.repetitions),
+	)
+}
+
+func (fact CreateStandingOrderFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for CreateStandingOrderFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.payer,
+		fact.payee,
+		fact.amount,
+		fact.interval,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.payer.Equal(fact.payee) {
+		return xerrors.Errorf("payee is same with payer, %q", fact.payer)
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return xerrors.Errorf("amount should be over zero")
+	}
+
+	if fact.interval < 1 {
+		return xerrors.Errorf("interval should be over zero, %v", fact.interval)
+	}
+
+	if fact.repetitions < 1 {
+		return xerrors.Errorf("repetitions should be over zero")
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact CreateStandingOrderFact) Payer() base.Address {
+	return fact.payer
+}
+
+func (fact CreateStandingOrderFact) Payee() base.Address {
+	return fact.payee
+}
+
+func (fact CreateStandingOrderFact) Amount() Amount {
+	return fact.amount
+}
+
+// Interval is the number of blocks between rounds; the first round is at the
+// interval after the block of CreateStandingOrder.
+func (fact CreateStandingOrderFact) Interval() base.Height {
+	return fact.interval
+}
+
+func (fact CreateStandingOrderFact) Repetitions() uint {
+	return fact.repetitions
+}
+
+func (fact CreateStandingOrderFact) Rebuild() CreateStandingOrderFact {
+	fact.amount = fact.amount.WithBig(fact.amount.Big())
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CreateStandingOrderFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.payer, fact.payee}, nil
+}
+
+type CreateStandingOrder struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewCreateStandingOrder(
+	fact CreateStandingOrderFact,
+	fs []operation.FactSign,
+	memo string,
+) (CreateStandingOrder, error) {
+	if bo, err := operation.NewBaseOperationFromFact(CreateStandingOrderHint, fact, fs); err != nil {
+		return CreateStandingOrder{}, err
+	} else {
+		op := CreateStandingOrder{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op CreateStandingOrder) Hint() hint.Hint {
+	return CreateStandingOrderHint
+}
+
+func (op CreateStandingOrder) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op CreateStandingOrder) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op CreateStandingOrder) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,69 @@
+package currency // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact CreateStandingOrderFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":        fact.h,
+				"token":       fact.token,
+				"payer":       fact.payer,
+				"payee":       fact.payee,
+				"amount":      fact.amount,
+				"interval":    fact.interval,
+				"repetitions": fact.repetitions,
+			}))
+}
+
+type CreateStandingOrderFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	PR base.AddressDecoder `bson:"payer"`
+	PE base.AddressDecoder `bson:"payee"`
+	AM bson.Raw            `bson:"amount"`
+	IN base.Height         `bson:"interval"`
+	RP uint                `bson:"repetitions"`
+}
+
+func (fact *CreateStandingOrderFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufact CreateStandingOrderFactBSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.PR, ufact.PE, ufact.AM, ufact.IN, ufact.RP)
+}
+
+func (op CreateStandingOrder) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(
+			op.BaseOperation.BSONM(),
+			bson.M{"memo": op.Memo},
+		))
+}
+
+func (op *CreateStandingOrder) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = CreateStandingOrder{BaseOperation: ubo}
+
+	var um MemoBSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
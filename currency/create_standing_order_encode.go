@@ -0,0 +1,48 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *CreateStandingOrderFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bPayer base.AddressDecoder,
+	bPayee base.AddressDecoder,
+	bam []byte,
+	interval base.Height,
+	repetitions uint,
+) error {
+	var payer, payee base.Address
+	if a, err := bPayer.Encode(enc); err != nil {
+		return err
+	} else {
+		payer = a
+	}
+
+	if a, err := bPayee.Encode(enc); err != nil {
+		return err
+	} else {
+		payee = a
+	}
+
+	var amount Amount
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		amount = am
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.payer = payer
+	fact.payee = payee
+	fact.amount = amount
+	fact.interval = interval
+	fact.repetitions = repetitions
+
+	return nil
+}
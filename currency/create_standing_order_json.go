@@ -0,0 +1,78 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type CreateStandingOrderFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	PR base.Address   `json:"payer"`
+	PE base.Address   `json:"payee"`
+	AM Amount         `json:"amount"`
+	IN base.Height    `json:"interval"`
+	RP uint           `json:"repetitions"`
+}
+
+func (fact CreateStandingOrderFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(CreateStandingOrderFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		PR:         fact.payer,
+		PE:         fact.payee,
+		AM:         fact.amount,
+		IN:         fact.interval,
+		RP:         fact.repetitions,
+	})
+}
+
+type CreateStandingOrderFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	PR base.AddressDecoder `json:"payer"`
+	PE base.AddressDecoder `json:"payee"`
+	AM json.RawMessage     `json:"amount"`
+	IN base.Height         `json:"interval"`
+	RP uint                `json:"repetitions"`
+}
+
+func (fact *CreateStandingOrderFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact CreateStandingOrderFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.PR, ufact.PE, ufact.AM, ufact.IN, ufact.RP)
+}
+
+func (op CreateStandingOrder) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *CreateStandingOrder) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = CreateStandingOrder{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op CreateStandingOrder) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type CreateStandingOrderProcessor struct {
+	cp *CurrencyPool
+	CreateStandingOrder
+	height base.Height
+	ss     state.State
+	sb     AmountState
+	fee    Big
+	sc     state.State
+	cm     Commitments
+}
+
+func NewCreateStandingOrderProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(CreateStandingOrder); !ok {
+			return nil, xerrors.Errorf("not CreateStandingOrder, %T", op)
+		} else {
+			return &CreateStandingOrderProcessor{
+				cp:                  cp,
+				CreateStandingOrder: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *CreateStandingOrderProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *CreateStandingOrderProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(CreateStandingOrderFact)
+	cid := fact.amount.Currency()
+
+	if _, err := existsAccountState(fact.payer, "payer", getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsAccountState(fact.payee, "payee", getState); err != nil {
+		return nil, err
+	}
+
+	if opp.cp != nil && !opp.cp.Exists(cid) {
+		return nil, util.IgnoreError.Errorf("currency not registered, %q", cid)
+	}
+
+	if err := checkTransferRestriction(opp.cp, fact.payer, cid, getState); err != nil {
+		return nil, err
+	} else if err := checkTransferRestriction(opp.cp, fact.payee, cid, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkNotFrozen(fact.payee, cid, true, getState); err != nil {
+		return nil, err
+	}
+
+	if st, err := notExistsState(StateKeyStandingOrder(fact.Hash()), "standing order", getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = st
+	}
+
+	if st, cm, err := loadCommitments(fact.payer, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sc = st
+		opp.cm = cm.Add(StateKeyStandingOrder(fact.Hash()))
+	}
+
+	if err := checkFactSignsByState(fact.payer, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	// NOTE the fee is charged once at creation; the amount of each round is paid
+	// without fee.
+	if sb, fee, err := loadOperationFee(
+		opp.cp, fact.payer, cid, CreateStandingOrderType, opp.height, getState,
+	); err != nil {
+		return nil, err
+	} else {
+		opp.sb = sb
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *CreateStandingOrderProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CreateStandingOrderFact)
+
+	so := NewStandingOrder(
+		fact.Hash(),
+		fact.payer,
+		fact.payee,
+		fact.amount,
+		fact.interval,
+		fact.repetitions,
+		opp.height+fact.interval,
+	)
+
+	var sts []state.State
+	if st, err := SetStateStandingOrderValue(opp.ss, so); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	if st, err := SetStateCommitmentsValue(opp.sc, opp.cm); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	return setState(fact.Hash(), append(sts, opp.sb.Sub(opp.fee).AddFee(opp.fee))...)
+}
+
+// loadActiveStandingOrder loads the StandingOrder, which is not completed or
+// cancelled yet.
+func loadActiveStandingOrder(
+	id valuehash.Hash,
+	getState func(key string) (state.State, bool, error),
+) (state.State, StandingOrder, error) {
+	var st state.State
+	if i, err := existsState(StateKeyStandingOrder(id), "standing order", getState); err != nil {
+		return nil, StandingOrder{}, err
+	} else {
+		st = i
+	}
+
+	switch so, err := StateStandingOrderValue(st); {
+	case err != nil:
+		return nil, StandingOrder{}, util.IgnoreError.Wrap(err)
+	case so.Status() != StandingOrderStatusActive:
+		return nil, StandingOrder{}, util.IgnoreError.Errorf("standing order already %s", so.Status())
+	default:
+		return st, so, nil
+	}
+}
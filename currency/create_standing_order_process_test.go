@@ -0,0 +1,429 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testCreateStandingOrderOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testCreateStandingOrderOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testCreateStandingOrderOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(CreateStandingOrder{}, NewCreateStandingOrderProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testCreateStandingOrderOperations) newCreateStandingOrder(
+	payer, payee base.Address,
+	keys []key.Privatekey,
+	amount Amount,
+	interval base.Height,
+	repetitions uint,
+) CreateStandingOrder {
+	token := util.UUID().Bytes()
+	fact := NewCreateStandingOrderFact(token, payer, payee, amount, interval, repetitions)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewCreateStandingOrder(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testCreateStandingOrderOperations) standingOrderQueue(pool *storage.Statepool) ScheduleQueue {
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyStandingOrderQueue {
+			sq, err := StateScheduleQueueValue(st.GetState())
+			t.NoError(err)
+
+			return sq
+		}
+	}
+
+	t.Fail("standing order queue not updated")
+
+	return ScheduleQueue{}
+}
+
+func (t *testCreateStandingOrderOperations) standingOrderOperationFact(
+	pool *storage.Statepool,
+) StandingOrderOperationFact {
+	for _, o := range pool.AddedOperations() {
+		if i, ok := o.Fact().(StandingOrderOperationFact); ok {
+			return i
+		}
+	}
+
+	t.Fail("StandingOrderOperation not added")
+
+	return StandingOrderOperationFact{}
+}
+
+func (t *testCreateStandingOrderOperations) TestNew() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	amount := NewAmount(NewBig(10), t.cid)
+	op := t.newCreateStandingOrder(sa.Address, ra.Address, sa.Privs(), amount, base.Height(5), 3)
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	var sst, ost, cst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyStandingOrder(op.Fact().Hash()):
+			ost = st.GetState()
+		case StateKeyCommitments(sa.Address):
+			cst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			t.Fail("balance of payee should not be updated")
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(33).Sub(fee)))
+	t.True(sst.(AmountState).Fee().Equal(fee))
+
+	so, err := StateStandingOrderValue(ost)
+	t.NoError(err)
+	t.NoError(so.IsValid(nil))
+	t.Equal(StandingOrderStatusActive, so.Status())
+	t.True(so.Payer().Equal(sa.Address))
+	t.True(so.Payee().Equal(ra.Address))
+	t.True(so.Amount().Equal(amount))
+	t.Equal(uint(3), so.Repetitions())
+	t.Equal(pool.Height()+5, so.Next())
+
+	sq := t.standingOrderQueue(pool)
+	t.Equal(1, len(sq.Items()))
+	t.True(op.Fact().Hash().Equal(sq.Items()[0].ID()))
+	t.Equal(pool.Height()+5, sq.Items()[0].Height())
+
+	cm, err := StateCommitmentsValue(cst)
+	t.NoError(err)
+	t.Equal([]string{StateKeyStandingOrder(op.Fact().Hash())}, cm.Keys())
+}
+
+func (t *testCreateStandingOrderOperations) TestPayeeNotExist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, _ := t.newAccount(false, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCreateStandingOrder(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), base.Height(5), 3)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "payee does not exist")
+}
+
+func (t *testCreateStandingOrderOperations) TestPay() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	height := t.height()
+	so := NewStandingOrder(valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), 5, 3, height)
+	sq := NewScheduleQueue([]ScheduleItem{NewScheduleItem(so.Next(), so.ID())})
+
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst, t.newStandingOrderState(so), t.newStandingOrderQueueState(sq),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+	t.NoError(opr.Close())
+
+	var sst, rst, ost state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyStandingOrder(so.ID()):
+			ost = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(23)))
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(NewBig(13)))
+
+	uso, err := StateStandingOrderValue(ost)
+	t.NoError(err)
+	t.Equal(StandingOrderStatusActive, uso.Status())
+	t.Equal(uint(1), uso.Paid())
+	t.Equal(height+5, uso.Next())
+
+	usq := t.standingOrderQueue(pool)
+	t.Equal(1, len(usq.Items()))
+	t.True(so.ID().Equal(usq.Items()[0].ID()))
+	t.Equal(height+5, usq.Items()[0].Height())
+
+	ofact := t.standingOrderOperationFact(pool)
+	t.Equal(1, len(ofact.Paid()))
+	t.Empty(ofact.Skipped())
+	t.True(so.ID().Equal(ofact.Paid()[0].ID()))
+}
+
+func (t *testCreateStandingOrderOperations) TestPayLate() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	// NOTE the blocks between the round and the current height have no
+	// operations, so the round is paid late and only one round is paid by
+	// block; the missed round is still due.
+	height := base.Height(10)
+	so := NewStandingOrder(valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), 1, 3, height-2)
+	sq := NewScheduleQueue([]ScheduleItem{NewScheduleItem(so.Next(), so.ID())})
+
+	pool := t.statepoolAt(height, st0, st1, []state.State{
+		dst, t.newStandingOrderState(so), t.newStandingOrderQueueState(sq),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+	t.NoError(opr.Close())
+
+	var rst, ost state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyStandingOrder(so.ID()):
+			ost = st.GetState()
+		}
+	}
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(NewBig(13)))
+
+	uso, err := StateStandingOrderValue(ost)
+	t.NoError(err)
+	t.Equal(StandingOrderStatusActive, uso.Status())
+	t.Equal(uint(1), uso.Paid())
+	t.Equal(height-1, uso.Next())
+
+	usq := t.standingOrderQueue(pool)
+	t.Equal(1, len(usq.Items()))
+	t.Equal(height-1, usq.Items()[0].Height())
+	t.Equal(1, len(usq.Due(height)))
+}
+
+func (t *testCreateStandingOrderOperations) TestCreateByOperationTypes() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	pa, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	height := t.height()
+	so := NewStandingOrder(valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), 5, 3, height)
+	sq := NewScheduleQueue([]ScheduleItem{NewScheduleItem(so.Next(), so.ID())})
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{
+		dst, t.newStandingOrderState(so), t.newStandingOrderQueueState(sq),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	copr := t.processor(cp, nil)
+	_, err := copr.(*OperationProcessor).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	// NOTE OperationProcessor is created for each operation type; the
+	// OperationProcessor of Transfers pays the due StandingOrder, which is
+	// closed before the OperationProcessor of CreateStandingOrder.
+	topr := copr.New(pool)
+	sopr := copr.New(pool)
+
+	fact := NewTransfersFact(
+		util.UUID().Bytes(),
+		ra.Address,
+		[]TransfersItem{NewTransfersItemSingleAmount(sa.Address, NewAmount(NewBig(1), t.cid))},
+	).SetSequence(1)
+	sig, err := operation.NewFactSignature(ra.Privs()[0], fact, nil)
+	t.NoError(err)
+	top, err := NewTransfers(fact, []operation.FactSign{operation.NewBaseFactSign(ra.Privs()[0].Publickey(), sig)}, "")
+	t.NoError(err)
+	t.NoError(topr.Process(top))
+
+	op := t.newCreateStandingOrder(pa.Address, ra.Address, pa.Privs(), NewAmount(NewBig(10), t.cid), base.Height(7), 3)
+	t.NoError(sopr.Process(op))
+
+	t.NoError(topr.Close())
+	t.NoError(sopr.Close())
+
+	var rst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(ra.Address, t.cid) {
+			rst = st.GetState()
+		}
+	}
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(NewBig(12)))
+
+	ids := map[string]base.Height{}
+	for _, i := range t.standingOrderQueue(pool).Items() {
+		ids[i.ID().String()] = i.Height()
+	}
+
+	t.Equal(2, len(ids))
+	t.Equal(height+5, ids[so.ID().String()])
+	t.Equal(pool.Height()+7, ids[op.Fact().Hash().String()])
+}
+
+func (t *testCreateStandingOrderOperations) TestSkipInsufficientBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(15), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	height := t.height()
+	a := NewStandingOrder(valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), 5, 3, height)
+	b := NewStandingOrder(valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), 5, 3, height)
+	sq := NewScheduleQueue([]ScheduleItem{NewScheduleItem(a.Next(), a.ID()), NewScheduleItem(b.Next(), b.ID())})
+
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst, t.newStandingOrderState(a), t.newStandingOrderState(b), t.newStandingOrderQueueState(sq),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+	t.NoError(opr.Close())
+
+	var sst, rst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(5)))
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(NewBig(13)))
+
+	ofact := t.standingOrderOperationFact(pool)
+	t.Equal(1, len(ofact.Paid()))
+	t.Equal(1, len(ofact.Skipped()))
+	t.Equal(uint(1), ofact.Skipped()[0].Skipped())
+	t.Equal(StandingOrderStatusActive, ofact.Skipped()[0].Status())
+
+	usq := t.standingOrderQueue(pool)
+	t.Equal(2, len(usq.Items()))
+	t.Equal(height+5, usq.Items()[0].Height())
+	t.Equal(height+5, usq.Items()[1].Height())
+}
+
+func (t *testCreateStandingOrderOperations) TestCompleted() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	so := NewStandingOrder(
+		valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), 5, 2, t.height()-5,
+	).Pay()
+	sq := NewScheduleQueue([]ScheduleItem{NewScheduleItem(so.Next(), so.ID())})
+
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst, t.newStandingOrderState(so), t.newStandingOrderQueueState(sq),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+	t.NoError(opr.Close())
+
+	var ost state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyStandingOrder(so.ID()) {
+			ost = st.GetState()
+		}
+	}
+
+	uso, err := StateStandingOrderValue(ost)
+	t.NoError(err)
+	t.Equal(StandingOrderStatusCompleted, uso.Status())
+	t.Equal(uint(2), uso.Paid())
+
+	t.True(t.standingOrderQueue(pool).IsEmpty())
+}
+
+func TestCreateStandingOrderOperations(t *testing.T) {
+	suite.Run(t, new(testCreateStandingOrderOperations))
+}
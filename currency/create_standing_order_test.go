@@ -0,0 +1,138 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCreateStandingOrder struct {
+	baseTest
+}
+
+func (t *testCreateStandingOrder) newOperation(
+	payer, payee base.Address,
+	amount Amount,
+	interval base.Height,
+	repetitions uint,
+) CreateStandingOrder {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewCreateStandingOrderFact(token, payer, payee, amount, interval, repetitions)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewCreateStandingOrder(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testCreateStandingOrder) TestNew() {
+	payer := NewTestAddress()
+	payee := NewTestAddress()
+
+	op := t.newOperation(payer, payee, NewAmount(NewBig(33), CurrencyID("SHOWME")), base.Height(10), 3)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	as, err := op.Fact().(CreateStandingOrderFact).Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+	t.True(payer.Equal(as[0]))
+	t.True(payee.Equal(as[1]))
+}
+
+func (t *testCreateStandingOrder) TestSamePayee() {
+	payer := NewTestAddress()
+
+	op := t.newOperation(payer, payer, NewAmount(NewBig(33), CurrencyID("SHOWME")), base.Height(10), 3)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "payee is same with payer")
+}
+
+func (t *testCreateStandingOrder) TestZeroInterval() {
+	amount := NewAmount(NewBig(33), CurrencyID("SHOWME"))
+	op := t.newOperation(NewTestAddress(), NewTestAddress(), amount, base.Height(0), 3)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "interval should be over zero")
+}
+
+func (t *testCreateStandingOrder) TestZeroRepetitions() {
+	amount := NewAmount(NewBig(33), CurrencyID("SHOWME"))
+	op := t.newOperation(NewTestAddress(), NewTestAddress(), amount, base.Height(10), 0)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "repetitions should be over zero")
+}
+
+func TestCreateStandingOrder(t *testing.T) {
+	suite.Run(t, new(testCreateStandingOrder))
+}
+
+func testCreateStandingOrderEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewCreateStandingOrderFact(
+			token, NewTestAddress(), NewTestAddress(), NewAmount(NewBig(33), CurrencyID("SHOWME")), base.Height(10), 3,
+		)
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewCreateStandingOrder(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(CreateStandingOrder)
+		tb := b.(CreateStandingOrder)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(CreateStandingOrderFact)
+		ufact := tb.Fact().(CreateStandingOrderFact)
+
+		t.True(fact.payer.Equal(ufact.payer))
+		t.True(fact.payee.Equal(ufact.payee))
+		t.True(fact.amount.Equal(ufact.amount))
+		t.Equal(fact.interval, ufact.interval)
+		t.Equal(fact.repetitions, ufact.repetitions)
+	}
+
+	return t
+}
+
+func TestCreateStandingOrderEncodeJSON(t *testing.T) {
+	suite.Run(t, testCreateStandingOrderEncode(jsonenc.NewEncoder()))
+}
+
+func TestCreateStandingOrderEncodeBSON(t *testing.T) {
+	suite.Run(t, testCreateStandingOrderEncode(bsonenc.NewEncoder()))
+}
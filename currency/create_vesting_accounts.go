@@ -0,0 +1,220 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CreateVestingAccountsFactType = hint.MustNewType(0xa0, 0x50, "mitum-currency-create-vesting-accounts-operation-fact")
+	CreateVestingAccountsFactHint = hint.MustHint(CreateVestingAccountsFactType, "0.0.1")
+	CreateVestingAccountsType     = hint.MustNewType(0xa0, 0x51, "mitum-currency-create-vesting-accounts-operation")
+	CreateVestingAccountsHint     = hint.MustHint(CreateVestingAccountsType, "0.0.1")
+)
+
+type CreateVestingAccountsFact struct {
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []CreateVestingAccountsItem
+}
+
+func NewCreateVestingAccountsFact(
+	token []byte,
+	sender base.Address,
+	items []CreateVestingAccountsItem,
+) CreateVestingAccountsFact {
+	fact := CreateVestingAccountsFact{
+		token:  token,
+		sender: sender,
+		items:  items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CreateVestingAccountsFact) Hint() hint.Hint {
+	return CreateVestingAccountsFactHint
+}
+
+func (fact CreateVestingAccountsFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CreateVestingAccountsFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CreateVestingAccountsFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact CreateVestingAccountsFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for CreateVestingAccountsFact")
+	} else if n := len(fact.items); n < 1 {
+		return xerrors.Errorf("empty items")
+	} else if n > int(maxCreateAccountsItems) {
+		return xerrors.Errorf("items, %d over max, %d", n, maxCreateAccountsItems)
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	foundKeys := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := it.IsValid(nil); err != nil {
+			return err
+		}
+
+		k := it.Keys().Hash().String()
+		if _, found := foundKeys[k]; found {
+			return xerrors.Errorf("duplicated acocunt Keys found, %s", k)
+		}
+
+		switch a, err := it.Address(); {
+		case err != nil:
+			return err
+		case fact.sender.Equal(a):
+			return xerrors.Errorf("target address is same with sender, %q", fact.sender)
+		default:
+			foundKeys[k] = struct{}{}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact CreateVestingAccountsFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CreateVestingAccountsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CreateVestingAccountsFact) Items() []CreateVestingAccountsItem {
+	return fact.items
+}
+
+func (fact CreateVestingAccountsFact) Targets() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items))
+	for i := range fact.items {
+		if a, err := fact.items[i].Address(); err != nil {
+			return nil, err
+		} else {
+			as[i] = a
+		}
+	}
+
+	return as, nil
+}
+
+func (fact CreateVestingAccountsFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items)+1)
+
+	if tas, err := fact.Targets(); err != nil {
+		return nil, err
+	} else {
+		copy(as, tas)
+	}
+
+	as[len(fact.items)] = fact.Sender()
+
+	return as, nil
+}
+
+func (fact CreateVestingAccountsFact) Rebuild() CreateVestingAccountsFact {
+	items := make([]CreateVestingAccountsItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i].Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type CreateVestingAccounts struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewCreateVestingAccounts(
+	fact CreateVestingAccountsFact,
+	fs []operation.FactSign,
+	memo string,
+) (CreateVestingAccounts, error) {
+	if bo, err := operation.NewBaseOperationFromFact(CreateVestingAccountsHint, fact, fs); err != nil {
+		return CreateVestingAccounts{}, err
+	} else {
+		op := CreateVestingAccounts{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op CreateVestingAccounts) Hint() hint.Hint {
+	return CreateVestingAccountsHint
+}
+
+func (op CreateVestingAccounts) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op CreateVestingAccounts) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op CreateVestingAccounts) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
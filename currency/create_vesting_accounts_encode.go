@@ -0,0 +1,42 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *CreateVestingAccountsFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	tk []byte,
+	bSender base.AddressDecoder,
+	bits [][]byte,
+) error {
+	var sender base.Address
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		sender = a
+	}
+
+	its := make([]CreateVestingAccountsItem, len(bits))
+	for i := range bits {
+		if j, err := enc.DecodeByHint(bits[i]); err != nil {
+			return err
+		} else if it, ok := j.(CreateVestingAccountsItem); !ok {
+			return xerrors.Errorf("not CreateVestingAccountsItem, %T", j)
+		} else {
+			its[i] = it
+		}
+	}
+
+	fact.h = h
+	fact.token = tk
+	fact.sender = sender
+	fact.items = its
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+var (
+	CreateVestingAccountsItemType = hint.MustNewType(0xa0, 0x4f, "mitum-currency-create-vesting-accounts-item")
+	CreateVestingAccountsItemHint = hint.MustHint(CreateVestingAccountsItemType, "0.0.1")
+)
+
+// CreateVestingAccountsItem creates the new account with amount. The part of
+// amount by releases is locked until the height of each release.
+type CreateVestingAccountsItem struct {
+	keys     Keys
+	amount   Amount
+	releases []VestingRelease
+}
+
+func NewCreateVestingAccountsItem(keys Keys, amount Amount, releases []VestingRelease) CreateVestingAccountsItem {
+	return CreateVestingAccountsItem{
+		keys:     keys,
+		amount:   amount,
+		releases: releases,
+	}
+}
+
+func (it CreateVestingAccountsItem) Hint() hint.Hint {
+	return CreateVestingAccountsItemHint
+}
+
+func (it CreateVestingAccountsItem) Bytes() []byte {
+	bs := make([][]byte, len(it.releases)+2)
+	bs[0] = it.keys.Bytes()
+	bs[1] = it.amount.Bytes()
+
+	for i := range it.releases {
+		bs[i+2] = it.releases[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (it CreateVestingAccountsItem) IsValid([]byte) error {
+	if err := it.keys.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := it.amount.IsValid(nil); err != nil {
+		return err
+	} else if !it.amount.Big().OverZero() {
+		return xerrors.Errorf("amount should be over zero")
+	}
+
+	vs := it.Vesting()
+	if err := vs.IsValid(nil); err != nil {
+		return err
+	}
+
+	if total := vs.Total(); total.Compare(it.amount.Big()) > 0 {
+		return xerrors.Errorf("total releases over amount, %v > %v", total, it.amount.Big())
+	}
+
+	return nil
+}
+
+func (it CreateVestingAccountsItem) Keys() Keys {
+	return it.keys
+}
+
+func (it CreateVestingAccountsItem) Address() (base.Address, error) {
+	return NewAddressFromKeys(it.keys)
+}
+
+func (it CreateVestingAccountsItem) Amount() Amount {
+	return it.amount
+}
+
+func (it CreateVestingAccountsItem) Amounts() []Amount {
+	return []Amount{it.amount}
+}
+
+func (it CreateVestingAccountsItem) Releases() []VestingRelease {
+	return it.releases
+}
+
+// Vesting returns the vesting of new account in the currency of amount.
+func (it CreateVestingAccountsItem) Vesting() Vesting {
+	return NewVesting(it.amount.Currency(), it.releases)
+}
+
+func (it CreateVestingAccountsItem) Rebuild() CreateVestingAccountsItem {
+	it.amount = it.amount.WithBig(it.amount.Big())
+
+	return it
+}
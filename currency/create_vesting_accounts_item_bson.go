@@ -0,0 +1,38 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (it CreateVestingAccountsItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(it.Hint()),
+		bson.M{
+			"keys":     it.keys,
+			"amount":   it.amount,
+			"releases": it.releases,
+		}),
+	)
+}
+
+type CreateVestingAccountsItemBSONUnpacker struct {
+	KS bson.Raw   `bson:"keys"`
+	AM bson.Raw   `bson:"amount"`
+	RL []bson.Raw `bson:"releases"`
+}
+
+func (it *CreateVestingAccountsItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uit CreateVestingAccountsItemBSONUnpacker
+	if err := bson.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	brl := make([][]byte, len(uit.RL))
+	for i := range uit.RL {
+		brl[i] = uit.RL[i]
+	}
+
+	return it.unpack(enc, uit.KS, uit.AM, brl)
+}
@@ -0,0 +1,43 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *CreateVestingAccountsItem) unpack(
+	enc encoder.Encoder,
+	bks []byte,
+	bam []byte,
+	brl [][]byte,
+) error {
+	if hinter, err := enc.DecodeByHint(bks); err != nil {
+		return err
+	} else if k, ok := hinter.(Keys); !ok {
+		return xerrors.Errorf("not Keys: %T", hinter)
+	} else {
+		it.keys = k
+	}
+
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		it.amount = am
+	}
+
+	releases := make([]VestingRelease, len(brl))
+	for i := range brl {
+		if j, err := enc.DecodeByHint(brl[i]); err != nil {
+			return err
+		} else if vr, ok := j.(VestingRelease); !ok {
+			return xerrors.Errorf("not VestingRelease, %T", j)
+		} else {
+			releases[i] = vr
+		}
+	}
+
+	it.releases = releases
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package currency
+
+import (
+	"encoding/json"
+
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type CreateVestingAccountsItemJSONPacker struct {
+	jsonenc.HintedHead
+	KS Keys             `json:"keys"`
+	AM Amount           `json:"amount"`
+	RL []VestingRelease `json:"releases"`
+}
+
+func (it CreateVestingAccountsItem) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(CreateVestingAccountsItemJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		KS:         it.keys,
+		AM:         it.amount,
+		RL:         it.releases,
+	})
+}
+
+type CreateVestingAccountsItemJSONUnpacker struct {
+	KS json.RawMessage   `json:"keys"`
+	AM json.RawMessage   `json:"amount"`
+	RL []json.RawMessage `json:"releases"`
+}
+
+func (it *CreateVestingAccountsItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uit CreateVestingAccountsItemJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	brl := make([][]byte, len(uit.RL))
+	for i := range uit.RL {
+		brl[i] = uit.RL[i]
+	}
+
+	return it.unpack(enc, uit.KS, uit.AM, brl)
+}
@@ -0,0 +1,74 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type CreateVestingAccountsFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash              `json:"hash"`
+	TK []byte                      `json:"token"`
+	SD base.Address                `json:"sender"`
+	IT []CreateVestingAccountsItem `json:"items"`
+}
+
+func (fact CreateVestingAccountsFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(CreateVestingAccountsFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		IT:         fact.items,
+	})
+}
+
+type CreateVestingAccountsFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	IT []json.RawMessage   `json:"items"`
+}
+
+func (fact *CreateVestingAccountsFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact CreateVestingAccountsFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	bits := make([][]byte, len(ufact.IT))
+	for i := range ufact.IT {
+		bits[i] = ufact.IT[i]
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, bits)
+}
+
+func (op CreateVestingAccounts) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *CreateVestingAccounts) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = CreateVestingAccounts{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
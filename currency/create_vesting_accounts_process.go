@@ -0,0 +1,221 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op CreateVestingAccounts) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type CreateVestingAccountsItemProcessor struct {
+	cp     *CurrencyPool
+	h      valuehash.Hash
+	sender base.Address
+	item   CreateVestingAccountsItem
+	ns     state.State
+	nb     AmountState
+	nv     state.State
+}
+
+func (opp *CreateVestingAccountsItemProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) error {
+	am := opp.item.Amount()
+
+	var policy CurrencyPolicy
+	if opp.cp != nil {
+		if i, found := opp.cp.Policy(am.Currency()); !found {
+			return xerrors.Errorf("currency not registered, %q", am.Currency())
+		} else {
+			policy = i
+		}
+	}
+
+	if am.Big().Compare(policy.NewAccountMinBalance()) < 0 {
+		return xerrors.Errorf("amount should be over minimum balance, %v < %v", am.Big(), policy.NewAccountMinBalance())
+	}
+
+	var target base.Address
+	if a, err := opp.item.Address(); err != nil {
+		return err
+	} else {
+		target = a
+	}
+
+	if st, err := notExistsState(StateKeyAccount(target), "keys of target", getState); err != nil {
+		return err
+	} else {
+		opp.ns = st
+	}
+
+	if err := checkTransferRestriction(opp.cp, opp.sender, am.Currency(), getState); err != nil {
+		return err
+	} else if err := checkTransferRestriction(opp.cp, target, am.Currency(), getState); err != nil {
+		return err
+	}
+
+	if st, _, err := getState(StateKeyBalance(target, am.Currency())); err != nil {
+		return err
+	} else {
+		opp.nb = NewAmountState(st, am.Currency())
+	}
+
+	if st, err := notExistsState(StateKeyVesting(target, am.Currency()), "vesting of target", getState); err != nil {
+		return err
+	} else {
+		opp.nv = st
+	}
+
+	return nil
+}
+
+func (opp *CreateVestingAccountsItemProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) ([]state.State, error) {
+	var nac Account
+	if ac, err := NewAccountFromKeys(opp.item.Keys()); err != nil {
+		return nil, err
+	} else {
+		nac = ac
+	}
+
+	sts := make([]state.State, 3)
+	if st, err := SetStateAccountValue(opp.ns, nac); err != nil {
+		return nil, err
+	} else {
+		sts[0] = st
+	}
+
+	sts[1] = opp.nb.Add(opp.item.Amount().Big())
+
+	if st, err := SetStateVestingValue(opp.nv, opp.item.Vesting()); err != nil {
+		return nil, err
+	} else {
+		sts[2] = st
+	}
+
+	return sts, nil
+}
+
+type CreateVestingAccountsProcessor struct {
+	cp *CurrencyPool
+	CreateVestingAccounts
+	proposable
+	height   base.Height
+	sb       map[CurrencyID]AmountState
+	ns       []*CreateVestingAccountsItemProcessor
+	required map[CurrencyID][2]Big
+	sl       []spendingLimitState
+}
+
+func NewCreateVestingAccountsProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(CreateVestingAccounts); !ok {
+			return nil, xerrors.Errorf("not CreateVestingAccounts, %T", op)
+		} else {
+			return &CreateVestingAccountsProcessor{
+				cp:                    cp,
+				CreateVestingAccounts: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *CreateVestingAccountsProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *CreateVestingAccountsProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(CreateVestingAccountsFact)
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, util.IgnoreError.Errorf("failed to calculate fee: %w", err)
+	} else if err := checkNotFrozenByRequired(fact.sender, nil, required, getState); err != nil {
+		return nil, err
+	} else if sb, err := CheckEnoughBalance(fact.sender, required, opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	if sl, err := checkSpendingLimit(fact.sender, requiredOutflow(opp.required), opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sl = sl
+	}
+
+	ns := make([]*CreateVestingAccountsItemProcessor, len(fact.items))
+	for i := range fact.items {
+		c := &CreateVestingAccountsItemProcessor{cp: opp.cp, h: opp.Hash(), sender: fact.sender, item: fact.items[i]}
+		if err := c.PreProcess(getState, setState); err != nil {
+			return nil, util.IgnoreError.Wrap(err)
+		}
+
+		ns[i] = c
+	}
+
+	if err := opp.checkFactSigns(fact.sender, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	opp.ns = ns
+
+	return opp, nil
+}
+
+func (opp *CreateVestingAccountsProcessor) Process(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CreateVestingAccountsFact)
+
+	var sts []state.State // nolint:prealloc
+	for i := range opp.ns {
+		if s, err := opp.ns[i].Process(getState, setState); err != nil {
+			return util.IgnoreError.Errorf("failed to process create vesting account item: %w", err)
+		} else {
+			sts = append(sts, s...)
+		}
+	}
+
+	sts = append(sts, debitRequired(opp.sb, nil, opp.required)...)
+
+	if sls, err := setSpendingLimitStates(opp.sl); err != nil {
+		return err
+	} else {
+		sts = append(sts, sls...)
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+func (opp *CreateVestingAccountsProcessor) calculateItemsFee() (map[CurrencyID][2]Big, error) {
+	fact := opp.Fact().(CreateVestingAccountsFact)
+
+	items := make([]AmountsItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateItemsFee(opp.cp, CreateVestingAccountsType, items)
+}
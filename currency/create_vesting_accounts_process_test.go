@@ -0,0 +1,288 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testCreateVestingAccountsOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testCreateVestingAccountsOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testCreateVestingAccountsOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(CreateVestingAccounts{}, NewCreateVestingAccountsProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testCreateVestingAccountsOperations) newOperation(
+	sender base.Address,
+	keys []key.Privatekey,
+	items []CreateVestingAccountsItem,
+) CreateVestingAccounts {
+	token := util.UUID().Bytes()
+	fact := NewCreateVestingAccountsFact(token, sender, items)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewCreateVestingAccounts(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testCreateVestingAccountsOperations) TestNew() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	amount := NewAmount(NewBig(10), t.cid)
+	releases := []VestingRelease{
+		NewVestingRelease(pool.Height()+10, NewBig(3)),
+		NewVestingRelease(pool.Height()+20, NewBig(5)),
+	}
+	item := NewCreateVestingAccountsItem(na.Keys(), amount, releases)
+
+	op := t.newOperation(sa.Address, sa.Privs(), []CreateVestingAccountsItem{item})
+	t.NoError(opr.Process(op))
+
+	var sst, nst, ast, vst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(na.Address, t.cid):
+			nst = st.GetState()
+		case StateKeyAccount(na.Address):
+			ast = st.GetState()
+		case StateKeyVesting(na.Address, t.cid):
+			vst = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(33).Sub(amount.Big()).Sub(fee)))
+
+	nstv, _ := StateBalanceValue(nst)
+	t.True(nstv.Big().Equal(amount.Big()))
+
+	ac, err := LoadStateAccountValue(ast)
+	t.NoError(err)
+	t.True(ac.Address().Equal(na.Address))
+
+	vs, err := StateVestingValue(vst)
+	t.NoError(err)
+	t.NoError(vs.IsValid(nil))
+	t.Equal(t.cid, vs.Currency())
+	t.True(vs.Locked(pool.Height()).Equal(NewBig(8)))
+}
+
+func (t *testCreateVestingAccountsOperations) TestInsufficientBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(9), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	releases := []VestingRelease{NewVestingRelease(pool.Height()+10, NewBig(3))}
+	item := NewCreateVestingAccountsItem(na.Keys(), NewAmount(NewBig(10), t.cid), releases)
+
+	op := t.newOperation(sa.Address, sa.Privs(), []CreateVestingAccountsItem{item})
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testCreateVestingAccountsOperations) TestSenderFrozen() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, []state.State{dst, t.newFreezeState(NewFreeze(sa.Address, t.cid, false))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	releases := []VestingRelease{NewVestingRelease(pool.Height()+10, NewBig(3))}
+	item := NewCreateVestingAccountsItem(na.Keys(), NewAmount(NewBig(10), t.cid), releases)
+
+	err := opr.Process(t.newOperation(sa.Address, sa.Privs(), []CreateVestingAccountsItem{item}))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testCreateVestingAccountsOperations) TestTargetNotInAllowlist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetTransferRestriction(TransferRestrictionAllowlist)
+	dst := t.newCurrencyDesignStateByPolicy(t.cid, NewBig(99), NewTestAddress(), po)
+	pool, _ := t.statepool(st0, []state.State{
+		dst,
+		t.newTransferListState(NewTransferListMember(sa.Address, t.cid, true)),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	releases := []VestingRelease{NewVestingRelease(pool.Height()+10, NewBig(3))}
+	item := NewCreateVestingAccountsItem(na.Keys(), NewAmount(NewBig(10), t.cid), releases)
+
+	err := opr.Process(t.newOperation(sa.Address, sa.Privs(), []CreateVestingAccountsItem{item}))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not in allowlist")
+}
+
+func (t *testCreateVestingAccountsOperations) TestSpendingLimit() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(10), base.Height(10), base.Height(0)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(2)))
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newSpendingLimitState(sl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	releases := []VestingRelease{NewVestingRelease(pool.Height()+10, NewBig(3))}
+	item := NewCreateVestingAccountsItem(na.Keys(), NewAmount(NewBig(10), t.cid), releases)
+
+	t.NoError(opr.Process(t.newOperation(sa.Address, sa.Privs(), []CreateVestingAccountsItem{item})))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySpendingLimit(sa.Address, t.cid) {
+			sst = st.GetState()
+		}
+	}
+
+	usl, err := StateSpendingLimitValue(sst)
+	t.NoError(err)
+	t.True(usl.Spent().Equal(NewBig(10)))
+}
+
+func (t *testCreateVestingAccountsOperations) TestSpendingLimitExceeded() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(0)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, []state.State{dst, t.newSpendingLimitState(sl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	releases := []VestingRelease{NewVestingRelease(pool.Height()+10, NewBig(3))}
+	item := NewCreateVestingAccountsItem(na.Keys(), NewAmount(NewBig(10), t.cid), releases)
+
+	err := opr.Process(t.newOperation(sa.Address, sa.Privs(), []CreateVestingAccountsItem{item}))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "spending limit exceeded")
+}
+
+func (t *testCreateVestingAccountsOperations) TestTargetExists() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	releases := []VestingRelease{NewVestingRelease(pool.Height()+10, NewBig(3))}
+	item := NewCreateVestingAccountsItem(na.Keys(), NewAmount(NewBig(10), t.cid), releases)
+
+	op := t.newOperation(sa.Address, sa.Privs(), []CreateVestingAccountsItem{item})
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "keys of target already exists")
+}
+
+func (t *testCreateVestingAccountsOperations) TestSenderLockedByVesting() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	vs := NewVesting(t.cid, []VestingRelease{NewVestingRelease(t.height()+1, NewBig(30))})
+	pool, _ := t.statepool(st0, []state.State{dst, t.newVestingState(sa.Address, vs)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	releases := []VestingRelease{NewVestingRelease(pool.Height()+10, NewBig(3))}
+	item := NewCreateVestingAccountsItem(na.Keys(), NewAmount(NewBig(10), t.cid), releases)
+
+	op := t.newOperation(sa.Address, sa.Privs(), []CreateVestingAccountsItem{item})
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient vested balance")
+}
+
+func TestCreateVestingAccountsOperations(t *testing.T) {
+	suite.Run(t, new(testCreateVestingAccountsOperations))
+}
@@ -0,0 +1,182 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testCreateVestingAccounts struct {
+	baseTest
+}
+
+func (t *testCreateVestingAccounts) newKeys() Keys {
+	k, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), 100)
+	t.NoError(err)
+
+	keys, err := NewKeys([]Key{k}, 100)
+	t.NoError(err)
+
+	return keys
+}
+
+func (t *testCreateVestingAccounts) newOperation(
+	sender base.Address,
+	items []CreateVestingAccountsItem,
+) CreateVestingAccounts {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewCreateVestingAccountsFact(token, sender, items)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewCreateVestingAccounts(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testCreateVestingAccounts) TestNew() {
+	sender := NewTestAddress()
+	keys := t.newKeys()
+
+	releases := []VestingRelease{
+		NewVestingRelease(base.Height(10), NewBig(3)),
+		NewVestingRelease(base.Height(20), NewBig(5)),
+	}
+	item := NewCreateVestingAccountsItem(keys, NewAmount(NewBig(10), CurrencyID("SHOWME")), releases)
+
+	op := t.newOperation(sender, []CreateVestingAccountsItem{item})
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	as, err := op.Fact().(CreateVestingAccountsFact).Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+
+	a, err := NewAddressFromKeys(keys)
+	t.NoError(err)
+	t.True(a.Equal(as[0]))
+	t.True(sender.Equal(as[1]))
+}
+
+func (t *testCreateVestingAccounts) TestReleasesOverAmount() {
+	releases := []VestingRelease{
+		NewVestingRelease(base.Height(10), NewBig(3)),
+		NewVestingRelease(base.Height(20), NewBig(8)),
+	}
+	item := NewCreateVestingAccountsItem(t.newKeys(), NewAmount(NewBig(10), CurrencyID("SHOWME")), releases)
+
+	op := t.newOperation(NewTestAddress(), []CreateVestingAccountsItem{item})
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "total releases over amount")
+}
+
+func (t *testCreateVestingAccounts) TestEmptyReleases() {
+	item := NewCreateVestingAccountsItem(t.newKeys(), NewAmount(NewBig(10), CurrencyID("SHOWME")), nil)
+
+	op := t.newOperation(NewTestAddress(), []CreateVestingAccountsItem{item})
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "empty releases")
+}
+
+func (t *testCreateVestingAccounts) TestDuplicatedKeys() {
+	keys := t.newKeys()
+	releases := []VestingRelease{NewVestingRelease(base.Height(10), NewBig(3))}
+
+	items := []CreateVestingAccountsItem{
+		NewCreateVestingAccountsItem(keys, NewAmount(NewBig(10), CurrencyID("SHOWME")), releases),
+		NewCreateVestingAccountsItem(keys, NewAmount(NewBig(10), CurrencyID("FINDME")), releases),
+	}
+
+	op := t.newOperation(NewTestAddress(), items)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "duplicated acocunt Keys found")
+}
+
+func TestCreateVestingAccounts(t *testing.T) {
+	suite.Run(t, new(testCreateVestingAccounts))
+}
+
+func testCreateVestingAccountsEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+		k, err := NewKey(pk.Publickey(), 100)
+		t.NoError(err)
+		keys, err := NewKeys([]Key{k}, 100)
+		t.NoError(err)
+
+		releases := []VestingRelease{
+			NewVestingRelease(base.Height(10), NewBig(3)),
+			NewVestingRelease(base.Height(20), NewBig(5)),
+		}
+		item := NewCreateVestingAccountsItem(keys, NewAmount(NewBig(10), CurrencyID("SHOWME")), releases)
+
+		token := util.UUID().Bytes()
+		fact := NewCreateVestingAccountsFact(token, NewTestAddress(), []CreateVestingAccountsItem{item})
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewCreateVestingAccounts(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(CreateVestingAccounts)
+		tb := b.(CreateVestingAccounts)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(CreateVestingAccountsFact)
+		ufact := tb.Fact().(CreateVestingAccountsFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.Equal(len(fact.items), len(ufact.items))
+
+		for i := range fact.items {
+			it := fact.items[i]
+			uit := ufact.items[i]
+
+			t.True(it.Keys().Equal(uit.Keys()))
+			t.True(it.Amount().Equal(uit.Amount()))
+			t.Equal(it.Vesting().Bytes(), uit.Vesting().Bytes())
+		}
+	}
+
+	return t
+}
+
+func TestCreateVestingAccountsEncodeJSON(t *testing.T) {
+	suite.Run(t, testCreateVestingAccountsEncode(jsonenc.NewEncoder()))
+}
+
+func TestCreateVestingAccountsEncodeBSON(t *testing.T) {
+	suite.Run(t, testCreateVestingAccountsEncode(bsonenc.NewEncoder()))
+}
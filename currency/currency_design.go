@@ -38,6 +38,14 @@ func (de CurrencyDesign) IsValid([]byte) error {
 		return xerrors.Errorf("invalid CurrencyPolicy: %w", err)
 	}
 
+	if err := de.policy.CheckMaxSupply(de.Big()); err != nil {
+		return xerrors.Errorf("invalid CurrencyDesign: %w", err)
+	}
+
+	if _, found := de.policy.FeeRates()[de.Currency()]; found {
+		return xerrors.Errorf("invalid CurrencyDesign: fee rate of own currency, %q", de.Currency())
+	}
+
 	return nil
 }
 
@@ -58,3 +66,9 @@ func (de CurrencyDesign) SetPolicy(po CurrencyPolicy) CurrencyDesign {
 
 	return de
 }
+
+func (de CurrencyDesign) AddAmount(b Big) CurrencyDesign {
+	de.Amount = de.Amount.WithBig(de.Big().Add(b))
+
+	return de
+}
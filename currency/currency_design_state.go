@@ -0,0 +1,168 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CurrencyDesignStateType = hint.MustNewType(0xa0, 0x3a, "mitum-currency-currency-design-state")
+	CurrencyDesignStateHint = hint.MustHint(CurrencyDesignStateType, "0.0.1")
+)
+
+// CurrencyDesignState keeps the changes of CurrencyDesign in state and
+// applies them to the merged CurrencyDesign, so the several operations in
+// one block can update the same CurrencyDesign.
+type CurrencyDesignState struct {
+	state.State
+	cid    CurrencyID
+	add    Big
+	policy *CurrencyPolicy
+}
+
+func NewCurrencyDesignState(st state.State, cid CurrencyID) CurrencyDesignState {
+	if sst, ok := st.(CurrencyDesignState); ok {
+		return sst
+	}
+
+	return CurrencyDesignState{
+		State: st,
+		cid:   cid,
+		add:   ZeroBig,
+	}
+}
+
+func (st CurrencyDesignState) Hint() hint.Hint {
+	return CurrencyDesignStateHint
+}
+
+func (st CurrencyDesignState) IsValid(b []byte) error {
+	if err := st.State.IsValid(b); err != nil {
+		return err
+	}
+
+	if st.policy != nil {
+		if err := st.policy.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (st CurrencyDesignState) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		st.State.Bytes(),
+		st.add.Bytes(),
+	)
+}
+
+func (st CurrencyDesignState) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(st.Bytes())
+}
+
+func (st CurrencyDesignState) Merge(base state.State) (state.State, error) {
+	var de CurrencyDesign
+	if i, err := StateCurrencyDesignValue(base); err != nil {
+		return nil, err
+	} else {
+		de = i
+	}
+
+	if st.policy != nil {
+		de = de.SetPolicy(*st.policy)
+	}
+
+	if !st.add.IsZero() {
+		de = de.AddAmount(st.add)
+	}
+
+	if de.Big().Compare(ZeroBig) < 0 {
+		return nil, xerrors.Errorf("currency amount under zero, %q", st.cid)
+	}
+
+	return SetStateCurrencyDesignValue(st, de)
+}
+
+func (st CurrencyDesignState) Currency() CurrencyID {
+	return st.cid
+}
+
+// Added returns the changed amount of CurrencyDesign.
+func (st CurrencyDesignState) Added() Big {
+	return st.add
+}
+
+func (st CurrencyDesignState) Add(a Big) CurrencyDesignState {
+	st.add = st.add.Add(a)
+
+	return st
+}
+
+func (st CurrencyDesignState) Sub(a Big) CurrencyDesignState {
+	st.add = st.add.Sub(a)
+
+	return st
+}
+
+func (st CurrencyDesignState) UpdatePolicy(po CurrencyPolicy) CurrencyDesignState {
+	st.policy = &po
+
+	return st
+}
+
+func (st CurrencyDesignState) SetValue(v state.Value) (state.State, error) {
+	if s, err := st.State.SetValue(v); err != nil {
+		return nil, err
+	} else {
+		st.State = s
+
+		return st, nil
+	}
+}
+
+func (st CurrencyDesignState) SetHash(h valuehash.Hash) (state.State, error) {
+	if s, err := st.State.SetHash(h); err != nil {
+		return nil, err
+	} else {
+		st.State = s
+
+		return st, nil
+	}
+}
+
+func (st CurrencyDesignState) SetHeight(h base.Height) state.State {
+	st.State = st.State.SetHeight(h)
+
+	return st
+}
+
+func (st CurrencyDesignState) SetPreviousHeight(h base.Height) (state.State, error) {
+	if s, err := st.State.SetPreviousHeight(h); err != nil {
+		return nil, err
+	} else {
+		st.State = s
+
+		return st, nil
+	}
+}
+
+func (st CurrencyDesignState) SetOperation(ops []valuehash.Hash) state.State {
+	st.State = st.State.SetOperation(ops)
+
+	return st
+}
+
+func (st CurrencyDesignState) Clear() state.State {
+	st.State = st.State.Clear()
+
+	st.add = ZeroBig
+	st.policy = nil
+
+	return st
+}
@@ -38,6 +38,14 @@ func (t *testCurrencyDesign) TestUnderZeroAmount() {
 	t.Contains(err.Error(), "should be over zero")
 }
 
+func (t *testCurrencyDesign) TestOverMaxSupply() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetMaxSupply(NewBig(32))
+	gc := NewCurrencyDesign(MustNewAmount(NewBig(33), CurrencyID("ABC")), NewTestAddress(), po)
+
+	err := gc.IsValid(nil)
+	t.Contains(err.Error(), "over max supply")
+}
+
 func TestCurrencyDesign(t *testing.T) {
 	suite.Run(t, new(testCurrencyDesign))
 }
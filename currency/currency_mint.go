@@ -0,0 +1,222 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	MintItemType         = hint.MustNewType(0xa0, 0x37, "mitum-currency-mint-item")
+	MintItemHint         = hint.MustHint(MintItemType, "0.0.1")
+	CurrencyMintFactType = hint.MustNewType(0xa0, 0x38, "mitum-currency-currency-mint-operation-fact")
+	CurrencyMintFactHint = hint.MustHint(CurrencyMintFactType, "0.0.1")
+	CurrencyMintType     = hint.MustNewType(0xa0, 0x39, "mitum-currency-currency-mint-operation")
+	CurrencyMintHint     = hint.MustHint(CurrencyMintType, "0.0.1")
+)
+
+var maxMintItems uint = 10
+
+type MintItem struct {
+	receiver base.Address
+	amount   Amount
+}
+
+func NewMintItem(receiver base.Address, amount Amount) MintItem {
+	return MintItem{
+		receiver: receiver,
+		amount:   amount,
+	}
+}
+
+func (it MintItem) Hint() hint.Hint {
+	return MintItemHint
+}
+
+func (it MintItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.receiver.Bytes(),
+		it.amount.Bytes(),
+	)
+}
+
+func (it MintItem) IsValid([]byte) error {
+	if err := isvalid.Check([]isvalid.IsValider{it.receiver, it.amount}, nil, false); err != nil {
+		return err
+	}
+
+	if !it.amount.Big().OverZero() {
+		return xerrors.Errorf("amount should be over zero")
+	}
+
+	return nil
+}
+
+func (it MintItem) Receiver() base.Address {
+	return it.receiver
+}
+
+func (it MintItem) Amount() Amount {
+	return it.amount
+}
+
+func (it MintItem) Rebuild() MintItem {
+	it.amount = it.amount.WithBig(it.amount.Big())
+
+	return it
+}
+
+type CurrencyMintFact struct {
+	h     valuehash.Hash
+	token []byte
+	items []MintItem
+}
+
+func NewCurrencyMintFact(token []byte, items []MintItem) CurrencyMintFact {
+	fact := CurrencyMintFact{
+		token: token,
+		items: items,
+	}
+
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CurrencyMintFact) Hint() hint.Hint {
+	return CurrencyMintFactHint
+}
+
+func (fact CurrencyMintFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CurrencyMintFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.items)+1)
+	bs[0] = fact.token
+
+	for i := range fact.items {
+		bs[i+1] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fact CurrencyMintFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for CurrencyMintFact")
+	} else if n := len(fact.items); n < 1 {
+		return xerrors.Errorf("empty items")
+	} else if n > int(maxMintItems) {
+		return xerrors.Errorf("items, %d over max, %d", n, maxMintItems)
+	}
+
+	if err := fact.h.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := it.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid item found: %w", err)
+		}
+
+		k := StateKeyBalance(it.receiver, it.amount.Currency())
+		if _, found := founds[k]; found {
+			return xerrors.Errorf("duplicated item found, %s-%s", it.receiver, it.amount.Currency())
+		}
+
+		founds[k] = struct{}{}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact CurrencyMintFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CurrencyMintFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CurrencyMintFact) Items() []MintItem {
+	return fact.items
+}
+
+// Currencies returns the unique currency ids of items by the item order.
+func (fact CurrencyMintFact) Currencies() []CurrencyID {
+	var cids []CurrencyID
+	founds := map[CurrencyID]struct{}{}
+	for i := range fact.items {
+		cid := fact.items[i].amount.Currency()
+		if _, found := founds[cid]; found {
+			continue
+		}
+
+		founds[cid] = struct{}{}
+		cids = append(cids, cid)
+	}
+
+	return cids
+}
+
+func (fact CurrencyMintFact) Rebuild() CurrencyMintFact {
+	items := make([]MintItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i].Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CurrencyMintFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items))
+	for i := range fact.items {
+		as[i] = fact.items[i].receiver
+	}
+
+	return as, nil
+}
+
+type CurrencyMint struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewCurrencyMint(fact CurrencyMintFact, fs []operation.FactSign, memo string) (CurrencyMint, error) {
+	if bo, err := operation.NewBaseOperationFromFact(CurrencyMintHint, fact, fs); err != nil {
+		return CurrencyMint{}, err
+	} else {
+		op := CurrencyMint{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op CurrencyMint) Hint() hint.Hint {
+	return CurrencyMintHint
+}
+
+func (op CurrencyMint) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
@@ -0,0 +1,90 @@
+package currency // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (it MintItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"receiver": it.receiver,
+				"amount":   it.amount,
+			}),
+	)
+}
+
+type MintItemBSONUnpacker struct {
+	RC base.AddressDecoder `bson:"receiver"`
+	AM bson.Raw            `bson:"amount"`
+}
+
+func (it *MintItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uit MintItemBSONUnpacker
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, uit.RC, uit.AM)
+}
+
+func (fact CurrencyMintFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":  fact.h,
+				"token": fact.token,
+				"items": fact.items,
+			}))
+}
+
+type CurrencyMintFactBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	TK []byte          `bson:"token"`
+	IT []bson.Raw      `bson:"items"`
+}
+
+func (fact *CurrencyMintFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufact CurrencyMintFactBSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	its := make([][]byte, len(ufact.IT))
+	for i := range ufact.IT {
+		its[i] = ufact.IT[i]
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, its)
+}
+
+func (op CurrencyMint) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(
+			op.BaseOperation.BSONM(),
+			bson.M{"memo": op.Memo},
+		))
+}
+
+func (op *CurrencyMint) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = CurrencyMint{BaseOperation: ubo}
+
+	var um MemoBSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
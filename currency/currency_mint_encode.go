@@ -0,0 +1,53 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (it *MintItem) unpack(
+	enc encoder.Encoder,
+	bReceiver base.AddressDecoder,
+	bam []byte,
+) error {
+	if a, err := bReceiver.Encode(enc); err != nil {
+		return err
+	} else {
+		it.receiver = a
+	}
+
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		it.amount = am
+	}
+
+	return nil
+}
+
+func (fact *CurrencyMintFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bitems [][]byte,
+) error {
+	items := make([]MintItem, len(bitems))
+	for i := range bitems {
+		if j, err := enc.DecodeByHint(bitems[i]); err != nil {
+			return err
+		} else if it, ok := j.(MintItem); !ok {
+			return xerrors.Errorf("not MintItem, %T", j)
+		} else {
+			items[i] = it
+		}
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.items = items
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type MintItemJSONPacker struct {
+	jsonenc.HintedHead
+	RC base.Address `json:"receiver"`
+	AM Amount       `json:"amount"`
+}
+
+func (it MintItem) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(MintItemJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		RC:         it.receiver,
+		AM:         it.amount,
+	})
+}
+
+type MintItemJSONUnpacker struct {
+	RC base.AddressDecoder `json:"receiver"`
+	AM json.RawMessage     `json:"amount"`
+}
+
+func (it *MintItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uit MintItemJSONUnpacker
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, uit.RC, uit.AM)
+}
+
+type CurrencyMintFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	IT []MintItem     `json:"items"`
+}
+
+func (fact CurrencyMintFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(CurrencyMintFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		IT:         fact.items,
+	})
+}
+
+type CurrencyMintFactJSONUnpacker struct {
+	H  valuehash.Bytes   `json:"hash"`
+	TK []byte            `json:"token"`
+	IT []json.RawMessage `json:"items"`
+}
+
+func (fact *CurrencyMintFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact CurrencyMintFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	its := make([][]byte, len(ufact.IT))
+	for i := range ufact.IT {
+		its[i] = ufact.IT[i]
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, its)
+}
+
+func (op CurrencyMint) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *CurrencyMint) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = CurrencyMint{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
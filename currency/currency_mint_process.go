@@ -0,0 +1,160 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op CurrencyMint) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type CurrencyMintProcessor struct {
+	CurrencyMint
+	cp        *CurrencyPool
+	pubs      []key.Publickey
+	threshold base.Threshold
+	rb        map[string]AmountState
+	de        map[CurrencyID]CurrencyDesignState
+}
+
+func NewCurrencyMintProcessor(cp *CurrencyPool, pubs []key.Publickey, threshold base.Threshold) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(CurrencyMint); !ok {
+			return nil, xerrors.Errorf("not CurrencyMint, %T", op)
+		} else {
+			return &CurrencyMintProcessor{
+				CurrencyMint: i,
+				cp:           cp,
+				pubs:         pubs,
+				threshold:    threshold,
+			}, nil
+		}
+	}
+}
+
+func (opp *CurrencyMintProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	if len(opp.pubs) < 1 {
+		return nil, xerrors.Errorf("empty publickeys for operation signs")
+	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+		return nil, err
+	}
+
+	fact := opp.Fact().(CurrencyMintFact)
+
+	de := map[CurrencyID]CurrencyDesignState{}
+	for _, cid := range fact.Currencies() {
+		if opp.cp != nil {
+			if !opp.cp.Exists(cid) {
+				return nil, xerrors.Errorf("currency not registered, %q", cid)
+			}
+		}
+
+		if st, err := existsState(StateKeyCurrencyDesign(cid), "currency design", getState); err != nil {
+			return nil, err
+		} else if err := opp.checkMaxSupply(cid, st, getState); err != nil {
+			return nil, err
+		} else {
+			de[cid] = NewCurrencyDesignState(st, cid)
+		}
+	}
+
+	rb := map[string]AmountState{}
+	for i := range fact.items {
+		it := fact.items[i]
+
+		if _, err := existsAccountState(it.Receiver(), "receiver", getState); err != nil {
+			return nil, xerrors.Errorf("receiver account not found: %w", err)
+		}
+
+		if err := checkTransferRestriction(opp.cp, it.Receiver(), it.Amount().Currency(), getState); err != nil {
+			return nil, err
+		} else if err := checkNotFrozen(it.Receiver(), it.Amount().Currency(), true, getState); err != nil {
+			return nil, err
+		}
+
+		k := StateKeyBalance(it.Receiver(), it.Amount().Currency())
+		if st, _, err := getState(k); err != nil {
+			return nil, err
+		} else {
+			rb[k] = NewAmountState(st, it.Amount().Currency())
+		}
+	}
+
+	opp.de = de
+	opp.rb = rb
+
+	return opp, nil
+}
+
+func (opp *CurrencyMintProcessor) checkMaxSupply(
+	cid CurrencyID,
+	st state.State,
+	getState func(key string) (state.State, bool, error),
+) error {
+	var de CurrencyDesign
+	if i, err := StateCurrencyDesignValue(st); err != nil {
+		return err
+	} else if !i.Policy().HasMaxSupply() {
+		return nil
+	} else {
+		de = i
+	}
+
+	minted := ZeroBig
+	fact := opp.Fact().(CurrencyMintFact)
+	for i := range fact.items {
+		if am := fact.items[i].Amount(); am.Currency() == cid {
+			minted = minted.Add(am.Big())
+		}
+	}
+
+	if total, err := loadTotalSupply(cid, de, getState); err != nil {
+		return err
+	} else if err := de.Policy().CheckMaxSupply(total.Add(minted)); err != nil {
+		return util.IgnoreError.Errorf("failed to mint, %q: %w", cid, err)
+	}
+
+	return nil
+}
+
+func (opp *CurrencyMintProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CurrencyMintFact)
+
+	var sts []state.State // nolint:prealloc
+
+	minted := map[CurrencyID]Big{}
+	for i := range fact.items {
+		it := fact.items[i]
+		cid := it.Amount().Currency()
+
+		sts = append(sts, opp.rb[StateKeyBalance(it.Receiver(), cid)].Add(it.Amount().Big()))
+
+		if b, found := minted[cid]; found {
+			minted[cid] = b.Add(it.Amount().Big())
+		} else {
+			minted[cid] = it.Amount().Big()
+		}
+	}
+
+	for _, cid := range fact.Currencies() {
+		sts = append(sts, opp.de[cid].Add(minted[cid]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
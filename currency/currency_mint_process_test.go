@@ -0,0 +1,308 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+)
+
+type testCurrencyMintOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testCurrencyMintOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testCurrencyMintOperations) newOperation(keys []key.Privatekey, items []MintItem) CurrencyMint {
+	token := util.UUID().Bytes()
+	fact := NewCurrencyMintFact(token, items)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	tf, err := NewCurrencyMint(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(tf.IsValid(nil))
+
+	return tf
+}
+
+func (t *testCurrencyMintOperations) processor(n int) ([]key.Privatekey, *OperationProcessor) {
+	return t.processorWithCurrencyPool(n, nil)
+}
+
+func (t *testCurrencyMintOperations) processorWithCurrencyPool(
+	n int,
+	cp *CurrencyPool,
+) ([]key.Privatekey, *OperationProcessor) {
+	privs := make([]key.Privatekey, n)
+	for i := 0; i < n; i++ {
+		privs[i] = key.MustNewBTCPrivatekey()
+	}
+
+	pubs := make([]key.Publickey, len(privs))
+	for i := range privs {
+		pubs[i] = privs[i].Publickey()
+	}
+	threshold, err := base.NewThreshold(uint(len(privs)), 100)
+	t.NoError(err)
+
+	opr := NewOperationProcessor(cp)
+	_, err = opr.SetProcessor(CurrencyMint{}, NewCurrencyMintProcessor(cp, pubs, threshold))
+	t.NoError(err)
+
+	return privs, opr
+}
+
+func (t *testCurrencyMintOperations) TestNew() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sts = append(sts, s...)
+	sts = append(sts, t.newCurrencyDesignState(t.cid, NewBig(33), ga.Address, NewNilFeeer()))
+
+	ra, s := t.newAccount(true, nil)
+	sts = append(sts, s...)
+	rb, s := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	sts = append(sts, s...)
+
+	op := t.newOperation(privs, []MintItem{
+		NewMintItem(ra.Address, NewAmount(NewBig(10), t.cid)),
+		NewMintItem(rb.Address, NewAmount(NewBig(20), t.cid)),
+	})
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	t.NoError(opr.Process(op))
+
+	var rast, rbst, dest state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(ra.Address, t.cid):
+			rast = st.GetState()
+		case StateKeyBalance(rb.Address, t.cid):
+			rbst = st.GetState()
+		case StateKeyCurrencyDesign(t.cid):
+			dest = st.GetState()
+		}
+	}
+
+	ram, err := StateBalanceValue(rast)
+	t.NoError(err)
+	t.Equal(NewBig(10), ram.Big())
+
+	rbm, err := StateBalanceValue(rbst)
+	t.NoError(err)
+	t.Equal(NewBig(23), rbm.Big())
+
+	de, err := StateCurrencyDesignValue(dest)
+	t.NoError(err)
+	t.Equal(NewBig(63), de.Big())
+}
+
+func (t *testCurrencyMintOperations) TestReceiverNotExist() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sts = append(sts, s...)
+	sts = append(sts, t.newCurrencyDesignState(t.cid, NewBig(33), ga.Address, NewNilFeeer()))
+
+	ra, _ := t.newAccount(false, nil)
+
+	op := t.newOperation(privs, []MintItem{NewMintItem(ra.Address, NewAmount(NewBig(10), t.cid))})
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "receiver account not found")
+}
+
+func (t *testCurrencyMintOperations) TestReceiverClosed() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sts = append(sts, s...)
+	sts = append(sts, t.newCurrencyDesignState(t.cid, NewBig(33), ga.Address, NewNilFeeer()))
+
+	ra, s := t.newClosedAccount(nil)
+	sts = append(sts, s...)
+
+	op := t.newOperation(privs, []MintItem{NewMintItem(ra.Address, NewAmount(NewBig(10), t.cid))})
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testCurrencyMintOperations) TestReceiverFrozen() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sts = append(sts, s...)
+	sts = append(sts, t.newCurrencyDesignState(t.cid, NewBig(33), ga.Address, NewNilFeeer()))
+
+	ra, s := t.newAccount(true, nil)
+	sts = append(sts, s...)
+	sts = append(sts, t.newFreezeState(NewFreeze(ra.Address, "", true)))
+
+	op := t.newOperation(privs, []MintItem{NewMintItem(ra.Address, NewAmount(NewBig(10), t.cid))})
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testCurrencyMintOperations) TestReceiverInDenylist() {
+	var sts []state.State
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sts = append(sts, s...)
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetTransferRestriction(TransferRestrictionDenylist)
+	dst := t.newCurrencyDesignStateByPolicy(t.cid, NewBig(33), ga.Address, po)
+	sts = append(sts, dst)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	privs, copr := t.processorWithCurrencyPool(3, cp)
+
+	ra, s := t.newAccount(true, nil)
+	sts = append(sts, s...)
+	sts = append(sts, t.newTransferListState(NewTransferListMember(ra.Address, t.cid, true)))
+
+	op := t.newOperation(privs, []MintItem{NewMintItem(ra.Address, NewAmount(NewBig(10), t.cid))})
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "in denylist")
+}
+
+func (t *testCurrencyMintOperations) TestCurrencyNotExist() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ra, s := t.newAccount(true, nil)
+	sts = append(sts, s...)
+
+	op := t.newOperation(privs, []MintItem{NewMintItem(ra.Address, NewAmount(NewBig(10), t.cid))})
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "currency design does not exist")
+}
+
+func (t *testCurrencyMintOperations) TestNotEnoughSigns() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sts = append(sts, s...)
+	sts = append(sts, t.newCurrencyDesignState(t.cid, NewBig(33), ga.Address, NewNilFeeer()))
+
+	op := t.newOperation(privs[:2], []MintItem{NewMintItem(ga.Address, NewAmount(NewBig(10), t.cid))})
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	err := opr.Process(op)
+	t.Contains(err.Error(), "not enough suffrage signs")
+}
+
+func (t *testCurrencyMintOperations) TestSameCurrencyInProposal() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sts = append(sts, s...)
+	sts = append(sts, t.newCurrencyDesignState(t.cid, NewBig(33), ga.Address, NewNilFeeer()))
+
+	op0 := t.newOperation(privs, []MintItem{NewMintItem(ga.Address, NewAmount(NewBig(10), t.cid))})
+	op1 := t.newOperation(privs, []MintItem{NewMintItem(ga.Address, NewAmount(NewBig(20), t.cid))})
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	t.NoError(opr.Process(op0))
+
+	err := opr.Process(op1)
+	t.Contains(err.Error(), "duplicated currency id")
+}
+
+func (t *testCurrencyMintOperations) TestOverMaxSupply() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sts = append(sts, s...)
+
+	de := NewCurrencyDesign(
+		NewAmount(NewBig(33), t.cid),
+		ga.Address,
+		NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetMaxSupply(NewBig(50)),
+	)
+
+	st, err := state.NewStateV0(StateKeyCurrencyDesign(t.cid), nil, base.NilHeight)
+	t.NoError(err)
+	dst, err := SetStateCurrencyDesignValue(st, de)
+	t.NoError(err)
+
+	sts = append(sts, dst, t.newCurrencySupplyState(NewCurrencySupply(t.cid, NewBig(40))))
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	op := t.newOperation(privs, []MintItem{NewMintItem(ga.Address, NewAmount(NewBig(11), t.cid))})
+
+	err = opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "over max supply")
+
+	op = t.newOperation(privs, []MintItem{NewMintItem(ga.Address, NewAmount(NewBig(10), t.cid))})
+	t.NoError(opr.Process(op))
+}
+
+func TestCurrencyMintOperations(t *testing.T) {
+	suite.Run(t, new(testCurrencyMintOperations))
+}
@@ -0,0 +1,167 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCurrencyMint struct {
+	baseTest
+}
+
+func (t *testCurrencyMint) newOperation(items []MintItem) CurrencyMint {
+	token := util.UUID().Bytes()
+	fact := NewCurrencyMintFact(token, items)
+
+	var fs []operation.FactSign
+
+	for _, pk := range []key.Privatekey{
+		key.MustNewBTCPrivatekey(),
+		key.MustNewBTCPrivatekey(),
+		key.MustNewBTCPrivatekey(),
+	} {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewCurrencyMint(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testCurrencyMint) TestNew() {
+	items := []MintItem{
+		NewMintItem(NewTestAddress(), NewAmount(NewBig(33), CurrencyID("SHOWME"))),
+		NewMintItem(NewTestAddress(), NewAmount(NewBig(44), CurrencyID("FINDME"))),
+	}
+
+	op := t.newOperation(items)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	fact := op.Fact().(CurrencyMintFact)
+	t.Equal([]CurrencyID{CurrencyID("SHOWME"), CurrencyID("FINDME")}, fact.Currencies())
+
+	as, err := fact.Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+	t.True(as[0].Equal(items[0].Receiver()))
+	t.True(as[1].Equal(items[1].Receiver()))
+}
+
+func (t *testCurrencyMint) TestEmptyItems() {
+	op := t.newOperation(nil)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "empty items")
+}
+
+func (t *testCurrencyMint) TestZeroAmount() {
+	op := t.newOperation([]MintItem{NewMintItem(NewTestAddress(), NewAmount(NewBig(0), CurrencyID("SHOWME")))})
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "amount should be over zero")
+}
+
+func (t *testCurrencyMint) TestDuplicatedItems() {
+	receiver := NewTestAddress()
+
+	op := t.newOperation([]MintItem{
+		NewMintItem(receiver, NewAmount(NewBig(33), CurrencyID("SHOWME"))),
+		NewMintItem(receiver, NewAmount(NewBig(44), CurrencyID("SHOWME"))),
+	})
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "duplicated item found")
+}
+
+func (t *testCurrencyMint) TestSameReceiverDifferentCurrency() {
+	receiver := NewTestAddress()
+
+	op := t.newOperation([]MintItem{
+		NewMintItem(receiver, NewAmount(NewBig(33), CurrencyID("SHOWME"))),
+		NewMintItem(receiver, NewAmount(NewBig(44), CurrencyID("FINDME"))),
+	})
+
+	t.NoError(op.IsValid(nil))
+}
+
+func TestCurrencyMint(t *testing.T) {
+	suite.Run(t, new(testCurrencyMint))
+}
+
+func testCurrencyMintEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		token := util.UUID().Bytes()
+		items := []MintItem{
+			NewMintItem(NewTestAddress(), NewAmount(NewBig(33), CurrencyID("SHOWME"))),
+			NewMintItem(NewTestAddress(), NewAmount(NewBig(44), CurrencyID("FINDME"))),
+		}
+		fact := NewCurrencyMintFact(token, items)
+
+		var fs []operation.FactSign
+
+		for _, pk := range []key.Privatekey{
+			key.MustNewBTCPrivatekey(),
+			key.MustNewBTCPrivatekey(),
+			key.MustNewBTCPrivatekey(),
+		} {
+			sig, err := operation.NewFactSignature(pk, fact, nil)
+			t.NoError(err)
+
+			fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+		}
+
+		op, err := NewCurrencyMint(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(CurrencyMint)
+		tb := b.(CurrencyMint)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(CurrencyMintFact)
+		ufact := tb.Fact().(CurrencyMintFact)
+
+		t.Equal(len(fact.items), len(ufact.items))
+		for i := range fact.items {
+			a := fact.items[i]
+			b := ufact.items[i]
+
+			t.True(a.Receiver().Equal(b.Receiver()))
+			t.True(a.Amount().Equal(b.Amount()))
+		}
+	}
+
+	return t
+}
+
+func TestCurrencyMintEncodeJSON(t *testing.T) {
+	suite.Run(t, testCurrencyMintEncode(jsonenc.NewEncoder()))
+}
+
+func TestCurrencyMintEncodeBSON(t *testing.T) {
+	suite.Run(t, testCurrencyMintEncode(bsonenc.NewEncoder()))
+}
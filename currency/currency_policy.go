@@ -1,6 +1,13 @@
 package currency
 
 import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/hint"
 	"golang.org/x/xerrors"
@@ -11,13 +18,27 @@ var (
 	CurrencyPolicyHint = hint.MustHint(CurrencyPolicyType, "0.0.1")
 )
 
+// CurrencyPolicy has the policy of currency. maxSupply is the optional cap of
+// the total supply; ZeroBig means no cap. operationFeeers is the optional fee
+// schedule by operation type; the operation type, which is not in
+// operationFeeers, is charged by feeer. feeShares is the optional distribution
+// of the collected fee; without feeShares, the whole fee goes to the receiver of
+// feeer. feeRates is the optional conversion rates of fee into the other
+// currencies; the items, which pays fee by the other currency, are charged by
+// fee * rate of the fee currency. transferRestriction is the optional mode of
+// the transfer list; empty means TransferRestrictionOpen.
 type CurrencyPolicy struct {
 	newAccountMinBalance Big
 	feeer                Feeer
+	maxSupply            Big
+	operationFeeers      map[hint.Type]Feeer
+	feeShares            []FeeShare
+	feeRates             map[CurrencyID]float64
+	transferRestriction  TransferRestriction
 }
 
 func NewCurrencyPolicy(newAccountMinBalance Big, feeer Feeer) CurrencyPolicy {
-	return CurrencyPolicy{newAccountMinBalance: newAccountMinBalance, feeer: feeer}
+	return CurrencyPolicy{newAccountMinBalance: newAccountMinBalance, feeer: feeer, maxSupply: ZeroBig}
 }
 
 func (po CurrencyPolicy) Hint() hint.Hint {
@@ -25,7 +46,33 @@ func (po CurrencyPolicy) Hint() hint.Hint {
 }
 
 func (po CurrencyPolicy) Bytes() []byte {
-	return util.ConcatBytesSlice(po.newAccountMinBalance.Bytes(), po.feeer.Bytes())
+	bs := [][]byte{po.newAccountMinBalance.Bytes(), po.feeer.Bytes()}
+	if po.HasMaxSupply() {
+		bs = append(bs, po.maxSupply.Bytes())
+	}
+
+	types := po.operationFeeerTypes()
+	for i := range types {
+		bs = append(bs, types[i].Bytes(), po.operationFeeers[types[i]].Bytes())
+	}
+
+	for i := range po.feeShares {
+		bs = append(bs, po.feeShares[i].Bytes())
+	}
+
+	cids := po.feeRateCurrencies()
+	for i := range cids {
+		var rb bytes.Buffer
+		_ = binary.Write(&rb, binary.BigEndian, po.feeRates[cids[i]])
+
+		bs = append(bs, cids[i].Bytes(), rb.Bytes())
+	}
+
+	if po.IsTransferRestricted() {
+		bs = append(bs, po.transferRestriction.Bytes())
+	}
+
+	return util.ConcatBytesSlice(bs...)
 }
 
 func (po CurrencyPolicy) IsValid([]byte) error {
@@ -37,6 +84,86 @@ func (po CurrencyPolicy) IsValid([]byte) error {
 		return err
 	}
 
+	if !po.maxSupply.OverNil() {
+		return xerrors.Errorf("MaxSupply under zero")
+	}
+
+	if err := po.isValidOperationFeeers(); err != nil {
+		return err
+	}
+
+	if err := po.isValidFeeShares(); err != nil {
+		return err
+	}
+
+	if len(po.transferRestriction) > 0 {
+		if err := po.transferRestriction.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	return po.isValidFeeRates()
+}
+
+func (po CurrencyPolicy) isValidFeeRates() error {
+	for cid := range po.feeRates {
+		if err := cid.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid fee currency: %w", err)
+		}
+
+		switch r := po.feeRates[cid]; {
+		case math.IsNaN(r) || math.IsInf(r, 0):
+			return xerrors.Errorf("invalid fee rate of %q, %v", cid, r)
+		case r <= 0:
+			return xerrors.Errorf("fee rate of %q should be over zero, %v", cid, r)
+		}
+	}
+
+	return nil
+}
+
+func (po CurrencyPolicy) isValidFeeShares() error {
+	founds := map[string]struct{}{}
+	for i := range po.feeShares {
+		sh := po.feeShares[i]
+		if err := sh.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[sh.Receiver().String()]; found {
+			return xerrors.Errorf("duplicated receiver, %q found in fee shares", sh.Receiver())
+		}
+
+		founds[sh.Receiver().String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (po CurrencyPolicy) isValidOperationFeeers() error {
+	receiver := po.feeer.Receiver()
+	for t := range po.operationFeeers {
+		feeer := po.operationFeeers[t]
+		if err := t.IsValid(nil); err != nil {
+			return err
+		} else if len(t.Name()) < 1 {
+			return xerrors.Errorf("unknown operation type, %x for operation feeer", t[:])
+		}
+
+		if feeer == nil {
+			return xerrors.Errorf("empty feeer for operation, %q", t.Name())
+		} else if err := feeer.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid feeer for operation, %q: %w", t.Name(), err)
+		}
+
+		// NOTE the fee of currency is collected to the receiver of feeer.
+		switch r := feeer.Receiver(); {
+		case r == nil:
+		case receiver == nil || !receiver.Equal(r):
+			return xerrors.Errorf("receiver of feeer for operation, %q should be same with feeer receiver", t.Name())
+		}
+	}
+
 	return nil
 }
 
@@ -47,3 +174,163 @@ func (po CurrencyPolicy) NewAccountMinBalance() Big {
 func (po CurrencyPolicy) Feeer() Feeer {
 	return po.feeer
 }
+
+// OperationFeeer returns the Feeer for the given operation type; if not set,
+// returns the default Feeer.
+func (po CurrencyPolicy) OperationFeeer(t hint.Type) Feeer {
+	if i, found := po.operationFeeers[t]; found {
+		return i
+	}
+
+	return po.feeer
+}
+
+func (po CurrencyPolicy) OperationFeeers() map[hint.Type]Feeer {
+	return po.operationFeeers
+}
+
+func (po CurrencyPolicy) SetOperationFeeers(m map[hint.Type]Feeer) CurrencyPolicy {
+	po.operationFeeers = m
+
+	return po
+}
+
+func (po CurrencyPolicy) operationFeeerTypes() []hint.Type {
+	types := make([]hint.Type, len(po.operationFeeers))
+
+	var i int
+	for t := range po.operationFeeers {
+		types[i] = t
+		i++
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		return bytes.Compare(types[i][:], types[j][:]) < 0
+	})
+
+	return types
+}
+
+func (po CurrencyPolicy) FeeShares() []FeeShare {
+	return po.feeShares
+}
+
+func (po CurrencyPolicy) SetFeeShares(shares []FeeShare) CurrencyPolicy {
+	po.feeShares = shares
+
+	return po
+}
+
+// FeeReceivers returns the accounts, which receive the collected fee. If
+// empty, the collected fee is burned.
+func (po CurrencyPolicy) FeeReceivers() []base.Address {
+	if len(po.feeShares) > 0 {
+		as := make([]base.Address, len(po.feeShares))
+		for i := range po.feeShares {
+			as[i] = po.feeShares[i].Receiver()
+		}
+
+		return as
+	}
+
+	if r := po.feeer.Receiver(); r != nil {
+		return []base.Address{r}
+	}
+
+	return nil
+}
+
+// FeePayouts distributes the collected fee to the fee receivers.
+func (po CurrencyPolicy) FeePayouts(fee Amount) []FeePayout {
+	if len(po.feeShares) > 0 {
+		return DistributeFee(po.feeShares, fee)
+	}
+
+	if r := po.feeer.Receiver(); r != nil {
+		return []FeePayout{NewFeePayout(r, fee)}
+	}
+
+	return nil
+}
+
+func (po CurrencyPolicy) FeeRates() map[CurrencyID]float64 {
+	return po.feeRates
+}
+
+func (po CurrencyPolicy) SetFeeRates(rates map[CurrencyID]float64) CurrencyPolicy {
+	po.feeRates = rates
+
+	return po
+}
+
+// ConvertFee converts the fee of this currency into the given fee currency by
+// the fee rate.
+func (po CurrencyPolicy) ConvertFee(fee Big, cid CurrencyID) (Big, error) {
+	if r, found := po.feeRates[cid]; !found {
+		return Big{}, xerrors.Errorf("fee rate of %q not found", cid)
+	} else {
+		return fee.MulFloat64(r), nil
+	}
+}
+
+func (po CurrencyPolicy) feeRateCurrencies() []CurrencyID {
+	cids := make([]CurrencyID, len(po.feeRates))
+
+	var i int
+	for cid := range po.feeRates {
+		cids[i] = cid
+		i++
+	}
+
+	sort.Slice(cids, func(i, j int) bool {
+		return strings.Compare(cids[i].String(), cids[j].String()) < 0
+	})
+
+	return cids
+}
+
+func (po CurrencyPolicy) MaxSupply() Big {
+	return po.maxSupply
+}
+
+func (po CurrencyPolicy) HasMaxSupply() bool {
+	return po.maxSupply.OverZero()
+}
+
+func (po CurrencyPolicy) SetMaxSupply(b Big) CurrencyPolicy {
+	po.maxSupply = b
+
+	return po
+}
+
+// CheckMaxSupply checks the total supply does not exceed the max supply.
+func (po CurrencyPolicy) CheckMaxSupply(total Big) error {
+	if !po.HasMaxSupply() {
+		return nil
+	}
+
+	if total.Compare(po.maxSupply) > 0 {
+		return xerrors.Errorf("total supply, %v over max supply, %v", total, po.maxSupply)
+	}
+
+	return nil
+}
+
+// TransferRestriction returns the mode of the transfer list of currency.
+func (po CurrencyPolicy) TransferRestriction() TransferRestriction {
+	if len(po.transferRestriction) < 1 {
+		return TransferRestrictionOpen
+	}
+
+	return po.transferRestriction
+}
+
+func (po CurrencyPolicy) IsTransferRestricted() bool {
+	return po.TransferRestriction() != TransferRestrictionOpen
+}
+
+func (po CurrencyPolicy) SetTransferRestriction(tr TransferRestriction) CurrencyPolicy {
+	po.transferRestriction = tr
+
+	return po
+}
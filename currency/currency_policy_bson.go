@@ -4,21 +4,63 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/hint"
 )
 
 func (po CurrencyPolicy) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(bsonenc.MergeBSONM(
-		bsonenc.NewHintedDoc(po.Hint()),
-		bson.M{
-			"new_account_min_balance": po.newAccountMinBalance,
-			"feeer":                   po.feeer,
-		}),
-	)
+	m := bson.M{
+		"new_account_min_balance": po.newAccountMinBalance,
+		"feeer":                   po.feeer,
+		"max_supply":              po.maxSupply,
+	}
+
+	if types := po.operationFeeerTypes(); len(types) > 0 {
+		ofs := make([]bson.M, len(types))
+		for i := range types {
+			ofs[i] = bson.M{"operation": types[i], "feeer": po.operationFeeers[types[i]]}
+		}
+
+		m["operation_feeers"] = ofs
+	}
+
+	if len(po.feeShares) > 0 {
+		m["fee_shares"] = po.feeShares
+	}
+
+	if cids := po.feeRateCurrencies(); len(cids) > 0 {
+		frs := make([]bson.M, len(cids))
+		for i := range cids {
+			frs[i] = bson.M{"currency": cids[i], "rate": po.feeRates[cids[i]]}
+		}
+
+		m["fee_rates"] = frs
+	}
+
+	if len(po.transferRestriction) > 0 {
+		m["transfer_restriction"] = po.transferRestriction
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(po.Hint()), m))
+}
+
+type OperationFeeerBSONUnpacker struct {
+	OP hint.Type `bson:"operation"`
+	FE bson.Raw  `bson:"feeer"`
+}
+
+type FeeRateBSONUnpacker struct {
+	CR string  `bson:"currency"`
+	RT float64 `bson:"rate"`
 }
 
 type CurrencyPolicyBSONUnpacker struct {
-	MN Big      `bson:"new_account_min_balance"`
-	FE bson.Raw `bson:"feeer"`
+	MN Big                          `bson:"new_account_min_balance"`
+	FE bson.Raw                     `bson:"feeer"`
+	MX Big                          `bson:"max_supply,omitempty"`
+	OF []OperationFeeerBSONUnpacker `bson:"operation_feeers,omitempty"`
+	FS []bson.Raw                   `bson:"fee_shares,omitempty"`
+	FR []FeeRateBSONUnpacker        `bson:"fee_rates,omitempty"`
+	TR string                       `bson:"transfer_restriction,omitempty"`
 }
 
 func (po *CurrencyPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -27,5 +69,22 @@ func (po *CurrencyPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return po.unpack(enc, upo.MN, upo.FE)
+	types := make([]hint.Type, len(upo.OF))
+	bofs := make([][]byte, len(upo.OF))
+	for i := range upo.OF {
+		types[i] = upo.OF[i].OP
+		bofs[i] = upo.OF[i].FE
+	}
+
+	bfs := make([][]byte, len(upo.FS))
+	for i := range upo.FS {
+		bfs[i] = upo.FS[i]
+	}
+
+	rates := make(map[CurrencyID]float64, len(upo.FR))
+	for i := range upo.FR {
+		rates[CurrencyID(upo.FR[i].CR)] = upo.FR[i].RT
+	}
+
+	return po.unpack(enc, upo.MN, upo.FE, upo.MX, types, bofs, bfs, rates, upo.TR)
 }
@@ -2,9 +2,21 @@ package currency
 
 import (
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"golang.org/x/xerrors"
 )
 
-func (po *CurrencyPolicy) unpack(enc encoder.Encoder, mn Big, bfe []byte) error {
+func (po *CurrencyPolicy) unpack(
+	enc encoder.Encoder,
+	mn Big,
+	bfe []byte,
+	mx Big,
+	types []hint.Type,
+	bofs [][]byte,
+	bfs [][]byte,
+	rates map[CurrencyID]float64,
+	tr string,
+) error {
 	if i, err := DecodeFeeer(enc, bfe); err != nil {
 		return err
 	} else {
@@ -13,5 +25,41 @@ func (po *CurrencyPolicy) unpack(enc encoder.Encoder, mn Big, bfe []byte) error
 
 	po.newAccountMinBalance = mn
 
+	if mx.Int == nil { // NOTE max supply is optional
+		po.maxSupply = ZeroBig
+	} else {
+		po.maxSupply = mx
+	}
+
+	if len(types) > 0 {
+		po.operationFeeers = map[hint.Type]Feeer{}
+		for i := range types {
+			if j, err := DecodeFeeer(enc, bofs[i]); err != nil {
+				return err
+			} else {
+				po.operationFeeers[types[i]] = j
+			}
+		}
+	}
+
+	if len(bfs) > 0 {
+		po.feeShares = make([]FeeShare, len(bfs))
+		for i := range bfs {
+			if j, err := enc.DecodeByHint(bfs[i]); err != nil {
+				return err
+			} else if sh, ok := j.(FeeShare); !ok {
+				return xerrors.Errorf("not FeeShare, %T", j)
+			} else {
+				po.feeShares[i] = sh
+			}
+		}
+	}
+
+	if len(rates) > 0 {
+		po.feeRates = rates
+	}
+
+	po.transferRestriction = TransferRestriction(tr)
+
 	return nil
 }
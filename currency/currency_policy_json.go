@@ -4,32 +4,100 @@ import (
 	"encoding/json"
 
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
 )
 
+type OperationFeeerJSONPacker struct {
+	OP hint.Type `json:"operation"`
+	FE Feeer     `json:"feeer"`
+}
+
+type FeeRateJSONPacker struct {
+	CR CurrencyID `json:"currency"`
+	RT float64    `json:"rate"`
+}
+
 type CurrencyPolicyJSONPacker struct {
 	jsonenc.HintedHead
-	MN Big   `json:"new_account_min_balance"`
-	FE Feeer `json:"feeer"`
+	MN Big                        `json:"new_account_min_balance"`
+	FE Feeer                      `json:"feeer"`
+	MX Big                        `json:"max_supply"`
+	OF []OperationFeeerJSONPacker `json:"operation_feeers,omitempty"`
+	FS []FeeShare                 `json:"fee_shares,omitempty"`
+	FR []FeeRateJSONPacker        `json:"fee_rates,omitempty"`
+	TR TransferRestriction        `json:"transfer_restriction,omitempty"`
 }
 
 func (po CurrencyPolicy) MarshalJSON() ([]byte, error) {
+	types := po.operationFeeerTypes()
+
+	var ofs []OperationFeeerJSONPacker
+	if len(types) > 0 {
+		ofs = make([]OperationFeeerJSONPacker, len(types))
+		for i := range types {
+			ofs[i] = OperationFeeerJSONPacker{OP: types[i], FE: po.operationFeeers[types[i]]}
+		}
+	}
+
+	cids := po.feeRateCurrencies()
+
+	var frs []FeeRateJSONPacker
+	if len(cids) > 0 {
+		frs = make([]FeeRateJSONPacker, len(cids))
+		for i := range cids {
+			frs[i] = FeeRateJSONPacker{CR: cids[i], RT: po.feeRates[cids[i]]}
+		}
+	}
+
 	return jsonenc.Marshal(CurrencyPolicyJSONPacker{
 		HintedHead: jsonenc.NewHintedHead(po.Hint()),
 		MN:         po.newAccountMinBalance,
 		FE:         po.feeer,
+		MX:         po.maxSupply,
+		OF:         ofs,
+		FS:         po.feeShares,
+		FR:         frs,
+		TR:         po.transferRestriction,
 	})
 }
 
-type CurrencyPolicyJSONUnpacker struct {
-	MN Big             `json:"new_account_min_balance"`
+type OperationFeeerJSONUnpacker struct {
+	OP hint.Type       `json:"operation"`
 	FE json.RawMessage `json:"feeer"`
 }
 
+type CurrencyPolicyJSONUnpacker struct {
+	MN Big                          `json:"new_account_min_balance"`
+	FE json.RawMessage              `json:"feeer"`
+	MX Big                          `json:"max_supply,omitempty"`
+	OF []OperationFeeerJSONUnpacker `json:"operation_feeers,omitempty"`
+	FS []json.RawMessage            `json:"fee_shares,omitempty"`
+	FR []FeeRateJSONPacker          `json:"fee_rates,omitempty"`
+	TR string                       `json:"transfer_restriction,omitempty"`
+}
+
 func (po *CurrencyPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 	var upo CurrencyPolicyJSONUnpacker
 	if err := enc.Unmarshal(b, &upo); err != nil {
 		return err
 	}
 
-	return po.unpack(enc, upo.MN, upo.FE)
+	types := make([]hint.Type, len(upo.OF))
+	bofs := make([][]byte, len(upo.OF))
+	for i := range upo.OF {
+		types[i] = upo.OF[i].OP
+		bofs[i] = upo.OF[i].FE
+	}
+
+	bfs := make([][]byte, len(upo.FS))
+	for i := range upo.FS {
+		bfs[i] = upo.FS[i]
+	}
+
+	rates := make(map[CurrencyID]float64, len(upo.FR))
+	for i := range upo.FR {
+		rates[upo.FR[i].CR] = upo.FR[i].RT
+	}
+
+	return po.unpack(enc, upo.MN, upo.FE, upo.MX, types, bofs, bfs, rates, upo.TR)
 }
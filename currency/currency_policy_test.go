@@ -7,6 +7,7 @@ import (
 	"github.com/spikeekips/mitum/util/encoder"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -25,6 +26,115 @@ func (t *testCurrencyPolicy) TestInValidNewAccountMinBalance() {
 	t.Contains(err.Error(), "NewAccountMinBalance under zero")
 }
 
+func (t *testCurrencyPolicy) TestInValidMaxSupply() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetMaxSupply(NilBig)
+	err := po.IsValid(nil)
+	t.Contains(err.Error(), "MaxSupply under zero")
+}
+
+func (t *testCurrencyPolicy) TestMaxSupply() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer())
+	t.False(po.HasMaxSupply())
+	t.NoError(po.CheckMaxSupply(NewBig(100)))
+
+	upo := po.SetMaxSupply(NewBig(100))
+	t.NoError(upo.IsValid(nil))
+	t.True(upo.HasMaxSupply())
+	t.NotEqual(po.Bytes(), upo.Bytes())
+
+	t.NoError(upo.CheckMaxSupply(NewBig(100)))
+
+	err := upo.CheckMaxSupply(NewBig(101))
+	t.Contains(err.Error(), "over max supply")
+}
+
+func (t *testCurrencyPolicy) TestOperationFeeers() {
+	receiver := MustAddress(util.UUID().String())
+	feeer := NewFixedFeeer(receiver, NewBig(1))
+	kfeeer := NewFixedFeeer(receiver, NewBig(3))
+
+	po := NewCurrencyPolicy(ZeroBig, feeer)
+	upo := po.SetOperationFeeers(map[hint.Type]Feeer{
+		KeyUpdaterType: kfeeer,
+		BurnType:       NewNilFeeer(),
+	})
+	t.NoError(upo.IsValid(nil))
+	t.NotEqual(po.Bytes(), upo.Bytes())
+
+	t.Equal(feeer, upo.OperationFeeer(TransfersType))
+	t.Equal(kfeeer, upo.OperationFeeer(KeyUpdaterType))
+	t.Equal(NewNilFeeer(), upo.OperationFeeer(BurnType))
+	t.Equal(feeer, po.OperationFeeer(KeyUpdaterType))
+}
+
+func (t *testCurrencyPolicy) TestInvalidOperationFeeers() {
+	receiver := MustAddress(util.UUID().String())
+	feeer := NewFixedFeeer(receiver, NewBig(1))
+
+	po := NewCurrencyPolicy(ZeroBig, feeer).SetOperationFeeers(map[hint.Type]Feeer{
+		KeyUpdaterType: NewFixedFeeer(MustAddress(util.UUID().String()), NewBig(3)),
+	})
+	err := po.IsValid(nil)
+	t.Contains(err.Error(), "should be same with feeer receiver")
+
+	po = NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetOperationFeeers(map[hint.Type]Feeer{
+		KeyUpdaterType: feeer,
+	})
+	err = po.IsValid(nil)
+	t.Contains(err.Error(), "should be same with feeer receiver")
+
+	po = NewCurrencyPolicy(ZeroBig, feeer).SetOperationFeeers(map[hint.Type]Feeer{
+		{0xff, 0xf0}: feeer,
+	})
+	err = po.IsValid(nil)
+	t.Contains(err.Error(), "unknown operation type")
+
+	po = NewCurrencyPolicy(ZeroBig, feeer).SetOperationFeeers(map[hint.Type]Feeer{
+		KeyUpdaterType: NewFixedFeeer(receiver, NilBig),
+	})
+	err = po.IsValid(nil)
+	t.Contains(err.Error(), "invalid feeer for operation")
+}
+
+func (t *testCurrencyPolicy) TestFeeRates() {
+	feeer := NewFixedFeeer(MustAddress(util.UUID().String()), NewBig(1))
+
+	po := NewCurrencyPolicy(ZeroBig, feeer)
+	upo := po.SetFeeRates(map[CurrencyID]float64{CurrencyID("FEE"): 0.5})
+	t.NoError(upo.IsValid(nil))
+	t.NotEqual(po.Bytes(), upo.Bytes())
+
+	fee, err := upo.ConvertFee(NewBig(10), CurrencyID("FEE"))
+	t.NoError(err)
+	t.True(fee.Equal(NewBig(5)))
+
+	_, err = upo.ConvertFee(NewBig(10), CurrencyID("UNKNOWN"))
+	t.Contains(err.Error(), "fee rate of \"UNKNOWN\" not found")
+
+	err = po.SetFeeRates(map[CurrencyID]float64{CurrencyID("FEE"): 0}).IsValid(nil)
+	t.Contains(err.Error(), "should be over zero")
+
+	err = po.SetFeeRates(map[CurrencyID]float64{CurrencyID("F"): 1}).IsValid(nil)
+	t.Contains(err.Error(), "invalid fee currency")
+}
+
+func (t *testCurrencyPolicy) TestTransferRestriction() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer())
+	t.Equal(TransferRestrictionOpen, po.TransferRestriction())
+	t.False(po.IsTransferRestricted())
+
+	upo := po.SetTransferRestriction(TransferRestrictionAllowlist)
+	t.NoError(upo.IsValid(nil))
+	t.True(upo.IsTransferRestricted())
+	t.NotEqual(po.Bytes(), upo.Bytes())
+
+	// NOTE open is same with empty
+	t.Equal(po.Bytes(), po.SetTransferRestriction(TransferRestrictionOpen).Bytes())
+
+	err := po.SetTransferRestriction(TransferRestriction("findme")).IsValid(nil)
+	t.Contains(err.Error(), "unknown transfer restriction")
+}
+
 func TestCurrencyPolicy(t *testing.T) {
 	suite.Run(t, new(testCurrencyPolicy))
 }
@@ -34,7 +144,16 @@ func testCurrencyPolicyEncode(enc encoder.Encoder) suite.TestingSuite {
 
 	t.enc = enc
 	t.newObject = func() interface{} {
-		po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(MustAddress(util.UUID().String()), NewBig(33)))
+		receiver := MustAddress(util.UUID().String())
+		po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(receiver, NewBig(33))).
+			SetMaxSupply(NewBig(100)).
+			SetOperationFeeers(map[hint.Type]Feeer{
+				KeyUpdaterType: NewFixedFeeer(receiver, NewBig(44)),
+				BurnType:       NewNilFeeer(),
+			}).
+			SetFeeShares([]FeeShare{NewFeeShare(receiver, 70), NewFeeShare(MustAddress(util.UUID().String()), 30)}).
+			SetFeeRates(map[CurrencyID]float64{CurrencyID("FEE"): 0.5, CurrencyID("SHOWME"): 2}).
+			SetTransferRestriction(TransferRestrictionDenylist)
 
 		return po
 	}
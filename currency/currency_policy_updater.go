@@ -65,6 +65,10 @@ func (fact CurrencyPolicyUpdaterFact) IsValid([]byte) error {
 		return xerrors.Errorf("invalid fact: %w", err)
 	}
 
+	if _, found := fact.policy.FeeRates()[fact.cid]; found {
+		return xerrors.Errorf("fee rate of own currency, %q", fact.cid)
+	}
+
 	if !fact.h.Equal(fact.GenerateHash()) {
 		return isvalid.InvalidError.Errorf("wrong Fact hash")
 	}
@@ -6,6 +6,7 @@ import (
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
@@ -61,10 +62,16 @@ func (opp *CurrencyPolicyUpdaterProcessor) PreProcess(
 		if !opp.cp.Exists(fact.Currency()) {
 			return nil, xerrors.Errorf("unknown currency, %q found", fact.Currency())
 		}
+
+		for cid := range fact.Policy().FeeRates() {
+			if !opp.cp.Exists(cid) {
+				return nil, xerrors.Errorf("unknown fee currency, %q found", cid)
+			}
+		}
 	}
 
-	if receiver := fact.Policy().Feeer().Receiver(); receiver != nil {
-		if err := checkExistsState(StateKeyAccount(receiver), getState); err != nil {
+	for _, receiver := range fact.Policy().FeeReceivers() {
+		if _, err := existsAccountState(receiver, "feeer receiver", getState); err != nil {
 			return nil, xerrors.Errorf("feeer receiver account not found: %w", err)
 		}
 	}
@@ -84,6 +91,14 @@ func (opp *CurrencyPolicyUpdaterProcessor) PreProcess(
 		}
 	}
 
+	if fact.Policy().HasMaxSupply() {
+		if total, err := loadTotalSupply(fact.Currency(), opp.de, getState); err != nil {
+			return nil, err
+		} else if err := fact.Policy().CheckMaxSupply(total); err != nil {
+			return nil, util.IgnoreError.Errorf("failed to update policy, %q: %w", fact.Currency(), err)
+		}
+	}
+
 	return opp, nil
 }
 
@@ -93,9 +108,5 @@ func (opp *CurrencyPolicyUpdaterProcessor) Process(
 ) error {
 	fact := opp.Fact().(CurrencyPolicyUpdaterFact)
 
-	if i, err := SetStateCurrencyDesignValue(opp.st, opp.de.SetPolicy(fact.Policy())); err != nil {
-		return err
-	} else {
-		return setState(fact.Hash(), i)
-	}
+	return setState(fact.Hash(), NewCurrencyDesignState(opp.st, fact.Currency()).UpdatePolicy(fact.Policy()))
 }
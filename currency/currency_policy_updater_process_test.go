@@ -177,6 +177,84 @@ func (t *testCurrencyPolicyUpdaterOperations) TestNotEnoughSigns() {
 	t.Contains(err.Error(), "not enough suffrage signs")
 }
 
+func (t *testCurrencyPolicyUpdaterOperations) TestMaxSupplyUnderTotalSupply() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sts = append(sts, s...)
+
+	de := t.currencyDesign(NewBig(33), t.cid, ga.Address)
+	sts = append(sts,
+		t.newCurrencyDesignState(t.cid, NewBig(33), ga.Address, NewNilFeeer()),
+		t.newCurrencySupplyState(NewCurrencySupply(de.Currency(), NewBig(40))),
+	)
+
+	pool, _ := t.statepool(sts)
+
+	opr := copr.New(pool)
+
+	po := NewCurrencyPolicy(NewBig(1), NewNilFeeer()).SetMaxSupply(NewBig(39))
+	op := t.newOperation(privs, t.cid, po)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "over max supply")
+
+	po = NewCurrencyPolicy(NewBig(1), NewNilFeeer()).SetMaxSupply(NewBig(40))
+	op = t.newOperation(privs, t.cid, po)
+	t.NoError(opr.Process(op))
+}
+
+func (t *testCurrencyPolicyUpdaterOperations) TestFeeShareReceiverNotFound() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sts = append(sts, s...)
+
+	sts = append(sts, t.newCurrencyDesignState(t.cid, NewBig(33), ga.Address, NewNilFeeer()))
+
+	pool, _ := t.statepool(sts)
+
+	opr := copr.New(pool)
+
+	unknown := MustAddress(util.UUID().String())
+	po := NewCurrencyPolicy(NewBig(1), NewFixedFeeer(ga.Address, NewBig(44))).
+		SetFeeShares([]FeeShare{NewFeeShare(ga.Address, 7), NewFeeShare(unknown, 3)})
+	op := t.newOperation(privs, t.cid, po)
+
+	err := opr.Process(op)
+	t.Contains(err.Error(), "feeer receiver account not found")
+}
+
+func (t *testCurrencyPolicyUpdaterOperations) TestFeeShareReceiverClosed() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sts = append(sts, s...)
+	ca, s := t.newClosedAccount(nil)
+	sts = append(sts, s...)
+
+	sts = append(sts, t.newCurrencyDesignState(t.cid, NewBig(33), ga.Address, NewNilFeeer()))
+
+	pool, _ := t.statepool(sts)
+
+	opr := copr.New(pool)
+
+	po := NewCurrencyPolicy(NewBig(1), NewFixedFeeer(ga.Address, NewBig(44))).
+		SetFeeShares([]FeeShare{NewFeeShare(ga.Address, 7), NewFeeShare(ca.Address, 3)})
+	op := t.newOperation(privs, t.cid, po)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "closed")
+}
+
 func TestCurrencyPolicyUpdaterOperations(t *testing.T) {
 	suite.Run(t, new(testCurrencyPolicyUpdaterOperations))
 }
@@ -4,12 +4,15 @@ import (
 	"sync"
 
 	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/hint"
+	"golang.org/x/xerrors"
 )
 
 type CurrencyPool struct {
 	sync.RWMutex
 	demap  map[CurrencyID]CurrencyDesign
 	stsmap map[CurrencyID]state.State
+	spmap  map[CurrencyID]CurrencySupply
 	cids   []CurrencyID
 }
 
@@ -17,6 +20,7 @@ func NewCurrencyPool() *CurrencyPool {
 	return &CurrencyPool{
 		demap:  map[CurrencyID]CurrencyDesign{},
 		stsmap: map[CurrencyID]state.State{},
+		spmap:  map[CurrencyID]CurrencySupply{},
 	}
 }
 
@@ -26,13 +30,25 @@ func (cp *CurrencyPool) Clear() {
 
 	cp.demap = nil
 	cp.stsmap = nil
+	cp.spmap = nil
 	cp.cids = nil
 }
 
+// Set sets the CurrencyDesign or CurrencySupply state.
 func (cp *CurrencyPool) Set(st state.State) error {
 	cp.Lock()
 	defer cp.Unlock()
 
+	if IsStateCurrencySupplyKey(st.Key()) {
+		if i, err := StateCurrencySupplyValue(st); err != nil {
+			return err
+		} else {
+			cp.spmap[i.Currency()] = i
+
+			return nil
+		}
+	}
+
 	var de CurrencyDesign
 	if i, err := StateCurrencyDesignValue(st); err != nil {
 		return err
@@ -94,6 +110,31 @@ func (cp *CurrencyPool) Feeer(cid CurrencyID) (Feeer, bool) {
 	}
 }
 
+func (cp *CurrencyPool) OperationFeeer(cid CurrencyID, t hint.Type) (Feeer, bool) {
+	if i, found := cp.Get(cid); !found {
+		return nil, false
+	} else {
+		return i.Policy().OperationFeeer(t), true
+	}
+}
+
+// ConvertFee converts the fee of currency, cid into the fee currency, fcid by
+// the fee rate of cid policy.
+func (cp *CurrencyPool) ConvertFee(cid, fcid CurrencyID, fee Big) (Big, error) {
+	var po CurrencyPolicy
+	if i, found := cp.Policy(cid); !found {
+		return Big{}, xerrors.Errorf("unknown currency id found, %q", cid)
+	} else {
+		po = i
+	}
+
+	if !cp.Exists(fcid) {
+		return Big{}, xerrors.Errorf("unknown fee currency id found, %q", fcid)
+	}
+
+	return po.ConvertFee(fee, fcid)
+}
+
 func (cp *CurrencyPool) State(cid CurrencyID) (state.State, bool) {
 	if i, found := cp.stsmap[cid]; !found {
 		return nil, false
@@ -143,3 +184,14 @@ func (cp *CurrencyPool) Get(cid CurrencyID) (CurrencyDesign, bool) {
 		return i, true
 	}
 }
+
+func (cp *CurrencyPool) Supply(cid CurrencyID) (CurrencySupply, bool) {
+	cp.RLock()
+	defer cp.RUnlock()
+
+	if i, found := cp.spmap[cid]; !found {
+		return CurrencySupply{}, false
+	} else {
+		return i, true
+	}
+}
@@ -24,6 +24,7 @@ type CurrencyRegisterProcessor struct {
 	threshold base.Threshold
 	ga        AmountState
 	de        state.State
+	sp        state.State
 }
 
 func NewCurrencyRegisterProcessor(cp *CurrencyPool, pubs []key.Publickey, threshold base.Threshold) GetNewProcessor {
@@ -59,12 +60,12 @@ func (opp *CurrencyRegisterProcessor) PreProcess(
 		}
 	}
 
-	if err := checkExistsState(StateKeyAccount(item.GenesisAccount()), getState); err != nil {
+	if _, err := existsAccountState(item.GenesisAccount(), "genesis account", getState); err != nil {
 		return nil, xerrors.Errorf("genesis account not found: %w", err)
 	}
 
-	if receiver := item.Policy().Feeer().Receiver(); receiver != nil {
-		if err := checkExistsState(StateKeyAccount(receiver), getState); err != nil {
+	for _, receiver := range item.Policy().FeeReceivers() {
+		if _, err := existsAccountState(receiver, "feeer receiver", getState); err != nil {
 			return nil, xerrors.Errorf("feeer receiver account not found: %w", err)
 		}
 	}
@@ -78,6 +79,15 @@ func (opp *CurrencyRegisterProcessor) PreProcess(
 		opp.de = st
 	}
 
+	switch st, found, err := getState(StateKeyCurrencySupply(item.Currency())); {
+	case err != nil:
+		return nil, err
+	case found:
+		return nil, xerrors.Errorf("currency supply already exists, %q", item.Currency())
+	default:
+		opp.sp = st
+	}
+
 	switch st, found, err := getState(StateKeyBalance(item.GenesisAccount(), item.Currency())); {
 	case err != nil:
 		return nil, err
@@ -96,7 +106,7 @@ func (opp *CurrencyRegisterProcessor) Process(
 ) error {
 	fact := opp.Fact().(CurrencyRegisterFact)
 
-	sts := make([]state.State, 2)
+	sts := make([]state.State, 3)
 
 	sts[0] = opp.ga.Add(fact.currency.Big())
 	if i, err := SetStateCurrencyDesignValue(opp.de, fact.currency); err != nil {
@@ -105,5 +115,12 @@ func (opp *CurrencyRegisterProcessor) Process(
 		sts[1] = i
 	}
 
+	sp := NewCurrencySupply(fact.currency.Currency(), fact.currency.Big()).SetHolding(fact.currency.Big())
+	if i, err := SetStateCurrencySupplyValue(opp.sp, sp); err != nil {
+		return err
+	} else {
+		sts[2] = i
+	}
+
 	return setState(fact.Hash(), sts...)
 }
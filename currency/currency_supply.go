@@ -0,0 +1,176 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CurrencySupplyType = hint.MustNewType(0xa0, 0x3d, "mitum-currency-currency-supply")
+	CurrencySupplyHint = hint.MustHint(CurrencySupplyType, "0.0.1")
+)
+
+// CurrencySupply tracks how the supply of currency moves. The total supply is
+// increased by mint and decreased by burn and by the fee sent to nil receiver.
+// The circulating supply is the total supply except the balance of the genesis
+// account of currency.
+type CurrencySupply struct {
+	cid         CurrencyID
+	total       Big
+	circulating Big
+	minted      Big
+	burned      Big
+	fee         Big
+	burnedFee   Big
+}
+
+func NewCurrencySupply(cid CurrencyID, total Big) CurrencySupply {
+	return CurrencySupply{
+		cid:         cid,
+		total:       total,
+		circulating: ZeroBig,
+		minted:      ZeroBig,
+		burned:      ZeroBig,
+		fee:         ZeroBig,
+		burnedFee:   ZeroBig,
+	}
+}
+
+func (sp CurrencySupply) Hint() hint.Hint {
+	return CurrencySupplyHint
+}
+
+func (sp CurrencySupply) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		sp.cid.Bytes(),
+		sp.total.Bytes(),
+		sp.circulating.Bytes(),
+		sp.minted.Bytes(),
+		sp.burned.Bytes(),
+		sp.fee.Bytes(),
+		sp.burnedFee.Bytes(),
+	)
+}
+
+func (sp CurrencySupply) Hash() valuehash.Hash {
+	return sp.GenerateHash()
+}
+
+func (sp CurrencySupply) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(sp.Bytes())
+}
+
+func (sp CurrencySupply) IsValid([]byte) error {
+	if err := sp.cid.IsValid(nil); err != nil {
+		return err
+	}
+
+	for _, b := range []Big{sp.total, sp.circulating, sp.minted, sp.burned, sp.fee, sp.burnedFee} {
+		if !b.OverNil() {
+			return xerrors.Errorf("under zero found in CurrencySupply, %v", b)
+		}
+	}
+
+	if sp.circulating.Compare(sp.total) > 0 {
+		return xerrors.Errorf("circulating supply, %v over total supply, %v", sp.circulating, sp.total)
+	}
+
+	if sp.burnedFee.Compare(sp.fee) > 0 {
+		return xerrors.Errorf("burned fee, %v over fee, %v", sp.burnedFee, sp.fee)
+	}
+
+	return nil
+}
+
+func (sp CurrencySupply) Currency() CurrencyID {
+	return sp.cid
+}
+
+// Total returns the total supply.
+func (sp CurrencySupply) Total() Big {
+	return sp.total
+}
+
+// Circulating returns the circulating supply.
+func (sp CurrencySupply) Circulating() Big {
+	return sp.circulating
+}
+
+// Minted returns the accumulated amount by mint.
+func (sp CurrencySupply) Minted() Big {
+	return sp.minted
+}
+
+// Burned returns the accumulated amount by burn.
+func (sp CurrencySupply) Burned() Big {
+	return sp.burned
+}
+
+// Fee returns the accumulated fee collected by FeeOperation.
+func (sp CurrencySupply) Fee() Big {
+	return sp.fee
+}
+
+// BurnedFee returns the accumulated fee, which was sent to nil receiver.
+func (sp CurrencySupply) BurnedFee() Big {
+	return sp.burnedFee
+}
+
+func (sp CurrencySupply) Mint(b Big) CurrencySupply {
+	sp.total = sp.total.Add(b)
+	sp.minted = sp.minted.Add(b)
+
+	return sp
+}
+
+func (sp CurrencySupply) Burn(b Big) CurrencySupply {
+	sp.total = sp.total.Sub(b)
+	sp.burned = sp.burned.Add(b)
+
+	return sp
+}
+
+// CollectFee adds the collected fee. If receiver is nil, the fee is removed
+// from the total supply.
+func (sp CurrencySupply) CollectFee(b Big, nilReceiver bool) CurrencySupply {
+	sp.fee = sp.fee.Add(b)
+
+	if nilReceiver {
+		sp.total = sp.total.Sub(b)
+		sp.burnedFee = sp.burnedFee.Add(b)
+	}
+
+	return sp
+}
+
+// SetHolding sets the circulating supply by the amount held by genesis account.
+func (sp CurrencySupply) SetHolding(b Big) CurrencySupply {
+	sp.circulating = sp.total.Sub(b)
+
+	return sp
+}
+
+// loadTotalSupply returns the total supply from CurrencySupply state. If not
+// found, the amount of CurrencyDesign is used.
+func loadTotalSupply(
+	cid CurrencyID,
+	de CurrencyDesign,
+	getState func(key string) (state.State, bool, error),
+) (Big, error) {
+	switch st, found, err := getState(StateKeyCurrencySupply(cid)); {
+	case err != nil:
+		return NilBig, err
+	case !found:
+		return de.Big(), nil
+	default:
+		if sp, err := StateCurrencySupplyValue(st); err != nil {
+			return NilBig, err
+		} else {
+			return sp.Total(), nil
+		}
+	}
+}
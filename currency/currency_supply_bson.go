@@ -0,0 +1,49 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (sp CurrencySupply) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sp.Hint()),
+		bson.M{
+			"currency":    sp.cid,
+			"total":       sp.total,
+			"circulating": sp.circulating,
+			"minted":      sp.minted,
+			"burned":      sp.burned,
+			"fee":         sp.fee,
+			"burned_fee":  sp.burnedFee,
+		}),
+	)
+}
+
+type CurrencySupplyBSONUnpacker struct {
+	CI string `bson:"currency"`
+	TO Big    `bson:"total"`
+	CR Big    `bson:"circulating"`
+	MI Big    `bson:"minted"`
+	BU Big    `bson:"burned"`
+	FE Big    `bson:"fee"`
+	BF Big    `bson:"burned_fee"`
+}
+
+func (sp *CurrencySupply) UnmarshalBSON(b []byte) error {
+	var usp CurrencySupplyBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &usp); err != nil {
+		return err
+	}
+
+	sp.cid = CurrencyID(usp.CI)
+	sp.total = usp.TO
+	sp.circulating = usp.CR
+	sp.minted = usp.MI
+	sp.burned = usp.BU
+	sp.fee = usp.FE
+	sp.burnedFee = usp.BF
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package currency
+
+import (
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type CurrencySupplyJSONPacker struct {
+	jsonenc.HintedHead
+	CI CurrencyID `json:"currency"`
+	TO Big        `json:"total"`
+	CR Big        `json:"circulating"`
+	MI Big        `json:"minted"`
+	BU Big        `json:"burned"`
+	FE Big        `json:"fee"`
+	BF Big        `json:"burned_fee"`
+}
+
+func (sp CurrencySupply) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(CurrencySupplyJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sp.Hint()),
+		CI:         sp.cid,
+		TO:         sp.total,
+		CR:         sp.circulating,
+		MI:         sp.minted,
+		BU:         sp.burned,
+		FE:         sp.fee,
+		BF:         sp.burnedFee,
+	})
+}
+
+type CurrencySupplyJSONUnpacker struct {
+	CI string `json:"currency"`
+	TO Big    `json:"total"`
+	CR Big    `json:"circulating"`
+	MI Big    `json:"minted"`
+	BU Big    `json:"burned"`
+	FE Big    `json:"fee"`
+	BF Big    `json:"burned_fee"`
+}
+
+func (sp *CurrencySupply) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usp CurrencySupplyJSONUnpacker
+	if err := enc.Unmarshal(b, &usp); err != nil {
+		return err
+	}
+
+	sp.cid = CurrencyID(usp.CI)
+	sp.total = usp.TO
+	sp.circulating = usp.CR
+	sp.minted = usp.MI
+	sp.burned = usp.BU
+	sp.fee = usp.FE
+	sp.burnedFee = usp.BF
+
+	return nil
+}
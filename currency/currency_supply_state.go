@@ -0,0 +1,159 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CurrencySupplyStateType = hint.MustNewType(0xa0, 0x87, "mitum-currency-currency-supply-state")
+	CurrencySupplyStateHint = hint.MustHint(CurrencySupplyStateType, "0.0.1")
+)
+
+// CurrencySupplyState keeps the changes of CurrencySupply in state and applies
+// them to the merged CurrencySupply, so the OperationProcessors of the
+// different operation types can update the same CurrencySupply in one block.
+//
+// The holding amount of genesis account is not the change; the last one is
+// applied.
+type CurrencySupplyState struct {
+	state.State
+	cid         CurrencyID
+	minted      Big
+	burned      Big
+	fee         Big
+	nilReceiver bool
+	holding     *Big
+}
+
+func NewCurrencySupplyState(st state.State, cid CurrencyID) CurrencySupplyState {
+	if sst, ok := st.(CurrencySupplyState); ok {
+		return sst
+	}
+
+	return CurrencySupplyState{
+		State:  st,
+		cid:    cid,
+		minted: ZeroBig,
+		burned: ZeroBig,
+		fee:    ZeroBig,
+	}
+}
+
+func (st CurrencySupplyState) Hint() hint.Hint {
+	return CurrencySupplyStateHint
+}
+
+func (st CurrencySupplyState) Merge(base state.State) (state.State, error) {
+	var sp CurrencySupply
+	if i, err := StateCurrencySupplyValue(base); err != nil {
+		return nil, err
+	} else {
+		sp = i
+	}
+
+	if !st.minted.IsZero() {
+		sp = sp.Mint(st.minted)
+	}
+
+	if !st.burned.IsZero() {
+		sp = sp.Burn(st.burned)
+	}
+
+	if !st.fee.IsZero() {
+		sp = sp.CollectFee(st.fee, st.nilReceiver)
+	}
+
+	if st.holding != nil {
+		sp = sp.SetHolding(*st.holding)
+	}
+
+	return SetStateCurrencySupplyValue(st, sp)
+}
+
+func (st CurrencySupplyState) Currency() CurrencyID {
+	return st.cid
+}
+
+func (st CurrencySupplyState) Mint(b Big) CurrencySupplyState {
+	st.minted = st.minted.Add(b)
+
+	return st
+}
+
+func (st CurrencySupplyState) Burn(b Big) CurrencySupplyState {
+	st.burned = st.burned.Add(b)
+
+	return st
+}
+
+// CollectFee adds the collected fee; if nilReceiver is true, the fee is burned,
+// because there is no fee receiver.
+func (st CurrencySupplyState) CollectFee(b Big, nilReceiver bool) CurrencySupplyState {
+	st.fee = st.fee.Add(b)
+	st.nilReceiver = nilReceiver
+
+	return st
+}
+
+func (st CurrencySupplyState) SetHolding(b Big) CurrencySupplyState {
+	st.holding = &b
+
+	return st
+}
+
+func (st CurrencySupplyState) SetValue(v state.Value) (state.State, error) {
+	if s, err := st.State.SetValue(v); err != nil {
+		return nil, err
+	} else {
+		st.State = s
+
+		return st, nil
+	}
+}
+
+func (st CurrencySupplyState) SetHash(h valuehash.Hash) (state.State, error) {
+	if s, err := st.State.SetHash(h); err != nil {
+		return nil, err
+	} else {
+		st.State = s
+
+		return st, nil
+	}
+}
+
+func (st CurrencySupplyState) SetHeight(h base.Height) state.State {
+	st.State = st.State.SetHeight(h)
+
+	return st
+}
+
+func (st CurrencySupplyState) SetPreviousHeight(h base.Height) (state.State, error) {
+	if s, err := st.State.SetPreviousHeight(h); err != nil {
+		return nil, err
+	} else {
+		st.State = s
+
+		return st, nil
+	}
+}
+
+func (st CurrencySupplyState) SetOperation(ops []valuehash.Hash) state.State {
+	st.State = st.State.SetOperation(ops)
+
+	return st
+}
+
+func (st CurrencySupplyState) Clear() state.State {
+	st.State = st.State.Clear()
+
+	st.minted = ZeroBig
+	st.burned = ZeroBig
+	st.fee = ZeroBig
+	st.nilReceiver = false
+	st.holding = nil
+
+	return st
+}
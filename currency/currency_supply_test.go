@@ -0,0 +1,400 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testCurrencySupply struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testCurrencySupply) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testCurrencySupply) TestNew() {
+	sp := NewCurrencySupply(t.cid, NewBig(100)).SetHolding(NewBig(90))
+	t.NoError(sp.IsValid(nil))
+
+	t.Equal(NewBig(100), sp.Total())
+	t.Equal(NewBig(10), sp.Circulating())
+
+	sp = sp.Mint(NewBig(30)).Burn(NewBig(5)).CollectFee(NewBig(3), true).SetHolding(NewBig(90))
+	t.NoError(sp.IsValid(nil))
+
+	t.Equal(NewBig(122), sp.Total())
+	t.Equal(NewBig(32), sp.Circulating())
+	t.Equal(NewBig(30), sp.Minted())
+	t.Equal(NewBig(5), sp.Burned())
+	t.Equal(NewBig(3), sp.Fee())
+	t.Equal(NewBig(3), sp.BurnedFee())
+}
+
+func (t *testCurrencySupply) TestHoldingOverTotal() {
+	sp := NewCurrencySupply(t.cid, NewBig(100)).SetHolding(NewBig(101))
+
+	err := sp.IsValid(nil)
+	t.Contains(err.Error(), "under zero")
+}
+
+func (t *testCurrencySupply) newTransfer(sender base.Address, keys []key.Privatekey, receiver base.Address, big Big) Transfers {
+	fact := NewTransfersFact(
+		util.UUID().Bytes(),
+		sender,
+		[]TransfersItem{NewTransfersItemSingleAmount(receiver, NewAmount(big, t.cid))},
+	).SetSequence(1)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewTransfers(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testCurrencySupply) supplyState(updates []*state.StateUpdater) (state.State, CurrencySupply) {
+	for _, u := range updates {
+		if u.Key() != StateKeyCurrencySupply(t.cid) {
+			continue
+		}
+
+		st := u.GetState()
+		sp, err := StateCurrencySupplyValue(st)
+		t.NoError(err)
+
+		return st, sp
+	}
+
+	t.Fail("currency supply state not found")
+
+	return nil, CurrencySupply{}
+}
+
+func (t *testCurrencySupply) TestTransferFromGenesisWithFee() {
+	var sts []state.State
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	sts = append(sts, s...)
+	fa, s := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	sts = append(sts, s...)
+	ra, s := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	sts = append(sts, s...)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(100), ga.Address, NewFixedFeeer(fa.Address, NewBig(2)))
+	sts = append(sts, dst)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	pool, _ := t.statepool(sts)
+
+	copr, err := NewOperationProcessor(cp).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+	opr := copr.New(pool)
+
+	op := t.newTransfer(ga.Address, ga.Privs(), ra.Address, NewBig(10))
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	st, sp := t.supplyState(pool.Updates())
+
+	// NOTE fee receiver exists, so total supply is not changed
+	t.Equal(NewBig(100), sp.Total())
+	t.Equal(NewBig(12), sp.Circulating())
+	t.Equal(NewBig(2), sp.Fee())
+	t.True(sp.BurnedFee().IsZero())
+
+	var found bool
+	for _, h := range st.Operations() {
+		if h.Equal(op.Fact().Hash()) {
+			found = true
+
+			break
+		}
+	}
+	t.True(found)
+}
+
+func (t *testCurrencySupply) TestBurnedFee() {
+	var sts []state.State
+
+	fcid := CurrencyID("FEE")
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid), NewAmount(NewBig(5), fcid)})
+	sts = append(sts, s...)
+	ra, s := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	sts = append(sts, s...)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(ga.Address, NewBig(4))).
+		SetFeeRates(map[CurrencyID]float64{fcid: 0.5})
+
+	// NOTE the fee currency has no fee receiver, so the collected fee is burned
+	dst := t.newCurrencyDesignStateByPolicy(t.cid, NewBig(100), ga.Address, po)
+	fdst := t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	sts = append(sts, dst, fdst)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+	t.NoError(cp.Set(fdst))
+
+	pool, _ := t.statepool(sts)
+
+	copr, err := NewOperationProcessor(cp).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+	opr := copr.New(pool)
+
+	fact := NewTransfersFact(
+		util.UUID().Bytes(),
+		ga.Address,
+		[]TransfersItem{
+			NewTransfersItemMultiAmounts(ra.Address, []Amount{NewAmount(NewBig(10), t.cid)}).SetFeeCurrency(fcid),
+		},
+	).SetSequence(1)
+
+	sig, err := operation.NewFactSignature(ga.Privs()[0], fact, nil)
+	t.NoError(err)
+
+	op, err := NewTransfers(fact, []operation.FactSign{operation.NewBaseFactSign(ga.Privs()[0].Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	fee := NewBig(2)
+
+	var sst, dest state.State
+	for _, u := range pool.Updates() {
+		switch u.Key() {
+		case StateKeyCurrencySupply(fcid):
+			sst = u.GetState()
+		case StateKeyCurrencyDesign(fcid):
+			dest = u.GetState()
+		}
+	}
+
+	sp, err := StateCurrencySupplyValue(sst)
+	t.NoError(err)
+	t.Equal(NewBig(99).Sub(fee), sp.Total())
+	t.Equal(fee, sp.Fee())
+	t.Equal(fee, sp.BurnedFee())
+
+	de, err := StateCurrencyDesignValue(dest)
+	t.NoError(err)
+	t.Equal(sp.Total(), de.Big())
+}
+
+func (t *testCurrencySupply) TestMintAndBurn() {
+	var sts []state.State
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	sts = append(sts, s...)
+	ra, s := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	sts = append(sts, s...)
+	ba, s := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sts = append(sts, s...)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(110), ga.Address, NewNilFeeer())
+	sts = append(sts, dst, t.newCurrencySupplyState(NewCurrencySupply(t.cid, NewBig(110)).SetHolding(NewBig(100))))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	privs := []key.Privatekey{key.MustNewBTCPrivatekey()}
+	threshold, err := base.NewThreshold(1, 100)
+	t.NoError(err)
+
+	copr := NewOperationProcessor(cp)
+	_, err = copr.SetProcessor(CurrencyMint{}, NewCurrencyMintProcessor(cp, []key.Publickey{privs[0].Publickey()}, threshold))
+	t.NoError(err)
+	_, err = copr.SetProcessor(Burn{}, NewBurnProcessor(cp))
+	t.NoError(err)
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	mfact := NewCurrencyMintFact(util.UUID().Bytes(), []MintItem{NewMintItem(ra.Address, NewAmount(NewBig(30), t.cid))})
+	sig, err := operation.NewFactSignature(privs[0], mfact, nil)
+	t.NoError(err)
+	mop, err := NewCurrencyMint(mfact, []operation.FactSign{operation.NewBaseFactSign(privs[0].Publickey(), sig)}, "")
+	t.NoError(err)
+
+	bfact := NewBurnFact(util.UUID().Bytes(), ba.Address, []Amount{NewAmount(NewBig(5), t.cid)})
+	sig, err = operation.NewFactSignature(ba.Privs()[0], bfact, nil)
+	t.NoError(err)
+	bop, err := NewBurn(bfact, []operation.FactSign{operation.NewBaseFactSign(ba.Privs()[0].Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(mop))
+	t.NoError(opr.Process(bop))
+	t.NoError(opr.Close())
+
+	st, sp := t.supplyState(pool.Updates())
+
+	t.Equal(NewBig(135), sp.Total())
+	t.Equal(NewBig(35), sp.Circulating())
+	t.Equal(NewBig(30), sp.Minted())
+	t.Equal(NewBig(5), sp.Burned())
+
+	t.Equal(2, len(st.Operations()))
+}
+
+func (t *testCurrencySupply) TestByOperationTypes() {
+	var sts []state.State
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	sts = append(sts, s...)
+	fa, s := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	sts = append(sts, s...)
+	ra, s := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	sts = append(sts, s...)
+	ba, s := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sts = append(sts, s...)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(110), ga.Address, NewFixedFeeer(fa.Address, NewBig(1)))
+	sts = append(sts, dst, t.newCurrencySupplyState(NewCurrencySupply(t.cid, NewBig(110)).SetHolding(NewBig(100))))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	privs := []key.Privatekey{key.MustNewBTCPrivatekey()}
+	threshold, err := base.NewThreshold(1, 100)
+	t.NoError(err)
+
+	copr := NewOperationProcessor(cp)
+	_, err = copr.SetProcessor(CurrencyMint{}, NewCurrencyMintProcessor(cp, []key.Publickey{privs[0].Publickey()}, threshold))
+	t.NoError(err)
+	_, err = copr.SetProcessor(Burn{}, NewBurnProcessor(cp))
+	t.NoError(err)
+	_, err = copr.SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	pool, _ := t.statepool(sts)
+
+	// NOTE OperationProcessor is created for each operation type
+	mopr := copr.New(pool)
+	bopr := copr.New(pool)
+	topr := copr.New(pool)
+
+	mfact := NewCurrencyMintFact(util.UUID().Bytes(), []MintItem{NewMintItem(ra.Address, NewAmount(NewBig(30), t.cid))})
+	sig, err := operation.NewFactSignature(privs[0], mfact, nil)
+	t.NoError(err)
+	mop, err := NewCurrencyMint(mfact, []operation.FactSign{operation.NewBaseFactSign(privs[0].Publickey(), sig)}, "")
+	t.NoError(err)
+
+	bfact := NewBurnFact(util.UUID().Bytes(), ba.Address, []Amount{NewAmount(NewBig(5), t.cid)})
+	sig, err = operation.NewFactSignature(ba.Privs()[0], bfact, nil)
+	t.NoError(err)
+	bop, err := NewBurn(bfact, []operation.FactSign{operation.NewBaseFactSign(ba.Privs()[0].Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(mopr.Process(mop))
+	t.NoError(bopr.Process(bop))
+	t.NoError(topr.Process(t.newTransfer(ga.Address, ga.Privs(), ra.Address, NewBig(10))))
+
+	t.NoError(topr.Close())
+	t.NoError(bopr.Close())
+	t.NoError(mopr.Close())
+
+	_, sp := t.supplyState(pool.Updates())
+
+	// NOTE fee receiver exists, so total supply is not changed by fee
+	t.Equal(NewBig(135), sp.Total())
+	t.Equal(NewBig(30), sp.Minted())
+	t.Equal(NewBig(5), sp.Burned())
+	t.Equal(NewBig(2), sp.Fee())
+	t.True(sp.BurnedFee().IsZero())
+
+	// NOTE genesis account holds 100 - 10 - 1(fee)
+	t.Equal(NewBig(46), sp.Circulating())
+}
+
+func (t *testCurrencySupply) TestNotChanged() {
+	var sts []state.State
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	sts = append(sts, s...)
+	sa, s := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sts = append(sts, s...)
+	ra, s := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	sts = append(sts, s...)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(110), ga.Address, NewNilFeeer())
+	sts = append(sts, dst)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	pool, _ := t.statepool(sts)
+
+	copr, err := NewOperationProcessor(cp).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+	opr := copr.New(pool)
+
+	t.NoError(opr.Process(t.newTransfer(sa.Address, sa.Privs(), ra.Address, NewBig(3))))
+	t.NoError(opr.Close())
+
+	for _, u := range pool.Updates() {
+		t.False(IsStateCurrencySupplyKey(u.Key()))
+	}
+}
+
+func TestCurrencySupply(t *testing.T) {
+	suite.Run(t, new(testCurrencySupply))
+}
+
+func testCurrencySupplyEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		sp := NewCurrencySupply(CurrencyID("SHOWME"), NewBig(100)).
+			Mint(NewBig(30)).
+			Burn(NewBig(5)).
+			CollectFee(NewBig(3), false).
+			SetHolding(NewBig(90))
+		t.NoError(sp.IsValid(nil))
+
+		return sp
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(CurrencySupply)
+		tb := b.(CurrencySupply)
+
+		t.Equal(ta.Currency(), tb.Currency())
+		t.True(ta.Total().Equal(tb.Total()))
+		t.True(ta.Circulating().Equal(tb.Circulating()))
+		t.True(ta.Minted().Equal(tb.Minted()))
+		t.True(ta.Burned().Equal(tb.Burned()))
+		t.True(ta.Fee().Equal(tb.Fee()))
+		t.True(ta.BurnedFee().Equal(tb.BurnedFee()))
+	}
+
+	return t
+}
+
+func TestCurrencySupplyEncodeJSON(t *testing.T) {
+	suite.Run(t, testCurrencySupplyEncode(jsonenc.NewEncoder()))
+}
+
+func TestCurrencySupplyEncodeBSON(t *testing.T) {
+	suite.Run(t, testCurrencySupplyEncode(bsonenc.NewEncoder()))
+}
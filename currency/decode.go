@@ -87,3 +87,13 @@ func DecodeAccount(enc encoder.Encoder, b []byte) (Account, error) {
 		return ac, nil
 	}
 }
+
+func DecodeFreeze(enc encoder.Encoder, b []byte) (Freeze, error) {
+	if i, err := enc.DecodeByHint(b); err != nil {
+		return Freeze{}, err
+	} else if v, ok := i.(Freeze); !ok {
+		return Freeze{}, hint.InvalidTypeError.Errorf("not Freeze; type=%T", i)
+	} else {
+		return v, nil
+	}
+}
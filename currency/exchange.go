@@ -0,0 +1,189 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ExchangeFactType = hint.MustNewType(0xa0, 0x69, "mitum-currency-exchange-operation-fact")
+	ExchangeFactHint = hint.MustHint(ExchangeFactType, "0.0.1")
+	ExchangeType     = hint.MustNewType(0xa0, 0x6a, "mitum-currency-exchange-operation")
+	ExchangeHint     = hint.MustHint(ExchangeType, "0.0.1")
+)
+
+// ExchangeFact swaps the amounts of different currencies between sender and
+// counterparty; sender sends amount to counterparty and counterparty sends
+// counter amount to sender. Both accounts should sign and each pays the fee of
+// it's own currency. Either both amounts are transferred or nothing.
+type ExchangeFact struct {
+	h             valuehash.Hash
+	token         []byte
+	sender        base.Address
+	counterparty  base.Address
+	amount        Amount
+	counterAmount Amount
+}
+
+func NewExchangeFact(
+	token []byte,
+	sender base.Address,
+	counterparty base.Address,
+	amount Amount,
+	counterAmount Amount,
+) ExchangeFact {
+	fact := ExchangeFact{
+		token:         token,
+		sender:        sender,
+		counterparty:  counterparty,
+		amount:        amount,
+		counterAmount: counterAmount,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ExchangeFact) Hint() hint.Hint {
+	return ExchangeFactHint
+}
+
+func (fact ExchangeFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ExchangeFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ExchangeFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ExchangeFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.counterparty.Bytes(),
+		fact.amount.Bytes(),
+		fact.counterAmount.Bytes(),
+	)
+}
+
+func (fact ExchangeFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for ExchangeFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.counterparty,
+		fact.amount,
+		fact.counterAmount,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.counterparty) {
+		return xerrors.Errorf("counterparty is same with sender, %q", fact.sender)
+	}
+
+	if fact.amount.Currency() == fact.counterAmount.Currency() {
+		return xerrors.Errorf("same currency can not be exchanged, %q", fact.amount.Currency())
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return xerrors.Errorf("amount should be over zero")
+	} else if !fact.counterAmount.Big().OverZero() {
+		return xerrors.Errorf("counter amount should be over zero")
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ExchangeFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ExchangeFact) Counterparty() base.Address {
+	return fact.counterparty
+}
+
+// Amount is sent by sender to counterparty.
+func (fact ExchangeFact) Amount() Amount {
+	return fact.amount
+}
+
+// CounterAmount is sent by counterparty to sender.
+func (fact ExchangeFact) CounterAmount() Amount {
+	return fact.counterAmount
+}
+
+func (fact ExchangeFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.counterparty}, nil
+}
+
+type Exchange struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewExchange(fact ExchangeFact, fs []operation.FactSign, memo string) (Exchange, error) {
+	if bo, err := operation.NewBaseOperationFromFact(ExchangeHint, fact, fs); err != nil {
+		return Exchange{}, err
+	} else {
+		op := Exchange{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op Exchange) Hint() hint.Hint {
+	return ExchangeHint
+}
+
+func (op Exchange) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op Exchange) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op Exchange) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
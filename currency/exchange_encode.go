@@ -0,0 +1,46 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *ExchangeFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	bCounterparty base.AddressDecoder,
+	bam []byte,
+	bca []byte,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.sender = a
+	}
+
+	if a, err := bCounterparty.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.counterparty = a
+	}
+
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		fact.amount = am
+	}
+
+	if am, err := DecodeAmount(enc, bca); err != nil {
+		return err
+	} else {
+		fact.counterAmount = am
+	}
+
+	fact.h = h
+	fact.token = token
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type ExchangeFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	CP base.Address   `json:"counterparty"`
+	AM Amount         `json:"amount"`
+	CA Amount         `json:"counter_amount"`
+}
+
+func (fact ExchangeFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ExchangeFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		CP:         fact.counterparty,
+		AM:         fact.amount,
+		CA:         fact.counterAmount,
+	})
+}
+
+type ExchangeFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	CP base.AddressDecoder `json:"counterparty"`
+	AM json.RawMessage     `json:"amount"`
+	CA json.RawMessage     `json:"counter_amount"`
+}
+
+func (fact *ExchangeFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact ExchangeFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.CP, ufact.AM, ufact.CA)
+}
+
+func (op Exchange) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *Exchange) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = Exchange{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
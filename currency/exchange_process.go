@@ -0,0 +1,188 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op Exchange) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+// exchangeItem is the amount of one side of Exchange.
+type exchangeItem Amount
+
+func (it exchangeItem) Amounts() []Amount {
+	return []Amount{Amount(it)}
+}
+
+type ExchangeProcessor struct {
+	cp *CurrencyPool
+	Exchange
+	height    base.Height
+	sb        map[CurrencyID]AmountState // NOTE balance of sender to be sent
+	cb        map[CurrencyID]AmountState // NOTE balance of counterparty to be sent
+	sr        AmountState                // NOTE balance of sender to be received
+	cr        AmountState                // NOTE balance of counterparty to be received
+	srequired map[CurrencyID][2]Big
+	crequired map[CurrencyID][2]Big
+	sl        []spendingLimitState
+}
+
+func NewExchangeProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(Exchange); !ok {
+			return nil, xerrors.Errorf("not Exchange, %T", op)
+		} else {
+			return &ExchangeProcessor{
+				cp:       cp,
+				Exchange: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *ExchangeProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *ExchangeProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ExchangeFact)
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsAccountState(fact.counterparty, "counterparty", getState); err != nil {
+		return nil, err
+	}
+
+	// NOTE both sides send and receive
+	for _, cid := range []CurrencyID{fact.amount.Currency(), fact.counterAmount.Currency()} {
+		if err := checkTransferRestriction(opp.cp, fact.sender, cid, getState); err != nil {
+			return nil, err
+		} else if err := checkTransferRestriction(opp.cp, fact.counterparty, cid, getState); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkNotFrozen(fact.sender, fact.counterAmount.Currency(), true, getState); err != nil {
+		return nil, err
+	} else if err := checkNotFrozen(fact.counterparty, fact.amount.Currency(), true, getState); err != nil {
+		return nil, err
+	}
+
+	if required, err := CalculateItemsFee(opp.cp, ExchangeType, []AmountsItem{exchangeItem(fact.amount)}); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else if err := checkNotFrozenByRequired(fact.sender, nil, required, getState); err != nil {
+		return nil, err
+	} else if sb, err := CheckEnoughBalance(fact.sender, required, opp.height, getState); err != nil {
+		return nil, util.IgnoreError.Errorf("sender: %w", err)
+	} else {
+		opp.srequired = required
+		opp.sb = sb
+	}
+
+	if required, err := CalculateItemsFee(opp.cp, ExchangeType, []AmountsItem{exchangeItem(fact.counterAmount)}); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else if err := checkNotFrozenByRequired(fact.counterparty, nil, required, getState); err != nil {
+		return nil, err
+	} else if cb, err := CheckEnoughBalance(fact.counterparty, required, opp.height, getState); err != nil {
+		return nil, util.IgnoreError.Errorf("counterparty: %w", err)
+	} else {
+		opp.crequired = required
+		opp.cb = cb
+	}
+
+	if sl, err := checkSpendingLimit(fact.sender, requiredOutflow(opp.srequired), opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sl = sl
+	}
+
+	if sl, err := checkSpendingLimit(fact.counterparty, requiredOutflow(opp.crequired), opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sl = append(opp.sl, sl...)
+	}
+
+	if st, _, err := getState(StateKeyBalance(fact.sender, fact.counterAmount.Currency())); err != nil {
+		return nil, err
+	} else {
+		opp.sr = NewAmountState(st, fact.counterAmount.Currency())
+	}
+
+	if st, _, err := getState(StateKeyBalance(fact.counterparty, fact.amount.Currency())); err != nil {
+		return nil, err
+	} else {
+		opp.cr = NewAmountState(st, fact.amount.Currency())
+	}
+
+	if err := opp.checkSigns(getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *ExchangeProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(ExchangeFact)
+
+	sts := []state.State{
+		opp.sr.Add(fact.counterAmount.Big()),
+		opp.cr.Add(fact.amount.Big()),
+	}
+
+	sts = append(sts, debitRequired(opp.sb, nil, opp.srequired)...)
+	sts = append(sts, debitRequired(opp.cb, nil, opp.crequired)...)
+
+	if sls, err := setSpendingLimitStates(opp.sl); err != nil {
+		return err
+	} else {
+		sts = append(sts, sls...)
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// checkSigns checks the signs of sender and counterparty separately; the signs
+// of sender keys should pass the threshold of sender and the rest should pass
+// the threshold of counterparty.
+func (opp *ExchangeProcessor) checkSigns(getState func(key string) (state.State, bool, error)) error {
+	fact := opp.Fact().(ExchangeFact)
+
+	var keys Keys
+	if st, err := existsAccountState(fact.sender, "keys of sender", getState); err != nil {
+		return err
+	} else if ks, err := StateKeysValue(st); err != nil {
+		return util.IgnoreError.Wrap(err)
+	} else {
+		keys = ks
+	}
+
+	ss, cs := splitFactSigns(opp.Signs(), keys)
+
+	if err := checkFactSignsByState(fact.sender, ss, getState); err != nil {
+		return xerrors.Errorf("sender: %w", err)
+	}
+
+	if err := checkFactSignsByState(fact.counterparty, cs, getState); err != nil {
+		return xerrors.Errorf("counterparty: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,425 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testExchangeOperations struct {
+	baseTestOperationProcessor
+	cid  CurrencyID
+	ccid CurrencyID
+}
+
+func (t *testExchangeOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+	t.ccid = CurrencyID("FINDME")
+}
+
+func (t *testExchangeOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(Exchange{}, NewExchangeProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testExchangeOperations) currencyPool(sfee, cfee Big) (*CurrencyPool, []state.State) {
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), sfee))
+	cdst := t.newCurrencyDesignState(t.ccid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), cfee))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+	t.NoError(cp.Set(cdst))
+
+	return cp, []state.State{dst, cdst}
+}
+
+func (t *testExchangeOperations) newExchange(
+	sender, counterparty base.Address,
+	amount, counterAmount Amount,
+	keys []key.Privatekey,
+) Exchange {
+	token := util.UUID().Bytes()
+	fact := NewExchangeFact(token, sender, counterparty, amount, counterAmount)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewExchange(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testExchangeOperations) TestNew() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), t.ccid)})
+
+	cp, dsts := t.currencyPool(NewBig(1), NewBig(2))
+	pool, _ := t.statepool(st0, st1, dsts)
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+	t.NoError(opr.Process(op))
+
+	sts := map[string]state.State{}
+	for _, st := range pool.Updates() {
+		sts[st.Key()] = st.GetState()
+	}
+
+	t.Equal(4, len(sts))
+
+	for k, expected := range map[string][2]Big{
+		StateKeyBalance(sa.Address, t.cid):  {NewBig(100 - 10 - 1), NewBig(1)},
+		StateKeyBalance(sa.Address, t.ccid): {NewBig(20), ZeroBig},
+		StateKeyBalance(ca.Address, t.cid):  {NewBig(10), ZeroBig},
+		StateKeyBalance(ca.Address, t.ccid): {NewBig(50 - 20 - 2), NewBig(2)},
+	} {
+		am, err := StateBalanceValue(sts[k])
+		t.NoError(err)
+		t.Equal(expected[0].String(), am.Big().String(), k)
+		t.Equal(expected[1].String(), sts[k].(AmountState).Fee().String(), k)
+	}
+}
+
+func (t *testExchangeOperations) TestFrozen() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), t.ccid)})
+
+	cp, dsts := t.currencyPool(ZeroBig, ZeroBig)
+
+	for _, fz := range []Freeze{NewFreeze(sa.Address, t.cid, false), NewFreeze(ca.Address, t.ccid, false)} {
+		pool, _ := t.statepool(st0, st1, dsts, []state.State{t.newFreezeState(fz)})
+
+		opr := t.processor(cp, pool)
+
+		op := t.newExchange(sa.Address, ca.Address,
+			NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+			append(sa.Privs(), ca.Privs()...),
+		)
+
+		err := opr.Process(op)
+		t.True(xerrors.Is(err, util.IgnoreError))
+		t.Contains(err.Error(), "frozen")
+	}
+}
+
+func (t *testExchangeOperations) TestReceiverFrozen() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), t.ccid)})
+
+	cp, dsts := t.currencyPool(ZeroBig, ZeroBig)
+
+	// NOTE sender receives t.ccid and counterparty receives t.cid
+	for _, fz := range []Freeze{NewFreeze(sa.Address, t.ccid, true), NewFreeze(ca.Address, t.cid, true)} {
+		pool, _ := t.statepool(st0, st1, dsts, []state.State{t.newFreezeState(fz)})
+
+		opr := t.processor(cp, pool)
+
+		op := t.newExchange(sa.Address, ca.Address,
+			NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+			append(sa.Privs(), ca.Privs()...),
+		)
+
+		err := opr.Process(op)
+		t.True(xerrors.Is(err, util.IgnoreError))
+		t.Contains(err.Error(), "frozen for")
+	}
+}
+
+func (t *testExchangeOperations) TestCounterpartyInDenylist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), t.ccid)})
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetTransferRestriction(TransferRestrictionDenylist)
+	dst := t.newCurrencyDesignStateByPolicy(t.cid, NewBig(99), NewTestAddress(), po)
+	cdst := t.newCurrencyDesignState(t.ccid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+	t.NoError(cp.Set(cdst))
+
+	// NOTE counterparty can not receive the denylisted currency
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst, cdst,
+		t.newTransferListState(NewTransferListMember(ca.Address, t.cid, true)),
+	})
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "in denylist")
+}
+
+func (t *testExchangeOperations) TestSpendingLimit() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), t.ccid)})
+
+	ssl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(30), base.Height(10), base.Height(0)))
+	csl := NewSpendingLimit(ca.Address, t.ccid, NewSpendingLimitRule(NewBig(30), base.Height(10), base.Height(0)))
+
+	cp, dsts := t.currencyPool(NewBig(1), NewBig(2))
+	pool, _ := t.statepool(st0, st1, dsts, []state.State{t.newSpendingLimitState(ssl), t.newSpendingLimitState(csl)})
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+	t.NoError(opr.Process(op))
+
+	sts := map[string]state.State{}
+	for _, st := range pool.Updates() {
+		sts[st.Key()] = st.GetState()
+	}
+
+	for k, expected := range map[string]Big{
+		StateKeySpendingLimit(sa.Address, t.cid):  NewBig(10),
+		StateKeySpendingLimit(ca.Address, t.ccid): NewBig(20),
+	} {
+		usl, err := StateSpendingLimitValue(sts[k])
+		t.NoError(err)
+		t.Equal(expected.String(), usl.Spent().String(), k)
+	}
+}
+
+func (t *testExchangeOperations) TestSpendingLimitOfCounterpartyExceeded() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), t.ccid)})
+
+	csl := NewSpendingLimit(ca.Address, t.ccid, NewSpendingLimitRule(NewBig(10), base.Height(10), base.Height(0)))
+
+	cp, dsts := t.currencyPool(ZeroBig, ZeroBig)
+	pool, _ := t.statepool(st0, st1, dsts, []state.State{t.newSpendingLimitState(csl)})
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "spending limit exceeded")
+}
+
+func (t *testExchangeOperations) TestMultipleKeys() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, cprivs, st1 := t.newMultiKeysAccount([]uint{50, 50, 50}, 100, []Amount{NewAmount(NewBig(50), t.ccid)})
+
+	cp, dsts := t.currencyPool(ZeroBig, ZeroBig)
+	pool, _ := t.statepool(st0, st1, dsts)
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		append(sa.Privs(), cprivs[:2]...),
+	)
+	t.NoError(opr.Process(op))
+}
+
+func (t *testExchangeOperations) TestSignedOnlyBySender() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), t.ccid)})
+
+	cp, dsts := t.currencyPool(ZeroBig, ZeroBig)
+	pool, _ := t.statepool(st0, st1, dsts)
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		sa.Privs(),
+	)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "counterparty")
+	t.Contains(err.Error(), "not passed threshold")
+	t.Empty(pool.Updates())
+}
+
+func (t *testExchangeOperations) TestSignedOnlyByCounterparty() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), t.ccid)})
+
+	cp, dsts := t.currencyPool(ZeroBig, ZeroBig)
+	pool, _ := t.statepool(st0, st1, dsts)
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		ca.Privs(),
+	)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "sender")
+	t.Contains(err.Error(), "not passed threshold")
+}
+
+func (t *testExchangeOperations) TestUnknownSigner() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), t.ccid)})
+
+	cp, dsts := t.currencyPool(ZeroBig, ZeroBig)
+	pool, _ := t.statepool(st0, st1, dsts)
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		append(sa.Privs(), ca.Priv, key.MustNewBTCPrivatekey()),
+	)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "unknown key found")
+}
+
+func (t *testExchangeOperations) TestInsufficientBalanceOfCounterparty() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(21), t.ccid)})
+
+	cp, dsts := t.currencyPool(NewBig(1), NewBig(2))
+	pool, _ := t.statepool(st0, st1, dsts)
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "counterparty")
+	t.Contains(err.Error(), "insufficient balance")
+	t.Empty(pool.Updates())
+}
+
+func (t *testExchangeOperations) TestCounterpartyWithoutCurrency() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), t.cid)})
+
+	cp, dsts := t.currencyPool(ZeroBig, ZeroBig)
+	pool, _ := t.statepool(st0, st1, dsts)
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "counterparty")
+}
+
+func (t *testExchangeOperations) TestCounterpartyClosed() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newClosedAccount([]Amount{NewAmount(NewBig(50), t.ccid)})
+
+	cp, dsts := t.currencyPool(ZeroBig, ZeroBig)
+	pool, _ := t.statepool(st0, st1, dsts)
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testExchangeOperations) TestUnknownCurrency() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), CurrencyID("XXX"))})
+
+	cp, dsts := t.currencyPool(ZeroBig, ZeroBig)
+	pool, _ := t.statepool(st0, st1, dsts)
+
+	opr := t.processor(cp, pool)
+
+	op := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), CurrencyID("XXX")),
+		append(sa.Privs(), ca.Privs()...),
+	)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "unknown currency id found")
+}
+
+func (t *testExchangeOperations) TestCounterpartyDuplicated() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(50), t.ccid)})
+	na, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+
+	cp, dsts := t.currencyPool(ZeroBig, ZeroBig)
+	pool, _ := t.statepool(st0, st1, st2, dsts)
+
+	opr := t.processor(cp, pool)
+
+	op0 := t.newExchange(sa.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+	t.NoError(opr.Process(op0))
+
+	op1 := t.newExchange(na.Address, ca.Address,
+		NewAmount(NewBig(10), t.cid), NewAmount(NewBig(20), t.ccid),
+		append(na.Privs(), ca.Privs()...),
+	)
+
+	err := opr.Process(op1)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "violates only one sender")
+}
+
+func TestExchangeOperations(t *testing.T) {
+	suite.Run(t, new(testExchangeOperations))
+}
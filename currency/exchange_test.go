@@ -0,0 +1,137 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testExchange struct {
+	baseTest
+}
+
+func (t *testExchange) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewExchangeFact(token, NewTestAddress(), NewTestAddress(),
+		NewAmount(NewBig(10), CurrencyID("SHOWME")),
+		NewAmount(NewBig(20), CurrencyID("FINDME")),
+	)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewExchange(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testExchange) TestEmptyToken() {
+	fact := NewExchangeFact(nil, NewTestAddress(), NewTestAddress(),
+		NewAmount(NewBig(10), CurrencyID("SHOWME")),
+		NewAmount(NewBig(20), CurrencyID("FINDME")),
+	)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty token")
+}
+
+func (t *testExchange) TestSameWithSender() {
+	sender := NewTestAddress()
+	fact := NewExchangeFact(util.UUID().Bytes(), sender, sender,
+		NewAmount(NewBig(10), CurrencyID("SHOWME")),
+		NewAmount(NewBig(20), CurrencyID("FINDME")),
+	)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "counterparty is same with sender")
+}
+
+func (t *testExchange) TestSameCurrency() {
+	fact := NewExchangeFact(util.UUID().Bytes(), NewTestAddress(), NewTestAddress(),
+		NewAmount(NewBig(10), CurrencyID("SHOWME")),
+		NewAmount(NewBig(20), CurrencyID("SHOWME")),
+	)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "same currency can not be exchanged")
+}
+
+func (t *testExchange) TestZeroAmount() {
+	fact := NewExchangeFact(util.UUID().Bytes(), NewTestAddress(), NewTestAddress(),
+		NewAmount(NewBig(10), CurrencyID("SHOWME")),
+		NewAmount(ZeroBig, CurrencyID("FINDME")),
+	)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "counter amount should be over zero")
+}
+
+func TestExchange(t *testing.T) {
+	suite.Run(t, new(testExchange))
+}
+
+func testExchangeEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewExchangeFact(token, NewTestAddress(), NewTestAddress(),
+			NewAmount(NewBig(10), CurrencyID("SHOWME")),
+			NewAmount(NewBig(20), CurrencyID("FINDME")),
+		)
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewExchange(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Exchange)
+		tb := b.(Exchange)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(ExchangeFact)
+		ufact := tb.Fact().(ExchangeFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.counterparty.Equal(ufact.counterparty))
+		t.True(fact.amount.Equal(ufact.amount))
+		t.True(fact.counterAmount.Equal(ufact.counterAmount))
+	}
+
+	return t
+}
+
+func TestExchangeEncodeJSON(t *testing.T) {
+	suite.Run(t, testExchangeEncode(jsonenc.NewEncoder()))
+}
+
+func TestExchangeEncodeBSON(t *testing.T) {
+	suite.Run(t, testExchangeEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,24 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/util"
+)
+
+// The tags of the optional fields of fact. The optional field is framed by the
+// tag and the length, so the different optional fields of the same bytes do not
+// make the same fact hash.
+const (
+	factFieldFeePayer byte = iota + 1
+	factFieldSequence
+	factFieldValidUntilHeight
+)
+
+// optionalFieldBytes frames the bytes of the optional field; the empty field is
+// omitted, so the fact hash without optional fields is not changed.
+func optionalFieldBytes(tag byte, b []byte) []byte {
+	if len(b) < 1 {
+		return nil
+	}
+
+	return util.ConcatBytesSlice([]byte{tag}, util.Uint64ToBytes(uint64(len(b))), b)
+}
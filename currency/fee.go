@@ -1,6 +1,7 @@
 package currency
 
 import (
+	"sort"
 	"time"
 
 	"golang.org/x/xerrors"
@@ -19,26 +20,67 @@ var (
 	FeeOperationFactHint = hint.MustHint(FeeOperationFactType, "0.0.1")
 	FeeOperationType     = hint.MustNewType(0xa0, 0x13, "mitum-currency-fee-operation")
 	FeeOperationHint     = hint.MustHint(FeeOperationType, "0.0.1")
+	FeePayoutType        = hint.MustNewType(0xa0, 0x40, "mitum-currency-fee-payout")
+	FeePayoutHint        = hint.MustHint(FeePayoutType, "0.0.1")
 )
 
+// FeePayout is the fee amount, which is paid to the receiver.
+type FeePayout struct {
+	receiver base.Address
+	amount   Amount
+}
+
+func NewFeePayout(receiver base.Address, amount Amount) FeePayout {
+	return FeePayout{receiver: receiver, amount: amount}
+}
+
+func (fp FeePayout) Hint() hint.Hint {
+	return FeePayoutHint
+}
+
+func (fp FeePayout) Bytes() []byte {
+	return util.ConcatBytesSlice(fp.receiver.Bytes(), fp.amount.Bytes())
+}
+
+func (fp FeePayout) IsValid([]byte) error {
+	return isvalid.Check([]isvalid.IsValider{fp.receiver, fp.amount}, nil, false)
+}
+
+func (fp FeePayout) Receiver() base.Address {
+	return fp.receiver
+}
+
+func (fp FeePayout) Amount() Amount {
+	return fp.amount
+}
+
 type FeeOperationFact struct {
 	h       valuehash.Hash
 	token   []byte
 	amounts []Amount
+	payouts []FeePayout
 }
 
-func NewFeeOperationFact(height base.Height, ams map[CurrencyID]Big) FeeOperationFact {
-	amounts := make([]Amount, len(ams))
+func NewFeeOperationFact(height base.Height, ams map[CurrencyID]Big, payouts []FeePayout) FeeOperationFact {
+	cids := make([]string, len(ams))
 	var i int
 	for cid := range ams {
-		amounts[i] = NewAmount(ams[cid], cid)
+		cids[i] = cid.String()
 		i++
 	}
+	sort.Strings(cids)
+
+	amounts := make([]Amount, len(ams))
+	for i := range cids {
+		cid := CurrencyID(cids[i])
+		amounts[i] = NewAmount(ams[cid], cid)
+	}
 
 	// TODO replace random bytes with height
 	fact := FeeOperationFact{
 		token:   height.Bytes(), // for unique token
 		amounts: amounts,
+		payouts: payouts,
 	}
 	fact.h = valuehash.NewSHA256(fact.Bytes())
 
@@ -54,13 +96,17 @@ func (fact FeeOperationFact) Hash() valuehash.Hash {
 }
 
 func (fact FeeOperationFact) Bytes() []byte {
-	bs := make([][]byte, len(fact.amounts)+1)
+	bs := make([][]byte, len(fact.amounts)+len(fact.payouts)+1)
 	bs[0] = fact.token
 
 	for i := range fact.amounts {
 		bs[i+1] = fact.amounts[i].Bytes()
 	}
 
+	for i := range fact.payouts {
+		bs[len(fact.amounts)+i+1] = fact.payouts[i].Bytes()
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -73,10 +119,30 @@ func (fact FeeOperationFact) IsValid([]byte) error {
 		return err
 	}
 
+	ams := map[CurrencyID]Big{}
 	for i := range fact.amounts {
-		if err := fact.amounts[i].IsValid(nil); err != nil {
+		am := fact.amounts[i]
+		if err := am.IsValid(nil); err != nil {
+			return err
+		}
+
+		ams[am.Currency()] = am.Big()
+	}
+
+	for i := range fact.payouts {
+		fp := fact.payouts[i]
+		if err := fp.IsValid(nil); err != nil {
 			return err
 		}
+
+		cid := fp.Amount().Currency()
+		if b, found := ams[cid]; !found {
+			return xerrors.Errorf("unknown currency, %q of fee payout", cid)
+		} else if b = b.Sub(fp.Amount().Big()); !b.OverNil() {
+			return xerrors.Errorf("fee payouts over fee amount of currency, %q", cid)
+		} else {
+			ams[cid] = b
+		}
 	}
 
 	return nil
@@ -90,6 +156,26 @@ func (fact FeeOperationFact) Amounts() []Amount {
 	return fact.amounts
 }
 
+func (fact FeeOperationFact) Payouts() []FeePayout {
+	return fact.payouts
+}
+
+func (fact FeeOperationFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+	founds := map[string]struct{}{}
+	for i := range fact.payouts {
+		r := fact.payouts[i].Receiver()
+		if _, found := founds[r.String()]; found {
+			continue
+		}
+
+		founds[r.String()] = struct{}{}
+		as = append(as, r)
+	}
+
+	return as, nil
+}
+
 type FeeOperation struct {
 	fact FeeOperationFact
 	h    valuehash.Hash
@@ -177,30 +263,27 @@ func (opp *FeeOperationProcessor) Process(
 ) error {
 	fact := opp.Fact().(FeeOperationFact)
 
-	sts := make([]state.State, len(fact.amounts))
-	for i := range fact.amounts {
-		am := fact.amounts[i]
-		var feeer Feeer
-		if j, found := opp.cp.Feeer(am.Currency()); !found {
-			return xerrors.Errorf("unknown currency id, %q found for FeeOperation", am.Currency())
-		} else {
-			feeer = j
-		}
-
-		if feeer.Receiver() == nil {
-			continue
+	var sts []state.State
+	for i := range fact.payouts {
+		fp := fact.payouts[i]
+		if !opp.cp.Exists(fp.Amount().Currency()) {
+			return xerrors.Errorf("unknown currency id, %q found for FeeOperation", fp.Amount().Currency())
 		}
 
-		if err := checkExistsState(StateKeyAccount(feeer.Receiver()), getState); err != nil {
+		if _, err := existsAccountState(fp.Receiver(), "fee receiver", getState); err != nil {
 			return err
-		} else if st, _, err := getState(StateKeyBalance(feeer.Receiver(), am.Currency())); err != nil {
+		} else if st, _, err := getState(StateKeyBalance(fp.Receiver(), fp.Amount().Currency())); err != nil {
 			return err
 		} else {
-			rb := NewAmountState(st, am.Currency())
+			rb := NewAmountState(st, fp.Amount().Currency())
 
-			sts[i] = rb.Add(am.Big())
+			sts = append(sts, rb.Add(fp.Amount().Big()))
 		}
 	}
 
+	if len(sts) < 1 {
+		return nil
+	}
+
 	return setState(fact.Hash(), sts...)
 }
@@ -3,10 +3,35 @@ package currency
 import (
 	"go.mongodb.org/mongo-driver/bson"
 
+	"github.com/spikeekips/mitum/base"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
+func (fp FeePayout) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fp.Hint()),
+			bson.M{
+				"receiver": fp.receiver,
+				"amount":   fp.amount,
+			}),
+	)
+}
+
+type FeePayoutBSONUnpacker struct {
+	RC base.AddressDecoder `bson:"receiver"`
+	AM bson.Raw            `bson:"amount"`
+}
+
+func (fp *FeePayout) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufp FeePayoutBSONUnpacker
+	if err := enc.Unmarshal(b, &ufp); err != nil {
+		return err
+	}
+
+	return fp.unpack(enc, ufp.RC, ufp.AM)
+}
+
 func (fact FeeOperationFact) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
@@ -14,6 +39,7 @@ func (fact FeeOperationFact) MarshalBSON() ([]byte, error) {
 				"hash":    fact.h,
 				"token":   fact.token,
 				"amounts": fact.amounts,
+				"payouts": fact.payouts,
 			}))
 }
 
@@ -21,6 +47,7 @@ type FeeOperationFactBSONUnpacker struct {
 	H  valuehash.Bytes `bson:"hash"`
 	TK []byte          `bson:"token"`
 	AM []bson.Raw      `bson:"amounts"`
+	PO []bson.Raw      `bson:"payouts"`
 }
 
 func (fact *FeeOperationFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -34,7 +61,12 @@ func (fact *FeeOperationFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		bam[i] = uft.AM[i]
 	}
 
-	return fact.unpack(enc, uft.H, uft.TK, bam)
+	bpo := make([][]byte, len(uft.PO))
+	for i := range uft.PO {
+		bpo[i] = uft.PO[i]
+	}
+
+	return fact.unpack(enc, uft.H, uft.TK, bam, bpo)
 }
 
 func (op FeeOperation) MarshalBSON() ([]byte, error) {
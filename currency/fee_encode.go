@@ -8,11 +8,28 @@ import (
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
+func (fp *FeePayout) unpack(enc encoder.Encoder, bReceiver base.AddressDecoder, bam []byte) error {
+	if a, err := bReceiver.Encode(enc); err != nil {
+		return err
+	} else {
+		fp.receiver = a
+	}
+
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		fp.amount = am
+	}
+
+	return nil
+}
+
 func (fact *FeeOperationFact) unpack(
 	enc encoder.Encoder,
 	h valuehash.Hash,
 	token []byte,
 	bam [][]byte,
+	bpo [][]byte,
 ) error {
 	fact.h = h
 	fact.token = token
@@ -28,6 +45,22 @@ func (fact *FeeOperationFact) unpack(
 
 	fact.amounts = amounts
 
+	var payouts []FeePayout
+	if len(bpo) > 0 {
+		payouts = make([]FeePayout, len(bpo))
+		for i := range bpo {
+			if j, err := enc.DecodeByHint(bpo[i]); err != nil {
+				return err
+			} else if fp, ok := j.(FeePayout); !ok {
+				return xerrors.Errorf("not FeePayout, %T", j)
+			} else {
+				payouts[i] = fp
+			}
+		}
+	}
+
+	fact.payouts = payouts
+
 	return nil
 }
 
@@ -3,15 +3,45 @@ package currency
 import (
 	"encoding/json"
 
+	"github.com/spikeekips/mitum/base"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
+type FeePayoutJSONPacker struct {
+	jsonenc.HintedHead
+	RC base.Address `json:"receiver"`
+	AM Amount       `json:"amount"`
+}
+
+func (fp FeePayout) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(FeePayoutJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fp.Hint()),
+		RC:         fp.receiver,
+		AM:         fp.amount,
+	})
+}
+
+type FeePayoutJSONUnpacker struct {
+	RC base.AddressDecoder `json:"receiver"`
+	AM json.RawMessage     `json:"amount"`
+}
+
+func (fp *FeePayout) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufp FeePayoutJSONUnpacker
+	if err := enc.Unmarshal(b, &ufp); err != nil {
+		return err
+	}
+
+	return fp.unpack(enc, ufp.RC, ufp.AM)
+}
+
 type FeeOperationFactJSONPacker struct {
 	jsonenc.HintedHead
 	H  valuehash.Hash `json:"hash"`
 	TK []byte         `json:"token"`
 	AM []Amount       `json:"amounts"`
+	PO []FeePayout    `json:"payouts"`
 }
 
 func (fact FeeOperationFact) MarshalJSON() ([]byte, error) {
@@ -20,6 +50,7 @@ func (fact FeeOperationFact) MarshalJSON() ([]byte, error) {
 		H:          fact.h,
 		TK:         fact.token,
 		AM:         fact.amounts,
+		PO:         fact.payouts,
 	})
 }
 
@@ -27,6 +58,7 @@ type FeeOperationFactJSONUnpacker struct {
 	H  valuehash.Bytes   `json:"hash"`
 	TK []byte            `json:"token"`
 	AM []json.RawMessage `json:"amounts"`
+	PO []json.RawMessage `json:"payouts"`
 }
 
 func (fact *FeeOperationFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -40,7 +72,12 @@ func (fact *FeeOperationFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		bam[i] = uft.AM[i]
 	}
 
-	return fact.unpack(enc, uft.H, uft.TK, bam)
+	bpo := make([][]byte, len(uft.PO))
+	for i := range uft.PO {
+		bpo[i] = uft.PO[i]
+	}
+
+	return fact.unpack(enc, uft.H, uft.TK, bam, bpo)
 }
 
 type FeeOperationJSONPacker struct {
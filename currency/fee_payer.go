@@ -0,0 +1,101 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+)
+
+func feePayerBytes(feePayer base.Address) []byte {
+	if feePayer == nil {
+		return nil
+	}
+
+	return optionalFieldBytes(factFieldFeePayer, feePayer.Bytes())
+}
+
+func isValidFeePayer(sender, feePayer base.Address) error {
+	if feePayer == nil {
+		return nil
+	}
+
+	if err := feePayer.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid fee payer: %w", err)
+	}
+
+	if sender.Equal(feePayer) {
+		return xerrors.Errorf("fee payer is same with sender, %q", feePayer)
+	}
+
+	return nil
+}
+
+// CheckEnoughBalanceWithFeePayer works like CheckEnoughBalance, but if fee
+// payer is given, the fee of required is charged to the fee payer and the
+// rest to the holder. The returned fee payer balances are nil without fee
+// payer.
+func CheckEnoughBalanceWithFeePayer(
+	holder base.Address,
+	feePayer base.Address,
+	required map[CurrencyID][2]Big,
+	height base.Height,
+	getState func(key string) (state.State, bool, error),
+) (map[CurrencyID]AmountState, map[CurrencyID]AmountState, error) {
+	if feePayer == nil {
+		sb, err := CheckEnoughBalance(holder, required, height, getState)
+
+		return sb, nil, err
+	}
+
+	hr := map[CurrencyID][2]Big{}
+	pr := map[CurrencyID][2]Big{}
+	for cid := range required {
+		rq := required[cid]
+
+		hr[cid] = [2]Big{rq[0].Sub(rq[1]), ZeroBig}
+		if rq[1].OverZero() {
+			pr[cid] = [2]Big{rq[1], rq[1]}
+		}
+	}
+
+	var sb, pb map[CurrencyID]AmountState
+	if i, err := CheckEnoughBalance(holder, hr, height, getState); err != nil {
+		return nil, nil, err
+	} else {
+		sb = i
+	}
+
+	if i, err := CheckEnoughBalance(feePayer, pr, height, getState); err != nil {
+		return nil, nil, err
+	} else {
+		pb = i
+	}
+
+	return sb, pb, nil
+}
+
+// debitRequired returns the balance states, which the required amount and fee
+// are subtracted from. If fee payer balances, pb is not nil, the fee is
+// subtracted from the fee payer.
+func debitRequired(
+	sb, pb map[CurrencyID]AmountState,
+	required map[CurrencyID][2]Big,
+) []state.State {
+	var sts []state.State // nolint:prealloc
+	for cid := range required {
+		rq := required[cid]
+		if pb == nil {
+			sts = append(sts, sb[cid].Sub(rq[0]).AddFee(rq[1]))
+
+			continue
+		}
+
+		sts = append(sts, sb[cid].Sub(rq[0].Sub(rq[1])))
+		if rq[1].OverZero() {
+			sts = append(sts, pb[cid].Sub(rq[1]).AddFee(rq[1]))
+		}
+	}
+
+	return sts
+}
@@ -0,0 +1,86 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+var (
+	FeeShareType = hint.MustNewType(0xa0, 0x3f, "mitum-currency-fee-share")
+	FeeShareHint = hint.MustHint(FeeShareType, "0.0.1")
+)
+
+// FeeShare is the share of the collected fee by weight.
+type FeeShare struct {
+	receiver base.Address
+	weight   uint
+}
+
+func NewFeeShare(receiver base.Address, weight uint) FeeShare {
+	return FeeShare{receiver: receiver, weight: weight}
+}
+
+func (sh FeeShare) Hint() hint.Hint {
+	return FeeShareHint
+}
+
+func (sh FeeShare) Bytes() []byte {
+	return util.ConcatBytesSlice(sh.receiver.Bytes(), util.UintToBytes(sh.weight))
+}
+
+func (sh FeeShare) IsValid([]byte) error {
+	if err := sh.receiver.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid receiver for fee share: %w", err)
+	}
+
+	if sh.weight < 1 {
+		return xerrors.Errorf("fee share weight should be over zero")
+	}
+
+	return nil
+}
+
+func (sh FeeShare) Receiver() base.Address {
+	return sh.receiver
+}
+
+func (sh FeeShare) Weight() uint {
+	return sh.weight
+}
+
+// DistributeFee splits the fee by the weights of shares. The remainder of
+// division goes to the first share, so the sum of payouts is always same with
+// the fee. The share, which gets nothing, is not included.
+func DistributeFee(shares []FeeShare, fee Amount) []FeePayout {
+	if len(shares) < 1 {
+		return nil
+	}
+
+	var total int64
+	for i := range shares {
+		total += int64(shares[i].weight)
+	}
+
+	bs := make([]Big, len(shares))
+	left := fee.Big()
+	for i := range shares {
+		bs[i] = fee.Big().MulInt64(int64(shares[i].weight)).Div(NewBig(total))
+		left = left.Sub(bs[i])
+	}
+
+	bs[0] = bs[0].Add(left)
+
+	var payouts []FeePayout
+	for i := range shares {
+		if !bs[i].OverZero() {
+			continue
+		}
+
+		payouts = append(payouts, NewFeePayout(shares[i].receiver, NewAmount(bs[i], fee.Currency())))
+	}
+
+	return payouts
+}
@@ -0,0 +1,32 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (sh FeeShare) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(sh.Hint()),
+			bson.M{
+				"receiver": sh.receiver,
+				"weight":   sh.weight,
+			}),
+	)
+}
+
+type FeeShareBSONUnpacker struct {
+	RC base.AddressDecoder `bson:"receiver"`
+	WE uint                `bson:"weight"`
+}
+
+func (sh *FeeShare) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ush FeeShareBSONUnpacker
+	if err := enc.Unmarshal(b, &ush); err != nil {
+		return err
+	}
+
+	return sh.unpack(enc, ush.RC, ush.WE)
+}
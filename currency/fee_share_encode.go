@@ -0,0 +1,18 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (sh *FeeShare) unpack(enc encoder.Encoder, bReceiver base.AddressDecoder, weight uint) error {
+	if a, err := bReceiver.Encode(enc); err != nil {
+		return err
+	} else {
+		sh.receiver = a
+	}
+
+	sh.weight = weight
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type FeeShareJSONPacker struct {
+	jsonenc.HintedHead
+	RC base.Address `json:"receiver"`
+	WE uint         `json:"weight"`
+}
+
+func (sh FeeShare) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(FeeShareJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sh.Hint()),
+		RC:         sh.receiver,
+		WE:         sh.weight,
+	})
+}
+
+type FeeShareJSONUnpacker struct {
+	RC base.AddressDecoder `json:"receiver"`
+	WE uint                `json:"weight"`
+}
+
+func (sh *FeeShare) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ush FeeShareJSONUnpacker
+	if err := enc.Unmarshal(b, &ush); err != nil {
+		return err
+	}
+
+	return sh.unpack(enc, ush.RC, ush.WE)
+}
@@ -0,0 +1,255 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testFeeShare struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testFeeShare) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testFeeShare) TestDistribute() {
+	a := MustAddress(util.UUID().String())
+	b := MustAddress(util.UUID().String())
+	c := MustAddress(util.UUID().String())
+
+	cases := []struct {
+		name     string
+		shares   []FeeShare
+		fee      int64
+		expected []int64
+	}{
+		{
+			name:     "single",
+			shares:   []FeeShare{NewFeeShare(a, 1)},
+			fee:      33,
+			expected: []int64{33},
+		},
+		{
+			name:     "70:30",
+			shares:   []FeeShare{NewFeeShare(a, 70), NewFeeShare(b, 30)},
+			fee:      100,
+			expected: []int64{70, 30},
+		},
+		{
+			name:     "remainder to first",
+			shares:   []FeeShare{NewFeeShare(a, 1), NewFeeShare(b, 1), NewFeeShare(c, 1)},
+			fee:      10,
+			expected: []int64{4, 3, 3},
+		},
+		{
+			name:     "zero payout skipped",
+			shares:   []FeeShare{NewFeeShare(a, 1), NewFeeShare(b, 1), NewFeeShare(c, 1)},
+			fee:      2,
+			expected: []int64{2},
+		},
+	}
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(
+			c.name,
+			func() {
+				payouts := DistributeFee(c.shares, NewAmount(NewBig(c.fee), t.cid))
+				t.Equal(len(c.expected), len(payouts), "%d: %v", i, c.name)
+
+				for j := range payouts {
+					t.True(c.shares[j].Receiver().Equal(payouts[j].Receiver()), "%d: %v", i, c.name)
+					t.Equal(NewBig(c.expected[j]).String(), payouts[j].Amount().Big().String(), "%d: %v", i, c.name)
+					t.Equal(t.cid, payouts[j].Amount().Currency())
+				}
+			},
+		)
+	}
+}
+
+func (t *testFeeShare) TestInvalid() {
+	a := MustAddress(util.UUID().String())
+
+	err := NewFeeShare(a, 0).IsValid(nil)
+	t.Contains(err.Error(), "weight should be over zero")
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(a, NewBig(1))).
+		SetFeeShares([]FeeShare{NewFeeShare(a, 1), NewFeeShare(a, 2)})
+	err = po.IsValid(nil)
+	t.Contains(err.Error(), "duplicated receiver")
+}
+
+func (t *testFeeShare) TestPolicyPayouts() {
+	a := MustAddress(util.UUID().String())
+	b := MustAddress(util.UUID().String())
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer())
+	t.Empty(po.FeeReceivers())
+	t.Empty(po.FeePayouts(NewAmount(NewBig(10), t.cid)))
+
+	po = NewCurrencyPolicy(ZeroBig, NewFixedFeeer(a, NewBig(1)))
+	t.Equal(1, len(po.FeeReceivers()))
+	payouts := po.FeePayouts(NewAmount(NewBig(10), t.cid))
+	t.Equal(1, len(payouts))
+	t.True(a.Equal(payouts[0].Receiver()))
+	t.Equal(NewBig(10).String(), payouts[0].Amount().Big().String())
+
+	po = po.SetFeeShares([]FeeShare{NewFeeShare(b, 3), NewFeeShare(a, 7)})
+	t.NoError(po.IsValid(nil))
+	t.Equal(2, len(po.FeeReceivers()))
+	payouts = po.FeePayouts(NewAmount(NewBig(10), t.cid))
+	t.Equal(2, len(payouts))
+	t.True(b.Equal(payouts[0].Receiver()))
+	t.Equal(NewBig(3).String(), payouts[0].Amount().Big().String())
+	t.True(a.Equal(payouts[1].Receiver()))
+	t.Equal(NewBig(7).String(), payouts[1].Amount().Big().String())
+}
+
+func (t *testFeeShare) TestProcess() {
+	var sts []state.State
+
+	sa, s := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	sts = append(sts, s...)
+	ta, s := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	sts = append(sts, s...)
+	na, s := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	sts = append(sts, s...)
+	ra, s := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	sts = append(sts, s...)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(ta.Address, NewBig(10))).
+		SetFeeShares([]FeeShare{NewFeeShare(ta.Address, 70), NewFeeShare(na.Address, 30)})
+	dst := t.newCurrencyDesignStateByPolicy(t.cid, NewBig(100), NewTestAddress(), po)
+	sts = append(sts, dst)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	pool, _ := t.statepool(sts)
+
+	copr, err := NewOperationProcessor(cp).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+	opr := copr.New(pool)
+
+	fact := NewTransfersFact(
+		util.UUID().Bytes(),
+		sa.Address,
+		[]TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(20), t.cid))},
+	).SetSequence(1)
+
+	var fs []operation.FactSign
+	for _, pk := range sa.Privs() {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewTransfers(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	balances := map[string]Big{}
+	for _, u := range pool.Updates() {
+		if !IsStateBalanceKey(u.Key()) {
+			continue
+		}
+
+		am, err := StateBalanceValue(u.GetState())
+		t.NoError(err)
+
+		balances[u.Key()] = am.Big()
+	}
+
+	t.Equal(NewBig(70).String(), balances[StateKeyBalance(sa.Address, t.cid)].String())
+	t.Equal(NewBig(7).String(), balances[StateKeyBalance(ta.Address, t.cid)].String())
+	t.Equal(NewBig(3).String(), balances[StateKeyBalance(na.Address, t.cid)].String())
+	t.Equal(NewBig(20).String(), balances[StateKeyBalance(ra.Address, t.cid)].String())
+
+	var ffact FeeOperationFact
+	for _, o := range pool.AddedOperations() {
+		if i, ok := o.Fact().(FeeOperationFact); ok {
+			ffact = i
+		}
+	}
+
+	t.Equal(2, len(ffact.Payouts()))
+	t.NoError(ffact.IsValid(nil))
+
+	as, err := ffact.Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+	t.True(ta.Address.Equal(as[0]))
+	t.True(na.Address.Equal(as[1]))
+}
+
+func (t *testFeeShare) TestPayoutToClosedReceiver() {
+	var sts []state.State
+
+	ta, s := t.newAccount(true, nil)
+	sts = append(sts, s...)
+	na, s := t.newClosedAccount(nil)
+	sts = append(sts, s...)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(ta.Address, NewBig(10))).
+		SetFeeShares([]FeeShare{NewFeeShare(ta.Address, 70), NewFeeShare(na.Address, 30)})
+	dst := t.newCurrencyDesignStateByPolicy(t.cid, NewBig(100), NewTestAddress(), po)
+	sts = append(sts, dst)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	pool, _ := t.statepool(sts)
+
+	fee := NewAmount(NewBig(10), t.cid)
+	op := NewFeeOperation(NewFeeOperationFact(pool.Height(), map[CurrencyID]Big{t.cid: fee.Big()}, po.FeePayouts(fee)))
+
+	err := NewFeeOperationProcessor(cp, op).Process(pool.Get, pool.Set)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "closed")
+}
+
+func TestFeeShare(t *testing.T) {
+	suite.Run(t, new(testFeeShare))
+}
+
+func testFeeShareEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewFeeShare(MustAddress(util.UUID().String()), 70)
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(FeeShare)
+		cb := b.(FeeShare)
+
+		t.True(ca.Receiver().Equal(cb.Receiver()))
+		t.Equal(ca.Weight(), cb.Weight())
+	}
+
+	return t
+}
+
+func TestFeeShareEncodeJSON(t *testing.T) {
+	suite.Run(t, testFeeShareEncode(jsonenc.NewEncoder()))
+}
+
+func TestFeeShareEncodeBSON(t *testing.T) {
+	suite.Run(t, testFeeShareEncode(bsonenc.NewEncoder()))
+}
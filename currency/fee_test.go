@@ -7,6 +7,7 @@ import (
 
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/encoder"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
@@ -21,7 +22,7 @@ func (t *testFeeOperation) TestNew() {
 	fee := NewBig(33)
 
 	height := base.Height(3)
-	fact := NewFeeOperationFact(height, map[CurrencyID]Big{cid: fee})
+	fact := NewFeeOperationFact(height, map[CurrencyID]Big{cid: fee}, nil)
 
 	op := NewFeeOperation(fact)
 
@@ -35,6 +36,41 @@ func (t *testFeeOperation) TestNew() {
 	t.Equal(cid, nfact.Amounts()[0].Currency())
 }
 
+func (t *testFeeOperation) TestPayouts() {
+	cid := CurrencyID("SHOWME")
+	a := MustAddress(util.UUID().String())
+	b := MustAddress(util.UUID().String())
+
+	fact := NewFeeOperationFact(base.Height(3), map[CurrencyID]Big{cid: NewBig(10)}, []FeePayout{
+		NewFeePayout(a, NewAmount(NewBig(7), cid)),
+		NewFeePayout(b, NewAmount(NewBig(3), cid)),
+	})
+	t.NoError(fact.IsValid(nil))
+
+	as, err := fact.Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+	t.True(a.Equal(as[0]))
+	t.True(b.Equal(as[1]))
+}
+
+func (t *testFeeOperation) TestPayoutsOverAmount() {
+	cid := CurrencyID("SHOWME")
+	a := MustAddress(util.UUID().String())
+
+	fact := NewFeeOperationFact(base.Height(3), map[CurrencyID]Big{cid: NewBig(10)}, []FeePayout{
+		NewFeePayout(a, NewAmount(NewBig(11), cid)),
+	})
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "fee payouts over fee amount")
+
+	fact = NewFeeOperationFact(base.Height(3), map[CurrencyID]Big{cid: NewBig(10)}, []FeePayout{
+		NewFeePayout(a, NewAmount(NewBig(1), CurrencyID("FINDME"))),
+	})
+	err = fact.IsValid(nil)
+	t.Contains(err.Error(), "unknown currency")
+}
+
 func TestFeeOperation(t *testing.T) {
 	suite.Run(t, new(testFeeOperation))
 }
@@ -44,7 +80,11 @@ func testFeeOperationEncode(enc encoder.Encoder) suite.TestingSuite {
 
 	t.enc = enc
 	t.newObject = func() interface{} {
-		fact := NewFeeOperationFact(base.Height(3), map[CurrencyID]Big{CurrencyID("SHOWME"): NewBig(33)})
+		fact := NewFeeOperationFact(
+			base.Height(3),
+			map[CurrencyID]Big{CurrencyID("SHOWME"): NewBig(33)},
+			[]FeePayout{NewFeePayout(MustAddress(util.UUID().String()), NewAmount(NewBig(33), CurrencyID("SHOWME")))},
+		)
 
 		return NewFeeOperation(fact)
 	}
@@ -65,6 +105,13 @@ func testFeeOperationEncode(enc encoder.Encoder) suite.TestingSuite {
 
 			t.True(am.Equal(bm))
 		}
+
+		t.Equal(len(fact.Payouts()), len(ufact.Payouts()))
+
+		for i := range fact.Payouts() {
+			t.True(fact.Payouts()[i].Receiver().Equal(ufact.Payouts()[i].Receiver()))
+			t.True(fact.Payouts()[i].Amount().Equal(ufact.Payouts()[i].Amount()))
+		}
 	}
 
 	return t
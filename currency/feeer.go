@@ -13,18 +13,21 @@ import (
 )
 
 const (
-	FeeerNil   = "nil"
-	FeeerFixed = "fixed"
-	FeeerRatio = "ratio"
+	FeeerNil    = "nil"
+	FeeerFixed  = "fixed"
+	FeeerRatio  = "ratio"
+	FeeerTiered = "tiered"
 )
 
 var (
-	NilFeeerType   = hint.MustNewType(0xa0, 0x31, "mitum-currency-nil-feeer")
-	NilFeeerHint   = hint.MustHint(NilFeeerType, "0.0.1")
-	FixedFeeerType = hint.MustNewType(0xa0, 0x32, "mitum-currency-fixed-feeer")
-	FixedFeeerHint = hint.MustHint(FixedFeeerType, "0.0.1")
-	RatioFeeerType = hint.MustNewType(0xa0, 0x33, "mitum-currency-ratio-feeer")
-	RatioFeeerHint = hint.MustHint(RatioFeeerType, "0.0.1")
+	NilFeeerType    = hint.MustNewType(0xa0, 0x31, "mitum-currency-nil-feeer")
+	NilFeeerHint    = hint.MustHint(NilFeeerType, "0.0.1")
+	FixedFeeerType  = hint.MustNewType(0xa0, 0x32, "mitum-currency-fixed-feeer")
+	FixedFeeerHint  = hint.MustHint(FixedFeeerType, "0.0.1")
+	RatioFeeerType  = hint.MustNewType(0xa0, 0x33, "mitum-currency-ratio-feeer")
+	RatioFeeerHint  = hint.MustHint(RatioFeeerType, "0.0.1")
+	TieredFeeerType = hint.MustNewType(0xa0, 0x3e, "mitum-currency-tiered-feeer")
+	TieredFeeerHint = hint.MustHint(TieredFeeerType, "0.0.1")
 )
 
 var UnlimitedMaxFeeAmount = NewBig(-1)
@@ -210,6 +213,154 @@ func (fa RatioFeeer) IsValid([]byte) error {
 	return nil
 }
 
+// FeeTier is the fee bracket of TieredFeeer. The amounts up to max(inclusive)
+// are charged by fixed + amount * ratio. The max of the last tier should be
+// UnlimitedMaxFeeAmount.
+type FeeTier struct {
+	max   Big
+	fixed Big
+	ratio float64 // 0 >=, or <= 1.0
+}
+
+func NewFeeTier(max, fixed Big, ratio float64) FeeTier {
+	return FeeTier{max: max, fixed: fixed, ratio: ratio}
+}
+
+func (ft FeeTier) Bytes() []byte {
+	var rb bytes.Buffer
+	_ = binary.Write(&rb, binary.BigEndian, ft.ratio)
+
+	return util.ConcatBytesSlice(ft.max.Bytes(), ft.fixed.Bytes(), rb.Bytes())
+}
+
+func (ft FeeTier) Max() Big {
+	return ft.max
+}
+
+func (ft FeeTier) Fixed() Big {
+	return ft.fixed
+}
+
+func (ft FeeTier) Ratio() float64 {
+	return ft.ratio
+}
+
+func (ft FeeTier) IsUnlimited() bool {
+	return ft.max.Equal(UnlimitedMaxFeeAmount)
+}
+
+func (ft FeeTier) Fee(a Big) Big {
+	if ft.ratio == 0 || a.IsZero() {
+		return ft.fixed
+	}
+
+	return ft.fixed.Add(a.MulFloat64(ft.ratio))
+}
+
+func (ft FeeTier) IsValid([]byte) error {
+	if !ft.IsUnlimited() && !ft.max.OverNil() {
+		return xerrors.Errorf("fee tier max amount under zero")
+	}
+
+	if !ft.fixed.OverNil() {
+		return xerrors.Errorf("fee tier fixed amount under zero")
+	}
+
+	if ft.ratio < 0 || ft.ratio > 1 {
+		return xerrors.Errorf("invalid fee tier ratio, %v; it should be 0 >=, <= 1", ft.ratio)
+	}
+
+	return nil
+}
+
+type TieredFeeer struct {
+	receiver base.Address
+	tiers    []FeeTier
+}
+
+func NewTieredFeeer(receiver base.Address, tiers []FeeTier) TieredFeeer {
+	return TieredFeeer{receiver: receiver, tiers: tiers}
+}
+
+func (fa TieredFeeer) Type() string {
+	return FeeerTiered
+}
+
+func (fa TieredFeeer) Hint() hint.Hint {
+	return TieredFeeerHint
+}
+
+func (fa TieredFeeer) Bytes() []byte {
+	bs := make([][]byte, len(fa.tiers)+1)
+	bs[0] = fa.receiver.Bytes()
+	for i := range fa.tiers {
+		bs[i+1] = fa.tiers[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fa TieredFeeer) Receiver() base.Address {
+	return fa.receiver
+}
+
+func (fa TieredFeeer) Tiers() []FeeTier {
+	return fa.tiers
+}
+
+func (fa TieredFeeer) Min() Big {
+	if len(fa.tiers) < 1 {
+		return ZeroBig
+	}
+
+	return fa.tiers[0].fixed
+}
+
+func (fa TieredFeeer) Fee(a Big) (Big, error) {
+	for i := range fa.tiers {
+		t := fa.tiers[i]
+		if t.IsUnlimited() || a.Compare(t.max) <= 0 {
+			return t.Fee(a), nil
+		}
+	}
+
+	return ZeroBig, xerrors.Errorf("amount, %v not covered by fee tiers", a)
+}
+
+func (fa TieredFeeer) IsValid([]byte) error {
+	if err := fa.receiver.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid receiver for tiered feeer: %w", err)
+	}
+
+	if len(fa.tiers) < 1 {
+		return xerrors.Errorf("empty tiers for tiered feeer")
+	}
+
+	for i := range fa.tiers {
+		t := fa.tiers[i]
+		if err := t.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid tier, %d of tiered feeer: %w", i, err)
+		}
+
+		if i == len(fa.tiers)-1 {
+			if !t.IsUnlimited() {
+				return xerrors.Errorf("last tier of tiered feeer should be unlimited")
+			}
+
+			break
+		}
+
+		if t.IsUnlimited() {
+			return xerrors.Errorf("only last tier of tiered feeer can be unlimited")
+		} else if i > 0 && t.max.Compare(fa.tiers[i-1].max) <= 0 {
+			return xerrors.Errorf(
+				"tiers of tiered feeer should be ascending; tier %d, %v <= %v", i, t.max, fa.tiers[i-1].max)
+		}
+	}
+
+	return nil
+}
+
 func NewFeeToken(feeer Feeer, height base.Height) []byte {
 	return util.ConcatBytesSlice(feeer.Bytes(), height.Bytes())
 }
@@ -66,3 +66,52 @@ func (fa *RatioFeeer) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 
 	return fa.unpack(enc, ufa.RC, ufa.RA, ufa.MI, ufa.MA)
 }
+
+func (ft FeeTier) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"max":   ft.max,
+		"fixed": ft.fixed,
+		"ratio": ft.ratio,
+	})
+}
+
+type FeeTierBSONUnpacker struct {
+	MA Big     `bson:"max"`
+	FI Big     `bson:"fixed"`
+	RA float64 `bson:"ratio"`
+}
+
+func (ft *FeeTier) UnmarshalBSON(b []byte) error {
+	var uft FeeTierBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &uft); err != nil {
+		return err
+	}
+
+	*ft = NewFeeTier(uft.MA, uft.FI, uft.RA)
+
+	return nil
+}
+
+func (fa TieredFeeer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(fa.Hint()),
+		bson.M{
+			"receiver": fa.receiver,
+			"tiers":    fa.tiers,
+		}),
+	)
+}
+
+type TieredFeeerBSONUnpacker struct {
+	RC base.AddressDecoder `bson:"receiver"`
+	TI []FeeTier           `bson:"tiers"`
+}
+
+func (fa *TieredFeeer) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufa TieredFeeerBSONUnpacker
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return err
+	}
+
+	return fa.unpack(enc, ufa.RC, ufa.TI)
+}
@@ -30,3 +30,15 @@ func (fa *RatioFeeer) unpack(enc encoder.Encoder, brc base.AddressDecoder, ratio
 
 	return nil
 }
+
+func (fa *TieredFeeer) unpack(enc encoder.Encoder, brc base.AddressDecoder, tiers []FeeTier) error {
+	if i, err := brc.Encode(enc); err != nil {
+		return err
+	} else {
+		fa.receiver = i
+	}
+
+	fa.tiers = tiers
+
+	return nil
+}
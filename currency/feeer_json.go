@@ -84,3 +84,58 @@ func (fa *RatioFeeer) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 
 	return fa.unpack(enc, ufa.RC, ufa.RA, ufa.MI, ufa.MA)
 }
+
+type FeeTierJSONPacker struct {
+	MA Big     `json:"max"`
+	FI Big     `json:"fixed"`
+	RA float64 `json:"ratio"`
+}
+
+func (ft FeeTier) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(FeeTierJSONPacker{
+		MA: ft.max,
+		FI: ft.fixed,
+		RA: ft.ratio,
+	})
+}
+
+func (ft *FeeTier) UnmarshalJSON(b []byte) error {
+	var uft FeeTierJSONPacker
+	if err := jsonenc.Unmarshal(b, &uft); err != nil {
+		return err
+	}
+
+	*ft = NewFeeTier(uft.MA, uft.FI, uft.RA)
+
+	return nil
+}
+
+type TieredFeeerJSONPacker struct {
+	jsonenc.HintedHead
+	TY string       `json:"type"`
+	RC base.Address `json:"receiver"`
+	TI []FeeTier    `json:"tiers"`
+}
+
+func (fa TieredFeeer) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(TieredFeeerJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fa.Hint()),
+		TY:         fa.Type(),
+		RC:         fa.receiver,
+		TI:         fa.tiers,
+	})
+}
+
+type TieredFeeerJSONUnpacker struct {
+	RC base.AddressDecoder `json:"receiver"`
+	TI []FeeTier           `json:"tiers"`
+}
+
+func (fa *TieredFeeer) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufa TieredFeeerJSONUnpacker
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return err
+	}
+
+	return fa.unpack(enc, ufa.RC, ufa.TI)
+}
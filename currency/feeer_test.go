@@ -187,6 +187,120 @@ func (t *testFeeer) TestRatioFeeer() {
 	}
 }
 
+func (t *testFeeer) newTieredFeeer() TieredFeeer {
+	return NewTieredFeeer(
+		MustAddress(util.UUID().String()),
+		[]FeeTier{
+			NewFeeTier(NewBig(1000), NewBig(1), 0),
+			NewFeeTier(NewBig(100000), ZeroBig, 0.001),
+			NewFeeTier(UnlimitedMaxFeeAmount, NewBig(100), 0),
+		},
+	)
+}
+
+func (t *testFeeer) TestTieredFeeer() {
+	cases := []struct {
+		name   string
+		big    string
+		result string
+	}{
+		{name: "zero", big: "0", result: "1"},
+		{name: "first tier", big: "999", result: "1"},
+		{name: "first tier max", big: "1000", result: "1"},
+		{name: "second tier", big: "1001", result: "1"},
+		{name: "second tier ratio", big: "50000", result: "50"},
+		{name: "second tier max", big: "100000", result: "100"},
+		{name: "over tiers", big: "100001", result: "100"},
+		{name: "big", big: "9999999999", result: "100"},
+	}
+
+	fa := t.newTieredFeeer()
+	t.NoError(fa.IsValid(nil))
+	t.Equal(NewBig(1).String(), fa.Min().String())
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(
+			c.name,
+			func() {
+				big, err := NewBigFromString(c.big)
+				t.NoError(err)
+
+				result, err := fa.Fee(big)
+				t.NoError(err, "%d: %v", i, c.name)
+				t.Equal(c.result, result.String(), "%d: %v; %v != %v", i, c.name, c.result, result.String())
+			},
+		)
+	}
+}
+
+func (t *testFeeer) TestInvalidTieredFeeer() {
+	receiver := MustAddress(util.UUID().String())
+
+	cases := []struct {
+		name  string
+		tiers []FeeTier
+		err   string
+	}{
+		{
+			name: "empty",
+			err:  "empty tiers",
+		},
+		{
+			name: "last not unlimited",
+			tiers: []FeeTier{
+				NewFeeTier(NewBig(1000), NewBig(1), 0),
+			},
+			err: "should be unlimited",
+		},
+		{
+			name: "unlimited in middle",
+			tiers: []FeeTier{
+				NewFeeTier(UnlimitedMaxFeeAmount, NewBig(1), 0),
+				NewFeeTier(UnlimitedMaxFeeAmount, NewBig(1), 0),
+			},
+			err: "only last tier",
+		},
+		{
+			name: "not ascending",
+			tiers: []FeeTier{
+				NewFeeTier(NewBig(1000), NewBig(1), 0),
+				NewFeeTier(NewBig(1000), NewBig(2), 0),
+				NewFeeTier(UnlimitedMaxFeeAmount, NewBig(3), 0),
+			},
+			err: "should be ascending",
+		},
+		{
+			name: "invalid ratio",
+			tiers: []FeeTier{
+				NewFeeTier(UnlimitedMaxFeeAmount, NewBig(1), 1.1),
+			},
+			err: "invalid fee tier ratio",
+		},
+		{
+			name: "fixed under zero",
+			tiers: []FeeTier{
+				NewFeeTier(UnlimitedMaxFeeAmount, NewBig(-1), 0),
+			},
+			err: "fixed amount under zero",
+		},
+	}
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(
+			c.name,
+			func() {
+				err := NewTieredFeeer(receiver, c.tiers).IsValid(nil)
+				t.Error(err, "%d: %v", i, c.name)
+				t.Contains(err.Error(), c.err, "%d: %v; %v != %v", i, c.name, c.err, err)
+			},
+		)
+	}
+}
+
 func TestFeeer(t *testing.T) {
 	suite.Run(t, new(testFeeer))
 }
@@ -253,6 +367,33 @@ func testRatioFeeerEncode(enc encoder.Encoder) suite.TestingSuite {
 	return t
 }
 
+func testTieredFeeerEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewTieredFeeer(
+			MustAddress(util.UUID().String()),
+			[]FeeTier{
+				NewFeeTier(NewBig(1000), NewBig(1), 0),
+				NewFeeTier(NewBig(100000), ZeroBig, 0.001),
+				NewFeeTier(UnlimitedMaxFeeAmount, NewBig(100), 0),
+			},
+		)
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(TieredFeeer)
+		cb := b.(TieredFeeer)
+
+		t.True(ca.Receiver().Equal(cb.Receiver()))
+		t.Equal(len(ca.Tiers()), len(cb.Tiers()))
+		t.Equal(ca.Bytes(), cb.Bytes())
+	}
+
+	return t
+}
+
 func TestNilFeeerEncodeJSON(t *testing.T) {
 	suite.Run(t, testNilFeeerEncode(jsonenc.NewEncoder()))
 }
@@ -265,6 +406,10 @@ func TestRatioFeeerEncodeJSON(t *testing.T) {
 	suite.Run(t, testRatioFeeerEncode(jsonenc.NewEncoder()))
 }
 
+func TestTieredFeeerEncodeJSON(t *testing.T) {
+	suite.Run(t, testTieredFeeerEncode(jsonenc.NewEncoder()))
+}
+
 func TestNilFeeerEncodeBSON(t *testing.T) {
 	suite.Run(t, testNilFeeerEncode(bsonenc.NewEncoder()))
 }
@@ -276,3 +421,7 @@ func TestFixedFeeerEncodeBSON(t *testing.T) {
 func TestRatioFeeerEncodeBSON(t *testing.T) {
 	suite.Run(t, testRatioFeeerEncode(bsonenc.NewEncoder()))
 }
+
+func TestTieredFeeerEncodeBSON(t *testing.T) {
+	suite.Run(t, testTieredFeeerEncode(bsonenc.NewEncoder()))
+}
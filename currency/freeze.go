@@ -0,0 +1,149 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	FreezeType = hint.MustNewType(0xa0, 0x6b, "mitum-currency-freeze")
+	FreezeHint = hint.MustHint(FreezeType, "0.0.1")
+)
+
+// Freeze blocks the account to send amounts by FreezeAccount of suffrage. With
+// empty currency, every currency of account is frozen. If receiving is true,
+// the account can not receive amounts, either. UnfreezeAccount unfreezes it.
+type Freeze struct {
+	target    base.Address
+	currency  CurrencyID
+	receiving bool
+	frozen    bool
+}
+
+func NewFreeze(target base.Address, cid CurrencyID, receiving bool) Freeze {
+	return Freeze{target: target, currency: cid, receiving: receiving, frozen: true}
+}
+
+func (fz Freeze) Hint() hint.Hint {
+	return FreezeHint
+}
+
+func (fz Freeze) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fz.target.Bytes(),
+		fz.currency.Bytes(),
+		util.BoolToBytes(fz.receiving),
+		util.BoolToBytes(fz.frozen),
+	)
+}
+
+func (fz Freeze) Hash() valuehash.Hash {
+	return fz.GenerateHash()
+}
+
+func (fz Freeze) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fz.Bytes())
+}
+
+func (fz Freeze) IsValid([]byte) error {
+	if err := fz.target.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid target: %w", err)
+	}
+
+	if len(fz.currency) > 0 {
+		if err := fz.currency.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid freeze currency: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (fz Freeze) Target() base.Address {
+	return fz.target
+}
+
+// Currency returns the frozen currency. If empty, every currency is frozen.
+func (fz Freeze) Currency() CurrencyID {
+	return fz.currency
+}
+
+// Receiving returns true when the account can not receive amounts.
+func (fz Freeze) Receiving() bool {
+	return fz.receiving
+}
+
+func (fz Freeze) IsFrozen() bool {
+	return fz.frozen
+}
+
+func (fz Freeze) Unfreeze() Freeze {
+	fz.frozen = false
+
+	return fz
+}
+
+// checkNotFrozen checks the account is not frozen for the currency; both the
+// freeze of every currency and the freeze of the currency are checked. With
+// receiving, only the freeze, which blocks receiving is checked.
+func checkNotFrozen(
+	a base.Address,
+	cid CurrencyID,
+	receiving bool,
+	getState func(key string) (state.State, bool, error),
+) error {
+	for _, k := range []string{StateKeyFreeze(a, ""), StateKeyFreeze(a, cid)} {
+		var fz Freeze
+		switch st, found, err := getState(k); {
+		case err != nil:
+			return err
+		case !found:
+			continue
+		default:
+			if i, err := StateFreezeValue(st); err != nil {
+				return util.IgnoreError.Wrap(err)
+			} else {
+				fz = i
+			}
+		}
+
+		switch {
+		case !fz.IsFrozen(), receiving && !fz.Receiving():
+			continue
+		case len(fz.Currency()) < 1:
+			return util.IgnoreError.Errorf("account, %q frozen", a)
+		default:
+			return util.IgnoreError.Errorf("account, %q frozen for %q", a, cid)
+		}
+	}
+
+	return nil
+}
+
+// checkNotFrozenByRequired checks the sender and fee payer are not frozen for
+// the currencies of required amounts.
+func checkNotFrozenByRequired(
+	sender base.Address,
+	feePayer base.Address,
+	required map[CurrencyID][2]Big,
+	getState func(key string) (state.State, bool, error),
+) error {
+	for cid := range required {
+		if err := checkNotFrozen(sender, cid, false, getState); err != nil {
+			return err
+		}
+
+		if feePayer != nil && required[cid][1].OverZero() {
+			if err := checkNotFrozen(feePayer, cid, false, getState); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,295 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	FreezeAccountFactType   = hint.MustNewType(0xa0, 0x6c, "mitum-currency-freeze-account-operation-fact")
+	FreezeAccountFactHint   = hint.MustHint(FreezeAccountFactType, "0.0.1")
+	FreezeAccountType       = hint.MustNewType(0xa0, 0x6d, "mitum-currency-freeze-account-operation")
+	FreezeAccountHint       = hint.MustHint(FreezeAccountType, "0.0.1")
+	UnfreezeAccountFactType = hint.MustNewType(0xa0, 0x6e, "mitum-currency-unfreeze-account-operation-fact")
+	UnfreezeAccountFactHint = hint.MustHint(UnfreezeAccountFactType, "0.0.1")
+	UnfreezeAccountType     = hint.MustNewType(0xa0, 0x6f, "mitum-currency-unfreeze-account-operation")
+	UnfreezeAccountHint     = hint.MustHint(UnfreezeAccountType, "0.0.1")
+)
+
+// FreezeAccountFact freezes the target account. It should be signed by the
+// suffrage nodes like CurrencyRegister. With empty currency, every currency of
+// target is frozen.
+type FreezeAccountFact struct {
+	h         valuehash.Hash
+	token     []byte
+	target    base.Address
+	currency  CurrencyID
+	receiving bool
+}
+
+func NewFreezeAccountFact(token []byte, target base.Address, cid CurrencyID, receiving bool) FreezeAccountFact {
+	fact := FreezeAccountFact{
+		token:     token,
+		target:    target,
+		currency:  cid,
+		receiving: receiving,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact FreezeAccountFact) Hint() hint.Hint {
+	return FreezeAccountFactHint
+}
+
+func (fact FreezeAccountFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact FreezeAccountFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact FreezeAccountFact) Token() []byte {
+	return fact.token
+}
+
+func (fact FreezeAccountFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		fact.currency.Bytes(),
+		util.BoolToBytes(fact.receiving),
+	)
+}
+
+func (fact FreezeAccountFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for FreezeAccountFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{fact.h, fact.target}, nil, false); err != nil {
+		return err
+	}
+
+	if len(fact.currency) > 0 {
+		if err := fact.currency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact FreezeAccountFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact FreezeAccountFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact FreezeAccountFact) Receiving() bool {
+	return fact.receiving
+}
+
+func (fact FreezeAccountFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type FreezeAccount struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewFreezeAccount(fact FreezeAccountFact, fs []operation.FactSign, memo string) (FreezeAccount, error) {
+	if bo, err := operation.NewBaseOperationFromFact(FreezeAccountHint, fact, fs); err != nil {
+		return FreezeAccount{}, err
+	} else {
+		op := FreezeAccount{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op FreezeAccount) Hint() hint.Hint {
+	return FreezeAccountHint
+}
+
+func (op FreezeAccount) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op FreezeAccount) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op FreezeAccount) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
+
+// UnfreezeAccountFact unfreezes the target account, which is frozen by
+// FreezeAccount with the same currency.
+type UnfreezeAccountFact struct {
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	currency CurrencyID
+}
+
+func NewUnfreezeAccountFact(token []byte, target base.Address, cid CurrencyID) UnfreezeAccountFact {
+	fact := UnfreezeAccountFact{
+		token:    token,
+		target:   target,
+		currency: cid,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact UnfreezeAccountFact) Hint() hint.Hint {
+	return UnfreezeAccountFactHint
+}
+
+func (fact UnfreezeAccountFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact UnfreezeAccountFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UnfreezeAccountFact) Token() []byte {
+	return fact.token
+}
+
+func (fact UnfreezeAccountFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UnfreezeAccountFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for UnfreezeAccountFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{fact.h, fact.target}, nil, false); err != nil {
+		return err
+	}
+
+	if len(fact.currency) > 0 {
+		if err := fact.currency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact UnfreezeAccountFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact UnfreezeAccountFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact UnfreezeAccountFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type UnfreezeAccount struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewUnfreezeAccount(fact UnfreezeAccountFact, fs []operation.FactSign, memo string) (UnfreezeAccount, error) {
+	if bo, err := operation.NewBaseOperationFromFact(UnfreezeAccountHint, fact, fs); err != nil {
+		return UnfreezeAccount{}, err
+	} else {
+		op := UnfreezeAccount{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op UnfreezeAccount) Hint() hint.Hint {
+	return UnfreezeAccountHint
+}
+
+func (op UnfreezeAccount) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op UnfreezeAccount) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op UnfreezeAccount) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,49 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *FreezeAccountFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bTarget base.AddressDecoder,
+	cid string,
+	receiving bool,
+) error {
+	if a, err := bTarget.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.target = a
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.currency = CurrencyID(cid)
+	fact.receiving = receiving
+
+	return nil
+}
+
+func (fact *UnfreezeAccountFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bTarget base.AddressDecoder,
+	cid string,
+) error {
+	if a, err := bTarget.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.target = a
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.currency = CurrencyID(cid)
+
+	return nil
+}
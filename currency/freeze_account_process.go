@@ -0,0 +1,162 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op FreezeAccount) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type FreezeAccountProcessor struct {
+	FreezeAccount
+	cp        *CurrencyPool
+	pubs      []key.Publickey
+	threshold base.Threshold
+	fs        state.State
+}
+
+func NewFreezeAccountProcessor(cp *CurrencyPool, pubs []key.Publickey, threshold base.Threshold) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(FreezeAccount); !ok {
+			return nil, xerrors.Errorf("not FreezeAccount, %T", op)
+		} else {
+			return &FreezeAccountProcessor{
+				FreezeAccount: i,
+				cp:            cp,
+				pubs:          pubs,
+				threshold:     threshold,
+			}, nil
+		}
+	}
+}
+
+func (opp *FreezeAccountProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(FreezeAccountFact)
+
+	if len(opp.pubs) < 1 {
+		return nil, xerrors.Errorf("empty publickeys for operation signs")
+	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsAccountState(fact.target, "target", getState); err != nil {
+		return nil, err
+	}
+
+	if len(fact.currency) > 0 && opp.cp != nil && !opp.cp.Exists(fact.currency) {
+		return nil, util.IgnoreError.Errorf("currency not registered, %q", fact.currency)
+	}
+
+	switch st, found, err := getState(StateKeyFreeze(fact.target, fact.currency)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		opp.fs = st
+	default:
+		if fz, err := StateFreezeValue(st); err != nil {
+			return nil, util.IgnoreError.Wrap(err)
+		} else if fz.IsFrozen() && fz.Receiving() == fact.receiving {
+			return nil, util.IgnoreError.Errorf("already frozen, %q", fact.target)
+		}
+
+		opp.fs = st
+	}
+
+	return opp, nil
+}
+
+func (opp *FreezeAccountProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(FreezeAccountFact)
+
+	if st, err := SetStateFreezeValue(opp.fs, NewFreeze(fact.target, fact.currency, fact.receiving)); err != nil {
+		return util.IgnoreError.Wrap(err)
+	} else {
+		return setState(fact.Hash(), st)
+	}
+}
+
+func (op UnfreezeAccount) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type UnfreezeAccountProcessor struct {
+	UnfreezeAccount
+	pubs      []key.Publickey
+	threshold base.Threshold
+	fs        state.State
+	fz        Freeze
+}
+
+func NewUnfreezeAccountProcessor(pubs []key.Publickey, threshold base.Threshold) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(UnfreezeAccount); !ok {
+			return nil, xerrors.Errorf("not UnfreezeAccount, %T", op)
+		} else {
+			return &UnfreezeAccountProcessor{
+				UnfreezeAccount: i,
+				pubs:            pubs,
+				threshold:       threshold,
+			}, nil
+		}
+	}
+}
+
+func (opp *UnfreezeAccountProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(UnfreezeAccountFact)
+
+	if len(opp.pubs) < 1 {
+		return nil, xerrors.Errorf("empty publickeys for operation signs")
+	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+		return nil, err
+	}
+
+	if st, err := existsState(StateKeyFreeze(fact.target, fact.currency), "freeze", getState); err != nil {
+		return nil, err
+	} else if fz, err := StateFreezeValue(st); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else if !fz.IsFrozen() {
+		return nil, util.IgnoreError.Errorf("not frozen, %q", fact.target)
+	} else {
+		opp.fs = st
+		opp.fz = fz
+	}
+
+	return opp, nil
+}
+
+func (opp *UnfreezeAccountProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(UnfreezeAccountFact)
+
+	if st, err := SetStateFreezeValue(opp.fs, opp.fz.Unfreeze()); err != nil {
+		return util.IgnoreError.Wrap(err)
+	} else {
+		return setState(fact.Hash(), st)
+	}
+}
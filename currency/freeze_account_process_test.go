@@ -0,0 +1,229 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+)
+
+type testFreezeAccountOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testFreezeAccountOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testFreezeAccountOperations) newFreezeAccount(
+	keys []key.Privatekey,
+	target base.Address,
+	cid CurrencyID,
+	receiving bool,
+) FreezeAccount {
+	fact := NewFreezeAccountFact(util.UUID().Bytes(), target, cid, receiving)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewFreezeAccount(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testFreezeAccountOperations) newUnfreezeAccount(
+	keys []key.Privatekey,
+	target base.Address,
+	cid CurrencyID,
+) UnfreezeAccount {
+	fact := NewUnfreezeAccountFact(util.UUID().Bytes(), target, cid)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewUnfreezeAccount(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testFreezeAccountOperations) processor(n int) ([]key.Privatekey, *OperationProcessor) {
+	privs := make([]key.Privatekey, n)
+	for i := 0; i < n; i++ {
+		privs[i] = key.MustNewBTCPrivatekey()
+	}
+
+	pubs := make([]key.Publickey, len(privs))
+	for i := range privs {
+		pubs[i] = privs[i].Publickey()
+	}
+	threshold, err := base.NewThreshold(uint(len(privs)), 100)
+	t.NoError(err)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := NewOperationProcessor(cp)
+	_, err = opr.SetProcessor(FreezeAccount{}, NewFreezeAccountProcessor(cp, pubs, threshold))
+	t.NoError(err)
+	_, err = opr.SetProcessor(UnfreezeAccount{}, NewUnfreezeAccountProcessor(pubs, threshold))
+	t.NoError(err)
+
+	return privs, opr
+}
+
+func (t *testFreezeAccountOperations) TestNew() {
+	privs, copr := t.processor(3)
+
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	op := t.newFreezeAccount(privs, sa.Address, t.cid, true)
+	t.NoError(opr.Process(op))
+
+	var ns state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyFreeze(sa.Address, t.cid) {
+			ns = st.GetState()
+		}
+	}
+
+	t.NotNil(ns)
+
+	fz, err := StateFreezeValue(ns)
+	t.NoError(err)
+	t.True(fz.Target().Equal(sa.Address))
+	t.Equal(t.cid, fz.Currency())
+	t.True(fz.Receiving())
+	t.True(fz.IsFrozen())
+}
+
+func (t *testFreezeAccountOperations) TestNotEnoughSigns() {
+	privs, copr := t.processor(3)
+
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	op := t.newFreezeAccount(privs[:2], sa.Address, "", false)
+
+	err := opr.Process(op)
+	t.Contains(err.Error(), "not enough suffrage signs")
+}
+
+func (t *testFreezeAccountOperations) TestTargetNotExist() {
+	privs, copr := t.processor(3)
+
+	pool, _ := t.statepool()
+	opr := copr.New(pool)
+
+	op := t.newFreezeAccount(privs, NewTestAddress(), "", false)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "does not exist")
+}
+
+func (t *testFreezeAccountOperations) TestUnknownCurrency() {
+	privs, copr := t.processor(3)
+
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	op := t.newFreezeAccount(privs, sa.Address, CurrencyID("FINDME"), false)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "currency not registered")
+}
+
+func (t *testFreezeAccountOperations) TestAlreadyFrozen() {
+	privs, copr := t.processor(3)
+
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sts = append(sts, t.newFreezeState(NewFreeze(sa.Address, "", false)))
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	err := opr.Process(t.newFreezeAccount(privs, sa.Address, "", false))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "already frozen")
+
+	// NOTE receiving can be updated
+	t.NoError(opr.Process(t.newFreezeAccount(privs, sa.Address, "", true)))
+}
+
+func (t *testFreezeAccountOperations) TestUnfreeze() {
+	privs, copr := t.processor(3)
+
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sts = append(sts, t.newFreezeState(NewFreeze(sa.Address, "", false)))
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	t.NoError(opr.Process(t.newUnfreezeAccount(privs, sa.Address, "")))
+
+	var ns state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyFreeze(sa.Address, "") {
+			ns = st.GetState()
+		}
+	}
+
+	t.NotNil(ns)
+
+	fz, err := StateFreezeValue(ns)
+	t.NoError(err)
+	t.False(fz.IsFrozen())
+	t.NoError(checkNotFrozen(sa.Address, t.cid, true, pool.Get))
+}
+
+func (t *testFreezeAccountOperations) TestUnfreezeNotFrozen() {
+	privs, copr := t.processor(3)
+
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sts = append(sts, t.newFreezeState(NewFreeze(sa.Address, "", false).Unfreeze()))
+
+	pool, _ := t.statepool(sts)
+	opr := copr.New(pool)
+
+	err := opr.Process(t.newUnfreezeAccount(privs, sa.Address, ""))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not frozen")
+
+	err = opr.Process(t.newUnfreezeAccount(privs, sa.Address, t.cid))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "freeze does not exist")
+}
+
+func TestFreezeAccountOperations(t *testing.T) {
+	suite.Run(t, new(testFreezeAccountOperations))
+}
@@ -0,0 +1,178 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testFreezeAccount struct {
+	baseTest
+}
+
+func (t *testFreezeAccount) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewFreezeAccountFact(token, NewTestAddress(), CurrencyID("SHOWME"), true)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewFreezeAccount(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testFreezeAccount) TestWithoutCurrency() {
+	fact := NewFreezeAccountFact(util.UUID().Bytes(), NewTestAddress(), "", false)
+	t.NoError(fact.IsValid(nil))
+}
+
+func (t *testFreezeAccount) TestEmptyToken() {
+	fact := NewFreezeAccountFact(nil, NewTestAddress(), "", false)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty token")
+}
+
+func (t *testFreezeAccount) TestWrongCurrency() {
+	fact := NewFreezeAccountFact(util.UUID().Bytes(), NewTestAddress(), CurrencyID("s"), false)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "invalid length of currency id")
+}
+
+func (t *testFreezeAccount) TestUnfreezeNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewUnfreezeAccountFact(token, NewTestAddress(), "")
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewUnfreezeAccount(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+}
+
+func (t *testFreezeAccount) TestUnfreezeEmptyToken() {
+	fact := NewUnfreezeAccountFact(nil, NewTestAddress(), "")
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty token")
+}
+
+func TestFreezeAccount(t *testing.T) {
+	suite.Run(t, new(testFreezeAccount))
+}
+
+func testFreezeAccountEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewFreezeAccountFact(token, NewTestAddress(), CurrencyID("SHOWME"), true)
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewFreezeAccount(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(FreezeAccount)
+		tb := b.(FreezeAccount)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(FreezeAccountFact)
+		ufact := tb.Fact().(FreezeAccountFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.Equal(fact.currency, ufact.currency)
+		t.Equal(fact.receiving, ufact.receiving)
+	}
+
+	return t
+}
+
+func TestFreezeAccountEncodeJSON(t *testing.T) {
+	suite.Run(t, testFreezeAccountEncode(jsonenc.NewEncoder()))
+}
+
+func TestFreezeAccountEncodeBSON(t *testing.T) {
+	suite.Run(t, testFreezeAccountEncode(bsonenc.NewEncoder()))
+}
+
+func testUnfreezeAccountEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewUnfreezeAccountFact(token, NewTestAddress(), CurrencyID("SHOWME"))
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewUnfreezeAccount(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(UnfreezeAccount)
+		tb := b.(UnfreezeAccount)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(UnfreezeAccountFact)
+		ufact := tb.Fact().(UnfreezeAccountFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestUnfreezeAccountEncodeJSON(t *testing.T) {
+	suite.Run(t, testUnfreezeAccountEncode(jsonenc.NewEncoder()))
+}
+
+func TestUnfreezeAccountEncodeBSON(t *testing.T) {
+	suite.Run(t, testUnfreezeAccountEncode(bsonenc.NewEncoder()))
+}
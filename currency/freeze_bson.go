@@ -0,0 +1,36 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (fz Freeze) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(fz.Hint()),
+		bson.M{
+			"target":    fz.target,
+			"currency":  fz.currency,
+			"receiving": fz.receiving,
+			"frozen":    fz.frozen,
+		}),
+	)
+}
+
+type FreezeBSONUnpacker struct {
+	TG base.AddressDecoder `bson:"target"`
+	CR string              `bson:"currency"`
+	RC bool                `bson:"receiving"`
+	FZ bool                `bson:"frozen"`
+}
+
+func (fz *Freeze) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufz FreezeBSONUnpacker
+	if err := enc.Unmarshal(b, &ufz); err != nil {
+		return err
+	}
+
+	return fz.unpack(enc, ufz.TG, ufz.CR, ufz.RC, ufz.FZ)
+}
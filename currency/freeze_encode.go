@@ -0,0 +1,26 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (fz *Freeze) unpack(
+	enc encoder.Encoder,
+	bTarget base.AddressDecoder,
+	cid string,
+	receiving bool,
+	frozen bool,
+) error {
+	if a, err := bTarget.Encode(enc); err != nil {
+		return err
+	} else {
+		fz.target = a
+	}
+
+	fz.currency = CurrencyID(cid)
+	fz.receiving = receiving
+	fz.frozen = frozen
+
+	return nil
+}
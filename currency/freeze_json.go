@@ -0,0 +1,40 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type FreezeJSONPacker struct {
+	jsonenc.HintedHead
+	TG base.Address `json:"target"`
+	CR CurrencyID   `json:"currency,omitempty"`
+	RC bool         `json:"receiving"`
+	FZ bool         `json:"frozen"`
+}
+
+func (fz Freeze) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(FreezeJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fz.Hint()),
+		TG:         fz.target,
+		CR:         fz.currency,
+		RC:         fz.receiving,
+		FZ:         fz.frozen,
+	})
+}
+
+type FreezeJSONUnpacker struct {
+	TG base.AddressDecoder `json:"target"`
+	CR string              `json:"currency,omitempty"`
+	RC bool                `json:"receiving"`
+	FZ bool                `json:"frozen"`
+}
+
+func (fz *Freeze) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufz FreezeJSONUnpacker
+	if err := enc.Unmarshal(b, &ufz); err != nil {
+		return err
+	}
+
+	return fz.unpack(enc, ufz.TG, ufz.CR, ufz.RC, ufz.FZ)
+}
@@ -29,6 +29,7 @@ func (op GenesisCurrencies) Process(
 
 	gas := map[CurrencyID]state.State{}
 	sts := map[CurrencyID]state.State{}
+	sps := map[CurrencyID]state.State{}
 	for i := range fact.cs {
 		c := fact.cs[i]
 
@@ -38,6 +39,12 @@ func (op GenesisCurrencies) Process(
 			sts[c.Currency()] = st
 		}
 
+		if st, err := notExistsState(StateKeyCurrencySupply(c.Currency()), "currency supply", getState); err != nil {
+			return err
+		} else {
+			sps[c.Currency()] = st
+		}
+
 		if st, err := notExistsState(StateKeyBalance(newAddress, c.Currency()), "balance of genesis", getState); err != nil {
 			return err
 		} else {
@@ -56,13 +63,20 @@ func (op GenesisCurrencies) Process(
 
 	for i := range fact.cs {
 		c := fact.cs[i]
+		if c.GenesisAccount() == nil {
+			c = NewCurrencyDesign(c.Amount, newAddress, c.Policy())
+		}
+
 		am := NewAmount(c.Big(), c.Currency())
+		sp := NewCurrencySupply(c.Currency(), c.Big()).SetHolding(c.Big())
 		if gst, err := SetStateBalanceValue(gas[c.Currency()], am); err != nil {
 			return err
 		} else if dst, err := SetStateCurrencyDesignValue(sts[c.Currency()], c); err != nil {
 			return err
+		} else if sst, err := SetStateCurrencySupplyValue(sps[c.Currency()], sp); err != nil {
+			return err
 		} else {
-			states = append(states, gst, dst)
+			states = append(states, gst, dst, sst)
 		}
 	}
 
@@ -71,11 +71,12 @@ func (t *testGenesisCurrenciesOperation) TestNew() {
 
 	err = op.Process(sp.Get, sp.Set)
 	t.NoError(err)
-	t.Equal(5, len(sp.Updates()))
+	t.Equal(7, len(sp.Updates()))
 
 	var ns state.State
 	var nb []state.State
 	dts := map[CurrencyID]CurrencyDesign{}
+	sps := map[CurrencyID]CurrencySupply{}
 	for _, st := range sp.Updates() {
 		if key := st.Key(); key == StateKeyAccount(newAddress) {
 			ns = st.GetState()
@@ -85,6 +86,10 @@ func (t *testGenesisCurrenciesOperation) TestNew() {
 			i, err := StateCurrencyDesignValue(st.GetState())
 			t.NoError(err)
 			dts[i.Currency()] = i
+		} else if IsStateCurrencySupplyKey(key) {
+			i, err := StateCurrencySupplyValue(st.GetState())
+			t.NoError(err)
+			sps[i.Currency()] = i
 		}
 	}
 
@@ -113,6 +118,12 @@ func (t *testGenesisCurrenciesOperation) TestNew() {
 		t.True(found)
 
 		t.compareCurrencyDesign(a, b)
+		t.True(newAddress.Equal(b.GenesisAccount()))
+
+		c, found := sps[a.Currency()]
+		t.True(found)
+		t.True(a.Big().Equal(c.Total()))
+		t.True(c.Circulating().IsZero())
 	}
 }
 
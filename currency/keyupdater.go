@@ -13,21 +13,26 @@ import (
 
 var (
 	KeyUpdaterFactType = hint.MustNewType(0xa0, 0x09, "mitum-currency-keyupdater-operation-fact")
-	KeyUpdaterFactHint = hint.MustHint(KeyUpdaterFactType, "0.0.1")
+	KeyUpdaterFactHint = hint.MustHint(KeyUpdaterFactType, "0.0.2")
 	KeyUpdaterType     = hint.MustNewType(0xa0, 0x10, "mitum-currency-keyupdater-operation")
 	KeyUpdaterHint     = hint.MustHint(KeyUpdaterType, "0.0.1")
 )
 
 type KeyUpdaterFact struct {
-	h        valuehash.Hash
-	token    []byte
-	target   base.Address
-	keys     Keys
-	currency CurrencyID
+	hint             hint.Hint
+	h                valuehash.Hash
+	token            []byte
+	target           base.Address
+	keys             Keys
+	currency         CurrencyID
+	feePayer         base.Address
+	sequence         uint64
+	validUntilHeight base.Height
 }
 
 func NewKeyUpdaterFact(token []byte, target base.Address, keys Keys, currency CurrencyID) KeyUpdaterFact {
 	fact := KeyUpdaterFact{
+		hint:     KeyUpdaterFactHint,
 		token:    token,
 		target:   target,
 		keys:     keys,
@@ -38,8 +43,14 @@ func NewKeyUpdaterFact(token []byte, target base.Address, keys Keys, currency Cu
 	return fact
 }
 
+// Hint returns the hint of fact; the decoded fact of the previous version keeps
+// its hint.
 func (fact KeyUpdaterFact) Hint() hint.Hint {
-	return KeyUpdaterFactHint
+	if len(fact.hint.Version()) < 1 {
+		return KeyUpdaterFactHint
+	}
+
+	return fact.hint
 }
 
 func (fact KeyUpdaterFact) Hash() valuehash.Hash {
@@ -56,6 +67,9 @@ func (fact KeyUpdaterFact) Bytes() []byte {
 		fact.target.Bytes(),
 		fact.keys.Bytes(),
 		fact.currency.Bytes(),
+		feePayerBytes(fact.feePayer),
+		sequenceBytes(fact.sequence),
+		validUntilBytes(fact.validUntilHeight),
 	)
 }
 
@@ -73,6 +87,18 @@ func (fact KeyUpdaterFact) IsValid([]byte) error {
 		return err
 	}
 
+	if err := isValidFeePayer(fact.target, fact.feePayer); err != nil {
+		return err
+	}
+
+	if err := isValidSequence(fact.Hint(), fact.sequence); err != nil {
+		return err
+	}
+
+	if err := isValidValidUntil(fact.validUntilHeight); err != nil {
+		return err
+	}
+
 	if !fact.h.Equal(fact.GenerateHash()) {
 		return isvalid.InvalidError.Errorf("wrong Fact hash")
 	}
@@ -96,7 +122,53 @@ func (fact KeyUpdaterFact) Currency() CurrencyID {
 	return fact.currency
 }
 
+// FeePayer returns the account which pays the fee instead of target. If nil,
+// target pays the fee.
+func (fact KeyUpdaterFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+// SetFeePayer sets the fee payer and regenerates the fact hash.
+func (fact KeyUpdaterFact) SetFeePayer(feePayer base.Address) KeyUpdaterFact {
+	fact.feePayer = feePayer
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+// Sequence returns the sequence of target. If 0, the sequence of target is not
+// checked and not increased.
+func (fact KeyUpdaterFact) Sequence() uint64 {
+	return fact.sequence
+}
+
+// SetSequence sets the sequence and regenerates the fact hash.
+func (fact KeyUpdaterFact) SetSequence(s uint64) KeyUpdaterFact {
+	fact.sequence = s
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+// ValidUntilHeight returns the last height, at which the fact can be processed.
+// If 0, the fact does not expire by height.
+func (fact KeyUpdaterFact) ValidUntilHeight() base.Height {
+	return fact.validUntilHeight
+}
+
+// SetValidUntil sets the valid until height and regenerates the fact hash.
+func (fact KeyUpdaterFact) SetValidUntil(height base.Height) KeyUpdaterFact {
+	fact.validUntilHeight = height
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
 func (fact KeyUpdaterFact) Addresses() ([]base.Address, error) {
+	if fact.feePayer != nil {
+		return []base.Address{fact.target, fact.feePayer}, nil
+	}
+
 	return []base.Address{fact.target}, nil
 }
 
@@ -10,15 +10,27 @@ import (
 )
 
 func (fact KeyUpdaterFact) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
-			bson.M{
-				"hash":     fact.h,
-				"token":    fact.token,
-				"target":   fact.target,
-				"keys":     fact.keys,
-				"currency": fact.currency,
-			}))
+	m := bson.M{
+		"hash":     fact.h,
+		"token":    fact.token,
+		"target":   fact.target,
+		"keys":     fact.keys,
+		"currency": fact.currency,
+	}
+
+	if fact.feePayer != nil {
+		m["fee_payer"] = fact.feePayer
+	}
+
+	if fact.sequence > 0 {
+		m["sequence"] = fact.sequence
+	}
+
+	if fact.validUntilHeight > 0 {
+		m["valid_until_height"] = fact.validUntilHeight
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
 }
 
 type KeyUpdaterFactBSONUnpacker struct {
@@ -27,15 +39,23 @@ type KeyUpdaterFactBSONUnpacker struct {
 	TG base.AddressDecoder `bson:"target"`
 	KS bson.Raw            `bson:"keys"`
 	CR string              `bson:"currency"`
+	FP base.AddressDecoder `bson:"fee_payer,omitempty"`
+	SQ uint64              `bson:"sequence,omitempty"`
+	VH base.Height         `bson:"valid_until_height,omitempty"`
 }
 
 func (fact *KeyUpdaterFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var ufact KeyUpdaterFactBSONUnpacker
 	if err := bson.Unmarshal(b, &ufact); err != nil {
 		return err
 	}
 
-	return fact.unpack(enc, ufact.H, ufact.TK, ufact.TG, ufact.KS, ufact.CR)
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.TG, ufact.KS, ufact.CR, ufact.FP, ufact.SQ, ufact.VH)
 }
 
 func (op KeyUpdater) MarshalBSON() ([]byte, error) {
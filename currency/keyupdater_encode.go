@@ -5,16 +5,21 @@ import (
 
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 func (fact *KeyUpdaterFact) unpack(
 	enc encoder.Encoder,
+	ht hint.Hint,
 	h valuehash.Hash,
 	token []byte,
 	btarget base.AddressDecoder,
 	bks []byte,
 	cr string,
+	bFeePayer base.AddressDecoder,
+	sequence uint64,
+	validUntilHeight base.Height,
 ) error {
 	var target base.Address
 	if a, err := btarget.Encode(enc); err != nil {
@@ -32,6 +37,16 @@ func (fact *KeyUpdaterFact) unpack(
 		keys = k
 	}
 
+	if a, err := bFeePayer.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.feePayer = a
+	}
+
+	fact.sequence = sequence
+	fact.validUntilHeight = validUntilHeight
+
+	fact.hint = ht
 	fact.h = h
 	fact.token = token
 	fact.target = target
@@ -16,6 +16,9 @@ type KeyUpdaterFactJSONPacker struct {
 	TG base.Address   `json:"target"`
 	KS Keys           `json:"keys"`
 	CR CurrencyID     `json:"currency"`
+	FP base.Address   `json:"fee_payer,omitempty"`
+	SQ uint64         `json:"sequence,omitempty"`
+	VH base.Height    `json:"valid_until_height,omitempty"`
 }
 
 func (fact KeyUpdaterFact) MarshalJSON() ([]byte, error) {
@@ -26,6 +29,9 @@ func (fact KeyUpdaterFact) MarshalJSON() ([]byte, error) {
 		TG:         fact.target,
 		KS:         fact.keys,
 		CR:         fact.currency,
+		FP:         fact.feePayer,
+		SQ:         fact.sequence,
+		VH:         fact.validUntilHeight,
 	})
 }
 
@@ -35,15 +41,23 @@ type KeyUpdaterFactJSONUnpacker struct {
 	TG base.AddressDecoder `json:"target"`
 	KS json.RawMessage     `json:"keys"`
 	CR string              `json:"currency"`
+	FP base.AddressDecoder `json:"fee_payer,omitempty"`
+	SQ uint64              `json:"sequence,omitempty"`
+	VH base.Height         `json:"valid_until_height,omitempty"`
 }
 
 func (fact *KeyUpdaterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var ufact KeyUpdaterFactJSONUnpacker
 	if err := enc.Unmarshal(b, &ufact); err != nil {
 		return err
 	}
 
-	return fact.unpack(enc, ufact.H, ufact.TK, ufact.TG, ufact.KS, ufact.CR)
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.TG, ufact.KS, ufact.CR, ufact.FP, ufact.SQ, ufact.VH)
 }
 
 func (op KeyUpdater) MarshalJSON() ([]byte, error) {
@@ -1,6 +1,7 @@
 package currency
 
 import (
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/valuehash"
@@ -17,9 +18,12 @@ func (op KeyUpdater) Process(
 type KeyUpdaterProcessor struct {
 	cp *CurrencyPool
 	KeyUpdater
-	sa  state.State
-	sb  AmountState
-	fee Big
+	proposable
+	height base.Height
+	sa     state.State
+	sb     AmountState
+	fee    Big
+	sq     state.State
 }
 
 func NewKeyUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
@@ -35,13 +39,21 @@ func NewKeyUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
 	}
 }
 
+func (op *KeyUpdaterProcessor) setHeight(height base.Height) {
+	op.height = height
+}
+
 func (op *KeyUpdaterProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	_ func(valuehash.Hash, ...state.State) error,
 ) (state.Processor, error) {
 	fact := op.Fact().(KeyUpdaterFact)
 
-	if st, err := existsState(StateKeyAccount(fact.target), "target keys", getState); err != nil {
+	if err := checkValidUntil(fact, op.height); err != nil {
+		return nil, err
+	}
+
+	if st, err := existsAccountState(fact.target, "target keys", getState); err != nil {
 		return nil, err
 	} else {
 		op.sa = st
@@ -53,18 +65,37 @@ func (op *KeyUpdaterProcessor) PreProcess(
 		return nil, util.IgnoreError.Errorf("same Keys with the existing")
 	}
 
-	if st, err := existsState(StateKeyBalance(fact.target, fact.currency), "balance of target", getState); err != nil {
+	if err := checkNotFrozen(fact.target, fact.currency, false, getState); err != nil {
+		return nil, err
+	}
+
+	if st, err := checkSequence(fact.Hint(), fact.target, fact.sequence, getState); err != nil {
+		return nil, err
+	} else {
+		op.sq = st
+	}
+
+	payer, payerName := fact.target, "balance of target"
+	if fact.feePayer != nil {
+		payer, payerName = fact.feePayer, "balance of fee payer"
+
+		if err := checkNotFrozen(payer, fact.currency, false, getState); err != nil {
+			return nil, err
+		}
+	}
+
+	if st, err := existsState(StateKeyBalance(payer, fact.currency), payerName, getState); err != nil {
 		return nil, err
 	} else {
 		op.sb = NewAmountState(st, fact.currency)
 	}
 
-	if err := checkFactSignsByState(fact.target, op.Signs(), getState); err != nil {
+	if err := op.checkFactSigns(fact.target, fact.feePayer, op.Signs(), getState); err != nil {
 		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
 	}
 
 	var feeer Feeer
-	if i, found := op.cp.Feeer(fact.currency); !found {
+	if i, found := op.cp.OperationFeeer(fact.currency, KeyUpdaterType); !found {
 		return nil, util.IgnoreError.Errorf("currency, %q not found of KeyUpdater", fact.currency)
 	} else {
 		feeer = i
@@ -72,12 +103,16 @@ func (op *KeyUpdaterProcessor) PreProcess(
 
 	if fee, err := feeer.Fee(ZeroBig); err != nil {
 		return nil, util.IgnoreError.Wrap(err)
+	} else if locked, err := lockedByVesting(payer, fact.currency, op.height, getState); err != nil {
+		return nil, err
 	} else {
 		switch b, err := StateBalanceValue(op.sb); {
 		case err != nil:
 			return nil, util.IgnoreError.Wrap(err)
 		case b.Big().Compare(fee) < 0:
 			return nil, util.IgnoreError.Errorf("insufficient balance with fee")
+		case b.Big().Sub(locked).Compare(fee) < 0:
+			return nil, util.IgnoreError.Errorf("insufficient vested balance with fee; locked=%v", locked)
 		default:
 			op.fee = fee
 		}
@@ -93,9 +128,17 @@ func (op *KeyUpdaterProcessor) Process(
 	fact := op.Fact().(KeyUpdaterFact)
 
 	op.sb = op.sb.Sub(op.fee).AddFee(op.fee)
+
+	var sts []state.State
 	if st, err := SetStateKeysValue(op.sa, fact.keys); err != nil {
 		return err
 	} else {
-		return setState(fact.Hash(), st, op.sb)
+		sts = []state.State{st, op.sb}
 	}
+
+	if op.sq != nil {
+		sts = append(sts, op.sq)
+	}
+
+	return setState(fact.Hash(), sts...)
 }
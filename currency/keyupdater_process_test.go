@@ -13,6 +13,7 @@ import (
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/storage"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
 )
 
 type testKeyUpdaterOperation struct {
@@ -32,8 +33,17 @@ func (t *testKeyUpdaterOperation) processor(cp *CurrencyPool, pool *storage.Stat
 }
 
 func (t *testKeyUpdaterOperation) newOperation(target base.Address, keys Keys, pks []key.Privatekey, cid CurrencyID) KeyUpdater {
+	return t.newOperationWithFeePayer(target, nil, keys, pks, cid)
+}
+
+func (t *testKeyUpdaterOperation) newOperationWithFeePayer(
+	target, feePayer base.Address,
+	keys Keys,
+	pks []key.Privatekey,
+	cid CurrencyID,
+) KeyUpdater {
 	token := util.UUID().Bytes()
-	fact := NewKeyUpdaterFact(token, target, keys, cid)
+	fact := NewKeyUpdaterFact(token, target, keys, cid).SetSequence(1).SetFeePayer(feePayer)
 
 	var fs []operation.FactSign
 	for _, pk := range pks {
@@ -104,6 +114,90 @@ func (t *testKeyUpdaterOperation) TestNew() {
 	t.NoError(opr.Close())
 }
 
+func (t *testKeyUpdaterOperation) TestOperationFeeer() {
+	am := NewAmount(NewBig(10), t.cid)
+	sa, st := t.newAccount(true, []Amount{am})
+
+	pool, _ := t.statepool(st)
+
+	fee := NewBig(3)
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(1))).
+		SetOperationFeeers(map[hint.Type]Feeer{KeyUpdaterType: NewFixedFeeer(sa.Address, fee)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateByPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.MustNewBTCPrivatekey()
+	nkey, err := NewKey(npk.Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewKeys([]Key{nkey}, 100)
+	t.NoError(err)
+
+	op := t.newOperation(sa.Address, nkeys, sa.Privs(), t.cid)
+
+	t.NoError(opr.Process(op))
+
+	var nb Amount
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(sa.Address, am.Currency()) {
+			i, err := StateBalanceValue(st.GetState())
+			t.NoError(err)
+			nb = i
+		}
+	}
+
+	t.True(am.Big().Sub(fee).Equal(nb.Big()))
+}
+
+func (t *testKeyUpdaterOperation) TestFeePayer() {
+	am := NewAmount(NewBig(3), t.cid)
+	sa, st0 := t.newAccount(true, []Amount{am})
+	pa, st1 := t.newAccount(true, []Amount{am})
+	ra, st2 := t.newAccount(true, []Amount{am})
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	fee := NewBig(1)
+	feeer := NewFixedFeeer(ra.Address, fee)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.MustNewBTCPrivatekey()
+	nkey, err := NewKey(npk.Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewKeys([]Key{nkey}, 100)
+	t.NoError(err)
+
+	op := t.newOperationWithFeePayer(sa.Address, pa.Address, nkeys, append(sa.Privs(), pa.Privs()...), t.cid)
+
+	t.NoError(opr.Process(op))
+
+	var sb, pb *Amount
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			i, err := StateBalanceValue(st.GetState())
+			t.NoError(err)
+			sb = &i
+		case StateKeyBalance(pa.Address, t.cid):
+			i, err := StateBalanceValue(st.GetState())
+			t.NoError(err)
+			pb = &i
+		}
+	}
+
+	t.Nil(sb)
+	t.NotNil(pb)
+	t.True(am.Big().Sub(fee).Equal(pb.Big()))
+
+	t.NoError(opr.Close())
+}
+
 func (t *testKeyUpdaterOperation) TestUnknownCurrency() {
 	am := NewAmount(NewBig(3), CurrencyID("FINDME"))
 	sa, st := t.newAccount(true, []Amount{am})
@@ -154,6 +248,31 @@ func (t *testKeyUpdaterOperation) TestEmptyBalance() {
 	t.Contains(err.Error(), "insufficient balance")
 }
 
+func (t *testKeyUpdaterOperation) TestTargetFrozen() {
+	am := NewAmount(NewBig(3), t.cid)
+	sa, st := t.newAccount(true, []Amount{am})
+
+	pool, _ := t.statepool(st, []state.State{t.newFreezeState(NewFreeze(sa.Address, "", false))})
+	feeer := NewFixedFeeer(sa.Address, NewBig(1))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.MustNewBTCPrivatekey()
+	nkey, err := NewKey(npk.Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewKeys([]Key{nkey}, 100)
+	t.NoError(err)
+
+	op := t.newOperation(sa.Address, nkeys, sa.Privs(), t.cid)
+
+	err = opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
 func (t *testKeyUpdaterOperation) TestTargetNotExist() {
 	am := NewAmount(NewBig(3), t.cid)
 	sa, _ := t.newAccount(false, []Amount{am})
@@ -192,6 +311,56 @@ func (t *testKeyUpdaterOperation) TestSameKeys() {
 	t.Contains(err.Error(), "same Keys")
 }
 
+func (t *testKeyUpdaterOperation) TestSequence() {
+	am := NewAmount(NewBig(3), t.cid)
+	sa, st := t.newAccount(true, []Amount{am})
+
+	sq, err := NewSequence(sa.Address).Use(1)
+	t.NoError(err)
+
+	pool, _ := t.statepool(st, []state.State{t.newSequenceState(sq)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.MustNewBTCPrivatekey()
+	nkey, err := NewKey(npk.Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewKeys([]Key{nkey}, 100)
+	t.NoError(err)
+
+	newOperation := func(s uint64) KeyUpdater {
+		fact := NewKeyUpdaterFact(util.UUID().Bytes(), sa.Address, nkeys, t.cid).SetSequence(s)
+
+		sig, err := operation.NewFactSignature(sa.Priv, fact, nil)
+		t.NoError(err)
+
+		op, err := NewKeyUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(sa.Priv.Publickey(), sig)}, "")
+		t.NoError(err)
+
+		return op
+	}
+
+	err = opr.Process(newOperation(1))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "wrong sequence, 1; expected 2")
+
+	t.NoError(opr.Process(newOperation(2)))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySequence(sa.Address) {
+			sst = st.GetState()
+		}
+	}
+
+	usq, err := StateSequenceValue(sst)
+	t.NoError(err)
+	t.Equal(uint64(3), usq.Next())
+}
+
 func TestKeyUpdaterOperation(t *testing.T) {
 	suite.Run(t, new(testKeyUpdaterOperation))
 }
@@ -35,7 +35,7 @@ func (t *testKeyUpdater) TestNew() {
 
 	token := util.UUID().Bytes()
 
-	fact := NewKeyUpdaterFact(token, sender, nkeys, t.cid)
+	fact := NewKeyUpdaterFact(token, sender, nkeys, t.cid).SetSequence(1)
 	sig, err := operation.NewFactSignature(spk, fact, nil)
 	t.NoError(err)
 	fs := []operation.FactSign{operation.NewBaseFactSign(spk.Publickey(), sig)}
@@ -49,6 +49,29 @@ func (t *testKeyUpdater) TestNew() {
 	t.Implements((*operation.Operation)(nil), op)
 }
 
+func (t *testKeyUpdater) TestFeePayerSameWithTarget() {
+	spk := key.MustNewBTCPrivatekey()
+	skey, err := NewKey(spk.Publickey(), 100)
+	t.NoError(err)
+	skeys, err := NewKeys([]Key{skey}, 100)
+	t.NoError(err)
+	sender, err := NewAddressFromKeys(skeys)
+	t.NoError(err)
+
+	npk := key.MustNewBTCPrivatekey()
+	nkey, err := NewKey(npk.Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewKeys([]Key{nkey}, 100)
+	t.NoError(err)
+
+	token := util.UUID().Bytes()
+
+	fact := NewKeyUpdaterFact(token, sender, nkeys, t.cid).SetSequence(1).SetFeePayer(sender)
+
+	err = fact.IsValid(nil)
+	t.Contains(err.Error(), "fee payer is same with sender")
+}
+
 func TestKeyUpdater(t *testing.T) {
 	suite.Run(t, new(testKeyUpdater))
 }
@@ -74,7 +97,10 @@ func testKeyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
 
 		token := util.UUID().Bytes()
 
-		fact := NewKeyUpdaterFact(token, sender, nkeys, CurrencyID("SEEME"))
+		fact := NewKeyUpdaterFact(token, sender, nkeys, CurrencyID("SEEME")).SetSequence(1).
+			SetFeePayer(MustAddress(util.UUID().String())).
+			SetSequence(3).
+			SetValidUntil(base.Height(33))
 		sig, err := operation.NewFactSignature(spk, fact, nil)
 		t.NoError(err)
 		fs := []operation.FactSign{operation.NewBaseFactSign(spk.Publickey(), sig)}
@@ -99,6 +125,10 @@ func testKeyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
 		t.True(fact.target.Equal(ufact.target))
 		t.True(fact.Keys().Equal(ufact.Keys()))
 		t.Equal(fact.currency, ufact.currency)
+		t.True(fact.FeePayer().Equal(ufact.FeePayer()))
+		t.Equal(fact.Sequence(), ufact.Sequence())
+		t.Equal(fact.ValidUntilHeight(), ufact.ValidUntilHeight())
+		t.True(fact.Hash().Equal(ufact.Hash()))
 	}
 
 	return t
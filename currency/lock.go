@@ -0,0 +1,202 @@
+package currency
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	LockType = hint.MustNewType(0xa0, 0x46, "mitum-currency-lock")
+	LockHint = hint.MustHint(LockType, "0.0.1")
+)
+
+type LockStatus string
+
+const (
+	LockStatusLocked   LockStatus = "locked"
+	LockStatusClaimed  LockStatus = "claimed"
+	LockStatusRefunded LockStatus = "refunded"
+)
+
+func (ls LockStatus) Bytes() []byte {
+	return []byte(ls)
+}
+
+func (ls LockStatus) String() string {
+	return string(ls)
+}
+
+func (ls LockStatus) IsValid([]byte) error {
+	switch ls {
+	case LockStatusLocked, LockStatusClaimed, LockStatusRefunded:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown lock status, %q", ls)
+	}
+}
+
+// Lock is the amount, which is locked by sender for receiver by
+// LockTransfer. Receiver can claim it with the preimage of hashlock before
+// expiry height and after expiry height sender can refund it. The id of Lock is
+// the fact hash of LockTransfer.
+type Lock struct {
+	id       valuehash.Hash
+	sender   base.Address
+	receiver base.Address
+	amount   Amount
+	hashlock []byte
+	expiry   base.Height
+	status   LockStatus
+	preimage []byte
+}
+
+func NewLock(
+	id valuehash.Hash,
+	sender, receiver base.Address,
+	amount Amount,
+	hashlock []byte,
+	expiry base.Height,
+) Lock {
+	return Lock{
+		id:       id,
+		sender:   sender,
+		receiver: receiver,
+		amount:   amount,
+		hashlock: hashlock,
+		expiry:   expiry,
+		status:   LockStatusLocked,
+	}
+}
+
+func (lk Lock) Hint() hint.Hint {
+	return LockHint
+}
+
+func (lk Lock) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		lk.id.Bytes(),
+		lk.sender.Bytes(),
+		lk.receiver.Bytes(),
+		lk.amount.Bytes(),
+		lk.hashlock,
+		lk.expiry.Bytes(),
+		lk.status.Bytes(),
+		lk.preimage,
+	)
+}
+
+func (lk Lock) Hash() valuehash.Hash {
+	return lk.GenerateHash()
+}
+
+func (lk Lock) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(lk.Bytes())
+}
+
+func (lk Lock) IsValid([]byte) error {
+	if err := isvalid.Check([]isvalid.IsValider{
+		lk.id,
+		lk.sender,
+		lk.receiver,
+		lk.amount,
+		lk.expiry,
+		lk.status,
+	}, nil, false); err != nil {
+		return xerrors.Errorf("invalid Lock: %w", err)
+	}
+
+	if lk.sender.Equal(lk.receiver) {
+		return xerrors.Errorf("receiver is same with sender, %q", lk.sender)
+	}
+
+	if !lk.amount.Big().OverZero() {
+		return xerrors.Errorf("amount should be over zero")
+	}
+
+	if err := IsValidHashlock(lk.hashlock); err != nil {
+		return err
+	}
+
+	if lk.status == LockStatusClaimed {
+		if !lk.Unlock(lk.preimage) {
+			return xerrors.Errorf("claimed Lock has wrong preimage")
+		}
+	} else if len(lk.preimage) > 0 {
+		return xerrors.Errorf("preimage found in not claimed Lock")
+	}
+
+	return nil
+}
+
+func (lk Lock) ID() valuehash.Hash {
+	return lk.id
+}
+
+func (lk Lock) Sender() base.Address {
+	return lk.sender
+}
+
+func (lk Lock) Receiver() base.Address {
+	return lk.receiver
+}
+
+func (lk Lock) Amount() Amount {
+	return lk.amount
+}
+
+func (lk Lock) Currency() CurrencyID {
+	return lk.amount.Currency()
+}
+
+func (lk Lock) Hashlock() []byte {
+	return lk.hashlock
+}
+
+func (lk Lock) Expiry() base.Height {
+	return lk.expiry
+}
+
+func (lk Lock) Status() LockStatus {
+	return lk.status
+}
+
+func (lk Lock) Preimage() []byte {
+	return lk.preimage
+}
+
+// Unlock checks the SHA-256 digest of preimage matches with hashlock.
+func (lk Lock) Unlock(preimage []byte) bool {
+	h := sha256.Sum256(preimage)
+
+	return bytes.Equal(h[:], lk.hashlock)
+}
+
+func (lk Lock) Claim(preimage []byte) Lock {
+	lk.status = LockStatusClaimed
+	lk.preimage = preimage
+
+	return lk
+}
+
+func (lk Lock) Refund() Lock {
+	lk.status = LockStatusRefunded
+
+	return lk
+}
+
+// IsValidHashlock checks hashlock is SHA-256 digest.
+func IsValidHashlock(hashlock []byte) error {
+	if len(hashlock) != sha256.Size {
+		return isvalid.InvalidError.Errorf("hashlock should be %d bytes, not %d", sha256.Size, len(hashlock))
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (lk Lock) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(lk.Hint()),
+		bson.M{
+			"id":       lk.id,
+			"sender":   lk.sender,
+			"receiver": lk.receiver,
+			"amount":   lk.amount,
+			"hashlock": lk.hashlock,
+			"expiry":   lk.expiry,
+			"status":   lk.status,
+			"preimage": lk.preimage,
+		}),
+	)
+}
+
+type LockBSONUnpacker struct {
+	ID valuehash.Bytes     `bson:"id"`
+	SD base.AddressDecoder `bson:"sender"`
+	RC base.AddressDecoder `bson:"receiver"`
+	AM bson.Raw            `bson:"amount"`
+	HL []byte              `bson:"hashlock"`
+	EX base.Height         `bson:"expiry"`
+	ST LockStatus          `bson:"status"`
+	PI []byte              `bson:"preimage"`
+}
+
+func (lk *Lock) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ulk LockBSONUnpacker
+	if err := enc.Unmarshal(b, &ulk); err != nil {
+		return err
+	}
+
+	return lk.unpack(enc, ulk.ID, ulk.SD, ulk.RC, ulk.AM, ulk.HL, ulk.EX, ulk.ST, ulk.PI)
+}
@@ -0,0 +1,45 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (lk *Lock) unpack(
+	enc encoder.Encoder,
+	id valuehash.Hash,
+	bSender base.AddressDecoder,
+	bReceiver base.AddressDecoder,
+	bam []byte,
+	hashlock []byte,
+	expiry base.Height,
+	status LockStatus,
+	preimage []byte,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		lk.sender = a
+	}
+
+	if a, err := bReceiver.Encode(enc); err != nil {
+		return err
+	} else {
+		lk.receiver = a
+	}
+
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		lk.amount = am
+	}
+
+	lk.id = id
+	lk.hashlock = hashlock
+	lk.expiry = expiry
+	lk.status = status
+	lk.preimage = preimage
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type LockJSONPacker struct {
+	jsonenc.HintedHead
+	ID valuehash.Hash `json:"id"`
+	SD base.Address   `json:"sender"`
+	RC base.Address   `json:"receiver"`
+	AM Amount         `json:"amount"`
+	HL []byte         `json:"hashlock"`
+	EX base.Height    `json:"expiry"`
+	ST LockStatus     `json:"status"`
+	PI []byte         `json:"preimage,omitempty"`
+}
+
+func (lk Lock) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(LockJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(lk.Hint()),
+		ID:         lk.id,
+		SD:         lk.sender,
+		RC:         lk.receiver,
+		AM:         lk.amount,
+		HL:         lk.hashlock,
+		EX:         lk.expiry,
+		ST:         lk.status,
+		PI:         lk.preimage,
+	})
+}
+
+type LockJSONUnpacker struct {
+	ID valuehash.Bytes     `json:"id"`
+	SD base.AddressDecoder `json:"sender"`
+	RC base.AddressDecoder `json:"receiver"`
+	AM json.RawMessage     `json:"amount"`
+	HL []byte              `json:"hashlock"`
+	EX base.Height         `json:"expiry"`
+	ST LockStatus          `json:"status"`
+	PI []byte              `json:"preimage,omitempty"`
+}
+
+func (lk *Lock) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ulk LockJSONUnpacker
+	if err := enc.Unmarshal(b, &ulk); err != nil {
+		return err
+	}
+
+	return lk.unpack(enc, ulk.ID, ulk.SD, ulk.RC, ulk.AM, ulk.HL, ulk.EX, ulk.ST, ulk.PI)
+}
@@ -0,0 +1,129 @@
+package currency
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func newTestHashlock() ([]byte, []byte) {
+	preimage := util.UUID().Bytes()
+	h := sha256.Sum256(preimage)
+
+	return h[:], preimage
+}
+
+type testLock struct {
+	suite.Suite
+}
+
+func (t *testLock) newLock() (Lock, []byte) {
+	hashlock, preimage := newTestHashlock()
+
+	return NewLock(
+		valuehash.RandomSHA256(),
+		NewTestAddress(),
+		NewTestAddress(),
+		NewAmount(NewBig(10), CurrencyID("SHOWME")),
+		hashlock,
+		base.Height(33),
+	), preimage
+}
+
+func (t *testLock) TestNew() {
+	lk, preimage := t.newLock()
+	t.NoError(lk.IsValid(nil))
+	t.Equal(LockStatusLocked, lk.Status())
+
+	t.False(lk.Unlock(util.UUID().Bytes()))
+	t.True(lk.Unlock(preimage))
+}
+
+func (t *testLock) TestClaim() {
+	lk, preimage := t.newLock()
+
+	clk := lk.Claim(preimage)
+	t.NoError(clk.IsValid(nil))
+	t.Equal(LockStatusClaimed, clk.Status())
+	t.Equal(preimage, clk.Preimage())
+
+	err := lk.Claim(util.UUID().Bytes()).IsValid(nil)
+	t.Contains(err.Error(), "wrong preimage")
+}
+
+func (t *testLock) TestRefund() {
+	lk, _ := t.newLock()
+
+	rlk := lk.Refund()
+	t.NoError(rlk.IsValid(nil))
+	t.Equal(LockStatusRefunded, rlk.Status())
+}
+
+func (t *testLock) TestWrongHashlock() {
+	err := NewLock(
+		valuehash.RandomSHA256(),
+		NewTestAddress(),
+		NewTestAddress(),
+		NewAmount(NewBig(10), CurrencyID("SHOWME")),
+		[]byte("showme"),
+		base.Height(33),
+	).IsValid(nil)
+	t.Contains(err.Error(), "hashlock should be 32 bytes")
+}
+
+func TestLock(t *testing.T) {
+	suite.Run(t, new(testLock))
+}
+
+func testLockEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		hashlock, preimage := newTestHashlock()
+
+		lk := NewLock(
+			valuehash.RandomSHA256(),
+			NewTestAddress(),
+			NewTestAddress(),
+			NewAmount(NewBig(10), CurrencyID("SHOWME")),
+			hashlock,
+			base.Height(33),
+		).Claim(preimage)
+		t.NoError(lk.IsValid(nil))
+
+		return lk
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Lock)
+		tb := b.(Lock)
+
+		t.True(ta.ID().Equal(tb.ID()))
+		t.True(ta.Sender().Equal(tb.Sender()))
+		t.True(ta.Receiver().Equal(tb.Receiver()))
+		t.True(ta.Amount().Equal(tb.Amount()))
+		t.Equal(ta.Hashlock(), tb.Hashlock())
+		t.Equal(ta.Expiry(), tb.Expiry())
+		t.Equal(ta.Status(), tb.Status())
+		t.Equal(ta.Preimage(), tb.Preimage())
+	}
+
+	return t
+}
+
+func TestLockEncodeJSON(t *testing.T) {
+	suite.Run(t, testLockEncode(jsonenc.NewEncoder()))
+}
+
+func TestLockEncodeBSON(t *testing.T) {
+	suite.Run(t, testLockEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,202 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	LockTransferFactType = hint.MustNewType(0xa0, 0x47, "mitum-currency-lock-transfer-operation-fact")
+	LockTransferFactHint = hint.MustHint(LockTransferFactType, "0.0.1")
+	LockTransferType     = hint.MustNewType(0xa0, 0x48, "mitum-currency-lock-transfer-operation")
+	LockTransferHint     = hint.MustHint(LockTransferType, "0.0.1")
+)
+
+// LockTransferFact locks the amount of sender for receiver under the SHA-256
+// hashlock until the expiry height. The locked amount is kept in it's own
+// Lock state, not in the balance of sender.
+type LockTransferFact struct {
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	receiver base.Address
+	amount   Amount
+	hashlock []byte
+	expiry   base.Height
+}
+
+func NewLockTransferFact(
+	token []byte,
+	sender, receiver base.Address,
+	amount Amount,
+	hashlock []byte,
+	expiry base.Height,
+) LockTransferFact {
+	fact := LockTransferFact{
+		token:    token,
+		sender:   sender,
+		receiver: receiver,
+		amount:   amount,
+		hashlock: hashlock,
+		expiry:   expiry,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact LockTransferFact) Hint() hint.Hint {
+	return LockTransferFactHint
+}
+
+func (fact LockTransferFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact LockTransferFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact LockTransferFact) Token() []byte {
+	return fact.token
+}
+
+func (fact LockTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fact.hashlock,
+		fact.expiry.Bytes(),
+	)
+}
+
+func (fact LockTransferFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for LockTransferFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.receiver,
+		fact.amount,
+		fact.expiry,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.receiver) {
+		return xerrors.Errorf("receiver is same with sender, %q", fact.sender)
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return xerrors.Errorf("amount should be over zero")
+	}
+
+	if err := IsValidHashlock(fact.hashlock); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact LockTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact LockTransferFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact LockTransferFact) Amount() Amount {
+	return fact.amount
+}
+
+func (fact LockTransferFact) Amounts() []Amount {
+	return []Amount{fact.amount}
+}
+
+func (fact LockTransferFact) Hashlock() []byte {
+	return fact.hashlock
+}
+
+func (fact LockTransferFact) Expiry() base.Height {
+	return fact.expiry
+}
+
+func (fact LockTransferFact) Rebuild() LockTransferFact {
+	fact.amount = fact.amount.WithBig(fact.amount.Big())
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact LockTransferFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.receiver}, nil
+}
+
+type LockTransfer struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewLockTransfer(fact LockTransferFact, fs []operation.FactSign, memo string) (LockTransfer, error) {
+	if bo, err := operation.NewBaseOperationFromFact(LockTransferHint, fact, fs); err != nil {
+		return LockTransfer{}, err
+	} else {
+		op := LockTransfer{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op LockTransfer) Hint() hint.Hint {
+	return LockTransferHint
+}
+
+func (op LockTransfer) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op LockTransfer) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op LockTransfer) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
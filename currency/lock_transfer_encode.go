@@ -0,0 +1,48 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *LockTransferFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	bReceiver base.AddressDecoder,
+	bam []byte,
+	hashlock []byte,
+	expiry base.Height,
+) error {
+	var sender, receiver base.Address
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		sender = a
+	}
+
+	if a, err := bReceiver.Encode(enc); err != nil {
+		return err
+	} else {
+		receiver = a
+	}
+
+	var amount Amount
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		amount = am
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.sender = sender
+	fact.receiver = receiver
+	fact.amount = amount
+	fact.hashlock = hashlock
+	fact.expiry = expiry
+
+	return nil
+}
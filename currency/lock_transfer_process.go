@@ -0,0 +1,194 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op LockTransfer) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type LockTransferProcessor struct {
+	cp *CurrencyPool
+	LockTransfer
+	proposable
+	height   base.Height
+	sl       state.State
+	sb       map[CurrencyID]AmountState
+	required map[CurrencyID][2]Big
+	sls      []spendingLimitState
+	sc       state.State
+	cm       Commitments
+}
+
+func NewLockTransferProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(LockTransfer); !ok {
+			return nil, xerrors.Errorf("not LockTransfer, %T", op)
+		} else {
+			return &LockTransferProcessor{
+				cp:           cp,
+				LockTransfer: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *LockTransferProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *LockTransferProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(LockTransferFact)
+	cid := fact.amount.Currency()
+
+	if fact.expiry <= opp.height {
+		return nil, util.IgnoreError.Errorf("expiry should be over current height, %v <= %v", fact.expiry, opp.height)
+	}
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsAccountState(fact.receiver, "receiver", getState); err != nil {
+		return nil, err
+	}
+
+	if opp.cp != nil && !opp.cp.Exists(cid) {
+		return nil, util.IgnoreError.Errorf("currency not registered, %q", cid)
+	}
+
+	if err := checkTransferRestriction(opp.cp, fact.sender, cid, getState); err != nil {
+		return nil, err
+	} else if err := checkTransferRestriction(opp.cp, fact.receiver, cid, getState); err != nil {
+		return nil, err
+	}
+
+	if st, err := notExistsState(StateKeyLock(fact.Hash()), "lock", getState); err != nil {
+		return nil, err
+	} else {
+		opp.sl = st
+	}
+
+	if required, err := CalculateItemsFee(opp.cp, LockTransferType, []AmountsItem{fact}); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else if err := checkNotFrozenByRequired(fact.sender, nil, required, getState); err != nil {
+		return nil, err
+	} else if sb, err := CheckEnoughBalance(fact.sender, required, opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	if sls, err := checkSpendingLimit(fact.sender, requiredOutflow(opp.required), opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sls = sls
+	}
+
+	if st, cm, err := loadCommitments(fact.sender, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sc = st
+		opp.cm = cm.Add(StateKeyLock(fact.Hash()))
+	}
+
+	if err := opp.checkFactSigns(fact.sender, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *LockTransferProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(LockTransferFact)
+
+	var sts []state.State
+	if st, err := SetStateLockValue(
+		opp.sl,
+		NewLock(fact.Hash(), fact.sender, fact.receiver, fact.amount, fact.hashlock, fact.expiry),
+	); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	if st, err := SetStateCommitmentsValue(opp.sc, opp.cm); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	sts = append(sts, debitRequired(opp.sb, nil, opp.required)...)
+
+	if sls, err := setSpendingLimitStates(opp.sls); err != nil {
+		return err
+	} else {
+		sts = append(sts, sls...)
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// loadOpenLock loads the Lock, which is not claimed or refunded yet.
+func loadOpenLock(
+	id valuehash.Hash,
+	getState func(key string) (state.State, bool, error),
+) (state.State, Lock, error) {
+	var st state.State
+	if i, err := existsState(StateKeyLock(id), "lock", getState); err != nil {
+		return nil, Lock{}, err
+	} else {
+		st = i
+	}
+
+	switch lk, err := StateLockValue(st); {
+	case err != nil:
+		return nil, Lock{}, util.IgnoreError.Wrap(err)
+	case lk.Status() != LockStatusLocked:
+		return nil, Lock{}, util.IgnoreError.Errorf("lock already %s", lk.Status())
+	default:
+		return st, lk, nil
+	}
+}
+
+// releaseLockFee returns the fee of releasing Lock; the fee is charged to the
+// locked amount.
+func releaseLockFee(cp *CurrencyPool, lk Lock, ht hint.Type) (Big, error) {
+	if cp == nil {
+		return ZeroBig, nil
+	}
+
+	var feeer Feeer
+	if i, found := cp.OperationFeeer(lk.Currency(), ht); !found {
+		return ZeroBig, xerrors.Errorf("unknown currency id found, %q", lk.Currency())
+	} else {
+		feeer = i
+	}
+
+	switch fee, err := feeer.Fee(lk.Amount().Big()); {
+	case err != nil:
+		return ZeroBig, err
+	case lk.Amount().Big().Compare(fee) < 0:
+		return ZeroBig, xerrors.Errorf("insufficient locked amount with fee")
+	default:
+		return fee, nil
+	}
+}
@@ -0,0 +1,276 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testLockTransferOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testLockTransferOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testLockTransferOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(LockTransfer{}, NewLockTransferProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testLockTransferOperations) newLockTransfer(
+	sender, receiver base.Address,
+	keys []key.Privatekey,
+	amount Amount,
+	hashlock []byte,
+	expiry base.Height,
+) LockTransfer {
+	token := util.UUID().Bytes()
+	fact := NewLockTransferFact(token, sender, receiver, amount, hashlock, expiry)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewLockTransfer(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testLockTransferOperations) TestNew() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	hashlock, _ := newTestHashlock()
+	amount := NewAmount(NewBig(10), t.cid)
+	op := t.newLockTransfer(sa.Address, ra.Address, sa.Privs(), amount, hashlock, pool.Height()+10)
+	t.NoError(opr.Process(op))
+
+	var sst, lst, cst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyLock(op.Fact().Hash()):
+			lst = st.GetState()
+		case StateKeyCommitments(sa.Address):
+			cst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			t.Fail("balance of receiver should not be updated")
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(33).Sub(amount.Big()).Sub(fee)))
+	t.True(sst.(AmountState).Fee().Equal(fee))
+
+	lk, err := StateLockValue(lst)
+	t.NoError(err)
+	t.NoError(lk.IsValid(nil))
+	t.Equal(LockStatusLocked, lk.Status())
+	t.True(lk.Sender().Equal(sa.Address))
+	t.True(lk.Receiver().Equal(ra.Address))
+	t.True(lk.Amount().Equal(amount))
+	t.Equal(hashlock, lk.Hashlock())
+
+	cm, err := StateCommitmentsValue(cst)
+	t.NoError(err)
+	t.Equal([]string{StateKeyLock(op.Fact().Hash())}, cm.Keys())
+}
+
+func (t *testLockTransferOperations) TestExpired() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	hashlock, _ := newTestHashlock()
+	op := t.newLockTransfer(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), hashlock, pool.Height())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "expiry should be over current height")
+}
+
+func (t *testLockTransferOperations) TestReceiverNotExist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, _ := t.newAccount(false, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	hashlock, _ := newTestHashlock()
+	op := t.newLockTransfer(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), hashlock, pool.Height()+10)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "receiver does not exist")
+}
+
+func (t *testLockTransferOperations) TestInsufficientBalanceWithFee() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(2)))
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	hashlock, _ := newTestHashlock()
+	op := t.newLockTransfer(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), hashlock, pool.Height()+10)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testLockTransferOperations) TestSenderFrozen() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newFreezeState(NewFreeze(sa.Address, t.cid, false))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	hashlock, _ := newTestHashlock()
+	op := t.newLockTransfer(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), hashlock, pool.Height()+10)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testLockTransferOperations) TestReceiverNotInAllowlist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetTransferRestriction(TransferRestrictionAllowlist)
+	dst := t.newCurrencyDesignStateByPolicy(t.cid, NewBig(99), NewTestAddress(), po)
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst,
+		t.newTransferListState(NewTransferListMember(sa.Address, t.cid, true)),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	hashlock, _ := newTestHashlock()
+	op := t.newLockTransfer(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), hashlock, pool.Height()+10)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not in allowlist")
+}
+
+func (t *testLockTransferOperations) TestSpendingLimit() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(10), base.Height(10), base.Height(0)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(2)))
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst, t.newSpendingLimitState(sl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	hashlock, _ := newTestHashlock()
+	op := t.newLockTransfer(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), hashlock, pool.Height()+10)
+	t.NoError(opr.Process(op))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySpendingLimit(sa.Address, t.cid) {
+			sst = st.GetState()
+		}
+	}
+
+	usl, err := StateSpendingLimitValue(sst)
+	t.NoError(err)
+	t.True(usl.Spent().Equal(NewBig(10)))
+}
+
+func (t *testLockTransferOperations) TestSpendingLimitExceeded() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(0)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newSpendingLimitState(sl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	hashlock, _ := newTestHashlock()
+	op := t.newLockTransfer(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), hashlock, pool.Height()+10)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "spending limit exceeded")
+}
+
+func TestLockTransferOperations(t *testing.T) {
+	suite.Run(t, new(testLockTransferOperations))
+}
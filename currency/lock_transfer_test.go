@@ -0,0 +1,150 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testLockTransfer struct {
+	baseTest
+}
+
+func (t *testLockTransfer) newOperation(
+	sender, receiver base.Address,
+	amount Amount,
+	hashlock []byte,
+	expiry base.Height,
+) LockTransfer {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewLockTransferFact(token, sender, receiver, amount, hashlock, expiry)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewLockTransfer(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testLockTransfer) TestNew() {
+	sender := NewTestAddress()
+	receiver := NewTestAddress()
+	hashlock, _ := newTestHashlock()
+
+	op := t.newOperation(sender, receiver, NewAmount(NewBig(33), CurrencyID("SHOWME")), hashlock, base.Height(10))
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	as, err := op.Fact().(LockTransferFact).Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+	t.True(sender.Equal(as[0]))
+	t.True(receiver.Equal(as[1]))
+}
+
+func (t *testLockTransfer) TestSameReceiver() {
+	sender := NewTestAddress()
+	hashlock, _ := newTestHashlock()
+
+	op := t.newOperation(sender, sender, NewAmount(NewBig(33), CurrencyID("SHOWME")), hashlock, base.Height(10))
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "receiver is same with sender")
+}
+
+func (t *testLockTransfer) TestZeroAmount() {
+	hashlock, _ := newTestHashlock()
+
+	op := t.newOperation(NewTestAddress(), NewTestAddress(), NewZeroAmount(CurrencyID("SHOWME")), hashlock, base.Height(10))
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "amount should be over zero")
+}
+
+func (t *testLockTransfer) TestWrongHashlock() {
+	op := t.newOperation(NewTestAddress(), NewTestAddress(), NewAmount(NewBig(33), CurrencyID("SHOWME")), []byte("showme"), base.Height(10))
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "hashlock should be 32 bytes")
+}
+
+func (t *testLockTransfer) TestWrongExpiry() {
+	hashlock, _ := newTestHashlock()
+
+	op := t.newOperation(NewTestAddress(), NewTestAddress(), NewAmount(NewBig(33), CurrencyID("SHOWME")), hashlock, base.NilHeight)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "height must be greater than")
+}
+
+func TestLockTransfer(t *testing.T) {
+	suite.Run(t, new(testLockTransfer))
+}
+
+func testLockTransferEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+		hashlock, _ := newTestHashlock()
+
+		token := util.UUID().Bytes()
+		fact := NewLockTransferFact(
+			token, NewTestAddress(), NewTestAddress(), NewAmount(NewBig(33), CurrencyID("SHOWME")), hashlock, base.Height(10),
+		)
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewLockTransfer(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(LockTransfer)
+		tb := b.(LockTransfer)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(LockTransferFact)
+		ufact := tb.Fact().(LockTransferFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.receiver.Equal(ufact.receiver))
+		t.True(fact.amount.Equal(ufact.amount))
+		t.Equal(fact.hashlock, ufact.hashlock)
+		t.Equal(fact.expiry, ufact.expiry)
+	}
+
+	return t
+}
+
+func TestLockTransferEncodeJSON(t *testing.T) {
+	suite.Run(t, testLockTransferEncode(jsonenc.NewEncoder()))
+}
+
+func TestLockTransferEncodeBSON(t *testing.T) {
+	suite.Run(t, testLockTransferEncode(bsonenc.NewEncoder()))
+}
@@ -36,6 +36,8 @@ func (t *baseTestEncode) SetupSuite() {
 	t.encs.AddHinter(KeyUpdaterFact{})
 	t.encs.AddHinter(KeyUpdater{})
 	t.encs.AddHinter(FeeOperationFact{})
+	t.encs.AddHinter(FeePayout{})
+	t.encs.AddHinter(FeeShare{})
 	t.encs.AddHinter(FeeOperation{})
 	t.encs.AddHinter(Account{})
 	t.encs.AddHinter(GenesisCurrenciesFact{})
@@ -51,9 +53,87 @@ func (t *baseTestEncode) SetupSuite() {
 	t.encs.AddHinter(NilFeeer{})
 	t.encs.AddHinter(FixedFeeer{})
 	t.encs.AddHinter(RatioFeeer{})
+	t.encs.AddHinter(TieredFeeer{})
 	t.encs.AddHinter(CurrencyPolicyUpdaterFact{})
 	t.encs.AddHinter(CurrencyPolicyUpdater{})
 	t.encs.AddHinter(CurrencyPolicy{})
+	t.encs.AddHinter(MintItem{})
+	t.encs.AddHinter(CurrencyMintFact{})
+	t.encs.AddHinter(CurrencyMint{})
+	t.encs.AddHinter(BurnFact{})
+	t.encs.AddHinter(Burn{})
+	t.encs.AddHinter(CurrencySupply{})
+	t.encs.AddHinter(Allowance{})
+	t.encs.AddHinter(ApproveFact{})
+	t.encs.AddHinter(Approve{})
+	t.encs.AddHinter(TransferFromFact{})
+	t.encs.AddHinter(TransferFrom{})
+	t.encs.AddHinter(Lock{})
+	t.encs.AddHinter(LockTransferFact{})
+	t.encs.AddHinter(LockTransfer{})
+	t.encs.AddHinter(ClaimTransferFact{})
+	t.encs.AddHinter(ClaimTransfer{})
+	t.encs.AddHinter(RefundTransferFact{})
+	t.encs.AddHinter(RefundTransfer{})
+	t.encs.AddHinter(VestingRelease{})
+	t.encs.AddHinter(Vesting{})
+	t.encs.AddHinter(CreateVestingAccountsItem{})
+	t.encs.AddHinter(CreateVestingAccountsFact{})
+	t.encs.AddHinter(CreateVestingAccounts{})
+	t.encs.AddHinter(Proposal{})
+	t.encs.AddHinter(ProposeOperationFact{})
+	t.encs.AddHinter(ProposeOperation{})
+	t.encs.AddHinter(ApproveOperationFact{})
+	t.encs.AddHinter(ApproveOperation{})
+	t.encs.AddHinter(RecoveryConfig{})
+	t.encs.AddHinter(Recovery{})
+	t.encs.AddHinter(RecoveryUpdaterFact{})
+	t.encs.AddHinter(RecoveryUpdater{})
+	t.encs.AddHinter(RecoverAccountFact{})
+	t.encs.AddHinter(RecoverAccount{})
+	t.encs.AddHinter(CancelRecoveryFact{})
+	t.encs.AddHinter(CancelRecovery{})
+	t.encs.AddHinter(Alias(""))
+	t.encs.AddHinter(AccountAlias{})
+	t.encs.AddHinter(RegisterAliasFact{})
+	t.encs.AddHinter(RegisterAlias{})
+	t.encs.AddHinter(TransferAliasFact{})
+	t.encs.AddHinter(TransferAlias{})
+	t.encs.AddHinter(ReleaseAliasFact{})
+	t.encs.AddHinter(ReleaseAlias{})
+	t.encs.AddHinter(CloseAccountFact{})
+	t.encs.AddHinter(CloseAccount{})
+	t.encs.AddHinter(ExchangeFact{})
+	t.encs.AddHinter(Exchange{})
+	t.encs.AddHinter(Freeze{})
+	t.encs.AddHinter(FreezeAccountFact{})
+	t.encs.AddHinter(FreezeAccount{})
+	t.encs.AddHinter(UnfreezeAccountFact{})
+	t.encs.AddHinter(UnfreezeAccount{})
+	t.encs.AddHinter(TransferListMember{})
+	t.encs.AddHinter(TransferListUpdaterFact{})
+	t.encs.AddHinter(TransferListUpdater{})
+	t.encs.AddHinter(SpendingLimit{})
+	t.encs.AddHinter(SetSpendingLimitFact{})
+	t.encs.AddHinter(SetSpendingLimit{})
+	t.encs.AddHinter(ScheduledTransfer{})
+	t.encs.AddHinter(ScheduleItem{})
+	t.encs.AddHinter(ScheduleQueue{})
+	t.encs.AddHinter(ScheduleTransferFact{})
+	t.encs.AddHinter(ScheduleTransfer{})
+	t.encs.AddHinter(CancelScheduledTransferFact{})
+	t.encs.AddHinter(CancelScheduledTransfer{})
+	t.encs.AddHinter(ScheduledTransferOperationFact{})
+	t.encs.AddHinter(ScheduledTransferOperation{})
+	t.encs.AddHinter(StandingOrder{})
+	t.encs.AddHinter(CreateStandingOrderFact{})
+	t.encs.AddHinter(CreateStandingOrder{})
+	t.encs.AddHinter(CancelStandingOrderFact{})
+	t.encs.AddHinter(CancelStandingOrder{})
+	t.encs.AddHinter(StandingOrderOperationFact{})
+	t.encs.AddHinter(StandingOrderOperation{})
+	t.encs.AddHinter(Sequence{})
+	t.encs.AddHinter(Commitments{})
 }
 
 func (t *baseTestEncode) TestEncode() {
@@ -1,9 +1,12 @@
 package currency
 
 import (
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/prprocessor"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/storage"
@@ -16,23 +19,60 @@ import (
 
 type GetNewProcessor func(state.Processor) (state.Processor, error)
 
+// heightedProcessor is the processor, which needs the height of the block
+// being processed, like the expiry of Lock and the releases of Vesting.
+type heightedProcessor interface {
+	setHeight(base.Height)
+}
+
+// approvedProcessor is the processor of the proposed operation. The proposed
+// operation has no signs of it's own; it is approved by the signs of Proposal,
+// which already passed the threshold of account keys.
+type approvedProcessor interface {
+	setApproved()
+}
+
+// proposalProcessor is the processor of Proposal, which executes the proposed
+// operation when the signs of Proposal pass the threshold of account keys.
+type proposalProcessor interface {
+	operation.Operation
+	proposed() operation.Operation
+	setProposed(state.Processor)
+}
+
 type DuplicationType string
 
+// DuplicationTypeSender allows only one operation of the sender in proposal.
+// DuplicationTypeAmount allows the multiple operations of the sender, which
+// only spend the amounts, but it is not allowed with DuplicationTypeSender.
 const (
 	DuplicationTypeSender   DuplicationType = "sender"
+	DuplicationTypeAmount   DuplicationType = "amount"
 	DuplicationTypeCurrency DuplicationType = "currency"
 )
 
 type OperationProcessor struct {
 	sync.RWMutex
 	*logging.Logging
-	processorHintSet     *hint.Hintmap
-	cp                   *CurrencyPool
-	pool                 *storage.Statepool
-	fee                  map[CurrencyID]Big
-	amountPool           map[string]AmountState
-	duplicated           map[string]DuplicationType
-	duplicatedNewAddress map[string]struct{}
+	processorHintSet *hint.Hintmap
+	cp               *CurrencyPool
+	pool             *storage.Statepool
+	fee              map[CurrencyID]Big
+	minted           map[CurrencyID]Big
+	burned           map[CurrencyID]Big
+	supplyOps        map[CurrencyID][]valuehash.Hash
+	processingPools  *processingPools
+	processing       *processingPool
+	scheduleLock     *sync.Mutex
+	schedules        map[string]scheduleUpdate
+}
+
+// scheduleUpdate has the items, which are added to or removed from the
+// ScheduleQueue by the operations in this block.
+type scheduleUpdate struct {
+	added   []ScheduleItem
+	removed []valuehash.Hash
+	ops     []valuehash.Hash
 }
 
 func NewOperationProcessor(cp *CurrencyPool) *OperationProcessor {
@@ -42,6 +82,8 @@ func NewOperationProcessor(cp *CurrencyPool) *OperationProcessor {
 		}),
 		processorHintSet: hint.NewHintmap(),
 		cp:               cp,
+		processingPools:  newProcessingPools(),
+		scheduleLock:     &sync.Mutex{},
 	}
 }
 
@@ -50,13 +92,17 @@ func (opr *OperationProcessor) New(pool *storage.Statepool) prprocessor.Operatio
 		Logging: logging.NewLogging(func(c logging.Context) logging.Emitter {
 			return c.Str("module", "mitum-currency-operations-processor")
 		}),
-		processorHintSet:     opr.processorHintSet,
-		cp:                   opr.cp,
-		pool:                 pool,
-		fee:                  map[CurrencyID]Big{},
-		amountPool:           map[string]AmountState{},
-		duplicated:           map[string]DuplicationType{},
-		duplicatedNewAddress: map[string]struct{}{},
+		processorHintSet: opr.processorHintSet,
+		cp:               opr.cp,
+		pool:             pool,
+		fee:              map[CurrencyID]Big{},
+		minted:           map[CurrencyID]Big{},
+		burned:           map[CurrencyID]Big{},
+		supplyOps:        map[CurrencyID][]valuehash.Hash{},
+		processingPools:  opr.processingPools,
+		processing:       opr.processingPools.get(pool),
+		scheduleLock:     opr.scheduleLock,
+		schedules:        map[string]scheduleUpdate{},
 	}
 }
 
@@ -76,19 +122,88 @@ func (opr *OperationProcessor) setState(op valuehash.Hash, sts ...state.State) e
 	defer opr.Unlock()
 
 	for i := range sts {
-		if t, ok := sts[i].(AmountState); ok {
+		switch t := sts[i].(type) {
+		case AmountState:
 			if t.Fee().OverZero() {
-				var f Big = ZeroBig
-				if i, found := opr.fee[t.Currency()]; found {
-					f = i
-				}
+				opr.fee[t.Currency()] = addBig(opr.fee, t.Currency(), t.Fee())
+			}
+		case CurrencyDesignState:
+			switch a := t.Added(); {
+			case a.OverZero():
+				opr.minted[t.Currency()] = addBig(opr.minted, t.Currency(), a)
+			case a.IsZero():
+				continue
+			default:
+				opr.burned[t.Currency()] = addBig(opr.burned, t.Currency(), a.Neg())
+			}
 
-				opr.fee[t.Currency()] = f.Add(t.Fee())
+			opr.supplyOps[t.Currency()] = append(opr.supplyOps[t.Currency()], op)
+		default:
+			if err := opr.setScheduleState(op, t); err != nil {
+				return err
 			}
 		}
 	}
 
-	return opr.pool.Set(op, sts...)
+	return opr.processing.set(op, sts...)
+}
+
+// setScheduleState keeps the ScheduledTransfers and StandingOrders, which are
+// scheduled or cancelled by the operation; they are applied to their
+// ScheduleQueue in Close.
+func (opr *OperationProcessor) setScheduleState(op valuehash.Hash, st state.State) error {
+	var key string
+	var item ScheduleItem
+	var scheduled bool
+
+	switch {
+	case IsStateScheduledTransferKey(st.Key()):
+		sc, err := StateScheduledTransferValue(st)
+		if err != nil {
+			return err
+		}
+
+		switch sc.Status() {
+		case ScheduledTransferStatusPending:
+			scheduled = true
+		case ScheduledTransferStatusCancelled:
+		default:
+			return nil
+		}
+
+		key = StateKeyScheduleQueue
+		item = NewScheduleItem(sc.Height(), sc.ID())
+	case IsStateStandingOrderKey(st.Key()):
+		so, err := StateStandingOrderValue(st)
+		if err != nil {
+			return err
+		}
+
+		switch so.Status() {
+		case StandingOrderStatusActive:
+			scheduled = true
+		case StandingOrderStatusCancelled:
+		default:
+			return nil
+		}
+
+		key = StateKeyStandingOrderQueue
+		item = NewScheduleItem(so.Next(), so.ID())
+	default:
+		return nil
+	}
+
+	su := opr.schedules[key]
+	if scheduled {
+		su.added = append(su.added, item)
+	} else {
+		su.removed = append(su.removed, item.ID())
+	}
+
+	su.ops = append(su.ops, op)
+	opr.schedules[key] = su
+
+	return nil
 }
 
 func (opr *OperationProcessor) PreProcess(op state.Processor) (state.Processor, error) {
@@ -102,8 +217,12 @@ func (opr *OperationProcessor) PreProcess(op state.Processor) (state.Processor,
 		sp = i
 	}
 
+	if hp, ok := sp.(heightedProcessor); ok {
+		hp.setHeight(opr.pool.Height())
+	}
+
 	var pop state.Processor
-	if pr, err := sp.(state.PreProcessor).PreProcess(opr.pool.Get, opr.setState); err != nil {
+	if pr, err := sp.(state.PreProcessor).PreProcess(opr.getState(sp), opr.setState); err != nil {
 		return nil, err
 	} else {
 		pop = pr
@@ -113,18 +232,150 @@ func (opr *OperationProcessor) PreProcess(op state.Processor) (state.Processor,
 		return nil, util.IgnoreError.Errorf("duplication found: %w", err)
 	}
 
+	if ap, ok := pop.(amountProcessor); ok {
+		if err := opr.processing.reserve(ap.Fact().Hash(), ap); err != nil {
+			return nil, err
+		}
+	}
+
+	if pp, ok := pop.(proposalProcessor); ok && pp.proposed() != nil {
+		if err := opr.preProcessProposed(pp); err != nil {
+			return nil, err
+		}
+	}
+
 	return pop, nil
 }
 
+// preProcessProposed prepares the processor of proposed operation, which will
+// be processed with it's Proposal.
+func (opr *OperationProcessor) preProcessProposed(pp proposalProcessor) error {
+	var op state.Processor
+	if i, ok := pp.proposed().(state.Processor); !ok {
+		return util.IgnoreError.Errorf("proposed operation is not state.Processor, %T", pp.proposed())
+	} else {
+		op = i
+	}
+
+	var sp state.Processor
+	switch i, known, err := opr.getNewProcessor(op); {
+	case err != nil:
+		return util.IgnoreError.Wrap(err)
+	case !known:
+		return util.IgnoreError.Errorf("unknown proposed operation, %T", op)
+	default:
+		sp = i
+	}
+
+	if hp, ok := sp.(heightedProcessor); ok {
+		hp.setHeight(opr.pool.Height())
+	}
+
+	if ap, ok := sp.(approvedProcessor); !ok {
+		return util.IgnoreError.Errorf("proposed operation can not be approved, %T", op)
+	} else {
+		ap.setApproved()
+	}
+
+	var pop state.Processor
+	if pr, err := sp.(state.PreProcessor).PreProcess(opr.getState(sp), opr.setState); err != nil {
+		return err
+	} else {
+		pop = pr
+	}
+
+	if err := opr.checkDuplication(op); err != nil {
+		return util.IgnoreError.Errorf("duplication found in proposed operation: %w", err)
+	}
+
+	if ap, ok := pop.(amountProcessor); ok {
+		if err := opr.processing.reserve(pp.Fact().Hash(), ap); err != nil {
+			return err
+		}
+	}
+
+	pp.setProposed(pop)
+
+	return nil
+}
+
+// getState returns the getState for PreProcess. The amountProcessor is
+// pre-processed with the pending states of the previous operations in
+// proposal; the other processors can not be processed with the operations of
+// the same sender, so they get the states of Statepool.
+func (opr *OperationProcessor) getState(sp state.Processor) func(string) (state.State, bool, error) {
+	if _, ok := sp.(amountProcessor); ok {
+		return opr.processing.getState
+	}
+
+	return opr.pool.Get
+}
+
 func (opr *OperationProcessor) Process(op state.Processor) error {
 	switch op.(type) {
 	case *TransfersProcessor,
 		*CreateAccountsProcessor,
 		*KeyUpdaterProcessor,
 		*CurrencyRegisterProcessor,
-		*CurrencyPolicyUpdaterProcessor:
+		*CurrencyPolicyUpdaterProcessor,
+		*CurrencyMintProcessor,
+		*BurnProcessor,
+		*ApproveProcessor,
+		*TransferFromProcessor,
+		*LockTransferProcessor,
+		*ClaimTransferProcessor,
+		*RefundTransferProcessor,
+		*CreateVestingAccountsProcessor,
+		*ProposeOperationProcessor,
+		*ApproveOperationProcessor,
+		*RecoveryUpdaterProcessor,
+		*RecoverAccountProcessor,
+		*CancelRecoveryProcessor,
+		*RegisterAliasProcessor,
+		*TransferAliasProcessor,
+		*ReleaseAliasProcessor,
+		*CloseAccountProcessor,
+		*ExchangeProcessor,
+		*FreezeAccountProcessor,
+		*UnfreezeAccountProcessor,
+		*TransferListUpdaterProcessor,
+		*SetSpendingLimitProcessor,
+		*ScheduleTransferProcessor,
+		*CancelScheduledTransferProcessor,
+		*CreateStandingOrderProcessor,
+		*CancelStandingOrderProcessor:
 		return opr.process(op)
-	case Transfers, CreateAccounts, KeyUpdater, CurrencyRegister, CurrencyPolicyUpdater:
+	case Transfers,
+		CreateAccounts,
+		KeyUpdater,
+		CurrencyRegister,
+		CurrencyPolicyUpdater,
+		CurrencyMint,
+		Burn,
+		Approve,
+		TransferFrom,
+		LockTransfer,
+		ClaimTransfer,
+		RefundTransfer,
+		CreateVestingAccounts,
+		ProposeOperation,
+		ApproveOperation,
+		RecoveryUpdater,
+		RecoverAccount,
+		CancelRecovery,
+		RegisterAlias,
+		TransferAlias,
+		ReleaseAlias,
+		CloseAccount,
+		Exchange,
+		FreezeAccount,
+		UnfreezeAccount,
+		TransferListUpdater,
+		SetSpendingLimit,
+		ScheduleTransfer,
+		CancelScheduledTransfer,
+		CreateStandingOrder,
+		CancelStandingOrder:
 		if pr, err := opr.PreProcess(op); err != nil {
 			return err
 		} else {
@@ -145,6 +396,58 @@ func (opr *OperationProcessor) process(op state.Processor) error {
 		sp = t
 	case *KeyUpdaterProcessor:
 		sp = t
+	case *BurnProcessor:
+		sp = t
+	case *CurrencyMintProcessor:
+		sp = t
+	case *ApproveProcessor:
+		sp = t
+	case *TransferFromProcessor:
+		sp = t
+	case *LockTransferProcessor:
+		sp = t
+	case *ClaimTransferProcessor:
+		sp = t
+	case *RefundTransferProcessor:
+		sp = t
+	case *CreateVestingAccountsProcessor:
+		sp = t
+	case *ProposeOperationProcessor:
+		sp = t
+	case *ApproveOperationProcessor:
+		sp = t
+	case *RecoveryUpdaterProcessor:
+		sp = t
+	case *RecoverAccountProcessor:
+		sp = t
+	case *CancelRecoveryProcessor:
+		sp = t
+	case *RegisterAliasProcessor:
+		sp = t
+	case *TransferAliasProcessor:
+		sp = t
+	case *ReleaseAliasProcessor:
+		sp = t
+	case *CloseAccountProcessor:
+		sp = t
+	case *ExchangeProcessor:
+		sp = t
+	case *FreezeAccountProcessor:
+		sp = t
+	case *UnfreezeAccountProcessor:
+		sp = t
+	case *TransferListUpdaterProcessor:
+		sp = t
+	case *SetSpendingLimitProcessor:
+		sp = t
+	case *ScheduleTransferProcessor:
+		sp = t
+	case *CancelScheduledTransferProcessor:
+		sp = t
+	case *CreateStandingOrderProcessor:
+		sp = t
+	case *CancelStandingOrderProcessor:
+		sp = t
 	default:
 		return op.Process(opr.pool.Get, opr.pool.Set)
 	}
@@ -153,17 +456,20 @@ func (opr *OperationProcessor) process(op state.Processor) error {
 }
 
 func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
-	opr.Lock()
-	defer opr.Unlock()
+	opr.processing.Lock()
+	defer opr.processing.Unlock()
 
 	var did string
+	var dids []string
 	var didtype DuplicationType
 	var newAddresses []base.Address
 
 	switch t := op.(type) {
 	case Transfers:
-		did = t.Fact().(TransfersFact).Sender().String()
-		didtype = DuplicationTypeSender
+		fact := t.Fact().(TransfersFact)
+		did = fact.Sender().String()
+		dids = feePayerDuplicationIDs(fact.FeePayer())
+		didtype = DuplicationTypeAmount
 	case CreateAccounts:
 		fact := t.Fact().(CreateAccountsFact)
 		if as, err := fact.Targets(); err != nil {
@@ -173,9 +479,113 @@ func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
 		}
 
 		did = fact.Sender().String()
-		didtype = DuplicationTypeSender
+		dids = feePayerDuplicationIDs(fact.FeePayer())
+		didtype = DuplicationTypeAmount
 	case KeyUpdater:
-		did = t.Fact().(KeyUpdaterFact).Target().String()
+		fact := t.Fact().(KeyUpdaterFact)
+		did = fact.Target().String()
+		dids = feePayerDuplicationIDs(fact.FeePayer())
+		didtype = DuplicationTypeSender
+	case Burn:
+		did = t.Fact().(BurnFact).Sender().String()
+		didtype = DuplicationTypeSender
+	case Approve:
+		did = t.Fact().(ApproveFact).Owner().String()
+		didtype = DuplicationTypeSender
+	case TransferFrom:
+		fact := t.Fact().(TransferFromFact)
+		did = fact.Sender().String()
+		dids = []string{fact.Owner().String()}
+		didtype = DuplicationTypeSender
+	case LockTransfer:
+		did = t.Fact().(LockTransferFact).Sender().String()
+		didtype = DuplicationTypeSender
+	case ClaimTransfer:
+		fact := t.Fact().(ClaimTransferFact)
+		did = fact.Sender().String()
+		dids = []string{fact.Lock().String()}
+		didtype = DuplicationTypeSender
+	case RefundTransfer:
+		fact := t.Fact().(RefundTransferFact)
+		did = fact.Sender().String()
+		dids = []string{fact.Lock().String()}
+		didtype = DuplicationTypeSender
+	case CreateVestingAccounts:
+		fact := t.Fact().(CreateVestingAccountsFact)
+		if as, err := fact.Targets(); err != nil {
+			return xerrors.Errorf("failed to get Addresses")
+		} else {
+			newAddresses = as
+		}
+
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case ProposeOperation:
+		did = t.Fact().Hash().String()
+		didtype = DuplicationTypeSender
+	case ApproveOperation:
+		did = t.Fact().(ApproveOperationFact).Proposal().String()
+		didtype = DuplicationTypeSender
+	case RecoveryUpdater:
+		did = t.Fact().(RecoveryUpdaterFact).Target().String()
+		didtype = DuplicationTypeSender
+	case RecoverAccount:
+		fact := t.Fact().(RecoverAccountFact)
+		did = fact.Sender().String()
+		dids = []string{fact.Target().String()}
+		didtype = DuplicationTypeSender
+	case CancelRecovery:
+		did = t.Fact().(CancelRecoveryFact).Target().String()
+		didtype = DuplicationTypeSender
+	case RegisterAlias:
+		fact := t.Fact().(RegisterAliasFact)
+		did = fact.Sender().String()
+		dids = []string{fact.Alias().String()}
+		didtype = DuplicationTypeSender
+	case TransferAlias:
+		fact := t.Fact().(TransferAliasFact)
+		did = fact.Sender().String()
+		dids = []string{fact.Alias().String(), fact.Receiver().String()}
+		didtype = DuplicationTypeSender
+	case ReleaseAlias:
+		fact := t.Fact().(ReleaseAliasFact)
+		did = fact.Sender().String()
+		dids = []string{fact.Alias().String()}
+		didtype = DuplicationTypeSender
+	case CloseAccount:
+		fact := t.Fact().(CloseAccountFact)
+		did = fact.Sender().String()
+		dids = []string{fact.Beneficiary().String()}
+		didtype = DuplicationTypeSender
+	case Exchange:
+		fact := t.Fact().(ExchangeFact)
+		did = fact.Sender().String()
+		dids = []string{fact.Counterparty().String()}
+		didtype = DuplicationTypeSender
+	case FreezeAccount:
+		did = t.Fact().(FreezeAccountFact).Target().String()
+		didtype = DuplicationTypeSender
+	case UnfreezeAccount:
+		did = t.Fact().(UnfreezeAccountFact).Target().String()
+		didtype = DuplicationTypeSender
+	case SetSpendingLimit:
+		did = t.Fact().(SetSpendingLimitFact).Sender().String()
+		didtype = DuplicationTypeSender
+	case ScheduleTransfer:
+		did = t.Fact().(ScheduleTransferFact).Sender().String()
+		didtype = DuplicationTypeSender
+	case CancelScheduledTransfer:
+		fact := t.Fact().(CancelScheduledTransferFact)
+		did = fact.Sender().String()
+		dids = []string{fact.Transfer().String()}
+		didtype = DuplicationTypeSender
+	case CreateStandingOrder:
+		did = t.Fact().(CreateStandingOrderFact).Payer().String()
+		didtype = DuplicationTypeSender
+	case CancelStandingOrder:
+		fact := t.Fact().(CancelStandingOrderFact)
+		did = fact.Payer().String()
+		dids = []string{fact.Order().String()}
 		didtype = DuplicationTypeSender
 	case CurrencyRegister:
 		did = t.Fact().(CurrencyRegisterFact).Currency().Currency().String()
@@ -183,23 +593,43 @@ func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
 	case CurrencyPolicyUpdater:
 		did = t.Fact().(CurrencyPolicyUpdaterFact).Currency().String()
 		didtype = DuplicationTypeCurrency
+	case TransferListUpdater:
+		did = t.Fact().(TransferListUpdaterFact).Currency().String()
+		didtype = DuplicationTypeCurrency
+	case CurrencyMint:
+		cids := t.Fact().(CurrencyMintFact).Currencies()
+		dids = make([]string, len(cids))
+		for i := range cids {
+			dids[i] = cids[i].String()
+		}
+		didtype = DuplicationTypeCurrency
 	default:
 		return nil
 	}
 
 	if len(did) > 0 {
-		if _, found := opr.duplicated[did]; found {
-			switch didtype {
-			case DuplicationTypeSender:
+		dids = append(dids, did)
+	}
+
+	for i := range dids {
+		if dt, found := opr.processing.duplicated[dids[i]]; found {
+			switch {
+			case didtype == DuplicationTypeAmount && dt == DuplicationTypeAmount:
+				continue
+			case didtype == DuplicationTypeAmount:
+				return xerrors.Errorf("sender, %q already used by the other operation in proposal", dids[i])
+			case didtype == DuplicationTypeSender:
 				return xerrors.Errorf("violates only one sender in proposal")
-			case DuplicationTypeCurrency:
-				return xerrors.Errorf("duplicated currency id, %q found in proposal", did)
+			case didtype == DuplicationTypeCurrency:
+				return xerrors.Errorf("duplicated currency id, %q found in proposal", dids[i])
 			default:
 				return xerrors.Errorf("violates duplication in proposal")
 			}
 		}
+	}
 
-		opr.duplicated[did] = didtype
+	for i := range dids {
+		opr.processing.duplicated[dids[i]] = didtype
 	}
 
 	if len(newAddresses) > 0 {
@@ -211,15 +641,25 @@ func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
 	return nil
 }
 
+// feePayerDuplicationIDs returns the duplication id of fee payer; fee payer
+// is treated like sender, so it follows the duplication type of the operation.
+func feePayerDuplicationIDs(feePayer base.Address) []string {
+	if feePayer == nil {
+		return nil
+	}
+
+	return []string{feePayer.String()}
+}
+
 func (opr *OperationProcessor) checkNewAddressDuplication(as []base.Address) error {
 	for i := range as {
-		if _, found := opr.duplicatedNewAddress[as[i].String()]; found {
+		if _, found := opr.processing.duplicatedNewAddress[as[i].String()]; found {
 			return xerrors.Errorf("new address already processed")
 		}
 	}
 
 	for i := range as {
-		opr.duplicatedNewAddress[as[i].String()] = struct{}{}
+		opr.processing.duplicatedNewAddress[as[i].String()] = struct{}{}
 	}
 
 	return nil
@@ -229,8 +669,19 @@ func (opr *OperationProcessor) Close() error {
 	opr.RLock()
 	defer opr.RUnlock()
 
-	if opr.cp != nil && len(opr.fee) > 0 {
-		op := NewFeeOperation(NewFeeOperationFact(opr.pool.Height(), opr.fee))
+	defer opr.processingPools.release(opr.pool)
+
+	if err := opr.closeSchedule(); err != nil {
+		return err
+	}
+
+	if opr.cp == nil {
+		return nil
+	}
+
+	var feeFact valuehash.Hash
+	if len(opr.fee) > 0 {
+		op := NewFeeOperation(NewFeeOperationFact(opr.pool.Height(), opr.fee, opr.feePayouts()))
 
 		pr := NewFeeOperationProcessor(opr.cp, op)
 		if err := pr.Process(opr.pool.Get, opr.pool.Set); err != nil {
@@ -238,6 +689,402 @@ func (opr *OperationProcessor) Close() error {
 		} else {
 			opr.pool.AddOperations(op)
 		}
+
+		feeFact = op.Fact().Hash()
+	}
+
+	return opr.closeSupply(feeFact)
+}
+
+// closeSchedule applies the ScheduledTransfers and StandingOrders, which are
+// scheduled or cancelled in this block, to their ScheduleQueue and executes the
+// items, which reach the height. Close is called by the OperationProcessor of
+// each operation type, so ScheduleQueue is handled under the shared lock and
+// read from the states updated in this block. Close is not called in the block
+// without operations; the items, which reach the height in that block, are
+// executed in the next block.
+func (opr *OperationProcessor) closeSchedule() error {
+	opr.scheduleLock.Lock()
+	defer opr.scheduleLock.Unlock()
+
+	if err := opr.closeQueue(StateKeyScheduleQueue, opr.executeSchedule); err != nil {
+		return err
+	}
+
+	return opr.closeQueue(StateKeyStandingOrderQueue, opr.executeStandingOrders)
+}
+
+// closeQueue updates the ScheduleQueue of the key. execute executes the due
+// items and returns the fact hash of the executing operation and the items,
+// which should be scheduled again.
+func (opr *OperationProcessor) closeQueue(
+	key string,
+	execute func([]ScheduleItem) (valuehash.Hash, []ScheduleItem, error),
+) error {
+	su := opr.schedules[key]
+
+	var st state.State
+	var sq ScheduleQueue
+	switch i, found, err := opr.updatedStateGetter()(key); {
+	case err != nil:
+		return err
+	case !found && len(su.added) < 1:
+		return nil
+	case !found:
+		st = i
+	default:
+		if j, err := StateScheduleQueueValue(i); err != nil {
+			return err
+		} else {
+			st = i
+			sq = j
+		}
+	}
+
+	ops := su.ops
+	sq = sq.Add(su.added...).Remove(su.removed...)
+
+	if due := sq.Due(opr.pool.Height()); len(due) > 0 {
+		if fact, reschedule, err := execute(due); err != nil {
+			return err
+		} else if fact != nil {
+			ids := make([]valuehash.Hash, len(due))
+			for i := range due {
+				ids[i] = due[i].ID()
+			}
+
+			sq = sq.Remove(ids...).Add(reschedule...)
+			ops = append(ops, fact)
+		}
+	}
+
+	if len(ops) < 1 {
+		return nil
+	}
+
+	// NOTE the queue is updated by the OperationProcessors of the other
+	// operation types in this block; the updated queue is kept.
+	if nst, err := SetStateScheduleQueueValue(st, sq); err != nil {
+		return err
+	} else {
+		return opr.processing.setValue(ops, nst)
+	}
+}
+
+// updatedStateGetter returns the getState, which returns the state updated in
+// this block; Statepool.Get returns the state before this block.
+func (opr *OperationProcessor) updatedStateGetter() func(string) (state.State, bool, error) {
+	updates := map[string]*state.StateUpdater{}
+	for _, u := range opr.pool.Updates() {
+		updates[u.Key()] = u
+	}
+
+	return func(key string) (state.State, bool, error) {
+		if u, found := updates[key]; found {
+			return u.GetState().Clear(), true, nil
+		}
+
+		return opr.pool.Get(key)
+	}
+}
+
+// executeSchedule executes the ScheduledTransfers by ScheduledTransferOperation.
+// If receiver can not receive the amount, it is refunded to sender. The fact
+// hash of ScheduledTransferOperation is returned.
+func (opr *OperationProcessor) executeSchedule(due []ScheduleItem) (valuehash.Hash, []ScheduleItem, error) {
+	getState := opr.updatedStateGetter()
+
+	var transfers []ScheduledTransfer
+	for i := range due {
+		var sc ScheduledTransfer
+		switch _, j, err := loadPendingScheduledTransfer(due[i].ID(), getState); {
+		case err == nil:
+			sc = j
+		case xerrors.Is(err, util.IgnoreError):
+			continue
+		default:
+			return nil, nil, err
+		}
+
+		switch err := checkScheduledTransferReceiver(opr.cp, sc, getState); {
+		case err == nil:
+			transfers = append(transfers, sc.Execute())
+		case xerrors.Is(err, util.IgnoreError):
+			opr.Log().Debug().Err(err).Hinted("scheduled_transfer", sc.ID()).Msg("scheduled transfer refunded")
+
+			transfers = append(transfers, sc.Refund())
+		default:
+			return nil, nil, err
+		}
+	}
+
+	if len(transfers) < 1 {
+		return nil, nil, nil
+	}
+
+	op := NewScheduledTransferOperation(NewScheduledTransferOperationFact(opr.pool.Height(), transfers))
+	if err := NewScheduledTransferOperationProcessor(op).Process(getState, opr.pool.Set); err != nil {
+		return nil, nil, err
+	}
+
+	opr.pool.AddOperations(op)
+
+	return op.Fact().Hash(), nil, nil
+}
+
+// executeStandingOrders pays the rounds of StandingOrders by
+// StandingOrderOperation. If payer can not pay the round, the round is
+// skipped. The active StandingOrders are scheduled again at the next round.
+func (opr *OperationProcessor) executeStandingOrders(due []ScheduleItem) (valuehash.Hash, []ScheduleItem, error) {
+	getState := opr.updatedStateGetter()
+
+	spent := map[string]Big{}
+	var paid, skipped []StandingOrder
+	var reschedule []ScheduleItem
+	for i := range due {
+		var so StandingOrder
+		switch _, j, err := loadActiveStandingOrder(due[i].ID(), getState); {
+		case err == nil:
+			so = j
+		case xerrors.Is(err, util.IgnoreError):
+			continue
+		default:
+			return nil, nil, err
+		}
+
+		k := StateKeyBalance(so.Payer(), so.Currency())
+		s := ZeroBig
+		if j, found := spent[k]; found {
+			s = j
+		}
+
+		switch err := checkStandingOrderPayment(opr.cp, so, s, opr.pool.Height(), getState); {
+		case err == nil:
+			spent[k] = s.Add(so.Amount().Big())
+
+			so = so.Pay()
+			paid = append(paid, so)
+		case xerrors.Is(err, util.IgnoreError):
+			opr.Log().Debug().Err(err).Hinted("standing_order", so.ID()).Msg("round of standing order skipped")
+
+			so = so.Skip()
+			skipped = append(skipped, so)
+		default:
+			return nil, nil, err
+		}
+
+		if so.Status() == StandingOrderStatusActive {
+			reschedule = append(reschedule, NewScheduleItem(so.Next(), so.ID()))
+		}
+	}
+
+	if len(paid) < 1 && len(skipped) < 1 {
+		return nil, nil, nil
+	}
+
+	op := NewStandingOrderOperation(NewStandingOrderOperationFact(opr.pool.Height(), paid, skipped))
+	if err := NewStandingOrderOperationProcessor(op, opr.pool.Height()).Process(getState, opr.pool.Set); err != nil {
+		return nil, nil, err
+	}
+
+	opr.pool.AddOperations(op)
+
+	return op.Fact().Hash(), reschedule, nil
+}
+
+// checkScheduledTransferReceiver checks the receiver of ScheduledTransfer still
+// can receive the amount at the target height.
+func checkScheduledTransferReceiver(
+	cp *CurrencyPool,
+	sc ScheduledTransfer,
+	getState func(key string) (state.State, bool, error),
+) error {
+	if _, err := existsAccountState(sc.Receiver(), "receiver", getState); err != nil {
+		return err
+	}
+
+	if err := checkNotFrozen(sc.Receiver(), sc.Currency(), true, getState); err != nil {
+		return err
+	}
+
+	return checkTransferRestriction(cp, sc.Receiver(), sc.Currency(), getState)
+}
+
+// feePayouts distributes the collected fee of this block by the policy of each
+// currency.
+func (opr *OperationProcessor) feePayouts() []FeePayout {
+	cids := make([]CurrencyID, len(opr.fee))
+	var i int
+	for cid := range opr.fee {
+		cids[i] = cid
+		i++
+	}
+
+	sort.Slice(cids, func(i, j int) bool {
+		return strings.Compare(cids[i].String(), cids[j].String()) < 0
+	})
+
+	var payouts []FeePayout
+	for i := range cids {
+		cid := cids[i]
+		if po, found := opr.cp.Policy(cid); found {
+			payouts = append(payouts, po.FeePayouts(NewAmount(opr.fee[cid], cid))...)
+		}
+	}
+
+	return payouts
+}
+
+// closeSupply updates the CurrencySupply by the changes of this
+// OperationProcessor. The OperationProcessors of the same Statepool are closed
+// concurrently, so the holding amount of genesis account is read and set under
+// the lock of processingPool; the last one has the holding amount after all
+// the fee operations.
+func (opr *OperationProcessor) closeSupply(feeFact valuehash.Hash) error {
+	opr.processing.Lock()
+	defer opr.processing.Unlock()
+
+	updates := map[string]*state.StateUpdater{}
+	for _, u := range opr.pool.Updates() {
+		updates[u.Key()] = u
+	}
+
+	designs := opr.cp.Designs()
+
+	cids := make([]CurrencyID, len(designs))
+	var i int
+	for cid := range designs {
+		cids[i] = cid
+		i++
+	}
+
+	sort.Slice(cids, func(i, j int) bool {
+		return strings.Compare(cids[i].String(), cids[j].String()) < 0
+	})
+
+	for i := range cids {
+		cid := cids[i]
+		de := designs[cid]
+
+		ops := opr.supplyOps[cid]
+		if _, found := opr.fee[cid]; found && feeFact != nil {
+			ops = append(ops, feeFact)
+		}
+
+		var gb state.State
+		if de.GenesisAccount() != nil {
+			if u, found := updates[StateKeyBalance(de.GenesisAccount(), cid)]; found {
+				gb = u.GetState()
+				ops = append(ops, u.Operations()...)
+			}
+		}
+
+		if len(ops) < 1 {
+			continue
+		}
+
+		if err := opr.burnFee(de, feeFact); err != nil {
+			return err
+		}
+
+		if err := opr.updateSupply(de, gb, ops); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// burnFee decreases the amount of CurrencyDesign by the fee of this
+// OperationProcessor, which is burned without fee receivers, like Burn does.
+func (opr *OperationProcessor) burnFee(de CurrencyDesign, feeFact valuehash.Hash) error {
+	cid := de.Currency()
+
+	fee, found := opr.fee[cid]
+	switch {
+	case !found, feeFact == nil, !fee.OverZero():
+		return nil
+	case len(de.Policy().FeeReceivers()) > 0:
+		return nil
+	}
+
+	switch st, found, err := opr.pool.Get(StateKeyCurrencyDesign(cid)); {
+	case err != nil:
+		return err
+	case !found:
+		return xerrors.Errorf("currency design not found, %q", cid)
+	default:
+		return opr.pool.Set(feeFact, NewCurrencyDesignState(st, cid).Sub(fee))
+	}
+}
+
+func (opr *OperationProcessor) updateSupply(
+	de CurrencyDesign,
+	gb state.State,
+	ops []valuehash.Hash,
+) error {
+	cid := de.Currency()
+
+	var st CurrencySupplyState
+	switch i, found, err := opr.pool.Get(StateKeyCurrencySupply(cid)); {
+	case err != nil:
+		return err
+	case found:
+		st = NewCurrencySupplyState(i, cid)
+	default:
+		// NOTE the currency registered before CurrencySupply; the supply starts
+		// from the amount of CurrencyDesign before this block.
+		if j, err := SetStateCurrencySupplyValue(i, NewCurrencySupply(cid, de.Big())); err != nil {
+			return err
+		} else {
+			st = NewCurrencySupplyState(j, cid)
+		}
+	}
+
+	if b, found := opr.minted[cid]; found {
+		st = st.Mint(b)
+	}
+
+	if b, found := opr.burned[cid]; found {
+		st = st.Burn(b)
+	}
+
+	if b, found := opr.fee[cid]; found {
+		st = st.CollectFee(b, len(de.Policy().FeeReceivers()) < 1)
+	}
+
+	holding := ZeroBig
+	if de.GenesisAccount() != nil {
+		if gb == nil {
+			if i, found, err := opr.pool.Get(StateKeyBalance(de.GenesisAccount(), cid)); err != nil {
+				return err
+			} else if found {
+				gb = i
+			}
+		}
+
+		if gb != nil {
+			if am, err := StateBalanceValue(gb); err != nil {
+				return err
+			} else {
+				holding = am.Big()
+			}
+		}
+	}
+
+	st = st.SetHolding(holding)
+
+	// NOTE the changes are merged once; the other operations are added to the
+	// merged state.
+	for i := range ops {
+		var nst state.State = st
+		if i > 0 {
+			nst = st.Clear()
+		}
+
+		if err := opr.pool.Set(ops[i], nst); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -247,6 +1094,8 @@ func (opr *OperationProcessor) Cancel() error {
 	opr.RLock()
 	defer opr.RUnlock()
 
+	opr.processingPools.release(opr.pool)
+
 	return nil
 }
 
@@ -263,7 +1112,33 @@ func (opr *OperationProcessor) getNewProcessor(op state.Processor) (state.Proces
 		CreateAccounts,
 		KeyUpdater,
 		CurrencyRegister,
-		CurrencyPolicyUpdater:
+		CurrencyPolicyUpdater,
+		CurrencyMint,
+		Burn,
+		Approve,
+		TransferFrom,
+		LockTransfer,
+		ClaimTransfer,
+		RefundTransfer,
+		CreateVestingAccounts,
+		ProposeOperation,
+		ApproveOperation,
+		RecoveryUpdater,
+		RecoverAccount,
+		CancelRecovery,
+		RegisterAlias,
+		TransferAlias,
+		ReleaseAlias,
+		CloseAccount,
+		Exchange,
+		FreezeAccount,
+		UnfreezeAccount,
+		TransferListUpdater,
+		SetSpendingLimit,
+		ScheduleTransfer,
+		CancelScheduledTransfer,
+		CreateStandingOrder,
+		CancelStandingOrder:
 		return nil, false, xerrors.Errorf("%T needs SetProcessor", t)
 	default:
 		return op, false, nil
@@ -284,3 +1159,11 @@ func (opr *OperationProcessor) getNewProcessorFromHintset(op state.Processor) (s
 
 	return f(op)
 }
+
+func addBig(m map[CurrencyID]Big, cid CurrencyID, b Big) Big {
+	if i, found := m[cid]; found {
+		return i.Add(b)
+	}
+
+	return b
+}
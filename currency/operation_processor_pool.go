@@ -0,0 +1,302 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"golang.org/x/xerrors"
+)
+
+// amountProcessor is the processor, of which sender can send the other
+// operations in the same proposal. pendingStates returns the states of sender,
+// which will be set by Process; the next operation of the sender is
+// pre-processed with them.
+type amountProcessor interface {
+	operation.Operation
+	pendingStates() ([]state.State, error)
+}
+
+// processingPools keeps the processingPool of each Statepool. The
+// processingPool is kept until all the OperationProcessors of the Statepool are
+// closed or canceled. When processing operations fails, the OperationProcessor
+// is neither closed nor canceled, so the processingPools of the other heights
+// are dropped by the Statepool of the new height.
+type processingPools struct {
+	sync.Mutex
+	pools map[*storage.Statepool]*processingPool
+}
+
+func newProcessingPools() *processingPools {
+	return &processingPools{pools: map[*storage.Statepool]*processingPool{}}
+}
+
+func (pps *processingPools) get(pool *storage.Statepool) *processingPool {
+	pps.Lock()
+	defer pps.Unlock()
+
+	for i := range pps.pools {
+		if pps.pools[i].height != pool.Height() {
+			delete(pps.pools, i)
+		}
+	}
+
+	pp, found := pps.pools[pool]
+	if !found {
+		pp = newProcessingPool(pool)
+		pps.pools[pool] = pp
+	}
+
+	pp.refs++
+
+	return pp
+}
+
+// release drops the processingPool of Statepool, when it is not used by any
+// OperationProcessor.
+func (pps *processingPools) release(pool *storage.Statepool) {
+	pps.Lock()
+	defer pps.Unlock()
+
+	pp, found := pps.pools[pool]
+	if !found {
+		return
+	}
+
+	if pp.refs--; pp.refs < 1 {
+		delete(pps.pools, pool)
+	}
+}
+
+// processingPool keeps the duplications and the pending states of the
+// operations in the same Statepool. OperationProcessor is created by New for
+// each operation type, so they share processingPool to check the operations of
+// the same sender across the operation types.
+//
+// The operations are pre-processed in the order of proposal, but processed
+// concurrently. The amounts spent by the amountProcessor are accumulated in
+// amountPool and the other pending states, like sequence and SpendingLimit are
+// kept by the order of proposal, so the next operation of the same sender can
+// not spend them again.
+type processingPool struct {
+	sync.Mutex
+	pool                 *storage.Statepool
+	height               base.Height
+	refs                 int
+	index                map[string]int
+	amountPool           map[string]AmountState
+	pending              map[string]state.State
+	written              map[string]writtenState
+	duplicated           map[string]DuplicationType
+	duplicatedNewAddress map[string]struct{}
+}
+
+// writtenState is the pending state in Statepool, which is set by the operation
+// of the index.
+type writtenState struct {
+	index   int
+	updater *state.StateUpdater
+}
+
+func newProcessingPool(pool *storage.Statepool) *processingPool {
+	return &processingPool{
+		pool:                 pool,
+		height:               pool.Height(),
+		index:                map[string]int{},
+		amountPool:           map[string]AmountState{},
+		pending:              map[string]state.State{},
+		written:              map[string]writtenState{},
+		duplicated:           map[string]DuplicationType{},
+		duplicatedNewAddress: map[string]struct{}{},
+	}
+}
+
+// getState returns the state of Statepool with the pending states of the
+// operations pre-processed before. The balance is subtracted by the amounts,
+// which are spent by them.
+func (pp *processingPool) getState(key string) (state.State, bool, error) {
+	pp.Lock()
+	defer pp.Unlock()
+
+	if st, found := pp.pending[key]; found {
+		return st, true, nil
+	}
+
+	var st state.State
+	switch i, found, err := pp.pool.Get(key); {
+	case err != nil:
+		return nil, false, err
+	case !found:
+		return i, false, nil
+	default:
+		st = i
+	}
+
+	var spent AmountState
+	if i, found := pp.amountPool[key]; !found {
+		return st, true, nil
+	} else {
+		spent = i
+	}
+
+	am, err := StateBalanceValue(st)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if nst, err := SetStateBalanceValue(st, am.WithBig(am.Big().Add(spent.add))); err != nil {
+		return nil, false, err
+	} else {
+		return nst, true, nil
+	}
+}
+
+// reserve keeps the pending states of the amountProcessor; the operation is
+// indexed by the order of proposal with the fact hash, which sets the states.
+// The proposed operation is indexed by the fact hash of it's Proposal
+// operation.
+func (pp *processingPool) reserve(fact valuehash.Hash, ap amountProcessor) error {
+	var sts []state.State
+	if i, err := ap.pendingStates(); err != nil {
+		return err
+	} else {
+		sts = i
+	}
+
+	pp.Lock()
+	defer pp.Unlock()
+
+	pp.index[fact.String()] = len(pp.index)
+
+	for i := range sts {
+		switch t := sts[i].(type) {
+		case AmountState:
+			if am, found := pp.amountPool[t.Key()]; found {
+				pp.amountPool[t.Key()] = am.Add(t.add)
+			} else {
+				pp.amountPool[t.Key()] = t
+			}
+		default:
+			pp.pending[t.Key()] = t
+		}
+	}
+
+	return nil
+}
+
+// set sets the states of operation to Statepool. The balance, which is
+// subtracted by getState, is based on the state of Statepool again; the
+// AmountState is merged by the added amount.
+func (pp *processingPool) set(fact valuehash.Hash, sts ...state.State) error {
+	pp.Lock()
+	defer pp.Unlock()
+
+	nsts := make([]state.State, len(sts))
+	for i := range sts {
+		t, ok := sts[i].(AmountState)
+		if !ok {
+			nsts[i] = sts[i]
+
+			continue
+		}
+
+		if _, found := pp.amountPool[t.Key()]; found {
+			if st, _, err := pp.pool.Get(t.Key()); err != nil {
+				return err
+			} else {
+				t.State = st
+			}
+		}
+
+		nsts[i] = t
+	}
+
+	if err := pp.pool.Set(fact, nsts...); err != nil {
+		return err
+	}
+
+	for i := range nsts {
+		if _, ok := nsts[i].(AmountState); ok {
+			continue
+		}
+
+		if err := pp.setLastPending(fact, nsts[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setLastPending keeps the value of pending state by the operation, which is
+// pre-processed last in proposal. Statepool keeps the value of the state, which
+// is set first, but the operations are processed concurrently.
+func (pp *processingPool) setLastPending(fact valuehash.Hash, st state.State) error {
+	if _, found := pp.pending[st.Key()]; !found {
+		return nil
+	}
+
+	var index int
+	if i, found := pp.index[fact.String()]; !found {
+		return nil
+	} else {
+		index = i
+	}
+
+	w, found := pp.written[st.Key()]
+	switch {
+	case !found:
+		pp.written[st.Key()] = writtenState{index: index}
+
+		return nil
+	case w.index > index:
+		return nil
+	}
+
+	if w.updater == nil {
+		if u := pp.updater(st.Key()); u == nil {
+			return xerrors.Errorf("pending state not found in statepool, %q", st.Key())
+		} else {
+			w.updater = u
+		}
+	}
+
+	w.index = index
+	pp.written[st.Key()] = w
+
+	return w.updater.SetValue(st.Value())
+}
+
+// setValue sets the state to Statepool by the operations and keeps the value of
+// the given state. Statepool keeps the value of the state, which is set first,
+// but the state, like ScheduleQueue is read and updated again by the
+// OperationProcessor, which is closed later.
+func (pp *processingPool) setValue(ops []valuehash.Hash, st state.State) error {
+	pp.Lock()
+	defer pp.Unlock()
+
+	for i := range ops {
+		if err := pp.pool.Set(ops[i], st); err != nil {
+			return err
+		}
+	}
+
+	if u := pp.updater(st.Key()); u == nil {
+		return xerrors.Errorf("state not found in statepool, %q", st.Key())
+	} else {
+		return u.SetValue(st.Value())
+	}
+}
+
+func (pp *processingPool) updater(key string) *state.StateUpdater {
+	for _, u := range pp.pool.Updates() {
+		if u.Key() == key {
+			return u
+		}
+	}
+
+	return nil
+}
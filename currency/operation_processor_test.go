@@ -0,0 +1,258 @@
+package currency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+type testOperationProcessor struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testOperationProcessor) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testOperationProcessor) processor(cp *CurrencyPool) *OperationProcessor {
+	opr := NewOperationProcessor(cp)
+
+	_, err := opr.SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+	_, err = opr.SetProcessor(CreateAccounts{}, NewCreateAccountsProcessor(cp))
+	t.NoError(err)
+	_, err = opr.SetProcessor(KeyUpdater{}, NewKeyUpdaterProcessor(cp))
+	t.NoError(err)
+
+	return opr
+}
+
+func (t *testOperationProcessor) factSigns(fact base.Fact, keys []key.Privatekey) []operation.FactSign {
+	fs := make([]operation.FactSign, len(keys))
+	for i := range keys {
+		sig, err := operation.NewFactSignature(keys[i], fact, nil)
+		t.NoError(err)
+
+		fs[i] = operation.NewBaseFactSign(keys[i].Publickey(), sig)
+	}
+
+	return fs
+}
+
+func (t *testOperationProcessor) newTransfer(fact TransfersFact, keys []key.Privatekey) Transfers {
+	op, err := NewTransfers(fact, t.factSigns(fact, keys), "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testOperationProcessor) newCreateAccounts(fact CreateAccountsFact, keys []key.Privatekey) CreateAccounts {
+	op, err := NewCreateAccounts(fact, t.factSigns(fact, keys), "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testOperationProcessor) newKeyUpdater(target *account) KeyUpdater {
+	nk, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), 100)
+	t.NoError(err)
+	nks, err := NewKeys([]Key{nk}, 100)
+	t.NoError(err)
+
+	fact := NewKeyUpdaterFact(util.UUID().Bytes(), target.Address, nks, t.cid).SetSequence(1)
+
+	op, err := NewKeyUpdater(fact, t.factSigns(fact, target.Privs()), "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testOperationProcessor) TestKeyUpdaterAfterTransfers() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(ra.Address, ZeroBig))))
+
+	opr := t.processor(cp).New(pool)
+
+	items := []TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(1), t.cid))}
+	t.NoError(opr.Process(t.newTransfer(NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(1), sa.Privs())))
+
+	err := opr.Process(t.newKeyUpdater(sa))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "violates only one sender")
+}
+
+func (t *testOperationProcessor) TestTransfersAfterKeyUpdater() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ta, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(ra.Address, NewBig(1)))))
+
+	opr := t.processor(cp).New(pool)
+
+	t.NoError(opr.Process(t.newKeyUpdater(sa)))
+
+	// NOTE the fee payer of transfer can not be the target of KeyUpdater
+	fact := NewTransfersFact(
+		util.UUID().Bytes(),
+		ra.Address,
+		[]TransfersItem{NewTransfersItemSingleAmount(ta.Address, NewAmount(NewBig(1), t.cid))},
+	).SetSequence(1).SetFeePayer(sa.Address)
+
+	err := opr.Process(t.newTransfer(fact, append(ra.Privs(), sa.Privs()...)))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "already used by the other operation")
+}
+
+func (t *testOperationProcessor) TestSharedByOperationTypes() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(ra.Address, ZeroBig))))
+
+	// NOTE OperationProcessor is created for each operation type
+	copr := t.processor(cp)
+	opr0 := copr.New(pool)
+	opr1 := copr.New(pool)
+
+	items := []TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(2), t.cid))}
+	t.NoError(opr0.Process(t.newTransfer(NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(1), sa.Privs())))
+
+	citems := []CreateAccountsItem{NewCreateAccountsItemSingleAmount(na.Keys(), NewAmount(NewBig(2), t.cid))}
+	err := opr1.Process(t.newCreateAccounts(NewCreateAccountsFact(util.UUID().Bytes(), sa.Address, citems).SetSequence(2), sa.Privs()))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testOperationProcessor) TestReleaseProcessingPool() {
+	_, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	cp := NewCurrencyPool()
+	copr := t.processor(cp)
+
+	pool0, _ := t.statepool(st0)
+	pool1, _ := t.statepool(st0)
+
+	opr0 := copr.New(pool0)
+	opr1 := copr.New(pool0)
+	opr2 := copr.New(pool1)
+	t.Len(copr.processingPools.pools, 2)
+	t.True(opr0.(*OperationProcessor).processing == opr1.(*OperationProcessor).processing)
+	t.False(opr0.(*OperationProcessor).processing == opr2.(*OperationProcessor).processing)
+
+	t.NoError(opr0.Close())
+	t.Len(copr.processingPools.pools, 2)
+
+	t.NoError(opr1.Cancel())
+	t.Len(copr.processingPools.pools, 1)
+
+	t.NoError(opr2.Close())
+	t.Empty(copr.processingPools.pools)
+}
+
+func (t *testOperationProcessor) TestReleaseProcessingPoolOfOtherHeight() {
+	_, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	cp := NewCurrencyPool()
+	copr := t.processor(cp)
+
+	pool0, _ := t.statepool(st0)
+	pool1, _ := t.statepool(st0)
+
+	// NOTE the OperationProcessor of failed block is neither closed nor
+	// canceled
+	_ = copr.New(pool0)
+	copr.processingPools.pools[pool0].height = pool0.Height() - 1
+	t.Len(copr.processingPools.pools, 1)
+
+	opr1 := copr.New(pool1)
+	t.Len(copr.processingPools.pools, 1)
+	t.NotNil(copr.processingPools.pools[pool1])
+
+	t.NoError(opr1.Close())
+	t.Empty(copr.processingPools.pools)
+}
+
+func (t *testOperationProcessor) TestConcurrentSameSender() {
+	size := 30
+
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(int64(size*3)), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	fee := NewBig(1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(ra.Address, fee))))
+
+	copr := t.processor(cp)
+
+	oppHintSet := hint.NewHintmap()
+	t.NoError(oppHintSet.Add(Transfers{}, copr))
+	t.NoError(oppHintSet.Add(CreateAccounts{}, copr))
+
+	ops := make([]operation.Operation, size)
+	for i := range ops {
+		sq := uint64(i + 1)
+		if i%3 == 0 {
+			na, _ := t.newAccount(false, nil)
+			items := []CreateAccountsItem{NewCreateAccountsItemSingleAmount(na.Keys(), NewAmount(NewBig(1), t.cid))}
+			ops[i] = t.newCreateAccounts(NewCreateAccountsFact(util.UUID().Bytes(), sa.Address, items).SetSequence(sq), sa.Privs())
+
+			continue
+		}
+
+		items := []TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(1), t.cid))}
+		ops[i] = t.newTransfer(NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(sq), sa.Privs())
+	}
+
+	pool, _ := t.statepool(st0, st1)
+
+	co, err := prprocessor.NewConcurrentOperationsProcessor(10, pool, oppHintSet)
+	t.NoError(err)
+	co.Start(context.Background(), nil)
+
+	for i := range ops {
+		t.NoError(co.Process(ops[i]))
+	}
+	t.NoError(co.Close())
+
+	result := map[string]state.State{}
+	for _, st := range pool.Updates() {
+		result[st.Key()] = st.GetState()
+	}
+
+	sb, err := StateBalanceValue(result[StateKeyBalance(sa.Address, t.cid)])
+	t.NoError(err)
+	t.True(sb.Big().Equal(NewBig(int64(size))), "balance=%v", sb.Big())
+
+	sq, err := StateSequenceValue(result[StateKeySequence(sa.Address)])
+	t.NoError(err)
+	t.Equal(uint64(size+1), sq.Next())
+}
+
+func TestOperationProcessor(t *testing.T) {
+	suite.Run(t, new(testOperationProcessor))
+}
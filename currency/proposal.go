@@ -0,0 +1,336 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ProposalType = hint.MustNewType(0xa0, 0x52, "mitum-currency-proposal")
+	ProposalHint = hint.MustHint(ProposalType, "0.0.1")
+)
+
+type ProposalStatus string
+
+const (
+	ProposalStatusPending  ProposalStatus = "pending"
+	ProposalStatusExecuted ProposalStatus = "executed"
+)
+
+func (ps ProposalStatus) Bytes() []byte {
+	return []byte(ps)
+}
+
+func (ps ProposalStatus) String() string {
+	return string(ps)
+}
+
+func (ps ProposalStatus) IsValid([]byte) error {
+	switch ps {
+	case ProposalStatusPending, ProposalStatusExecuted:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown proposal status, %q", ps)
+	}
+}
+
+// Proposal is the operation fact, which is proposed by ProposeOperation and
+// waits the signs of the keys of account. ApproveOperation adds the signs and
+// when the sum of weights of signs passes the threshold of account keys, the
+// operation of fact is executed. The id of Proposal is the fact hash of
+// ProposeOperation.
+type Proposal struct {
+	id      valuehash.Hash
+	account base.Address
+	fact    operation.OperationFact
+	signs   []operation.FactSign
+	status  ProposalStatus
+}
+
+func NewProposal(
+	id valuehash.Hash,
+	account base.Address,
+	fact operation.OperationFact,
+	signs []operation.FactSign,
+) Proposal {
+	return Proposal{
+		id:      id,
+		account: account,
+		fact:    fact,
+		signs:   signs,
+		status:  ProposalStatusPending,
+	}
+}
+
+func (pr Proposal) Hint() hint.Hint {
+	return ProposalHint
+}
+
+func (pr Proposal) Bytes() []byte {
+	bs := make([][]byte, len(pr.signs)+4)
+	bs[0] = pr.id.Bytes()
+	bs[1] = pr.account.Bytes()
+	bs[2] = pr.fact.Hash().Bytes()
+	bs[3] = pr.status.Bytes()
+
+	for i := range pr.signs {
+		bs[i+4] = pr.signs[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (pr Proposal) Hash() valuehash.Hash {
+	return pr.GenerateHash()
+}
+
+func (pr Proposal) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(pr.Bytes())
+}
+
+func (pr Proposal) IsValid([]byte) error {
+	if pr.fact == nil {
+		return xerrors.Errorf("empty fact of Proposal")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		pr.id,
+		pr.account,
+		pr.fact,
+		pr.status,
+	}, nil, false); err != nil {
+		return xerrors.Errorf("invalid Proposal: %w", err)
+	}
+
+	if len(pr.signs) < 1 {
+		return xerrors.Errorf("empty signs of Proposal")
+	}
+
+	signers := map[string]struct{}{}
+	for i := range pr.signs {
+		fs := pr.signs[i]
+		if err := fs.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := signers[fs.Signer().String()]; found {
+			return xerrors.Errorf("duplicated signer found, %q", fs.Signer())
+		}
+
+		signers[fs.Signer().String()] = struct{}{}
+	}
+
+	if err := checkProposalFact(pr.account, pr.fact); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (pr Proposal) ID() valuehash.Hash {
+	return pr.id
+}
+
+func (pr Proposal) Account() base.Address {
+	return pr.account
+}
+
+func (pr Proposal) Fact() operation.OperationFact {
+	return pr.fact
+}
+
+func (pr Proposal) Signs() []operation.FactSign {
+	return pr.signs
+}
+
+func (pr Proposal) Status() ProposalStatus {
+	return pr.status
+}
+
+func (pr Proposal) Signed(signer key.Publickey) bool {
+	for i := range pr.signs {
+		if pr.signs[i].Signer().Equal(signer) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (pr Proposal) Approve(fs ...operation.FactSign) Proposal {
+	signs := make([]operation.FactSign, len(pr.signs)+len(fs))
+	copy(signs, pr.signs)
+	copy(signs[len(pr.signs):], fs)
+
+	pr.signs = signs
+
+	return pr
+}
+
+func (pr Proposal) Execute() Proposal {
+	pr.status = ProposalStatusExecuted
+
+	return pr
+}
+
+// proposedWeight returns the sum of weights of the signs by the current account
+// keys; the signs of the removed keys are ignored.
+func proposedWeight(fs []operation.FactSign, keys Keys) uint {
+	var sum uint
+
+	signers := map[string]struct{}{}
+	for i := range fs {
+		if _, found := signers[fs[i].Signer().String()]; found {
+			continue
+		}
+
+		if ky, found := keys.Key(fs[i].Signer()); found {
+			sum += ky.Weight()
+			signers[fs[i].Signer().String()] = struct{}{}
+		}
+	}
+
+	return sum
+}
+
+// checkProposalFact checks the fact can be proposed by account. The fact
+// should be signed only by the account, so the fact with fee payer can not be
+// proposed.
+func checkProposalFact(account base.Address, fact operation.OperationFact) error {
+	switch a, err := proposalAccount(fact); {
+	case err != nil:
+		return err
+	case !a.Equal(account):
+		return xerrors.Errorf("account of proposed fact, %q is not %q", a, account)
+	}
+
+	if i, ok := fact.(interface{ FeePayer() base.Address }); ok && i.FeePayer() != nil {
+		return xerrors.Errorf("proposed fact with fee payer not supported")
+	}
+
+	return nil
+}
+
+// proposalAccount returns the account, which should sign the fact.
+func proposalAccount(fact operation.OperationFact) (base.Address, error) {
+	switch t := fact.(type) {
+	case TransfersFact:
+		return t.Sender(), nil
+	case CreateAccountsFact:
+		return t.Sender(), nil
+	case KeyUpdaterFact:
+		return t.Target(), nil
+	case BurnFact:
+		return t.Sender(), nil
+	case ApproveFact:
+		return t.Owner(), nil
+	case TransferFromFact:
+		return t.Sender(), nil
+	case LockTransferFact:
+		return t.Sender(), nil
+	case ClaimTransferFact:
+		return t.Sender(), nil
+	case RefundTransferFact:
+		return t.Sender(), nil
+	case CreateVestingAccountsFact:
+		return t.Sender(), nil
+	case RecoveryUpdaterFact:
+		return t.Target(), nil
+	case RecoverAccountFact:
+		return t.Sender(), nil
+	case CancelRecoveryFact:
+		return t.Target(), nil
+	case RegisterAliasFact:
+		return t.Sender(), nil
+	case TransferAliasFact:
+		return t.Sender(), nil
+	case ReleaseAliasFact:
+		return t.Sender(), nil
+	case CloseAccountFact:
+		return t.Sender(), nil
+	default:
+		return nil, xerrors.Errorf("fact can not be proposed, %T", fact)
+	}
+}
+
+// proposable is embedded by the processor of the fact, which can be proposed.
+// When the operation is approved by Proposal, it has no signs and the signs of
+// Proposal are checked instead by proposalExecutor.
+type proposable struct {
+	approved bool
+}
+
+func (p *proposable) setApproved() {
+	p.approved = true
+}
+
+// checkFactSigns checks the signs like checkFactSignsWithFeePayer; the approved
+// operation only checks the account exists.
+func (p proposable) checkFactSigns(
+	sender base.Address,
+	feePayer base.Address,
+	fs []operation.FactSign,
+	getState func(key string) (state.State, bool, error),
+) error {
+	if p.approved {
+		_, err := existsAccountState(sender, "keys of account", getState)
+
+		return err
+	}
+
+	return checkFactSignsWithFeePayer(sender, feePayer, fs, getState)
+}
+
+// newProposedOperation creates the operation of proposed fact. The operation
+// does not have signs; the signs of Proposal are the signs of
+// ProposeOperationFact and ApproveOperationFact, not of the proposed fact.
+func newProposedOperation(fact operation.OperationFact) (operation.Operation, error) {
+	var fs []operation.FactSign
+
+	switch t := fact.(type) {
+	case TransfersFact:
+		return NewTransfers(t, fs, "")
+	case CreateAccountsFact:
+		return NewCreateAccounts(t, fs, "")
+	case KeyUpdaterFact:
+		return NewKeyUpdater(t, fs, "")
+	case BurnFact:
+		return NewBurn(t, fs, "")
+	case ApproveFact:
+		return NewApprove(t, fs, "")
+	case TransferFromFact:
+		return NewTransferFrom(t, fs, "")
+	case LockTransferFact:
+		return NewLockTransfer(t, fs, "")
+	case ClaimTransferFact:
+		return NewClaimTransfer(t, fs, "")
+	case RefundTransferFact:
+		return NewRefundTransfer(t, fs, "")
+	case CreateVestingAccountsFact:
+		return NewCreateVestingAccounts(t, fs, "")
+	case RecoveryUpdaterFact:
+		return NewRecoveryUpdater(t, fs, "")
+	case RecoverAccountFact:
+		return NewRecoverAccount(t, fs, "")
+	case CancelRecoveryFact:
+		return NewCancelRecovery(t, fs, "")
+	case RegisterAliasFact:
+		return NewRegisterAlias(t, fs, "")
+	case TransferAliasFact:
+		return NewTransferAlias(t, fs, "")
+	case ReleaseAliasFact:
+		return NewReleaseAlias(t, fs, "")
+	case CloseAccountFact:
+		return NewCloseAccount(t, fs, "")
+	default:
+		return nil, xerrors.Errorf("fact can not be proposed, %T", fact)
+	}
+}
@@ -0,0 +1,44 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (pr Proposal) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(pr.Hint()),
+		bson.M{
+			"id":      pr.id,
+			"account": pr.account,
+			"fact":    pr.fact,
+			"signs":   pr.signs,
+			"status":  pr.status,
+		}),
+	)
+}
+
+type ProposalBSONUnpacker struct {
+	ID valuehash.Bytes     `bson:"id"`
+	AC base.AddressDecoder `bson:"account"`
+	FC bson.Raw            `bson:"fact"`
+	SG []bson.Raw          `bson:"signs"`
+	ST ProposalStatus      `bson:"status"`
+}
+
+func (pr *Proposal) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var upr ProposalBSONUnpacker
+	if err := enc.Unmarshal(b, &upr); err != nil {
+		return err
+	}
+
+	sg := make([][]byte, len(upr.SG))
+	for i := range upr.SG {
+		sg[i] = upr.SG[i]
+	}
+
+	return pr.unpack(enc, upr.ID, upr.AC, upr.FC, sg, upr.ST)
+}
@@ -0,0 +1,56 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (pr *Proposal) unpack(
+	enc encoder.Encoder,
+	id valuehash.Hash,
+	bAccount base.AddressDecoder,
+	bFact []byte,
+	bSigns [][]byte,
+	status ProposalStatus,
+) error {
+	if a, err := bAccount.Encode(enc); err != nil {
+		return err
+	} else {
+		pr.account = a
+	}
+
+	if fact, err := decodeProposalFact(enc, bFact); err != nil {
+		return err
+	} else {
+		pr.fact = fact
+	}
+
+	signs := make([]operation.FactSign, len(bSigns))
+	for i := range bSigns {
+		if fs, err := operation.DecodeFactSign(enc, bSigns[i]); err != nil {
+			return err
+		} else {
+			signs[i] = fs
+		}
+	}
+
+	pr.id = id
+	pr.signs = signs
+	pr.status = status
+
+	return nil
+}
+
+func decodeProposalFact(enc encoder.Encoder, b []byte) (operation.OperationFact, error) {
+	if hinter, err := base.DecodeFact(enc, b); err != nil {
+		return nil, err
+	} else if f, ok := hinter.(operation.OperationFact); !ok {
+		return nil, xerrors.Errorf("not OperationFact, %T", hinter)
+	} else {
+		return f, nil
+	}
+}
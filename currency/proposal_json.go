@@ -0,0 +1,52 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type ProposalJSONPacker struct {
+	jsonenc.HintedHead
+	ID valuehash.Hash          `json:"id"`
+	AC base.Address            `json:"account"`
+	FC operation.OperationFact `json:"fact"`
+	SG []operation.FactSign    `json:"signs"`
+	ST ProposalStatus          `json:"status"`
+}
+
+func (pr Proposal) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ProposalJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(pr.Hint()),
+		ID:         pr.id,
+		AC:         pr.account,
+		FC:         pr.fact,
+		SG:         pr.signs,
+		ST:         pr.status,
+	})
+}
+
+type ProposalJSONUnpacker struct {
+	ID valuehash.Bytes     `json:"id"`
+	AC base.AddressDecoder `json:"account"`
+	FC json.RawMessage     `json:"fact"`
+	SG []json.RawMessage   `json:"signs"`
+	ST ProposalStatus      `json:"status"`
+}
+
+func (pr *Proposal) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var upr ProposalJSONUnpacker
+	if err := enc.Unmarshal(b, &upr); err != nil {
+		return err
+	}
+
+	sg := make([][]byte, len(upr.SG))
+	for i := range upr.SG {
+		sg[i] = upr.SG[i]
+	}
+
+	return pr.unpack(enc, upr.ID, upr.AC, upr.FC, sg, upr.ST)
+}
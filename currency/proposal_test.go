@@ -0,0 +1,125 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"github.com/stretchr/testify/suite"
+)
+
+func newTestProposal(sign bool) (Proposal, []key.Privatekey) {
+	sender := NewTestAddress()
+	fact := NewTransfersFact(
+		util.UUID().Bytes(),
+		sender,
+		[]TransfersItem{NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(10), CurrencyID("SHOWME")))},
+	).SetSequence(1)
+
+	pks := []key.Privatekey{key.MustNewBTCPrivatekey(), key.MustNewBTCPrivatekey()}
+
+	var fs []operation.FactSign
+	if sign {
+		for i := range pks {
+			sig, err := operation.NewFactSignature(pks[i], fact, nil)
+			if err != nil {
+				panic(err)
+			}
+
+			fs = append(fs, operation.NewBaseFactSign(pks[i].Publickey(), sig))
+		}
+	}
+
+	return NewProposal(valuehash.RandomSHA256(), sender, fact, fs), pks
+}
+
+type testProposal struct {
+	suite.Suite
+}
+
+func (t *testProposal) TestNew() {
+	pr, _ := newTestProposal(true)
+	t.NoError(pr.IsValid(nil))
+
+	t.Equal(ProposalStatusPending, pr.Status())
+	t.Equal(ProposalStatusExecuted, pr.Execute().Status())
+}
+
+func (t *testProposal) TestEmptySigns() {
+	pr, _ := newTestProposal(false)
+
+	err := pr.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "empty signs")
+}
+
+func (t *testProposal) TestDuplicatedSigner() {
+	pr, _ := newTestProposal(true)
+	pr = pr.Approve(pr.Signs()[0])
+
+	err := pr.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "duplicated signer")
+}
+
+func (t *testProposal) TestWrongAccount() {
+	pr, _ := newTestProposal(true)
+	pr = NewProposal(pr.ID(), NewTestAddress(), pr.Fact(), pr.Signs())
+
+	err := pr.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "account of proposed fact")
+}
+
+func (t *testProposal) TestSigned() {
+	pr, pks := newTestProposal(true)
+
+	t.True(pr.Signed(pks[0].Publickey()))
+	t.False(pr.Signed(key.MustNewBTCPrivatekey().Publickey()))
+}
+
+func TestProposal(t *testing.T) {
+	suite.Run(t, new(testProposal))
+}
+
+func testProposalEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pr, _ := newTestProposal(true)
+
+		return pr
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Proposal)
+		tb := b.(Proposal)
+
+		t.True(ta.ID().Equal(tb.ID()))
+		t.True(ta.Account().Equal(tb.Account()))
+		t.True(ta.Fact().Hash().Equal(tb.Fact().Hash()))
+		t.Equal(ta.Status(), tb.Status())
+		t.Equal(len(ta.Signs()), len(tb.Signs()))
+
+		for i := range ta.Signs() {
+			t.True(ta.Signs()[i].Signer().Equal(tb.Signs()[i].Signer()))
+			t.True(ta.Signs()[i].Signature().Equal(tb.Signs()[i].Signature()))
+		}
+	}
+
+	return t
+}
+
+func TestProposalEncodeJSON(t *testing.T) {
+	suite.Run(t, testProposalEncode(jsonenc.NewEncoder()))
+}
+
+func TestProposalEncodeBSON(t *testing.T) {
+	suite.Run(t, testProposalEncode(bsonenc.NewEncoder()))
+}
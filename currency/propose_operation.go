@@ -0,0 +1,182 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ProposeOperationFactType = hint.MustNewType(0xa0, 0x53, "mitum-currency-propose-operation-fact")
+	ProposeOperationFactHint = hint.MustHint(ProposeOperationFactType, "0.0.1")
+	ProposeOperationType     = hint.MustNewType(0xa0, 0x54, "mitum-currency-propose-operation")
+	ProposeOperationHint     = hint.MustHint(ProposeOperationType, "0.0.1")
+)
+
+// ProposeOperationFact proposes the operation fact of sender account. The
+// signs of ProposeOperation should come from the keys of sender, but they
+// don't need to pass the threshold; the other keys can approve it later by
+// ApproveOperation.
+type ProposeOperationFact struct {
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	fact   operation.OperationFact
+}
+
+func NewProposeOperationFact(
+	token []byte,
+	sender base.Address,
+	fact operation.OperationFact,
+) ProposeOperationFact {
+	pfact := ProposeOperationFact{
+		token:  token,
+		sender: sender,
+		fact:   fact,
+	}
+	pfact.h = pfact.GenerateHash()
+
+	return pfact
+}
+
+func (fact ProposeOperationFact) Hint() hint.Hint {
+	return ProposeOperationFactHint
+}
+
+func (fact ProposeOperationFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ProposeOperationFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ProposeOperationFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ProposeOperationFact) Bytes() []byte {
+	var fb []byte
+	if fact.fact != nil {
+		fb = fact.fact.Hash().Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fb,
+	)
+}
+
+func (fact ProposeOperationFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for ProposeOperationFact")
+	}
+
+	if fact.fact == nil {
+		return xerrors.Errorf("empty proposed fact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.fact,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if err := checkProposalFact(fact.sender, fact.fact); err != nil {
+		return isvalid.InvalidError.Wrap(err)
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ProposeOperationFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ProposeOperationFact) Fact() operation.OperationFact {
+	return fact.fact
+}
+
+func (fact ProposeOperationFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender}
+
+	if i, ok := fact.fact.(Addresses); ok {
+		if bs, err := i.Addresses(); err != nil {
+			return nil, err
+		} else {
+			for j := range bs {
+				if !bs[j].Equal(fact.sender) {
+					as = append(as, bs[j])
+				}
+			}
+		}
+	}
+
+	return as, nil
+}
+
+type ProposeOperation struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewProposeOperation(fact ProposeOperationFact, fs []operation.FactSign, memo string) (ProposeOperation, error) {
+	if bo, err := operation.NewBaseOperationFromFact(ProposeOperationHint, fact, fs); err != nil {
+		return ProposeOperation{}, err
+	} else {
+		op := ProposeOperation{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op ProposeOperation) Hint() hint.Hint {
+	return ProposeOperationHint
+}
+
+func (op ProposeOperation) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op ProposeOperation) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op ProposeOperation) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
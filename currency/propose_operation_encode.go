@@ -0,0 +1,32 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *ProposeOperationFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	bFact []byte,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.sender = a
+	}
+
+	if i, err := decodeProposalFact(enc, bFact); err != nil {
+		return err
+	} else {
+		fact.fact = i
+	}
+
+	fact.h = h
+	fact.token = token
+
+	return nil
+}
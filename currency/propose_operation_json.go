@@ -0,0 +1,69 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type ProposeOperationFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash          `json:"hash"`
+	TK []byte                  `json:"token"`
+	SD base.Address            `json:"sender"`
+	FC operation.OperationFact `json:"fact"`
+}
+
+func (fact ProposeOperationFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ProposeOperationFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		FC:         fact.fact,
+	})
+}
+
+type ProposeOperationFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	FC json.RawMessage     `json:"fact"`
+}
+
+func (fact *ProposeOperationFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact ProposeOperationFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.FC)
+}
+
+func (op ProposeOperation) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *ProposeOperation) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = ProposeOperation{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op ProposeOperation) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type ProposeOperationProcessor struct {
+	ProposeOperation
+	proposalExecutor
+}
+
+func NewProposeOperationProcessor(_ *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(ProposeOperation); !ok {
+			return nil, xerrors.Errorf("not ProposeOperation, %T", op)
+		} else {
+			return &ProposeOperationProcessor{
+				ProposeOperation: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *ProposeOperationProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ProposeOperationFact)
+
+	var keys Keys
+	if i, err := loadProposalKeys(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, err
+	} else {
+		keys = i
+	}
+
+	if st, err := notExistsState(StateKeyProposal(fact.Hash()), "proposal", getState); err != nil {
+		return nil, err
+	} else if err := opp.prepare(st, NewProposal(fact.Hash(), fact.sender, fact.fact, opp.Signs()), keys); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	}
+
+	return opp, nil
+}
+
+func (opp *ProposeOperationProcessor) Process(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	if sts, err := opp.process(getState); err != nil {
+		return err
+	} else {
+		return setState(opp.Fact().Hash(), sts...)
+	}
+}
+
+// proposalExecutor keeps the Proposal state and, when the signs pass the
+// threshold, the proposed operation and it's processor.
+type proposalExecutor struct {
+	sp  state.State
+	pr  Proposal
+	op  operation.Operation
+	opp state.Processor
+}
+
+func (pe *proposalExecutor) proposed() operation.Operation {
+	return pe.op
+}
+
+func (pe *proposalExecutor) setProposed(opp state.Processor) {
+	pe.opp = opp
+}
+
+func (pe *proposalExecutor) prepare(st state.State, pr Proposal, keys Keys) error {
+	pe.sp = st
+	pe.pr = pr
+
+	if proposedWeight(pr.Signs(), keys) >= keys.Threshold() {
+		if op, err := newProposedOperation(pr.Fact()); err != nil {
+			return err
+		} else {
+			pe.op = op
+		}
+	}
+
+	return nil
+}
+
+// process processes the proposed operation and returns it's states with the
+// updated Proposal state.
+func (pe *proposalExecutor) process(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	var sts []state.State
+
+	pr := pe.pr
+	if pe.opp != nil {
+		if err := pe.opp.Process(getState, func(_ valuehash.Hash, s ...state.State) error {
+			sts = append(sts, s...)
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		pr = pr.Execute()
+	}
+
+	if st, err := SetStateProposalValue(pe.sp, pr); err != nil {
+		return nil, err
+	} else {
+		return append(sts, st), nil
+	}
+}
+
+// loadProposalKeys loads the keys of account and checks the signers are the
+// keys of account; unlike checkFactSignsByState, the signs don't need to pass
+// the threshold.
+func loadProposalKeys(
+	account base.Address,
+	fs []operation.FactSign,
+	getState func(key string) (state.State, bool, error),
+) (Keys, error) {
+	var keys Keys
+	if st, err := existsAccountState(account, "keys of account", getState); err != nil {
+		return Keys{}, err
+	} else if ks, err := StateKeysValue(st); err != nil {
+		return Keys{}, util.IgnoreError.Wrap(err)
+	} else {
+		keys = ks
+	}
+
+	for i := range fs {
+		if _, found := keys.Key(fs[i].Signer()); !found {
+			return Keys{}, util.IgnoreError.Errorf("invalid signing: unknown key found, %s", fs[i].Signer())
+		}
+	}
+
+	return keys, nil
+}
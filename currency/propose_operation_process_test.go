@@ -0,0 +1,298 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testProposeOperationOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testProposeOperationOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testProposeOperationOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	copr, err = copr.(*OperationProcessor).SetProcessor(ProposeOperation{}, NewProposeOperationProcessor(cp))
+	t.NoError(err)
+
+	copr, err = copr.(*OperationProcessor).SetProcessor(ApproveOperation{}, NewApproveOperationProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testProposeOperationOperations) newTransfersFact(sender, receiver base.Address, big Big) TransfersFact {
+	return NewTransfersFact(
+		util.UUID().Bytes(),
+		sender,
+		[]TransfersItem{NewTransfersItemSingleAmount(receiver, NewAmount(big, t.cid))},
+	).SetSequence(1)
+}
+
+func (t *testProposeOperationOperations) newProposeOperation(
+	sender base.Address,
+	keys []key.Privatekey,
+	fact operation.OperationFact,
+) ProposeOperation {
+	token := util.UUID().Bytes()
+	pfact := NewProposeOperationFact(token, sender, fact)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, pfact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewProposeOperation(pfact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testProposeOperationOperations) TestPending() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{50, 50}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newProposeOperation(sa, privs[:1], t.newTransfersFact(sa, ra.Address, NewBig(10)))
+	t.NoError(opr.Process(op))
+
+	var pst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyProposal(op.Fact().Hash()):
+			pst = st.GetState()
+		case StateKeyBalance(sa, t.cid), StateKeyBalance(ra.Address, t.cid):
+			t.Fail("balance should not be updated")
+		}
+	}
+
+	pr, err := StateProposalValue(pst)
+	t.NoError(err)
+	t.Equal(ProposalStatusPending, pr.Status())
+	t.True(pr.Account().Equal(sa))
+	t.Equal(1, len(pr.Signs()))
+	t.True(pr.Signed(privs[0].Publickey()))
+}
+
+func (t *testProposeOperationOperations) TestExecuted() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{50, 50}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newProposeOperation(sa, privs, t.newTransfersFact(sa, ra.Address, NewBig(10)))
+	t.NoError(opr.Process(op))
+
+	var pst, sst, rst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyProposal(op.Fact().Hash()):
+			pst = st.GetState()
+		case StateKeyBalance(sa, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		}
+	}
+
+	pr, err := StateProposalValue(pst)
+	t.NoError(err)
+	t.Equal(ProposalStatusExecuted, pr.Status())
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(23)))
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(NewBig(10)))
+}
+
+func (t *testProposeOperationOperations) TestProposedWithoutSigns() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{50, 50}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	fact := t.newTransfersFact(sa, ra.Address, NewBig(10))
+	op := t.newProposeOperation(sa, privs, fact)
+
+	pop, err := opr.(*OperationProcessor).PreProcess(op)
+	t.NoError(err)
+
+	proposed := pop.(*ProposeOperationProcessor).proposed()
+	t.NotNil(proposed)
+	t.True(proposed.Fact().Hash().Equal(fact.Hash()))
+	t.Empty(proposed.Signs())
+}
+
+func (t *testProposeOperationOperations) TestDuplicatedPropose() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{34, 33, 33}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	pfact := NewProposeOperationFact(util.UUID().Bytes(), sa, t.newTransfersFact(sa, ra.Address, NewBig(10)))
+
+	for i, pk := range privs[:2] {
+		sig, err := operation.NewFactSignature(pk, pfact, nil)
+		t.NoError(err)
+
+		op, err := NewProposeOperation(pfact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+
+		if i == 0 {
+			t.NoError(opr.Process(op))
+
+			continue
+		}
+
+		err = opr.Process(op)
+		t.True(xerrors.Is(err, util.IgnoreError))
+		t.Contains(err.Error(), "duplication found")
+	}
+}
+
+func (t *testProposeOperationOperations) TestSameSenderWithTransfers() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{50, 50}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool).(*OperationProcessor)
+
+	fact := t.newTransfersFact(sa, ra.Address, NewBig(10))
+	var fs []operation.FactSign
+	for _, pk := range privs {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	top, err := NewTransfers(fact, fs, "")
+	t.NoError(err)
+
+	pop := t.newProposeOperation(sa, privs, t.newTransfersFact(sa, ra.Address, NewBig(10)).SetSequence(2))
+
+	tpr, err := opr.PreProcess(top)
+	t.NoError(err)
+	ppr, err := opr.PreProcess(pop)
+	t.NoError(err)
+
+	// NOTE Statepool keeps the state, which is set first, but the sequence of
+	// the proposed operation, which is pre-processed last, should be kept.
+	t.NoError(opr.Process(tpr))
+	t.NoError(opr.Process(ppr))
+
+	result := map[string]state.State{}
+	for _, st := range pool.Updates() {
+		result[st.Key()] = st.GetState()
+	}
+
+	sb, err := StateBalanceValue(result[StateKeyBalance(sa, t.cid)])
+	t.NoError(err)
+	t.True(sb.Big().Equal(NewBig(13)))
+
+	sq, err := StateSequenceValue(result[StateKeySequence(sa)])
+	t.NoError(err)
+	t.Equal(uint64(3), sq.Next())
+}
+
+func (t *testProposeOperationOperations) TestUnknownKey() {
+	sa, _, st0 := t.newMultiKeysAccount([]uint{50, 50}, 100, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newProposeOperation(sa, ra.Privs(), t.newTransfersFact(sa, ra.Address, NewBig(10)))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "unknown key found")
+}
+
+func (t *testProposeOperationOperations) TestExecutedButFailed() {
+	sa, privs, st0 := t.newMultiKeysAccount([]uint{50, 50}, 100, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newProposeOperation(sa, privs, t.newTransfersFact(sa, ra.Address, NewBig(10)))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func TestProposeOperationOperations(t *testing.T) {
+	suite.Run(t, new(testProposeOperationOperations))
+}
@@ -0,0 +1,137 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testProposeOperation struct {
+	baseTest
+}
+
+func (t *testProposeOperation) newTransfersFact(sender base.Address) TransfersFact {
+	return NewTransfersFact(
+		util.UUID().Bytes(),
+		sender,
+		[]TransfersItem{NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(10), CurrencyID("SHOWME")))},
+	).SetSequence(1)
+}
+
+func (t *testProposeOperation) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+	sender := NewTestAddress()
+
+	token := util.UUID().Bytes()
+	fact := NewProposeOperationFact(token, sender, t.newTransfersFact(sender))
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewProposeOperation(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testProposeOperation) TestNotSender() {
+	token := util.UUID().Bytes()
+	fact := NewProposeOperationFact(token, NewTestAddress(), t.newTransfersFact(NewTestAddress()))
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "account of proposed fact")
+}
+
+func (t *testProposeOperation) TestWithFeePayer() {
+	sender := NewTestAddress()
+
+	token := util.UUID().Bytes()
+	fact := NewProposeOperationFact(token, sender, t.newTransfersFact(sender).SetFeePayer(NewTestAddress()))
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "fee payer not supported")
+}
+
+func (t *testProposeOperation) TestNotProposable() {
+	sender := NewTestAddress()
+
+	token := util.UUID().Bytes()
+	inner := NewProposeOperationFact(util.UUID().Bytes(), sender, t.newTransfersFact(sender))
+	fact := NewProposeOperationFact(token, sender, inner)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "can not be proposed")
+}
+
+func TestProposeOperation(t *testing.T) {
+	suite.Run(t, new(testProposeOperation))
+}
+
+func testProposeOperationEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+		sender := NewTestAddress()
+
+		inner := NewTransfersFact(
+			util.UUID().Bytes(),
+			sender,
+			[]TransfersItem{NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(10), CurrencyID("SHOWME")))},
+		).SetSequence(1)
+
+		token := util.UUID().Bytes()
+		fact := NewProposeOperationFact(token, sender, inner)
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewProposeOperation(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(ProposeOperation)
+		tb := b.(ProposeOperation)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(ProposeOperationFact)
+		ufact := tb.Fact().(ProposeOperationFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.fact.Hash().Equal(ufact.fact.Hash()))
+		t.IsType(fact.fact, ufact.fact)
+	}
+
+	return t
+}
+
+func TestProposeOperationEncodeJSON(t *testing.T) {
+	suite.Run(t, testProposeOperationEncode(jsonenc.NewEncoder()))
+}
+
+func TestProposeOperationEncodeBSON(t *testing.T) {
+	suite.Run(t, testProposeOperationEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,179 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RecoverAccountFactType = hint.MustNewType(0xa0, 0x5b, "mitum-currency-recover-account-operation-fact")
+	RecoverAccountFactHint = hint.MustHint(RecoverAccountFactType, "0.0.1")
+	RecoverAccountType     = hint.MustNewType(0xa0, 0x5c, "mitum-currency-recover-account-operation")
+	RecoverAccountHint     = hint.MustHint(RecoverAccountType, "0.0.1")
+)
+
+// RecoverAccountFact requests the new keys of target by the guardian, sender.
+// When the quorum of guardians request the same keys and the delay of
+// RecoveryConfig is passed, the keys of target are replaced. The keys are
+// replaced only by processing RecoverAccount, so when the quorum is reached
+// before the delay, one of the guardians should send it again after the delay.
+// The fee is charged to sender.
+type RecoverAccountFact struct {
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	target   base.Address
+	keys     Keys
+	currency CurrencyID
+}
+
+func NewRecoverAccountFact(
+	token []byte,
+	sender base.Address,
+	target base.Address,
+	keys Keys,
+	currency CurrencyID,
+) RecoverAccountFact {
+	fact := RecoverAccountFact{
+		token:    token,
+		sender:   sender,
+		target:   target,
+		keys:     keys,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RecoverAccountFact) Hint() hint.Hint {
+	return RecoverAccountFactHint
+}
+
+func (fact RecoverAccountFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RecoverAccountFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RecoverAccountFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RecoverAccountFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.target.Bytes(),
+		fact.keys.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RecoverAccountFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for RecoverAccountFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.target,
+		fact.keys,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.target) {
+		return xerrors.Errorf("target is same with sender, %q", fact.sender)
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact RecoverAccountFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RecoverAccountFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact RecoverAccountFact) Keys() Keys {
+	return fact.keys
+}
+
+func (fact RecoverAccountFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact RecoverAccountFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.target}, nil
+}
+
+type RecoverAccount struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewRecoverAccount(fact RecoverAccountFact, fs []operation.FactSign, memo string) (RecoverAccount, error) {
+	if bo, err := operation.NewBaseOperationFromFact(RecoverAccountHint, fact, fs); err != nil {
+		return RecoverAccount{}, err
+	} else {
+		op := RecoverAccount{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op RecoverAccount) Hint() hint.Hint {
+	return RecoverAccountHint
+}
+
+func (op RecoverAccount) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op RecoverAccount) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op RecoverAccount) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
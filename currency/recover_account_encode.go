@@ -0,0 +1,45 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *RecoverAccountFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	bTarget base.AddressDecoder,
+	bks []byte,
+	cr string,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.sender = a
+	}
+
+	if a, err := bTarget.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.target = a
+	}
+
+	if hinter, err := enc.DecodeByHint(bks); err != nil {
+		return err
+	} else if k, ok := hinter.(Keys); !ok {
+		return xerrors.Errorf("not Keys: %T", hinter)
+	} else {
+		fact.keys = k
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.currency = CurrencyID(cr)
+
+	return nil
+}
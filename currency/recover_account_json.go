@@ -0,0 +1,75 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type RecoverAccountFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	TG base.Address   `json:"target"`
+	KS Keys           `json:"keys"`
+	CR CurrencyID     `json:"currency"`
+}
+
+func (fact RecoverAccountFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RecoverAccountFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		TG:         fact.target,
+		KS:         fact.keys,
+		CR:         fact.currency,
+	})
+}
+
+type RecoverAccountFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	TG base.AddressDecoder `json:"target"`
+	KS json.RawMessage     `json:"keys"`
+	CR string              `json:"currency"`
+}
+
+func (fact *RecoverAccountFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact RecoverAccountFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.TG, ufact.KS, ufact.CR)
+}
+
+func (op RecoverAccount) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *RecoverAccount) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = RecoverAccount{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op RecoverAccount) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type RecoverAccountProcessor struct {
+	cp *CurrencyPool
+	RecoverAccount
+	proposable
+	height base.Height
+	sa     state.State
+	sr     state.State
+	rv     Recovery
+	ready  bool
+	sb     AmountState
+	fee    Big
+}
+
+func NewRecoverAccountProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(RecoverAccount); !ok {
+			return nil, xerrors.Errorf("not RecoverAccount, %T", op)
+		} else {
+			return &RecoverAccountProcessor{
+				cp:             cp,
+				RecoverAccount: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *RecoverAccountProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *RecoverAccountProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RecoverAccountFact)
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if st, err := existsAccountState(fact.target, "target keys", getState); err != nil {
+		return nil, err
+	} else {
+		opp.sa = st
+	}
+
+	if err := checkNotFrozen(fact.target, fact.currency, false, getState); err != nil {
+		return nil, err
+	}
+
+	var config RecoveryConfig
+	if st, err := existsState(StateKeyRecoveryConfig(fact.target), "recovery config of target", getState); err != nil {
+		return nil, err
+	} else if rc, err := StateRecoveryConfigValue(st); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else if !rc.IsGuardian(fact.sender) {
+		return nil, util.IgnoreError.Errorf("sender is not guardian of target, %q", fact.sender)
+	} else {
+		config = rc
+	}
+
+	if err := opp.checkFactSigns(fact.sender, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	if err := opp.prepareRecovery(config, getState); err != nil {
+		return nil, err
+	}
+
+	if sb, fee, err := loadOperationFee(
+		opp.cp, fact.sender, fact.currency, RecoverAccountType, opp.height, getState,
+	); err != nil {
+		return nil, err
+	} else {
+		opp.sb = sb
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *RecoverAccountProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RecoverAccountFact)
+
+	var sts []state.State
+	if opp.ready {
+		opp.rv = opp.rv.Recover()
+
+		if st, err := SetStateKeysValue(opp.sa, fact.keys); err != nil {
+			return err
+		} else {
+			sts = append(sts, st)
+		}
+	}
+
+	if st, err := SetStateRecoveryValue(opp.sr, opp.rv); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+
+	return setState(fact.Hash(), append(sts, opp.sb)...)
+}
+
+// prepareRecovery starts the new Recovery or approves the pending Recovery.
+// When the quorum of guardians agree and the delay is passed, the keys of
+// target will be replaced.
+func (opp *RecoverAccountProcessor) prepareRecovery(
+	config RecoveryConfig,
+	getState func(key string) (state.State, bool, error),
+) error {
+	fact := opp.Fact().(RecoverAccountFact)
+
+	switch st, found, err := getState(StateKeyRecovery(fact.target)); {
+	case err != nil:
+		return err
+	case found:
+		opp.sr = st
+
+		if rv, err := StateRecoveryValue(st); err != nil {
+			return util.IgnoreError.Wrap(err)
+		} else if rv.Status() == RecoveryStatusPending {
+			opp.rv = rv
+		}
+	default:
+		opp.sr = st
+	}
+
+	if opp.rv.Status() != RecoveryStatusPending {
+		if ks, err := StateKeysValue(opp.sa); err != nil {
+			return util.IgnoreError.Wrap(err)
+		} else if ks.Equal(fact.keys) {
+			return util.IgnoreError.Errorf("same Keys with the existing")
+		}
+
+		opp.rv = NewRecovery(fact.target, fact.keys, fact.sender, opp.height)
+	} else {
+		switch {
+		case !opp.rv.Keys().Equal(fact.keys):
+			return util.IgnoreError.Errorf("keys are different with the pending recovery")
+		case !opp.rv.Approved(fact.sender):
+			opp.rv = opp.rv.Approve(fact.sender)
+		case !opp.rv.Ready(config, opp.height):
+			if h := opp.rv.Height() + config.Delay(); opp.height < h {
+				return util.IgnoreError.Errorf("already approved by %q; send again after height %v", fact.sender, h)
+			}
+
+			return util.IgnoreError.Errorf("already approved by %q", fact.sender)
+		}
+	}
+
+	opp.ready = opp.rv.Ready(config, opp.height)
+
+	return nil
+}
@@ -0,0 +1,464 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testRecoverAccountOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testRecoverAccountOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testRecoverAccountOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(RecoverAccount{}, NewRecoverAccountProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testRecoverAccountOperations) newRecoverAccount(
+	sender, target base.Address,
+	keys Keys,
+	pks []key.Privatekey,
+) RecoverAccount {
+	token := util.UUID().Bytes()
+	fact := NewRecoverAccountFact(token, sender, target, keys, t.cid)
+
+	var fs []operation.FactSign
+	for _, pk := range pks {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewRecoverAccount(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testRecoverAccountOperations) updates(pool *storage.Statepool, target, sender base.Address) (
+	state.State, state.State, state.State,
+) {
+	var kst, rst, bst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyAccount(target):
+			kst = st.GetState()
+		case StateKeyRecovery(target):
+			rst = st.GetState()
+		case StateKeyBalance(sender, t.cid):
+			bst = st.GetState()
+		}
+	}
+
+	return kst, rst, bst
+}
+
+func (t *testRecoverAccountOperations) TestStart() {
+	fa, st0 := t.newAccount(true, nil)
+	ta, st1 := t.newAccount(true, nil)
+	ga, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	gb, st3 := t.newAccount(true, nil)
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+
+	rc := NewRecoveryConfig([]base.Address{ga.Address, gb.Address}, 2, base.Height(0))
+	pool, _ := t.statepool(st0, st1, st2, st3, []state.State{dst, t.newRecoveryConfigState(ta.Address, rc)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	keys := newTestRecoveryKeys()
+	op := t.newRecoverAccount(ga.Address, ta.Address, keys, ga.Privs())
+	t.NoError(opr.Process(op))
+
+	kst, rst, bst := t.updates(pool, ta.Address, ga.Address)
+	t.Nil(kst)
+
+	urv, err := StateRecoveryValue(rst)
+	t.NoError(err)
+	t.Equal(RecoveryStatusPending, urv.Status())
+	t.True(urv.Keys().Equal(keys))
+	t.True(urv.Approved(ga.Address))
+	t.Equal(t.height(), urv.Height())
+
+	bstv, _ := StateBalanceValue(bst)
+	t.True(bstv.Big().Equal(NewBig(8)))
+	t.True(bst.(AmountState).Fee().Equal(fee))
+}
+
+func (t *testRecoverAccountOperations) TestRecovered() {
+	ta, st0 := t.newAccount(true, nil)
+	ga, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	rc := NewRecoveryConfig([]base.Address{ga.Address}, 1, base.Height(0))
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newRecoveryConfigState(ta.Address, rc)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	keys := newTestRecoveryKeys()
+	op := t.newRecoverAccount(ga.Address, ta.Address, keys, ga.Privs())
+	t.NoError(opr.Process(op))
+
+	kst, rst, _ := t.updates(pool, ta.Address, ga.Address)
+
+	uks, err := StateKeysValue(kst)
+	t.NoError(err)
+	t.True(uks.Equal(keys))
+
+	urv, err := StateRecoveryValue(rst)
+	t.NoError(err)
+	t.Equal(RecoveryStatusRecovered, urv.Status())
+}
+
+func (t *testRecoverAccountOperations) TestApproveAfterDelay() {
+	ta, st0 := t.newAccount(true, nil)
+	ga, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	gb, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	delay := base.Height(3)
+	rc := NewRecoveryConfig([]base.Address{ga.Address, gb.Address}, 2, delay)
+
+	keys := newTestRecoveryKeys()
+	rv := NewRecovery(ta.Address, keys, ga.Address, t.height()-delay)
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{
+		dst,
+		t.newRecoveryConfigState(ta.Address, rc),
+		t.newRecoveryState(rv),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRecoverAccount(gb.Address, ta.Address, keys, gb.Privs())
+	t.NoError(opr.Process(op))
+
+	kst, rst, _ := t.updates(pool, ta.Address, gb.Address)
+
+	uks, err := StateKeysValue(kst)
+	t.NoError(err)
+	t.True(uks.Equal(keys))
+
+	urv, err := StateRecoveryValue(rst)
+	t.NoError(err)
+	t.Equal(RecoveryStatusRecovered, urv.Status())
+	t.True(urv.Approved(ga.Address))
+	t.True(urv.Approved(gb.Address))
+}
+
+func (t *testRecoverAccountOperations) TestApproveBeforeDelay() {
+	ta, st0 := t.newAccount(true, nil)
+	ga, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	gb, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	delay := base.Height(3)
+	rc := NewRecoveryConfig([]base.Address{ga.Address, gb.Address}, 2, delay)
+
+	keys := newTestRecoveryKeys()
+	rv := NewRecovery(ta.Address, keys, ga.Address, t.height()-1)
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{
+		dst,
+		t.newRecoveryConfigState(ta.Address, rc),
+		t.newRecoveryState(rv),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRecoverAccount(gb.Address, ta.Address, keys, gb.Privs())
+	t.NoError(opr.Process(op))
+
+	kst, rst, _ := t.updates(pool, ta.Address, gb.Address)
+	t.Nil(kst)
+
+	urv, err := StateRecoveryValue(rst)
+	t.NoError(err)
+	t.Equal(RecoveryStatusPending, urv.Status())
+	t.True(urv.Approved(gb.Address))
+}
+
+func (t *testRecoverAccountOperations) TestNotGuardian() {
+	ta, st0 := t.newAccount(true, nil)
+	ga, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	gb, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	rc := NewRecoveryConfig([]base.Address{ga.Address}, 1, base.Height(0))
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst, t.newRecoveryConfigState(ta.Address, rc)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRecoverAccount(gb.Address, ta.Address, newTestRecoveryKeys(), gb.Privs())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "sender is not guardian of target")
+}
+
+func (t *testRecoverAccountOperations) TestTargetFrozen() {
+	ta, st0 := t.newAccount(true, nil)
+	ga, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	rc := NewRecoveryConfig([]base.Address{ga.Address}, 1, base.Height(0))
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst,
+		t.newRecoveryConfigState(ta.Address, rc),
+		t.newFreezeState(NewFreeze(ta.Address, "", false)),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRecoverAccount(ga.Address, ta.Address, newTestRecoveryKeys(), ga.Privs())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testRecoverAccountOperations) TestNoConfig() {
+	ta, st0 := t.newAccount(true, nil)
+	ga, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRecoverAccount(ga.Address, ta.Address, newTestRecoveryKeys(), ga.Privs())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "recovery config of target")
+}
+
+func (t *testRecoverAccountOperations) TestDifferentKeys() {
+	ta, st0 := t.newAccount(true, nil)
+	ga, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	gb, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	rc := NewRecoveryConfig([]base.Address{ga.Address, gb.Address}, 2, base.Height(0))
+	rv := NewRecovery(ta.Address, newTestRecoveryKeys(), ga.Address, t.height())
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{
+		dst,
+		t.newRecoveryConfigState(ta.Address, rc),
+		t.newRecoveryState(rv),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRecoverAccount(gb.Address, ta.Address, newTestRecoveryKeys(), gb.Privs())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "keys are different with the pending recovery")
+}
+
+func (t *testRecoverAccountOperations) TestAlreadyApproved() {
+	ta, st0 := t.newAccount(true, nil)
+	ga, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	gb, st2 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	rc := NewRecoveryConfig([]base.Address{ga.Address, gb.Address}, 2, base.Height(0))
+
+	keys := newTestRecoveryKeys()
+	rv := NewRecovery(ta.Address, keys, ga.Address, t.height())
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{
+		dst,
+		t.newRecoveryConfigState(ta.Address, rc),
+		t.newRecoveryState(rv),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRecoverAccount(ga.Address, ta.Address, keys, ga.Privs())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "already approved")
+}
+
+// processAt processes op at the given height with the states of the previous
+// blocks and returns the states with the updated states.
+func (t *testRecoverAccountOperations) processAt(
+	cp *CurrencyPool,
+	height base.Height,
+	sts []state.State,
+	op RecoverAccount,
+) ([]state.State, error) {
+	pool, _ := t.statepool(sts)
+
+	i, err := NewRecoverAccountProcessor(cp)(op)
+	t.NoError(err)
+
+	opp := i.(*RecoverAccountProcessor)
+	opp.setHeight(height)
+
+	if _, err := opp.PreProcess(pool.Get, pool.Set); err != nil {
+		return nil, err
+	} else if err := opp.Process(pool.Get, pool.Set); err != nil {
+		return nil, err
+	}
+
+	updated := map[string]state.State{}
+	for _, st := range pool.Updates() {
+		updated[st.Key()] = st.GetState()
+	}
+
+	nsts := make([]state.State, len(sts))
+	for i := range sts {
+		if st, found := updated[sts[i].Key()]; found {
+			nsts[i] = st
+			delete(updated, sts[i].Key())
+		} else {
+			nsts[i] = sts[i]
+		}
+	}
+
+	for _, st := range updated {
+		nsts = append(nsts, st)
+	}
+
+	return nsts, nil
+}
+
+func (t *testRecoverAccountOperations) TestSendAgainAfterDelay() {
+	ta, st0 := t.newAccount(true, nil)
+	ga, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	gb, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	delay := base.Height(3)
+	rc := NewRecoveryConfig([]base.Address{ga.Address, gb.Address}, 2, delay)
+
+	var sts []state.State
+	for _, l := range [][]state.State{st0, st1, st2, {dst, t.newRecoveryConfigState(ta.Address, rc)}} {
+		sts = append(sts, l...)
+	}
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	keys := newTestRecoveryKeys()
+	height := base.Height(10)
+
+	recovery := func(sts []state.State) (Keys, Recovery) {
+		var ks Keys
+		var rv Recovery
+		for _, st := range sts {
+			switch st.Key() {
+			case StateKeyAccount(ta.Address):
+				i, err := StateKeysValue(st)
+				t.NoError(err)
+				ks = i
+			case StateKeyRecovery(ta.Address):
+				i, err := StateRecoveryValue(st)
+				t.NoError(err)
+				rv = i
+			}
+		}
+
+		return ks, rv
+	}
+
+	// NOTE ga starts and gb approves before the delay
+	sts, err := t.processAt(cp, height, sts, t.newRecoverAccount(ga.Address, ta.Address, keys, ga.Privs()))
+	t.NoError(err)
+
+	sts, err = t.processAt(cp, height+1, sts, t.newRecoverAccount(gb.Address, ta.Address, keys, gb.Privs()))
+	t.NoError(err)
+
+	uks, urv := recovery(sts)
+	t.False(uks.Equal(keys))
+	t.Equal(RecoveryStatusPending, urv.Status())
+	t.True(urv.Approved(ga.Address))
+	t.True(urv.Approved(gb.Address))
+
+	// NOTE sending again before the delay is rejected
+	_, err = t.processAt(cp, height+delay-1, sts, t.newRecoverAccount(ga.Address, ta.Address, keys, ga.Privs()))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "already approved")
+	t.Contains(err.Error(), "send again after height")
+
+	// NOTE sending again after the delay replaces the keys
+	sts, err = t.processAt(cp, height+delay, sts, t.newRecoverAccount(ga.Address, ta.Address, keys, ga.Privs()))
+	t.NoError(err)
+
+	uks, urv = recovery(sts)
+	t.True(uks.Equal(keys))
+	t.Equal(RecoveryStatusRecovered, urv.Status())
+}
+
+func TestRecoverAccountOperations(t *testing.T) {
+	suite.Run(t, new(testRecoverAccountOperations))
+}
@@ -0,0 +1,104 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testRecoverAccount struct {
+	baseTest
+}
+
+func (t *testRecoverAccount) newOperation(sender, target base.Address) RecoverAccount {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewRecoverAccountFact(token, sender, target, newTestRecoveryKeys(), t.cid)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewRecoverAccount(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testRecoverAccount) TestNew() {
+	op := t.newOperation(NewTestAddress(), NewTestAddress())
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testRecoverAccount) TestSameSenderAndTarget() {
+	a := NewTestAddress()
+	op := t.newOperation(a, a)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "target is same with sender")
+}
+
+func TestRecoverAccount(t *testing.T) {
+	suite.Run(t, new(testRecoverAccount))
+}
+
+func testRecoverAccountEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewRecoverAccountFact(token, NewTestAddress(), NewTestAddress(), newTestRecoveryKeys(), CurrencyID("SHOWME"))
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewRecoverAccount(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(RecoverAccount)
+		tb := b.(RecoverAccount)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(RecoverAccountFact)
+		ufact := tb.Fact().(RecoverAccountFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.target.Equal(ufact.target))
+		t.True(fact.keys.Equal(ufact.keys))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestRecoverAccountEncodeJSON(t *testing.T) {
+	suite.Run(t, testRecoverAccountEncode(jsonenc.NewEncoder()))
+}
+
+func TestRecoverAccountEncodeBSON(t *testing.T) {
+	suite.Run(t, testRecoverAccountEncode(bsonenc.NewEncoder()))
+}
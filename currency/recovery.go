@@ -0,0 +1,282 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RecoveryConfigType = hint.MustNewType(0xa0, 0x57, "mitum-currency-recovery-config")
+	RecoveryConfigHint = hint.MustHint(RecoveryConfigType, "0.0.1")
+	RecoveryType       = hint.MustNewType(0xa0, 0x58, "mitum-currency-recovery")
+	RecoveryHint       = hint.MustHint(RecoveryType, "0.0.1")
+)
+
+// RecoveryConfig is the guardians of account. When the quorum of guardians
+// agree with the new keys by RecoverAccount, the keys of account are replaced
+// after delay in blocks.
+type RecoveryConfig struct {
+	guardians []base.Address
+	quorum    uint
+	delay     base.Height
+}
+
+func NewRecoveryConfig(guardians []base.Address, quorum uint, delay base.Height) RecoveryConfig {
+	return RecoveryConfig{guardians: guardians, quorum: quorum, delay: delay}
+}
+
+func (rc RecoveryConfig) Hint() hint.Hint {
+	return RecoveryConfigHint
+}
+
+func (rc RecoveryConfig) Bytes() []byte {
+	bs := make([][]byte, len(rc.guardians)+2)
+	bs[0] = util.UintToBytes(rc.quorum)
+	bs[1] = rc.delay.Bytes()
+
+	for i := range rc.guardians {
+		bs[i+2] = rc.guardians[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (rc RecoveryConfig) Hash() valuehash.Hash {
+	return rc.GenerateHash()
+}
+
+func (rc RecoveryConfig) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(rc.Bytes())
+}
+
+func (rc RecoveryConfig) IsValid([]byte) error {
+	if len(rc.guardians) < 1 {
+		return xerrors.Errorf("empty guardians")
+	}
+
+	founds := map[string]struct{}{}
+	for i := range rc.guardians {
+		g := rc.guardians[i]
+		if err := g.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid guardian: %w", err)
+		}
+
+		if _, found := founds[g.String()]; found {
+			return xerrors.Errorf("duplicated guardian found, %q", g)
+		}
+
+		founds[g.String()] = struct{}{}
+	}
+
+	if rc.quorum < 1 || rc.quorum > uint(len(rc.guardians)) {
+		return xerrors.Errorf("quorum should be between 1 and the number of guardians, %d", rc.quorum)
+	}
+
+	if rc.delay < 0 {
+		return xerrors.Errorf("delay should not be negative, %v", rc.delay)
+	}
+
+	return nil
+}
+
+func (rc RecoveryConfig) Guardians() []base.Address {
+	return rc.guardians
+}
+
+func (rc RecoveryConfig) Quorum() uint {
+	return rc.quorum
+}
+
+func (rc RecoveryConfig) Delay() base.Height {
+	return rc.delay
+}
+
+func (rc RecoveryConfig) IsGuardian(a base.Address) bool {
+	for i := range rc.guardians {
+		if rc.guardians[i].Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type RecoveryStatus string
+
+const (
+	RecoveryStatusPending   RecoveryStatus = "pending"
+	RecoveryStatusRecovered RecoveryStatus = "recovered"
+	RecoveryStatusCancelled RecoveryStatus = "cancelled"
+)
+
+func (rs RecoveryStatus) Bytes() []byte {
+	return []byte(rs)
+}
+
+func (rs RecoveryStatus) String() string {
+	return string(rs)
+}
+
+func (rs RecoveryStatus) IsValid([]byte) error {
+	switch rs {
+	case RecoveryStatusPending, RecoveryStatusRecovered, RecoveryStatusCancelled:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown recovery status, %q", rs)
+	}
+}
+
+// Recovery is the new keys of target, which are requested by guardians. It is
+// started at height and the original keys of target can cancel it by
+// CancelRecovery until it is recovered.
+type Recovery struct {
+	target    base.Address
+	keys      Keys
+	guardians []base.Address
+	height    base.Height
+	status    RecoveryStatus
+}
+
+func NewRecovery(target base.Address, keys Keys, guardian base.Address, height base.Height) Recovery {
+	return Recovery{
+		target:    target,
+		keys:      keys,
+		guardians: []base.Address{guardian},
+		height:    height,
+		status:    RecoveryStatusPending,
+	}
+}
+
+func (rv Recovery) Hint() hint.Hint {
+	return RecoveryHint
+}
+
+func (rv Recovery) Bytes() []byte {
+	bs := make([][]byte, len(rv.guardians)+4)
+	bs[0] = rv.target.Bytes()
+	bs[1] = rv.keys.Bytes()
+	bs[2] = rv.height.Bytes()
+	bs[3] = rv.status.Bytes()
+
+	for i := range rv.guardians {
+		bs[i+4] = rv.guardians[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (rv Recovery) Hash() valuehash.Hash {
+	return rv.GenerateHash()
+}
+
+func (rv Recovery) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(rv.Bytes())
+}
+
+func (rv Recovery) IsValid([]byte) error {
+	if err := isvalid.Check([]isvalid.IsValider{
+		rv.target,
+		rv.keys,
+		rv.height,
+		rv.status,
+	}, nil, false); err != nil {
+		return xerrors.Errorf("invalid Recovery: %w", err)
+	}
+
+	if len(rv.guardians) < 1 {
+		return xerrors.Errorf("empty guardians of Recovery")
+	}
+
+	founds := map[string]struct{}{}
+	for i := range rv.guardians {
+		g := rv.guardians[i]
+		if err := g.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid guardian: %w", err)
+		}
+
+		if g.Equal(rv.target) {
+			return xerrors.Errorf("target is guardian, %q", g)
+		}
+
+		if _, found := founds[g.String()]; found {
+			return xerrors.Errorf("duplicated guardian found, %q", g)
+		}
+
+		founds[g.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (rv Recovery) Target() base.Address {
+	return rv.target
+}
+
+func (rv Recovery) Keys() Keys {
+	return rv.keys
+}
+
+// Guardians returns the guardians, which agree with the keys.
+func (rv Recovery) Guardians() []base.Address {
+	return rv.guardians
+}
+
+// Height is the height when recovery is started.
+func (rv Recovery) Height() base.Height {
+	return rv.height
+}
+
+func (rv Recovery) Status() RecoveryStatus {
+	return rv.status
+}
+
+func (rv Recovery) Approved(guardian base.Address) bool {
+	for i := range rv.guardians {
+		if rv.guardians[i].Equal(guardian) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rv Recovery) Approve(guardian base.Address) Recovery {
+	guardians := make([]base.Address, len(rv.guardians)+1)
+	copy(guardians, rv.guardians)
+	guardians[len(rv.guardians)] = guardian
+
+	rv.guardians = guardians
+
+	return rv
+}
+
+// Ready checks the guardians of config pass the quorum and the delay of config
+// is passed at the given height. The guardians, which are removed from config
+// are not counted.
+func (rv Recovery) Ready(config RecoveryConfig, height base.Height) bool {
+	var approved uint
+	for i := range rv.guardians {
+		if config.IsGuardian(rv.guardians[i]) {
+			approved++
+		}
+	}
+
+	return approved >= config.Quorum() && height >= rv.height+config.Delay()
+}
+
+func (rv Recovery) Recover() Recovery {
+	rv.status = RecoveryStatusRecovered
+
+	return rv
+}
+
+func (rv Recovery) Cancel() Recovery {
+	rv.status = RecoveryStatusCancelled
+
+	return rv
+}
@@ -0,0 +1,64 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (rc RecoveryConfig) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(rc.Hint()),
+		bson.M{
+			"guardians": rc.guardians,
+			"quorum":    rc.quorum,
+			"delay":     rc.delay,
+		}),
+	)
+}
+
+type RecoveryConfigBSONUnpacker struct {
+	GD []base.AddressDecoder `bson:"guardians"`
+	QR uint                  `bson:"quorum"`
+	DL base.Height           `bson:"delay"`
+}
+
+func (rc *RecoveryConfig) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var urc RecoveryConfigBSONUnpacker
+	if err := enc.Unmarshal(b, &urc); err != nil {
+		return err
+	}
+
+	return rc.unpack(enc, urc.GD, urc.QR, urc.DL)
+}
+
+func (rv Recovery) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(rv.Hint()),
+		bson.M{
+			"target":    rv.target,
+			"keys":      rv.keys,
+			"guardians": rv.guardians,
+			"height":    rv.height,
+			"status":    rv.status,
+		}),
+	)
+}
+
+type RecoveryBSONUnpacker struct {
+	TG base.AddressDecoder   `bson:"target"`
+	KS bson.Raw              `bson:"keys"`
+	GD []base.AddressDecoder `bson:"guardians"`
+	HT base.Height           `bson:"height"`
+	ST RecoveryStatus        `bson:"status"`
+}
+
+func (rv *Recovery) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var urv RecoveryBSONUnpacker
+	if err := enc.Unmarshal(b, &urv); err != nil {
+		return err
+	}
+
+	return rv.unpack(enc, urv.TG, urv.KS, urv.GD, urv.HT, urv.ST)
+}
@@ -0,0 +1,73 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (rc *RecoveryConfig) unpack(
+	enc encoder.Encoder,
+	bGuardians []base.AddressDecoder,
+	quorum uint,
+	delay base.Height,
+) error {
+	if gs, err := decodeGuardians(enc, bGuardians); err != nil {
+		return err
+	} else {
+		rc.guardians = gs
+	}
+
+	rc.quorum = quorum
+	rc.delay = delay
+
+	return nil
+}
+
+func (rv *Recovery) unpack(
+	enc encoder.Encoder,
+	bTarget base.AddressDecoder,
+	bks []byte,
+	bGuardians []base.AddressDecoder,
+	height base.Height,
+	status RecoveryStatus,
+) error {
+	if a, err := bTarget.Encode(enc); err != nil {
+		return err
+	} else {
+		rv.target = a
+	}
+
+	if hinter, err := enc.DecodeByHint(bks); err != nil {
+		return err
+	} else if k, ok := hinter.(Keys); !ok {
+		return xerrors.Errorf("not Keys: %T", hinter)
+	} else {
+		rv.keys = k
+	}
+
+	if gs, err := decodeGuardians(enc, bGuardians); err != nil {
+		return err
+	} else {
+		rv.guardians = gs
+	}
+
+	rv.height = height
+	rv.status = status
+
+	return nil
+}
+
+func decodeGuardians(enc encoder.Encoder, bGuardians []base.AddressDecoder) ([]base.Address, error) {
+	gs := make([]base.Address, len(bGuardians))
+	for i := range bGuardians {
+		if a, err := bGuardians[i].Encode(enc); err != nil {
+			return nil, err
+		} else {
+			gs[i] = a
+		}
+	}
+
+	return gs, nil
+}
@@ -0,0 +1,76 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type RecoveryConfigJSONPacker struct {
+	jsonenc.HintedHead
+	GD []base.Address `json:"guardians"`
+	QR uint           `json:"quorum"`
+	DL base.Height    `json:"delay"`
+}
+
+func (rc RecoveryConfig) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RecoveryConfigJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(rc.Hint()),
+		GD:         rc.guardians,
+		QR:         rc.quorum,
+		DL:         rc.delay,
+	})
+}
+
+type RecoveryConfigJSONUnpacker struct {
+	GD []base.AddressDecoder `json:"guardians"`
+	QR uint                  `json:"quorum"`
+	DL base.Height           `json:"delay"`
+}
+
+func (rc *RecoveryConfig) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var urc RecoveryConfigJSONUnpacker
+	if err := enc.Unmarshal(b, &urc); err != nil {
+		return err
+	}
+
+	return rc.unpack(enc, urc.GD, urc.QR, urc.DL)
+}
+
+type RecoveryJSONPacker struct {
+	jsonenc.HintedHead
+	TG base.Address   `json:"target"`
+	KS Keys           `json:"keys"`
+	GD []base.Address `json:"guardians"`
+	HT base.Height    `json:"height"`
+	ST RecoveryStatus `json:"status"`
+}
+
+func (rv Recovery) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RecoveryJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(rv.Hint()),
+		TG:         rv.target,
+		KS:         rv.keys,
+		GD:         rv.guardians,
+		HT:         rv.height,
+		ST:         rv.status,
+	})
+}
+
+type RecoveryJSONUnpacker struct {
+	TG base.AddressDecoder   `json:"target"`
+	KS json.RawMessage       `json:"keys"`
+	GD []base.AddressDecoder `json:"guardians"`
+	HT base.Height           `json:"height"`
+	ST RecoveryStatus        `json:"status"`
+}
+
+func (rv *Recovery) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var urv RecoveryJSONUnpacker
+	if err := enc.Unmarshal(b, &urv); err != nil {
+		return err
+	}
+
+	return rv.unpack(enc, urv.TG, urv.KS, urv.GD, urv.HT, urv.ST)
+}
@@ -0,0 +1,174 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+func newTestRecoveryKeys() Keys {
+	k, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), 100)
+	if err != nil {
+		panic(err)
+	}
+
+	keys, err := NewKeys([]Key{k}, 100)
+	if err != nil {
+		panic(err)
+	}
+
+	return keys
+}
+
+type testRecovery struct {
+	suite.Suite
+}
+
+func (t *testRecovery) TestNewConfig() {
+	rc := NewRecoveryConfig([]base.Address{NewTestAddress(), NewTestAddress()}, 2, base.Height(10))
+	t.NoError(rc.IsValid(nil))
+}
+
+func (t *testRecovery) TestConfigEmptyGuardians() {
+	err := NewRecoveryConfig(nil, 1, base.Height(10)).IsValid(nil)
+	t.Contains(err.Error(), "empty guardians")
+}
+
+func (t *testRecovery) TestConfigDuplicatedGuardians() {
+	g := NewTestAddress()
+
+	err := NewRecoveryConfig([]base.Address{g, g}, 1, base.Height(10)).IsValid(nil)
+	t.Contains(err.Error(), "duplicated guardian found")
+}
+
+func (t *testRecovery) TestConfigWrongQuorum() {
+	gs := []base.Address{NewTestAddress(), NewTestAddress()}
+
+	err := NewRecoveryConfig(gs, 0, base.Height(10)).IsValid(nil)
+	t.Contains(err.Error(), "quorum should be between")
+
+	err = NewRecoveryConfig(gs, 3, base.Height(10)).IsValid(nil)
+	t.Contains(err.Error(), "quorum should be between")
+}
+
+func (t *testRecovery) TestConfigNegativeDelay() {
+	err := NewRecoveryConfig([]base.Address{NewTestAddress()}, 1, base.NilHeight).IsValid(nil)
+	t.Contains(err.Error(), "delay should not be negative")
+}
+
+func (t *testRecovery) TestNew() {
+	rv := NewRecovery(NewTestAddress(), newTestRecoveryKeys(), NewTestAddress(), base.Height(33))
+	t.NoError(rv.IsValid(nil))
+	t.Equal(RecoveryStatusPending, rv.Status())
+
+	t.Equal(RecoveryStatusRecovered, rv.Recover().Status())
+	t.Equal(RecoveryStatusCancelled, rv.Cancel().Status())
+}
+
+func (t *testRecovery) TestTargetIsGuardian() {
+	target := NewTestAddress()
+
+	err := NewRecovery(target, newTestRecoveryKeys(), target, base.Height(33)).IsValid(nil)
+	t.Contains(err.Error(), "target is guardian")
+}
+
+func (t *testRecovery) TestReady() {
+	ga, gb, gc := NewTestAddress(), NewTestAddress(), NewTestAddress()
+	rc := NewRecoveryConfig([]base.Address{ga, gb, gc}, 2, base.Height(10))
+
+	rv := NewRecovery(NewTestAddress(), newTestRecoveryKeys(), ga, base.Height(33))
+	t.False(rv.Ready(rc, base.Height(43)))
+
+	rv = rv.Approve(gb)
+	t.True(rv.Approved(gb))
+	t.False(rv.Ready(rc, base.Height(42)))
+	t.True(rv.Ready(rc, base.Height(43)))
+
+	// NOTE the removed guardian is not counted
+	nrc := NewRecoveryConfig([]base.Address{ga, gc}, 2, base.Height(10))
+	t.False(rv.Ready(nrc, base.Height(43)))
+}
+
+func TestRecovery(t *testing.T) {
+	suite.Run(t, new(testRecovery))
+}
+
+func testRecoveryConfigEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		rc := NewRecoveryConfig([]base.Address{NewTestAddress(), NewTestAddress()}, 2, base.Height(10))
+		t.NoError(rc.IsValid(nil))
+
+		return rc
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(RecoveryConfig)
+		tb := b.(RecoveryConfig)
+
+		t.Equal(len(ta.Guardians()), len(tb.Guardians()))
+		for i := range ta.Guardians() {
+			t.True(ta.Guardians()[i].Equal(tb.Guardians()[i]))
+		}
+
+		t.Equal(ta.Quorum(), tb.Quorum())
+		t.Equal(ta.Delay(), tb.Delay())
+	}
+
+	return t
+}
+
+func TestRecoveryConfigEncodeJSON(t *testing.T) {
+	suite.Run(t, testRecoveryConfigEncode(jsonenc.NewEncoder()))
+}
+
+func TestRecoveryConfigEncodeBSON(t *testing.T) {
+	suite.Run(t, testRecoveryConfigEncode(bsonenc.NewEncoder()))
+}
+
+func testRecoveryEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		rv := NewRecovery(NewTestAddress(), newTestRecoveryKeys(), NewTestAddress(), base.Height(33)).
+			Approve(NewTestAddress())
+		t.NoError(rv.IsValid(nil))
+
+		return rv
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Recovery)
+		tb := b.(Recovery)
+
+		t.True(ta.Target().Equal(tb.Target()))
+		t.True(ta.Keys().Equal(tb.Keys()))
+
+		t.Equal(len(ta.Guardians()), len(tb.Guardians()))
+		for i := range ta.Guardians() {
+			t.True(ta.Guardians()[i].Equal(tb.Guardians()[i]))
+		}
+
+		t.Equal(ta.Height(), tb.Height())
+		t.Equal(ta.Status(), tb.Status())
+	}
+
+	return t
+}
+
+func TestRecoveryEncodeJSON(t *testing.T) {
+	suite.Run(t, testRecoveryEncode(jsonenc.NewEncoder()))
+}
+
+func TestRecoveryEncodeBSON(t *testing.T) {
+	suite.Run(t, testRecoveryEncode(bsonenc.NewEncoder()))
+}
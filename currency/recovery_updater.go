@@ -0,0 +1,170 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RecoveryUpdaterFactType = hint.MustNewType(0xa0, 0x59, "mitum-currency-recovery-updater-operation-fact")
+	RecoveryUpdaterFactHint = hint.MustHint(RecoveryUpdaterFactType, "0.0.1")
+	RecoveryUpdaterType     = hint.MustNewType(0xa0, 0x5a, "mitum-currency-recovery-updater-operation")
+	RecoveryUpdaterHint     = hint.MustHint(RecoveryUpdaterType, "0.0.1")
+)
+
+// RecoveryUpdaterFact sets the RecoveryConfig of target. The fee is charged to
+// target.
+type RecoveryUpdaterFact struct {
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	config   RecoveryConfig
+	currency CurrencyID
+}
+
+func NewRecoveryUpdaterFact(
+	token []byte,
+	target base.Address,
+	config RecoveryConfig,
+	currency CurrencyID,
+) RecoveryUpdaterFact {
+	fact := RecoveryUpdaterFact{
+		token:    token,
+		target:   target,
+		config:   config,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RecoveryUpdaterFact) Hint() hint.Hint {
+	return RecoveryUpdaterFactHint
+}
+
+func (fact RecoveryUpdaterFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RecoveryUpdaterFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RecoveryUpdaterFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RecoveryUpdaterFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		fact.config.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RecoveryUpdaterFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for RecoveryUpdaterFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.target,
+		fact.config,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.config.IsGuardian(fact.target) {
+		return xerrors.Errorf("target is guardian, %q", fact.target)
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact RecoveryUpdaterFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact RecoveryUpdaterFact) Config() RecoveryConfig {
+	return fact.config
+}
+
+func (fact RecoveryUpdaterFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact RecoveryUpdaterFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.config.Guardians())+1)
+	as[0] = fact.target
+	copy(as[1:], fact.config.Guardians())
+
+	return as, nil
+}
+
+type RecoveryUpdater struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewRecoveryUpdater(fact RecoveryUpdaterFact, fs []operation.FactSign, memo string) (RecoveryUpdater, error) {
+	if bo, err := operation.NewBaseOperationFromFact(RecoveryUpdaterHint, fact, fs); err != nil {
+		return RecoveryUpdater{}, err
+	} else {
+		op := RecoveryUpdater{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op RecoveryUpdater) Hint() hint.Hint {
+	return RecoveryUpdaterHint
+}
+
+func (op RecoveryUpdater) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op RecoveryUpdater) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op RecoveryUpdater) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,38 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *RecoveryUpdaterFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bTarget base.AddressDecoder,
+	bConfig []byte,
+	cr string,
+) error {
+	if a, err := bTarget.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.target = a
+	}
+
+	if hinter, err := enc.DecodeByHint(bConfig); err != nil {
+		return err
+	} else if rc, ok := hinter.(RecoveryConfig); !ok {
+		return xerrors.Errorf("not RecoveryConfig: %T", hinter)
+	} else {
+		fact.config = rc
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.currency = CurrencyID(cr)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op RecoveryUpdater) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type RecoveryUpdaterProcessor struct {
+	cp *CurrencyPool
+	RecoveryUpdater
+	proposable
+	height base.Height
+	sc     state.State
+	sb     AmountState
+	fee    Big
+}
+
+func NewRecoveryUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(RecoveryUpdater); !ok {
+			return nil, xerrors.Errorf("not RecoveryUpdater, %T", op)
+		} else {
+			return &RecoveryUpdaterProcessor{
+				cp:              cp,
+				RecoveryUpdater: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *RecoveryUpdaterProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *RecoveryUpdaterProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RecoveryUpdaterFact)
+
+	if _, err := existsAccountState(fact.target, "target", getState); err != nil {
+		return nil, err
+	}
+
+	gs := fact.config.Guardians()
+	for i := range gs {
+		if _, err := existsAccountState(gs[i], "guardian", getState); err != nil {
+			return nil, util.IgnoreError.Errorf("guardian, %q does not exist", gs[i])
+		}
+	}
+
+	if st, _, err := getState(StateKeyRecoveryConfig(fact.target)); err != nil {
+		return nil, err
+	} else {
+		opp.sc = st
+	}
+
+	if err := opp.checkFactSigns(fact.target, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	if sb, fee, err := loadOperationFee(
+		opp.cp, fact.target, fact.currency, RecoveryUpdaterType, opp.height, getState,
+	); err != nil {
+		return nil, err
+	} else {
+		opp.sb = sb
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *RecoveryUpdaterProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RecoveryUpdaterFact)
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+	if st, err := SetStateRecoveryConfigValue(opp.sc, fact.config); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), st, opp.sb)
+	}
+}
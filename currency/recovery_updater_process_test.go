@@ -0,0 +1,148 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testRecoveryUpdaterOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testRecoveryUpdaterOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testRecoveryUpdaterOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(RecoveryUpdater{}, NewRecoveryUpdaterProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testRecoveryUpdaterOperations) newRecoveryUpdater(
+	target base.Address,
+	keys []key.Privatekey,
+	config RecoveryConfig,
+) RecoveryUpdater {
+	token := util.UUID().Bytes()
+	fact := NewRecoveryUpdaterFact(token, target, config, t.cid)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewRecoveryUpdater(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testRecoveryUpdaterOperations) TestNew() {
+	fa, st0 := t.newAccount(true, nil)
+	ta, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ga, st2 := t.newAccount(true, nil)
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	config := NewRecoveryConfig([]base.Address{ga.Address}, 1, base.Height(10))
+	op := t.newRecoveryUpdater(ta.Address, ta.Privs(), config)
+	t.NoError(opr.Process(op))
+
+	var bst, cst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(ta.Address, t.cid):
+			bst = st.GetState()
+		case StateKeyRecoveryConfig(ta.Address):
+			cst = st.GetState()
+		}
+	}
+
+	bstv, _ := StateBalanceValue(bst)
+	t.True(bstv.Big().Equal(NewBig(8)))
+	t.True(bst.(AmountState).Fee().Equal(fee))
+
+	urc, err := StateRecoveryConfigValue(cst)
+	t.NoError(err)
+	t.True(config.Hash().Equal(urc.Hash()))
+}
+
+func (t *testRecoveryUpdaterOperations) TestUnknownGuardian() {
+	ta, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	config := NewRecoveryConfig([]base.Address{NewTestAddress()}, 1, base.Height(10))
+	op := t.newRecoveryUpdater(ta.Address, ta.Privs(), config)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "guardian")
+	t.Contains(err.Error(), "does not exist")
+}
+
+func (t *testRecoveryUpdaterOperations) TestNotSignedByTarget() {
+	ta, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ga, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	config := NewRecoveryConfig([]base.Address{ga.Address}, 1, base.Height(10))
+	op := t.newRecoveryUpdater(ta.Address, ga.Privs(), config)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func TestRecoveryUpdaterOperations(t *testing.T) {
+	suite.Run(t, new(testRecoveryUpdaterOperations))
+}
@@ -0,0 +1,114 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testRecoveryUpdater struct {
+	baseTest
+}
+
+func (t *testRecoveryUpdater) newOperation(target base.Address, config RecoveryConfig) RecoveryUpdater {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewRecoveryUpdaterFact(token, target, config, t.cid)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewRecoveryUpdater(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testRecoveryUpdater) TestNew() {
+	config := NewRecoveryConfig([]base.Address{NewTestAddress()}, 1, base.Height(10))
+	op := t.newOperation(NewTestAddress(), config)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testRecoveryUpdater) TestTargetIsGuardian() {
+	target := NewTestAddress()
+	config := NewRecoveryConfig([]base.Address{target}, 1, base.Height(10))
+	op := t.newOperation(target, config)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "target is guardian")
+}
+
+func (t *testRecoveryUpdater) TestInvalidConfig() {
+	config := NewRecoveryConfig([]base.Address{NewTestAddress()}, 2, base.Height(10))
+	op := t.newOperation(NewTestAddress(), config)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "quorum should be between")
+}
+
+func TestRecoveryUpdater(t *testing.T) {
+	suite.Run(t, new(testRecoveryUpdater))
+}
+
+func testRecoveryUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		config := NewRecoveryConfig([]base.Address{NewTestAddress(), NewTestAddress()}, 2, base.Height(10))
+		fact := NewRecoveryUpdaterFact(token, NewTestAddress(), config, CurrencyID("SHOWME"))
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewRecoveryUpdater(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(RecoveryUpdater)
+		tb := b.(RecoveryUpdater)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(RecoveryUpdaterFact)
+		ufact := tb.Fact().(RecoveryUpdaterFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.True(fact.config.Hash().Equal(ufact.config.Hash()))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestRecoveryUpdaterEncodeJSON(t *testing.T) {
+	suite.Run(t, testRecoveryUpdaterEncode(jsonenc.NewEncoder()))
+}
+
+func TestRecoveryUpdaterEncodeBSON(t *testing.T) {
+	suite.Run(t, testRecoveryUpdaterEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,152 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RefundTransferFactType = hint.MustNewType(0xa0, 0x4b, "mitum-currency-refund-transfer-operation-fact")
+	RefundTransferFactHint = hint.MustHint(RefundTransferFactType, "0.0.1")
+	RefundTransferType     = hint.MustNewType(0xa0, 0x4c, "mitum-currency-refund-transfer-operation")
+	RefundTransferHint     = hint.MustHint(RefundTransferType, "0.0.1")
+)
+
+// RefundTransferFact refunds the expired Lock to it's sender.
+type RefundTransferFact struct {
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	lock   valuehash.Hash
+}
+
+func NewRefundTransferFact(
+	token []byte,
+	sender base.Address,
+	lock valuehash.Hash,
+) RefundTransferFact {
+	fact := RefundTransferFact{
+		token:  token,
+		sender: sender,
+		lock:   lock,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RefundTransferFact) Hint() hint.Hint {
+	return RefundTransferFactHint
+}
+
+func (fact RefundTransferFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RefundTransferFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RefundTransferFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RefundTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.lock.Bytes(),
+	)
+}
+
+func (fact RefundTransferFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for RefundTransferFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.lock,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact RefundTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RefundTransferFact) Lock() valuehash.Hash {
+	return fact.lock
+}
+
+func (fact RefundTransferFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type RefundTransfer struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewRefundTransfer(fact RefundTransferFact, fs []operation.FactSign, memo string) (RefundTransfer, error) {
+	if bo, err := operation.NewBaseOperationFromFact(RefundTransferHint, fact, fs); err != nil {
+		return RefundTransfer{}, err
+	} else {
+		op := RefundTransfer{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op RefundTransfer) Hint() hint.Hint {
+	return RefundTransferHint
+}
+
+func (op RefundTransfer) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op RefundTransfer) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op RefundTransfer) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,106 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op RefundTransfer) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type RefundTransferProcessor struct {
+	cp *CurrencyPool
+	RefundTransfer
+	proposable
+	height base.Height
+	sl     state.State
+	lk     Lock
+	sb     AmountState
+	fee    Big
+}
+
+func NewRefundTransferProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(RefundTransfer); !ok {
+			return nil, xerrors.Errorf("not RefundTransfer, %T", op)
+		} else {
+			return &RefundTransferProcessor{
+				cp:             cp,
+				RefundTransfer: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *RefundTransferProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *RefundTransferProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RefundTransferFact)
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if st, lk, err := loadOpenLock(fact.lock, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sl = st
+		opp.lk = lk
+	}
+
+	switch {
+	case !opp.lk.Sender().Equal(fact.sender):
+		return nil, util.IgnoreError.Errorf("sender is not sender of lock, %q", fact.sender)
+	case opp.height < opp.lk.Expiry():
+		return nil, util.IgnoreError.Errorf("lock not expired until %v", opp.lk.Expiry())
+	}
+
+	if err := checkNotFrozen(fact.sender, opp.lk.Currency(), true, getState); err != nil {
+		return nil, err
+	}
+
+	if fee, err := releaseLockFee(opp.cp, opp.lk, RefundTransferType); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else {
+		opp.fee = fee
+	}
+
+	if st, _, err := getState(StateKeyBalance(fact.sender, opp.lk.Currency())); err != nil {
+		return nil, err
+	} else {
+		opp.sb = NewAmountState(st, opp.lk.Currency())
+	}
+
+	if err := opp.checkFactSigns(fact.sender, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *RefundTransferProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RefundTransferFact)
+
+	if st, err := SetStateLockValue(opp.sl, opp.lk.Refund()); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), st, opp.sb.Add(opp.lk.Amount().Big().Sub(opp.fee)).AddFee(opp.fee))
+	}
+}
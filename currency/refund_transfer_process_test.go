@@ -0,0 +1,191 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testRefundTransferOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testRefundTransferOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testRefundTransferOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(RefundTransfer{}, NewRefundTransferProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testRefundTransferOperations) newRefundTransfer(
+	sender base.Address,
+	keys []key.Privatekey,
+	lock valuehash.Hash,
+) RefundTransfer {
+	token := util.UUID().Bytes()
+	fact := NewRefundTransferFact(token, sender, lock)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewRefundTransfer(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testRefundTransferOperations) newLock(sender, receiver base.Address, expiry base.Height) Lock {
+	hashlock, _ := newTestHashlock()
+
+	return NewLock(valuehash.RandomSHA256(), sender, receiver, NewAmount(NewBig(10), t.cid), hashlock, expiry)
+}
+
+func (t *testRefundTransferOperations) TestNew() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	lk := t.newLock(sa.Address, ra.Address, t.height())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newLockState(lk)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRefundTransfer(sa.Address, sa.Privs(), lk.ID())
+	t.NoError(opr.Process(op))
+
+	var sst, lst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyLock(lk.ID()):
+			lst = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(3).Add(lk.Amount().Big())))
+
+	ulk, err := StateLockValue(lst)
+	t.NoError(err)
+	t.Equal(LockStatusRefunded, ulk.Status())
+}
+
+func (t *testRefundTransferOperations) TestNotExpired() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	lk := t.newLock(sa.Address, ra.Address, t.height()+1)
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newLockState(lk)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRefundTransfer(sa.Address, sa.Privs(), lk.ID())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "lock not expired")
+}
+
+func (t *testRefundTransferOperations) TestNotSender() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	lk := t.newLock(sa.Address, ra.Address, t.height())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newLockState(lk)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRefundTransfer(ra.Address, ra.Privs(), lk.ID())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "sender is not sender of lock")
+}
+
+func (t *testRefundTransferOperations) TestSenderClosed() {
+	sa, st0 := t.newClosedAccount(nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	lk := t.newLock(sa.Address, ra.Address, t.height())
+	pool, _ := t.statepool(st0, st1, []state.State{dst, t.newLockState(lk)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRefundTransfer(sa.Address, sa.Privs(), lk.ID()))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testRefundTransferOperations) TestSenderFrozen() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	lk := t.newLock(sa.Address, ra.Address, t.height())
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst,
+		t.newLockState(lk),
+		t.newFreezeState(NewFreeze(sa.Address, "", true)),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRefundTransfer(sa.Address, sa.Privs(), lk.ID()))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func TestRefundTransferOperations(t *testing.T) {
+	suite.Run(t, new(testRefundTransferOperations))
+}
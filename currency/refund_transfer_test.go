@@ -0,0 +1,89 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"github.com/stretchr/testify/suite"
+)
+
+type testRefundTransfer struct {
+	baseTest
+}
+
+func (t *testRefundTransfer) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewRefundTransferFact(token, NewTestAddress(), valuehash.RandomSHA256())
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewRefundTransfer(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func TestRefundTransfer(t *testing.T) {
+	suite.Run(t, new(testRefundTransfer))
+}
+
+func testRefundTransferEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewRefundTransferFact(token, NewTestAddress(), valuehash.RandomSHA256())
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewRefundTransfer(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(RefundTransfer)
+		tb := b.(RefundTransfer)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(RefundTransferFact)
+		ufact := tb.Fact().(RefundTransferFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.lock.Equal(ufact.lock))
+	}
+
+	return t
+}
+
+func TestRefundTransferEncodeJSON(t *testing.T) {
+	suite.Run(t, testRefundTransferEncode(jsonenc.NewEncoder()))
+}
+
+func TestRefundTransferEncodeBSON(t *testing.T) {
+	suite.Run(t, testRefundTransferEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,158 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RegisterAliasFactType = hint.MustNewType(0xa0, 0x61, "mitum-currency-register-alias-operation-fact")
+	RegisterAliasFactHint = hint.MustHint(RegisterAliasFactType, "0.0.1")
+	RegisterAliasType     = hint.MustNewType(0xa0, 0x62, "mitum-currency-register-alias-operation")
+	RegisterAliasHint     = hint.MustHint(RegisterAliasType, "0.0.1")
+)
+
+// RegisterAliasFact registers alias to sender. The alias should not be
+// registered by the other account and sender can have only one alias. The
+// registration fee is charged to sender by the Feeer of currency.
+type RegisterAliasFact struct {
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	alias    Alias
+	currency CurrencyID
+}
+
+func NewRegisterAliasFact(token []byte, sender base.Address, alias Alias, currency CurrencyID) RegisterAliasFact {
+	fact := RegisterAliasFact{
+		token:    token,
+		sender:   sender,
+		alias:    alias,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RegisterAliasFact) Hint() hint.Hint {
+	return RegisterAliasFactHint
+}
+
+func (fact RegisterAliasFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RegisterAliasFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RegisterAliasFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RegisterAliasFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.alias.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RegisterAliasFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for RegisterAliasFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.alias,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact RegisterAliasFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RegisterAliasFact) Alias() Alias {
+	return fact.alias
+}
+
+func (fact RegisterAliasFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact RegisterAliasFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type RegisterAlias struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewRegisterAlias(fact RegisterAliasFact, fs []operation.FactSign, memo string) (RegisterAlias, error) {
+	if bo, err := operation.NewBaseOperationFromFact(RegisterAliasHint, fact, fs); err != nil {
+		return RegisterAlias{}, err
+	} else {
+		op := RegisterAlias{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op RegisterAlias) Hint() hint.Hint {
+	return RegisterAliasHint
+}
+
+func (op RegisterAlias) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op RegisterAlias) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op RegisterAlias) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,113 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op RegisterAlias) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type RegisterAliasProcessor struct {
+	cp *CurrencyPool
+	RegisterAlias
+	proposable
+	height base.Height
+	sa     state.State // NOTE state of alias
+	ss     state.State // NOTE state of alias of sender
+	sb     AmountState
+	fee    Big
+}
+
+func NewRegisterAliasProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(RegisterAlias); !ok {
+			return nil, xerrors.Errorf("not RegisterAlias, %T", op)
+		} else {
+			return &RegisterAliasProcessor{
+				cp:            cp,
+				RegisterAlias: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *RegisterAliasProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *RegisterAliasProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RegisterAliasFact)
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	switch st, _, found, err := loadAccountAlias(StateKeyAlias(fact.alias), getState); {
+	case err != nil:
+		return nil, err
+	case found:
+		return nil, util.IgnoreError.Errorf("alias, %q already registered", fact.alias.Raw())
+	default:
+		opp.sa = st
+	}
+
+	switch st, aa, found, err := loadAccountAlias(StateKeyAccountAlias(fact.sender), getState); {
+	case err != nil:
+		return nil, err
+	case found:
+		return nil, util.IgnoreError.Errorf("sender already has alias, %q", aa.Alias().Raw())
+	default:
+		opp.ss = st
+	}
+
+	if err := opp.checkFactSigns(fact.sender, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	if sb, fee, err := loadOperationFee(
+		opp.cp, fact.sender, fact.currency, RegisterAliasType, opp.height, getState,
+	); err != nil {
+		return nil, err
+	} else {
+		opp.sb = sb
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *RegisterAliasProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RegisterAliasFact)
+
+	aa := NewAccountAlias(fact.alias, fact.sender)
+
+	sts := make([]state.State, 3)
+	for i, st := range []state.State{opp.sa, opp.ss} {
+		if j, err := SetStateAccountAliasValue(st, aa); err != nil {
+			return err
+		} else {
+			sts[i] = j
+		}
+	}
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+	sts[2] = opp.sb
+
+	return setState(fact.Hash(), sts...)
+}
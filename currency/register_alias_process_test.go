@@ -0,0 +1,216 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testRegisterAliasOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testRegisterAliasOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testRegisterAliasOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(RegisterAlias{}, NewRegisterAliasProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testRegisterAliasOperations) newRegisterAlias(
+	sender base.Address,
+	keys []key.Privatekey,
+	alias Alias,
+) RegisterAlias {
+	token := util.UUID().Bytes()
+	fact := NewRegisterAliasFact(token, sender, alias, t.cid)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewRegisterAlias(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testRegisterAliasOperations) TestNew() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	fee := NewBig(3)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), fee))
+
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRegisterAlias(sa.Address, sa.Privs(), Alias("showme"))
+	t.NoError(opr.Process(op))
+
+	var ast, sst, bst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyAlias(Alias("showme")):
+			ast = st.GetState()
+		case StateKeyAccountAlias(sa.Address):
+			sst = st.GetState()
+		case StateKeyBalance(sa.Address, t.cid):
+			bst = st.GetState()
+		}
+	}
+
+	for _, st := range []state.State{ast, sst} {
+		aa, err := StateAccountAliasValue(st)
+		t.NoError(err)
+		t.True(aa.Registered())
+		t.Equal(Alias("showme"), aa.Alias())
+		t.True(aa.Account().Equal(sa.Address))
+	}
+
+	ub, _ := StateBalanceValue(bst)
+	t.True(ub.Big().Equal(NewBig(10).Sub(fee)))
+}
+
+func (t *testRegisterAliasOperations) TestAlreadyRegistered() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("showme"), NewTestAddress())
+	pool, _ := t.statepool(st0, append(t.newAccountAliasStates(aa), dst))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRegisterAlias(sa.Address, sa.Privs(), Alias("showme"))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "already registered")
+}
+
+func (t *testRegisterAliasOperations) TestReleased() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("showme"), NewTestAddress()).Release()
+	pool, _ := t.statepool(st0, append(t.newAccountAliasStates(aa), dst))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRegisterAlias(sa.Address, sa.Privs(), Alias("showme"))
+	t.NoError(opr.Process(op))
+
+	var ast state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyAlias(Alias("showme")) {
+			ast = st.GetState()
+		}
+	}
+
+	uaa, err := StateAccountAliasValue(ast)
+	t.NoError(err)
+	t.True(uaa.Registered())
+	t.True(uaa.Account().Equal(sa.Address))
+}
+
+func (t *testRegisterAliasOperations) TestSenderHasAlias() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("findme"), sa.Address)
+	pool, _ := t.statepool(st0, append(t.newAccountAliasStates(aa), dst))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRegisterAlias(sa.Address, sa.Privs(), Alias("showme"))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "sender already has alias")
+}
+
+func (t *testRegisterAliasOperations) TestSameAliasInBlock() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	na, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newRegisterAlias(sa.Address, sa.Privs(), Alias("showme"))))
+
+	err := opr.Process(t.newRegisterAlias(na.Address, na.Privs(), Alias("showme")))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "violates only one sender")
+}
+
+func (t *testRegisterAliasOperations) TestInsufficientBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), NewBig(3)))
+
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newRegisterAlias(sa.Address, sa.Privs(), Alias("showme"))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func TestRegisterAliasOperations(t *testing.T) {
+	suite.Run(t, new(testRegisterAliasOperations))
+}
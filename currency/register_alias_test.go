@@ -0,0 +1,104 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testRegisterAlias struct {
+	baseTest
+}
+
+func (t *testRegisterAlias) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewRegisterAliasFact(token, NewTestAddress(), Alias("showme"), t.cid)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewRegisterAlias(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testRegisterAlias) TestEmptyToken() {
+	fact := NewRegisterAliasFact(nil, NewTestAddress(), Alias("showme"), t.cid)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty token")
+}
+
+func (t *testRegisterAlias) TestWrongAlias() {
+	token := util.UUID().Bytes()
+	fact := NewRegisterAliasFact(token, NewTestAddress(), Alias("Show:Me"), t.cid)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "wrong alias")
+}
+
+func TestRegisterAlias(t *testing.T) {
+	suite.Run(t, new(testRegisterAlias))
+}
+
+func testRegisterAliasEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewRegisterAliasFact(token, NewTestAddress(), Alias("showme"), CurrencyID("SHOWME"))
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewRegisterAlias(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(RegisterAlias)
+		tb := b.(RegisterAlias)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(RegisterAliasFact)
+		ufact := tb.Fact().(RegisterAliasFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.Equal(fact.alias, ufact.alias)
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestRegisterAliasEncodeJSON(t *testing.T) {
+	suite.Run(t, testRegisterAliasEncode(jsonenc.NewEncoder()))
+}
+
+func TestRegisterAliasEncodeBSON(t *testing.T) {
+	suite.Run(t, testRegisterAliasEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,157 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ReleaseAliasFactType = hint.MustNewType(0xa0, 0x65, "mitum-currency-release-alias-operation-fact")
+	ReleaseAliasFactHint = hint.MustHint(ReleaseAliasFactType, "0.0.1")
+	ReleaseAliasType     = hint.MustNewType(0xa0, 0x66, "mitum-currency-release-alias-operation")
+	ReleaseAliasHint     = hint.MustHint(ReleaseAliasType, "0.0.1")
+)
+
+// ReleaseAliasFact releases the alias of sender, so the alias can be
+// registered again by any account. The fee is charged to sender.
+type ReleaseAliasFact struct {
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	alias    Alias
+	currency CurrencyID
+}
+
+func NewReleaseAliasFact(token []byte, sender base.Address, alias Alias, currency CurrencyID) ReleaseAliasFact {
+	fact := ReleaseAliasFact{
+		token:    token,
+		sender:   sender,
+		alias:    alias,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ReleaseAliasFact) Hint() hint.Hint {
+	return ReleaseAliasFactHint
+}
+
+func (fact ReleaseAliasFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ReleaseAliasFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ReleaseAliasFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ReleaseAliasFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.alias.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ReleaseAliasFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for ReleaseAliasFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.alias,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ReleaseAliasFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ReleaseAliasFact) Alias() Alias {
+	return fact.alias
+}
+
+func (fact ReleaseAliasFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact ReleaseAliasFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type ReleaseAlias struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewReleaseAlias(fact ReleaseAliasFact, fs []operation.FactSign, memo string) (ReleaseAlias, error) {
+	if bo, err := operation.NewBaseOperationFromFact(ReleaseAliasHint, fact, fs); err != nil {
+		return ReleaseAlias{}, err
+	} else {
+		op := ReleaseAlias{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op ReleaseAlias) Hint() hint.Hint {
+	return ReleaseAliasHint
+}
+
+func (op ReleaseAlias) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op ReleaseAlias) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op ReleaseAlias) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,109 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op ReleaseAlias) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type ReleaseAliasProcessor struct {
+	cp *CurrencyPool
+	ReleaseAlias
+	proposable
+	height base.Height
+	sa     state.State // NOTE state of alias
+	ss     state.State // NOTE state of alias of sender
+	aa     AccountAlias
+	sb     AmountState
+	fee    Big
+}
+
+func NewReleaseAliasProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(ReleaseAlias); !ok {
+			return nil, xerrors.Errorf("not ReleaseAlias, %T", op)
+		} else {
+			return &ReleaseAliasProcessor{
+				cp:           cp,
+				ReleaseAlias: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *ReleaseAliasProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *ReleaseAliasProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ReleaseAliasFact)
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if st, aa, err := loadOwnedAlias(fact.alias, fact.sender, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sa = st
+		opp.aa = aa
+	}
+
+	if st, _, err := getState(StateKeyAccountAlias(fact.sender)); err != nil {
+		return nil, err
+	} else {
+		opp.ss = st
+	}
+
+	if err := opp.checkFactSigns(fact.sender, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	if sb, fee, err := loadOperationFee(
+		opp.cp, fact.sender, fact.currency, ReleaseAliasType, opp.height, getState,
+	); err != nil {
+		return nil, err
+	} else {
+		opp.sb = sb
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *ReleaseAliasProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(ReleaseAliasFact)
+
+	aa := opp.aa.Release()
+
+	sts := make([]state.State, 3)
+	for i, st := range []state.State{opp.sa, opp.ss} {
+		if j, err := SetStateAccountAliasValue(st, aa); err != nil {
+			return err
+		} else {
+			sts[i] = j
+		}
+	}
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+	sts[2] = opp.sb
+
+	return setState(fact.Hash(), sts...)
+}
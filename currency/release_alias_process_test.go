@@ -0,0 +1,142 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testReleaseAliasOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testReleaseAliasOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testReleaseAliasOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(ReleaseAlias{}, NewReleaseAliasProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testReleaseAliasOperations) newReleaseAlias(
+	sender base.Address,
+	keys []key.Privatekey,
+	alias Alias,
+) ReleaseAlias {
+	token := util.UUID().Bytes()
+	fact := NewReleaseAliasFact(token, sender, alias, t.cid)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewReleaseAlias(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testReleaseAliasOperations) TestNew() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("showme"), sa.Address)
+	pool, _ := t.statepool(st0, append(t.newAccountAliasStates(aa), dst))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newReleaseAlias(sa.Address, sa.Privs(), Alias("showme"))
+	t.NoError(opr.Process(op))
+
+	var ast, sst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyAlias(Alias("showme")):
+			ast = st.GetState()
+		case StateKeyAccountAlias(sa.Address):
+			sst = st.GetState()
+		}
+	}
+
+	for _, st := range []state.State{ast, sst} {
+		uaa, err := StateAccountAliasValue(st)
+		t.NoError(err)
+		t.False(uaa.Registered())
+		t.Equal(AliasStatusReleased, uaa.Status())
+	}
+}
+
+func (t *testReleaseAliasOperations) TestAlreadyReleased() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("showme"), sa.Address).Release()
+	pool, _ := t.statepool(st0, append(t.newAccountAliasStates(aa), dst))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newReleaseAlias(sa.Address, sa.Privs(), Alias("showme"))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not registered")
+}
+
+func (t *testReleaseAliasOperations) TestNotOwned() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("showme"), NewTestAddress())
+	pool, _ := t.statepool(st0, append(t.newAccountAliasStates(aa), dst))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newReleaseAlias(sa.Address, sa.Privs(), Alias("showme"))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not owned by")
+}
+
+func TestReleaseAliasOperations(t *testing.T) {
+	suite.Run(t, new(testReleaseAliasOperations))
+}
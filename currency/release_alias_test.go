@@ -0,0 +1,96 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testReleaseAlias struct {
+	baseTest
+}
+
+func (t *testReleaseAlias) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewReleaseAliasFact(token, NewTestAddress(), Alias("showme"), t.cid)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewReleaseAlias(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testReleaseAlias) TestEmptyToken() {
+	fact := NewReleaseAliasFact(nil, NewTestAddress(), Alias("showme"), t.cid)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty token")
+}
+
+func TestReleaseAlias(t *testing.T) {
+	suite.Run(t, new(testReleaseAlias))
+}
+
+func testReleaseAliasEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewReleaseAliasFact(token, NewTestAddress(), Alias("showme"), CurrencyID("SHOWME"))
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewReleaseAlias(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(ReleaseAlias)
+		tb := b.(ReleaseAlias)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(ReleaseAliasFact)
+		ufact := tb.Fact().(ReleaseAliasFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.Equal(fact.alias, ufact.alias)
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestReleaseAliasEncodeJSON(t *testing.T) {
+	suite.Run(t, testReleaseAliasEncode(jsonenc.NewEncoder()))
+}
+
+func TestReleaseAliasEncodeBSON(t *testing.T) {
+	suite.Run(t, testReleaseAliasEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,194 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ScheduleTransferFactType = hint.MustNewType(0xa0, 0x79, "mitum-currency-schedule-transfer-operation-fact")
+	ScheduleTransferFactHint = hint.MustHint(ScheduleTransferFactType, "0.0.1")
+	ScheduleTransferType     = hint.MustNewType(0xa0, 0x7a, "mitum-currency-schedule-transfer-operation")
+	ScheduleTransferHint     = hint.MustHint(ScheduleTransferType, "0.0.1")
+)
+
+// ScheduleTransferFact reserves the amount of sender for receiver until the
+// target height. The reserved amount is kept in it's own ScheduledTransfer
+// state, not in the balance of sender, and it is transferred to receiver by the
+// first block with operations at or after the target height; the block without
+// operations is not closed by OperationProcessor, so if the block of the target
+// height has no operations, the transfer is executed late.
+type ScheduleTransferFact struct {
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	receiver base.Address
+	amount   Amount
+	height   base.Height
+}
+
+func NewScheduleTransferFact(
+	token []byte,
+	sender, receiver base.Address,
+	amount Amount,
+	height base.Height,
+) ScheduleTransferFact {
+	fact := ScheduleTransferFact{
+		token:    token,
+		sender:   sender,
+		receiver: receiver,
+		amount:   amount,
+		height:   height,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ScheduleTransferFact) Hint() hint.Hint {
+	return ScheduleTransferFactHint
+}
+
+func (fact ScheduleTransferFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ScheduleTransferFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ScheduleTransferFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ScheduleTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fact.height.Bytes(),
+	)
+}
+
+func (fact ScheduleTransferFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for ScheduleTransferFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.receiver,
+		fact.amount,
+		fact.height,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.receiver) {
+		return xerrors.Errorf("receiver is same with sender, %q", fact.sender)
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return xerrors.Errorf("amount should be over zero")
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ScheduleTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ScheduleTransferFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact ScheduleTransferFact) Amount() Amount {
+	return fact.amount
+}
+
+func (fact ScheduleTransferFact) Amounts() []Amount {
+	return []Amount{fact.amount}
+}
+
+// Height is the target height of transfer.
+func (fact ScheduleTransferFact) Height() base.Height {
+	return fact.height
+}
+
+func (fact ScheduleTransferFact) Rebuild() ScheduleTransferFact {
+	fact.amount = fact.amount.WithBig(fact.amount.Big())
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ScheduleTransferFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.receiver}, nil
+}
+
+type ScheduleTransfer struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewScheduleTransfer(fact ScheduleTransferFact, fs []operation.FactSign, memo string) (ScheduleTransfer, error) {
+	if bo, err := operation.NewBaseOperationFromFact(ScheduleTransferHint, fact, fs); err != nil {
+		return ScheduleTransfer{}, err
+	} else {
+		op := ScheduleTransfer{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op ScheduleTransfer) Hint() hint.Hint {
+	return ScheduleTransferHint
+}
+
+func (op ScheduleTransfer) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op ScheduleTransfer) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op ScheduleTransfer) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,160 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op ScheduleTransfer) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type ScheduleTransferProcessor struct {
+	cp *CurrencyPool
+	ScheduleTransfer
+	height   base.Height
+	ss       state.State
+	sb       map[CurrencyID]AmountState
+	sl       []spendingLimitState
+	required map[CurrencyID][2]Big
+}
+
+func NewScheduleTransferProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(ScheduleTransfer); !ok {
+			return nil, xerrors.Errorf("not ScheduleTransfer, %T", op)
+		} else {
+			return &ScheduleTransferProcessor{
+				cp:               cp,
+				ScheduleTransfer: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *ScheduleTransferProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *ScheduleTransferProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ScheduleTransferFact)
+	cid := fact.amount.Currency()
+
+	if fact.height <= opp.height {
+		return nil, util.IgnoreError.Errorf("height should be over current height, %v <= %v", fact.height, opp.height)
+	}
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsAccountState(fact.receiver, "receiver", getState); err != nil {
+		return nil, err
+	}
+
+	if opp.cp != nil && !opp.cp.Exists(cid) {
+		return nil, util.IgnoreError.Errorf("currency not registered, %q", cid)
+	}
+
+	if err := checkTransferRestriction(opp.cp, fact.sender, cid, getState); err != nil {
+		return nil, err
+	} else if err := checkTransferRestriction(opp.cp, fact.receiver, cid, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkNotFrozen(fact.receiver, cid, true, getState); err != nil {
+		return nil, err
+	}
+
+	if st, err := notExistsState(StateKeyScheduledTransfer(fact.Hash()), "scheduled transfer", getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = st
+	}
+
+	if required, err := CalculateItemsFee(opp.cp, ScheduleTransferType, []AmountsItem{fact}); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else if err := checkNotFrozenByRequired(fact.sender, nil, required, getState); err != nil {
+		return nil, err
+	} else if sb, err := CheckEnoughBalance(fact.sender, required, opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	if sl, err := checkSpendingLimit(
+		fact.sender, map[CurrencyID]Big{cid: fact.amount.Big()}, opp.height, getState,
+	); err != nil {
+		return nil, err
+	} else {
+		opp.sl = sl
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *ScheduleTransferProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(ScheduleTransferFact)
+
+	var sts []state.State
+	if st, err := SetStateScheduledTransferValue(
+		opp.ss,
+		NewScheduledTransfer(fact.Hash(), fact.sender, fact.receiver, fact.amount, fact.height),
+	); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	sts = append(sts, debitRequired(opp.sb, nil, opp.required)...)
+
+	if sls, err := setSpendingLimitStates(opp.sl); err != nil {
+		return err
+	} else {
+		sts = append(sts, sls...)
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// loadPendingScheduledTransfer loads the ScheduledTransfer, which is not
+// executed or cancelled yet.
+func loadPendingScheduledTransfer(
+	id valuehash.Hash,
+	getState func(key string) (state.State, bool, error),
+) (state.State, ScheduledTransfer, error) {
+	var st state.State
+	if i, err := existsState(StateKeyScheduledTransfer(id), "scheduled transfer", getState); err != nil {
+		return nil, ScheduledTransfer{}, err
+	} else {
+		st = i
+	}
+
+	switch sc, err := StateScheduledTransferValue(st); {
+	case err != nil:
+		return nil, ScheduledTransfer{}, util.IgnoreError.Wrap(err)
+	case sc.Status() != ScheduledTransferStatusPending:
+		return nil, ScheduledTransfer{}, util.IgnoreError.Errorf("scheduled transfer already %s", sc.Status())
+	default:
+		return st, sc, nil
+	}
+}
@@ -0,0 +1,437 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testScheduleTransferOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testScheduleTransferOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testScheduleTransferOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(ScheduleTransfer{}, NewScheduleTransferProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testScheduleTransferOperations) newScheduleTransfer(
+	sender, receiver base.Address,
+	keys []key.Privatekey,
+	amount Amount,
+	height base.Height,
+) ScheduleTransfer {
+	token := util.UUID().Bytes()
+	fact := NewScheduleTransferFact(token, sender, receiver, amount, height)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewScheduleTransfer(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testScheduleTransferOperations) scheduleQueue(pool *storage.Statepool) ScheduleQueue {
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyScheduleQueue {
+			sq, err := StateScheduleQueueValue(st.GetState())
+			t.NoError(err)
+
+			return sq
+		}
+	}
+
+	t.Fail("schedule queue not updated")
+
+	return ScheduleQueue{}
+}
+
+func (t *testScheduleTransferOperations) TestNew() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	amount := NewAmount(NewBig(10), t.cid)
+	op := t.newScheduleTransfer(sa.Address, ra.Address, sa.Privs(), amount, pool.Height()+10)
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	var sst, cst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyScheduledTransfer(op.Fact().Hash()):
+			cst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			t.Fail("balance of receiver should not be updated")
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(33).Sub(amount.Big()).Sub(fee)))
+	t.True(sst.(AmountState).Fee().Equal(fee))
+
+	sc, err := StateScheduledTransferValue(cst)
+	t.NoError(err)
+	t.NoError(sc.IsValid(nil))
+	t.Equal(ScheduledTransferStatusPending, sc.Status())
+	t.True(sc.Sender().Equal(sa.Address))
+	t.True(sc.Receiver().Equal(ra.Address))
+	t.True(sc.Amount().Equal(amount))
+	t.Equal(pool.Height()+10, sc.Height())
+
+	sq := t.scheduleQueue(pool)
+	t.Equal(1, len(sq.Items()))
+	t.True(op.Fact().Hash().Equal(sq.Items()[0].ID()))
+	t.Equal(pool.Height()+10, sq.Items()[0].Height())
+}
+
+func (t *testScheduleTransferOperations) TestPastHeight() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newScheduleTransfer(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), pool.Height())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "height should be over current height")
+}
+
+func (t *testScheduleTransferOperations) TestReceiverNotExist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, _ := t.newAccount(false, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newScheduleTransfer(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), pool.Height()+1)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "receiver does not exist")
+}
+
+func (t *testScheduleTransferOperations) TestInsufficientBalanceWithFee() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(11), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(2)))
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newScheduleTransfer(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), pool.Height()+1)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testScheduleTransferOperations) TestExecute() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	height := t.height()
+	sc := NewScheduledTransfer(valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), height)
+	later := NewScheduledTransfer(valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), height+1)
+	sq := NewScheduleQueue([]ScheduleItem{
+		NewScheduleItem(sc.Height(), sc.ID()),
+		NewScheduleItem(later.Height(), later.ID()),
+	})
+
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst, t.newScheduledTransferState(sc), t.newScheduledTransferState(later), t.newScheduleQueueState(sq),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+	t.NoError(opr.Close())
+
+	var rst, cst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyScheduledTransfer(sc.ID()):
+			cst = st.GetState()
+		case StateKeyScheduledTransfer(later.ID()):
+			t.Fail("not reached scheduled transfer should not be updated")
+		}
+	}
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(NewBig(13)))
+
+	usc, err := StateScheduledTransferValue(cst)
+	t.NoError(err)
+	t.Equal(ScheduledTransferStatusExecuted, usc.Status())
+
+	usq := t.scheduleQueue(pool)
+	t.Equal(1, len(usq.Items()))
+	t.True(later.ID().Equal(usq.Items()[0].ID()))
+
+	var ofact ScheduledTransferOperationFact
+	for _, o := range pool.AddedOperations() {
+		if i, ok := o.Fact().(ScheduledTransferOperationFact); ok {
+			ofact = i
+		}
+	}
+
+	t.Equal(1, len(ofact.Transfers()))
+	t.True(sc.ID().Equal(ofact.Transfers()[0].ID()))
+	t.Equal(ScheduledTransferStatusExecuted, ofact.Transfers()[0].Status())
+}
+
+func (t *testScheduleTransferOperations) TestExecuteLate() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	// NOTE the blocks between the target height and the current height have no
+	// operations, so the ScheduledTransfer is executed late.
+	height := base.Height(10)
+	sc := NewScheduledTransfer(valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), height-3)
+	sq := NewScheduleQueue([]ScheduleItem{NewScheduleItem(sc.Height(), sc.ID())})
+
+	pool := t.statepoolAt(height, st0, st1, []state.State{
+		dst, t.newScheduledTransferState(sc), t.newScheduleQueueState(sq),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+	t.NoError(opr.Close())
+
+	var rst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(ra.Address, t.cid) {
+			rst = st.GetState()
+		}
+	}
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(NewBig(13)))
+
+	t.True(t.scheduleQueue(pool).IsEmpty())
+
+	var ofact ScheduledTransferOperationFact
+	for _, o := range pool.AddedOperations() {
+		if i, ok := o.Fact().(ScheduledTransferOperationFact); ok {
+			ofact = i
+		}
+	}
+
+	t.Equal(1, len(ofact.Transfers()))
+	t.Equal(ScheduledTransferStatusExecuted, ofact.Transfers()[0].Status())
+}
+
+func (t *testScheduleTransferOperations) TestExecuteOnceByOperationProcessors() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	sc := NewScheduledTransfer(valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), t.height())
+	sq := NewScheduleQueue([]ScheduleItem{NewScheduleItem(sc.Height(), sc.ID())})
+
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst, t.newScheduledTransferState(sc), t.newScheduleQueueState(sq),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	// NOTE Close is called by the OperationProcessor of each operation type
+	copr := t.processor(cp, nil)
+	t.NoError(copr.New(pool).Close())
+	t.NoError(copr.New(pool).Close())
+
+	var rst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(ra.Address, t.cid) {
+			rst = st.GetState()
+		}
+	}
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(NewBig(13)))
+
+	t.True(t.scheduleQueue(pool).IsEmpty())
+}
+
+func (t *testScheduleTransferOperations) TestScheduleByOperationTypes() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	sc := NewScheduledTransfer(valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), t.height())
+	sq := NewScheduleQueue([]ScheduleItem{NewScheduleItem(sc.Height(), sc.ID())})
+
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst, t.newScheduledTransferState(sc), t.newScheduleQueueState(sq),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	copr := t.processor(cp, nil)
+	_, err := copr.(*OperationProcessor).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	// NOTE OperationProcessor is created for each operation type; the
+	// OperationProcessor of Transfers executes the due ScheduledTransfer, which
+	// is closed before the OperationProcessor of ScheduleTransfer.
+	topr := copr.New(pool)
+	sopr := copr.New(pool)
+
+	fact := NewTransfersFact(
+		util.UUID().Bytes(),
+		ra.Address,
+		[]TransfersItem{NewTransfersItemSingleAmount(sa.Address, NewAmount(NewBig(1), t.cid))},
+	).SetSequence(1)
+	sig, err := operation.NewFactSignature(ra.Privs()[0], fact, nil)
+	t.NoError(err)
+	top, err := NewTransfers(fact, []operation.FactSign{operation.NewBaseFactSign(ra.Privs()[0].Publickey(), sig)}, "")
+	t.NoError(err)
+	t.NoError(topr.Process(top))
+
+	op := t.newScheduleTransfer(sa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid), pool.Height()+10)
+	t.NoError(sopr.Process(op))
+
+	t.NoError(topr.Close())
+	t.NoError(sopr.Close())
+
+	var rst, cst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyScheduledTransfer(sc.ID()):
+			cst = st.GetState()
+		}
+	}
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(NewBig(12)))
+
+	usc, err := StateScheduledTransferValue(cst)
+	t.NoError(err)
+	t.Equal(ScheduledTransferStatusExecuted, usc.Status())
+
+	usq := t.scheduleQueue(pool)
+	t.Equal(1, len(usq.Items()))
+	t.True(op.Fact().Hash().Equal(usq.Items()[0].ID()))
+}
+
+func (t *testScheduleTransferOperations) TestRefundFrozenReceiver() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	sc := NewScheduledTransfer(valuehash.RandomSHA256(), sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), t.height()-1)
+	sq := NewScheduleQueue([]ScheduleItem{NewScheduleItem(sc.Height(), sc.ID())})
+
+	pool, _ := t.statepool(st0, st1, []state.State{
+		dst,
+		t.newScheduledTransferState(sc),
+		t.newScheduleQueueState(sq),
+		t.newFreezeState(NewFreeze(ra.Address, "", true)),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+	t.NoError(opr.Close())
+
+	var sst, cst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyScheduledTransfer(sc.ID()):
+			cst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			t.Fail("balance of receiver should not be updated")
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(11)))
+
+	usc, err := StateScheduledTransferValue(cst)
+	t.NoError(err)
+	t.Equal(ScheduledTransferStatusRefunded, usc.Status())
+
+	t.True(t.scheduleQueue(pool).IsEmpty())
+}
+
+func TestScheduleTransferOperations(t *testing.T) {
+	suite.Run(t, new(testScheduleTransferOperations))
+}
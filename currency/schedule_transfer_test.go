@@ -0,0 +1,136 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testScheduleTransfer struct {
+	baseTest
+}
+
+func (t *testScheduleTransfer) newOperation(
+	sender, receiver base.Address,
+	amount Amount,
+	height base.Height,
+) ScheduleTransfer {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewScheduleTransferFact(token, sender, receiver, amount, height)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewScheduleTransfer(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testScheduleTransfer) TestNew() {
+	sender := NewTestAddress()
+	receiver := NewTestAddress()
+
+	op := t.newOperation(sender, receiver, NewAmount(NewBig(33), CurrencyID("SHOWME")), base.Height(10))
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	as, err := op.Fact().(ScheduleTransferFact).Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+	t.True(sender.Equal(as[0]))
+	t.True(receiver.Equal(as[1]))
+}
+
+func (t *testScheduleTransfer) TestSameReceiver() {
+	sender := NewTestAddress()
+
+	op := t.newOperation(sender, sender, NewAmount(NewBig(33), CurrencyID("SHOWME")), base.Height(10))
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "receiver is same with sender")
+}
+
+func (t *testScheduleTransfer) TestZeroAmount() {
+
+	op := t.newOperation(NewTestAddress(), NewTestAddress(), NewZeroAmount(CurrencyID("SHOWME")), base.Height(10))
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "amount should be over zero")
+}
+
+func (t *testScheduleTransfer) TestWrongHeight() {
+
+	op := t.newOperation(NewTestAddress(), NewTestAddress(), NewAmount(NewBig(33), CurrencyID("SHOWME")), base.NilHeight)
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "height must be greater than")
+}
+
+func TestScheduleTransfer(t *testing.T) {
+	suite.Run(t, new(testScheduleTransfer))
+}
+
+func testScheduleTransferEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewScheduleTransferFact(
+			token, NewTestAddress(), NewTestAddress(), NewAmount(NewBig(33), CurrencyID("SHOWME")), base.Height(10),
+		)
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewScheduleTransfer(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(ScheduleTransfer)
+		tb := b.(ScheduleTransfer)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(ScheduleTransferFact)
+		ufact := tb.Fact().(ScheduleTransferFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.receiver.Equal(ufact.receiver))
+		t.True(fact.amount.Equal(ufact.amount))
+		t.Equal(fact.height, ufact.height)
+	}
+
+	return t
+}
+
+func TestScheduleTransferEncodeJSON(t *testing.T) {
+	suite.Run(t, testScheduleTransferEncode(jsonenc.NewEncoder()))
+}
+
+func TestScheduleTransferEncodeBSON(t *testing.T) {
+	suite.Run(t, testScheduleTransferEncode(bsonenc.NewEncoder()))
+}
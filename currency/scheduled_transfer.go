@@ -0,0 +1,338 @@
+package currency
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ScheduledTransferType = hint.MustNewType(0xa0, 0x76, "mitum-currency-scheduled-transfer")
+	ScheduledTransferHint = hint.MustHint(ScheduledTransferType, "0.0.1")
+	ScheduleItemType      = hint.MustNewType(0xa0, 0x77, "mitum-currency-schedule-item")
+	ScheduleItemHint      = hint.MustHint(ScheduleItemType, "0.0.1")
+	ScheduleQueueType     = hint.MustNewType(0xa0, 0x78, "mitum-currency-schedule-queue")
+	ScheduleQueueHint     = hint.MustHint(ScheduleQueueType, "0.0.1")
+)
+
+type ScheduledTransferStatus string
+
+const (
+	ScheduledTransferStatusPending   ScheduledTransferStatus = "pending"
+	ScheduledTransferStatusExecuted  ScheduledTransferStatus = "executed"
+	ScheduledTransferStatusCancelled ScheduledTransferStatus = "cancelled"
+	ScheduledTransferStatusRefunded  ScheduledTransferStatus = "refunded"
+)
+
+func (ss ScheduledTransferStatus) Bytes() []byte {
+	return []byte(ss)
+}
+
+func (ss ScheduledTransferStatus) String() string {
+	return string(ss)
+}
+
+func (ss ScheduledTransferStatus) IsValid([]byte) error {
+	switch ss {
+	case ScheduledTransferStatusPending,
+		ScheduledTransferStatusExecuted,
+		ScheduledTransferStatusCancelled,
+		ScheduledTransferStatusRefunded:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown scheduled transfer status, %q", ss)
+	}
+}
+
+// ScheduledTransfer is the amount, which is reserved by sender with
+// ScheduleTransfer. At the target height it is transferred to receiver; if
+// receiver can not receive it, it is refunded to sender. The id of
+// ScheduledTransfer is the fact hash of ScheduleTransfer.
+type ScheduledTransfer struct {
+	id       valuehash.Hash
+	sender   base.Address
+	receiver base.Address
+	amount   Amount
+	height   base.Height
+	status   ScheduledTransferStatus
+}
+
+func NewScheduledTransfer(
+	id valuehash.Hash,
+	sender, receiver base.Address,
+	amount Amount,
+	height base.Height,
+) ScheduledTransfer {
+	return ScheduledTransfer{
+		id:       id,
+		sender:   sender,
+		receiver: receiver,
+		amount:   amount,
+		height:   height,
+		status:   ScheduledTransferStatusPending,
+	}
+}
+
+func (sc ScheduledTransfer) Hint() hint.Hint {
+	return ScheduledTransferHint
+}
+
+func (sc ScheduledTransfer) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		sc.id.Bytes(),
+		sc.sender.Bytes(),
+		sc.receiver.Bytes(),
+		sc.amount.Bytes(),
+		sc.height.Bytes(),
+		sc.status.Bytes(),
+	)
+}
+
+func (sc ScheduledTransfer) Hash() valuehash.Hash {
+	return sc.GenerateHash()
+}
+
+func (sc ScheduledTransfer) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(sc.Bytes())
+}
+
+func (sc ScheduledTransfer) IsValid([]byte) error {
+	if err := isvalid.Check([]isvalid.IsValider{
+		sc.id,
+		sc.sender,
+		sc.receiver,
+		sc.amount,
+		sc.height,
+		sc.status,
+	}, nil, false); err != nil {
+		return xerrors.Errorf("invalid ScheduledTransfer: %w", err)
+	}
+
+	if sc.sender.Equal(sc.receiver) {
+		return xerrors.Errorf("receiver is same with sender, %q", sc.sender)
+	}
+
+	if !sc.amount.Big().OverZero() {
+		return xerrors.Errorf("amount should be over zero")
+	}
+
+	return nil
+}
+
+func (sc ScheduledTransfer) ID() valuehash.Hash {
+	return sc.id
+}
+
+func (sc ScheduledTransfer) Sender() base.Address {
+	return sc.sender
+}
+
+func (sc ScheduledTransfer) Receiver() base.Address {
+	return sc.receiver
+}
+
+func (sc ScheduledTransfer) Amount() Amount {
+	return sc.amount
+}
+
+func (sc ScheduledTransfer) Currency() CurrencyID {
+	return sc.amount.Currency()
+}
+
+// Height is the target height of transfer.
+func (sc ScheduledTransfer) Height() base.Height {
+	return sc.height
+}
+
+func (sc ScheduledTransfer) Status() ScheduledTransferStatus {
+	return sc.status
+}
+
+func (sc ScheduledTransfer) Execute() ScheduledTransfer {
+	sc.status = ScheduledTransferStatusExecuted
+
+	return sc
+}
+
+func (sc ScheduledTransfer) Cancel() ScheduledTransfer {
+	sc.status = ScheduledTransferStatusCancelled
+
+	return sc
+}
+
+func (sc ScheduledTransfer) Refund() ScheduledTransfer {
+	sc.status = ScheduledTransferStatusRefunded
+
+	return sc
+}
+
+// ScheduleItem points the pending ScheduledTransfer and it's target height.
+type ScheduleItem struct {
+	height base.Height
+	id     valuehash.Hash
+}
+
+func NewScheduleItem(height base.Height, id valuehash.Hash) ScheduleItem {
+	return ScheduleItem{height: height, id: id}
+}
+
+func (si ScheduleItem) Hint() hint.Hint {
+	return ScheduleItemHint
+}
+
+func (si ScheduleItem) Bytes() []byte {
+	return util.ConcatBytesSlice(si.height.Bytes(), si.id.Bytes())
+}
+
+func (si ScheduleItem) IsValid([]byte) error {
+	return isvalid.Check([]isvalid.IsValider{si.height, si.id}, nil, false)
+}
+
+func (si ScheduleItem) Height() base.Height {
+	return si.height
+}
+
+func (si ScheduleItem) ID() valuehash.Hash {
+	return si.id
+}
+
+// ScheduleQueue keeps the pending ScheduledTransfers of network, sorted by
+// the target height. States can not be looked up by prefix, so the queue is
+// the only way to find the ScheduledTransfers to be executed.
+type ScheduleQueue struct {
+	items []ScheduleItem
+}
+
+func NewScheduleQueue(items []ScheduleItem) ScheduleQueue {
+	n := make([]ScheduleItem, len(items))
+	copy(n, items)
+
+	sortScheduleItems(n)
+
+	return ScheduleQueue{items: n}
+}
+
+func (sq ScheduleQueue) Hint() hint.Hint {
+	return ScheduleQueueHint
+}
+
+func (sq ScheduleQueue) Bytes() []byte {
+	bs := make([][]byte, len(sq.items))
+	for i := range sq.items {
+		bs[i] = sq.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (sq ScheduleQueue) Hash() valuehash.Hash {
+	return sq.GenerateHash()
+}
+
+func (sq ScheduleQueue) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(sq.Bytes())
+}
+
+func (sq ScheduleQueue) IsValid([]byte) error {
+	founds := map[string]struct{}{}
+	for i := range sq.items {
+		it := sq.items[i]
+		if err := it.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid ScheduleQueue: %w", err)
+		}
+
+		if _, found := founds[it.id.String()]; found {
+			return xerrors.Errorf("duplicated scheduled transfer, %q found", it.id)
+		}
+
+		founds[it.id.String()] = struct{}{}
+
+		if i > 0 && sq.items[i-1].height > it.height {
+			return xerrors.Errorf("ScheduleQueue not sorted by height")
+		}
+	}
+
+	return nil
+}
+
+func (sq ScheduleQueue) Items() []ScheduleItem {
+	return sq.items
+}
+
+func (sq ScheduleQueue) IsEmpty() bool {
+	return len(sq.items) < 1
+}
+
+// Add adds the items; the item, which is already in queue, is ignored.
+func (sq ScheduleQueue) Add(items ...ScheduleItem) ScheduleQueue {
+	founds := map[string]struct{}{}
+	for i := range sq.items {
+		founds[sq.items[i].id.String()] = struct{}{}
+	}
+
+	n := make([]ScheduleItem, len(sq.items), len(sq.items)+len(items))
+	copy(n, sq.items)
+
+	for i := range items {
+		if _, found := founds[items[i].id.String()]; found {
+			continue
+		}
+
+		founds[items[i].id.String()] = struct{}{}
+		n = append(n, items[i])
+	}
+
+	sortScheduleItems(n)
+
+	return ScheduleQueue{items: n}
+}
+
+// Remove removes the items by id.
+func (sq ScheduleQueue) Remove(ids ...valuehash.Hash) ScheduleQueue {
+	removes := map[string]struct{}{}
+	for i := range ids {
+		removes[ids[i].String()] = struct{}{}
+	}
+
+	var n []ScheduleItem
+	for i := range sq.items {
+		if _, found := removes[sq.items[i].id.String()]; found {
+			continue
+		}
+
+		n = append(n, sq.items[i])
+	}
+
+	return ScheduleQueue{items: n}
+}
+
+// Due returns the items, whose target height is reached at the given height.
+func (sq ScheduleQueue) Due(height base.Height) []ScheduleItem {
+	var due []ScheduleItem
+	for i := range sq.items {
+		if sq.items[i].height > height {
+			break
+		}
+
+		due = append(due, sq.items[i])
+	}
+
+	return due
+}
+
+func sortScheduleItems(items []ScheduleItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].height != items[j].height {
+			return items[i].height < items[j].height
+		}
+
+		return strings.Compare(items[i].id.String(), items[j].id.String()) < 0
+	})
+}
@@ -0,0 +1,92 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (sc ScheduledTransfer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sc.Hint()),
+		bson.M{
+			"id":       sc.id,
+			"sender":   sc.sender,
+			"receiver": sc.receiver,
+			"amount":   sc.amount,
+			"height":   sc.height,
+			"status":   sc.status,
+		}),
+	)
+}
+
+type ScheduledTransferBSONUnpacker struct {
+	ID valuehash.Bytes         `bson:"id"`
+	SD base.AddressDecoder     `bson:"sender"`
+	RC base.AddressDecoder     `bson:"receiver"`
+	AM bson.Raw                `bson:"amount"`
+	HT base.Height             `bson:"height"`
+	ST ScheduledTransferStatus `bson:"status"`
+}
+
+func (sc *ScheduledTransfer) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usc ScheduledTransferBSONUnpacker
+	if err := enc.Unmarshal(b, &usc); err != nil {
+		return err
+	}
+
+	return sc.unpack(enc, usc.ID, usc.SD, usc.RC, usc.AM, usc.HT, usc.ST)
+}
+
+func (si ScheduleItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(si.Hint()),
+		bson.M{
+			"height": si.height,
+			"id":     si.id,
+		}),
+	)
+}
+
+type ScheduleItemBSONUnpacker struct {
+	HT base.Height     `bson:"height"`
+	ID valuehash.Bytes `bson:"id"`
+}
+
+func (si *ScheduleItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usi ScheduleItemBSONUnpacker
+	if err := enc.Unmarshal(b, &usi); err != nil {
+		return err
+	}
+
+	return si.unpack(usi.HT, usi.ID)
+}
+
+func (sq ScheduleQueue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sq.Hint()),
+		bson.M{
+			"items": sq.items,
+		}),
+	)
+}
+
+type ScheduleQueueBSONUnpacker struct {
+	IT []bson.Raw `bson:"items"`
+}
+
+func (sq *ScheduleQueue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usq ScheduleQueueBSONUnpacker
+	if err := enc.Unmarshal(b, &usq); err != nil {
+		return err
+	}
+
+	bit := make([][]byte, len(usq.IT))
+	for i := range usq.IT {
+		bit[i] = usq.IT[i]
+	}
+
+	return sq.unpack(enc, bit)
+}
@@ -0,0 +1,70 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (sc *ScheduledTransfer) unpack(
+	enc encoder.Encoder,
+	id valuehash.Hash,
+	bSender base.AddressDecoder,
+	bReceiver base.AddressDecoder,
+	bam []byte,
+	height base.Height,
+	status ScheduledTransferStatus,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		sc.sender = a
+	}
+
+	if a, err := bReceiver.Encode(enc); err != nil {
+		return err
+	} else {
+		sc.receiver = a
+	}
+
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		sc.amount = am
+	}
+
+	sc.id = id
+	sc.height = height
+	sc.status = status
+
+	return nil
+}
+
+func (si *ScheduleItem) unpack(height base.Height, id valuehash.Hash) error {
+	si.height = height
+	si.id = id
+
+	return nil
+}
+
+func (sq *ScheduleQueue) unpack(enc encoder.Encoder, bit [][]byte) error {
+	var items []ScheduleItem
+	if len(bit) > 0 {
+		items = make([]ScheduleItem, len(bit))
+		for i := range bit {
+			if j, err := enc.DecodeByHint(bit[i]); err != nil {
+				return err
+			} else if it, ok := j.(ScheduleItem); !ok {
+				return xerrors.Errorf("not ScheduleItem, %T", j)
+			} else {
+				items[i] = it
+			}
+		}
+	}
+
+	sq.items = items
+
+	return nil
+}
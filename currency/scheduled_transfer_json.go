@@ -0,0 +1,107 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type ScheduledTransferJSONPacker struct {
+	jsonenc.HintedHead
+	ID valuehash.Hash          `json:"id"`
+	SD base.Address            `json:"sender"`
+	RC base.Address            `json:"receiver"`
+	AM Amount                  `json:"amount"`
+	HT base.Height             `json:"height"`
+	ST ScheduledTransferStatus `json:"status"`
+}
+
+func (sc ScheduledTransfer) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ScheduledTransferJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sc.Hint()),
+		ID:         sc.id,
+		SD:         sc.sender,
+		RC:         sc.receiver,
+		AM:         sc.amount,
+		HT:         sc.height,
+		ST:         sc.status,
+	})
+}
+
+type ScheduledTransferJSONUnpacker struct {
+	ID valuehash.Bytes         `json:"id"`
+	SD base.AddressDecoder     `json:"sender"`
+	RC base.AddressDecoder     `json:"receiver"`
+	AM json.RawMessage         `json:"amount"`
+	HT base.Height             `json:"height"`
+	ST ScheduledTransferStatus `json:"status"`
+}
+
+func (sc *ScheduledTransfer) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usc ScheduledTransferJSONUnpacker
+	if err := enc.Unmarshal(b, &usc); err != nil {
+		return err
+	}
+
+	return sc.unpack(enc, usc.ID, usc.SD, usc.RC, usc.AM, usc.HT, usc.ST)
+}
+
+type ScheduleItemJSONPacker struct {
+	jsonenc.HintedHead
+	HT base.Height    `json:"height"`
+	ID valuehash.Hash `json:"id"`
+}
+
+func (si ScheduleItem) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ScheduleItemJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(si.Hint()),
+		HT:         si.height,
+		ID:         si.id,
+	})
+}
+
+type ScheduleItemJSONUnpacker struct {
+	HT base.Height     `json:"height"`
+	ID valuehash.Bytes `json:"id"`
+}
+
+func (si *ScheduleItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usi ScheduleItemJSONUnpacker
+	if err := enc.Unmarshal(b, &usi); err != nil {
+		return err
+	}
+
+	return si.unpack(usi.HT, usi.ID)
+}
+
+type ScheduleQueueJSONPacker struct {
+	jsonenc.HintedHead
+	IT []ScheduleItem `json:"items"`
+}
+
+func (sq ScheduleQueue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ScheduleQueueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sq.Hint()),
+		IT:         sq.items,
+	})
+}
+
+type ScheduleQueueJSONUnpacker struct {
+	IT []json.RawMessage `json:"items"`
+}
+
+func (sq *ScheduleQueue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usq ScheduleQueueJSONUnpacker
+	if err := enc.Unmarshal(b, &usq); err != nil {
+		return err
+	}
+
+	bit := make([][]byte, len(usq.IT))
+	for i := range usq.IT {
+		bit[i] = usq.IT[i]
+	}
+
+	return sq.unpack(enc, bit)
+}
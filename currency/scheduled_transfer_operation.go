@@ -0,0 +1,251 @@
+package currency
+
+import (
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ScheduledTransferOperationFactType = hint.MustNewType(0xa0, 0x7d, "mitum-currency-scheduled-transfer-operation-fact")
+	ScheduledTransferOperationFactHint = hint.MustHint(ScheduledTransferOperationFactType, "0.0.1")
+	ScheduledTransferOperationType     = hint.MustNewType(0xa0, 0x7e, "mitum-currency-scheduled-transfer-operation")
+	ScheduledTransferOperationHint     = hint.MustHint(ScheduledTransferOperationType, "0.0.1")
+)
+
+// ScheduledTransferOperationFact has the ScheduledTransfers, which reach the
+// target height in the block. The executed ScheduledTransfer is transferred to
+// receiver and the refunded one is returned to sender.
+type ScheduledTransferOperationFact struct {
+	h         valuehash.Hash
+	token     []byte
+	transfers []ScheduledTransfer
+}
+
+func NewScheduledTransferOperationFact(
+	height base.Height,
+	transfers []ScheduledTransfer,
+) ScheduledTransferOperationFact {
+	fact := ScheduledTransferOperationFact{
+		token:     height.Bytes(), // for unique token
+		transfers: transfers,
+	}
+	fact.h = valuehash.NewSHA256(fact.Bytes())
+
+	return fact
+}
+
+func (fact ScheduledTransferOperationFact) Hint() hint.Hint {
+	return ScheduledTransferOperationFactHint
+}
+
+func (fact ScheduledTransferOperationFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ScheduledTransferOperationFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.transfers)+1)
+	bs[0] = fact.token
+
+	for i := range fact.transfers {
+		bs[i+1] = fact.transfers[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fact ScheduledTransferOperationFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for ScheduledTransferOperationFact")
+	}
+
+	if err := fact.h.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(fact.transfers) < 1 {
+		return xerrors.Errorf("empty transfers for ScheduledTransferOperationFact")
+	}
+
+	founds := map[string]struct{}{}
+	for i := range fact.transfers {
+		sc := fact.transfers[i]
+		if err := sc.IsValid(nil); err != nil {
+			return err
+		}
+
+		switch sc.Status() {
+		case ScheduledTransferStatusExecuted, ScheduledTransferStatusRefunded:
+		default:
+			return xerrors.Errorf("scheduled transfer should be executed or refunded, not %s", sc.Status())
+		}
+
+		if _, found := founds[sc.ID().String()]; found {
+			return xerrors.Errorf("duplicated scheduled transfer, %q found", sc.ID())
+		}
+
+		founds[sc.ID().String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact ScheduledTransferOperationFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ScheduledTransferOperationFact) Transfers() []ScheduledTransfer {
+	return fact.transfers
+}
+
+func (fact ScheduledTransferOperationFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+	founds := map[string]struct{}{}
+	for i := range fact.transfers {
+		for _, a := range []base.Address{fact.transfers[i].Sender(), fact.transfers[i].Receiver()} {
+			if _, found := founds[a.String()]; found {
+				continue
+			}
+
+			founds[a.String()] = struct{}{}
+			as = append(as, a)
+		}
+	}
+
+	return as, nil
+}
+
+// ScheduledTransferOperation is created by OperationProcessor at the closing of
+// block like FeeOperation; it does not need the signs.
+type ScheduledTransferOperation struct {
+	fact ScheduledTransferOperationFact
+	h    valuehash.Hash
+}
+
+func NewScheduledTransferOperation(fact ScheduledTransferOperationFact) ScheduledTransferOperation {
+	op := ScheduledTransferOperation{fact: fact}
+	op.h = op.GenerateHash()
+
+	return op
+}
+
+func (op ScheduledTransferOperation) Hint() hint.Hint {
+	return ScheduledTransferOperationHint
+}
+
+func (op ScheduledTransferOperation) Fact() base.Fact {
+	return op.fact
+}
+
+func (op ScheduledTransferOperation) Hash() valuehash.Hash {
+	return op.h
+}
+
+func (op ScheduledTransferOperation) Signs() []operation.FactSign {
+	return nil
+}
+
+func (op ScheduledTransferOperation) IsValid([]byte) error {
+	if err := op.Hint().IsValid(nil); err != nil {
+		return err
+	}
+
+	if l := len(op.fact.Token()); l < 1 {
+		return isvalid.InvalidError.Errorf("ScheduledTransferOperation has empty token")
+	} else if l > operation.MaxTokenSize {
+		return isvalid.InvalidError.Errorf(
+			"ScheduledTransferOperation token size too large: %d > %d", l, operation.MaxTokenSize)
+	}
+
+	if err := op.Fact().IsValid(nil); err != nil {
+		return err
+	}
+
+	if !op.Hash().Equal(op.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong ScheduledTransferOperation hash")
+	}
+
+	return nil
+}
+
+func (op ScheduledTransferOperation) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(op.Fact().Hash().Bytes())
+}
+
+func (op ScheduledTransferOperation) AddFactSigns(...operation.FactSign) (operation.FactSignUpdater, error) {
+	return nil, nil
+}
+
+func (op ScheduledTransferOperation) LastSignedAt() time.Time {
+	return time.Time{}
+}
+
+func (op ScheduledTransferOperation) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type ScheduledTransferOperationProcessor struct {
+	ScheduledTransferOperation
+}
+
+func NewScheduledTransferOperationProcessor(op ScheduledTransferOperation) state.Processor {
+	return &ScheduledTransferOperationProcessor{
+		ScheduledTransferOperation: op,
+	}
+}
+
+func (opp *ScheduledTransferOperationProcessor) Process(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(ScheduledTransferOperationFact)
+
+	var sts []state.State // nolint:prealloc
+	balances := map[string]AmountState{}
+	var keys []string
+	for i := range fact.transfers {
+		sc := fact.transfers[i]
+
+		if st, _, err := loadPendingScheduledTransfer(sc.ID(), getState); err != nil {
+			return err
+		} else if nst, err := SetStateScheduledTransferValue(st, sc); err != nil {
+			return err
+		} else {
+			sts = append(sts, nst)
+		}
+
+		to := sc.Receiver()
+		if sc.Status() == ScheduledTransferStatusRefunded {
+			to = sc.Sender()
+		}
+
+		k := StateKeyBalance(to, sc.Currency())
+		if _, found := balances[k]; !found {
+			if st, _, err := getState(k); err != nil {
+				return err
+			} else {
+				balances[k] = NewAmountState(st, sc.Currency())
+				keys = append(keys, k)
+			}
+		}
+
+		balances[k] = balances[k].Add(sc.Amount().Big())
+	}
+
+	for i := range keys {
+		sts = append(sts, balances[keys[i]])
+	}
+
+	return setState(fact.Hash(), sts...)
+}
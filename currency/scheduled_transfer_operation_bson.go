@@ -0,0 +1,62 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact ScheduledTransferOperationFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":      fact.h,
+				"token":     fact.token,
+				"transfers": fact.transfers,
+			}))
+}
+
+type ScheduledTransferOperationFactBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	TK []byte          `bson:"token"`
+	TR []bson.Raw      `bson:"transfers"`
+}
+
+func (fact *ScheduledTransferOperationFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uft ScheduledTransferOperationFactBSONUnpacker
+	if err := enc.Unmarshal(b, &uft); err != nil {
+		return err
+	}
+
+	btr := make([][]byte, len(uft.TR))
+	for i := range uft.TR {
+		btr[i] = uft.TR[i]
+	}
+
+	return fact.unpack(enc, uft.H, uft.TK, btr)
+}
+
+func (op ScheduledTransferOperation) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(op.Hint()),
+		bson.M{
+			"hash": op.h,
+			"fact": op.fact,
+		},
+	))
+}
+
+type ScheduledTransferOperationBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	FC bson.Raw        `bson:"fact"`
+}
+
+func (op *ScheduledTransferOperation) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var upo ScheduledTransferOperationBSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	return op.unpack(enc, upo.H, upo.FC)
+}
@@ -0,0 +1,47 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *ScheduledTransferOperationFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	btr [][]byte,
+) error {
+	transfers := make([]ScheduledTransfer, len(btr))
+	for i := range btr {
+		if j, err := enc.DecodeByHint(btr[i]); err != nil {
+			return err
+		} else if sc, ok := j.(ScheduledTransfer); !ok {
+			return xerrors.Errorf("not ScheduledTransfer, %T", j)
+		} else {
+			transfers[i] = sc
+		}
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.transfers = transfers
+
+	return nil
+}
+
+func (op *ScheduledTransferOperation) unpack(enc encoder.Encoder, h valuehash.Hash, bfact []byte) error {
+	if hinter, err := base.DecodeFact(enc, bfact); err != nil {
+		return err
+	} else if fact, ok := hinter.(ScheduledTransferOperationFact); !ok {
+		return xerrors.Errorf("not ScheduledTransferOperationFact, %T", hinter)
+	} else {
+		op.fact = fact
+	}
+
+	op.h = h
+
+	return nil
+}
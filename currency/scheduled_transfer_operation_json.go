@@ -0,0 +1,72 @@
+package currency
+
+import (
+	"encoding/json"
+
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type ScheduledTransferOperationFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash      `json:"hash"`
+	TK []byte              `json:"token"`
+	TR []ScheduledTransfer `json:"transfers"`
+}
+
+func (fact ScheduledTransferOperationFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ScheduledTransferOperationFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		TR:         fact.transfers,
+	})
+}
+
+type ScheduledTransferOperationFactJSONUnpacker struct {
+	H  valuehash.Bytes   `json:"hash"`
+	TK []byte            `json:"token"`
+	TR []json.RawMessage `json:"transfers"`
+}
+
+func (fact *ScheduledTransferOperationFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uft ScheduledTransferOperationFactJSONUnpacker
+	if err := enc.Unmarshal(b, &uft); err != nil {
+		return err
+	}
+
+	btr := make([][]byte, len(uft.TR))
+	for i := range uft.TR {
+		btr[i] = uft.TR[i]
+	}
+
+	return fact.unpack(enc, uft.H, uft.TK, btr)
+}
+
+type ScheduledTransferOperationJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash                 `json:"hash"`
+	FT ScheduledTransferOperationFact `json:"fact"`
+}
+
+func (op ScheduledTransferOperation) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ScheduledTransferOperationJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(op.Hint()),
+		H:          op.h,
+		FT:         op.fact,
+	})
+}
+
+type ScheduledTransferOperationJSONUnpacker struct {
+	H  valuehash.Bytes `json:"hash"`
+	FT json.RawMessage `json:"fact"`
+}
+
+func (op *ScheduledTransferOperation) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var upo ScheduledTransferOperationJSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	return op.unpack(enc, upo.H, upo.FT)
+}
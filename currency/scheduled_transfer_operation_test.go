@@ -0,0 +1,115 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testScheduledTransferOperation struct {
+	baseTest
+}
+
+func (t *testScheduledTransferOperation) newScheduledTransfer(sender, receiver base.Address) ScheduledTransfer {
+	return NewScheduledTransfer(
+		valuehash.RandomSHA256(), sender, receiver, NewAmount(NewBig(10), CurrencyID("SHOWME")), base.Height(3),
+	)
+}
+
+func (t *testScheduledTransferOperation) TestNew() {
+	a := NewTestAddress()
+	b := NewTestAddress()
+	c := NewTestAddress()
+
+	fact := NewScheduledTransferOperationFact(base.Height(3), []ScheduledTransfer{
+		t.newScheduledTransfer(a, b).Execute(),
+		t.newScheduledTransfer(b, c).Refund(),
+	})
+
+	op := NewScheduledTransferOperation(fact)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	as, err := fact.Addresses()
+	t.NoError(err)
+	t.Equal(3, len(as))
+	t.True(a.Equal(as[0]))
+	t.True(b.Equal(as[1]))
+	t.True(c.Equal(as[2]))
+}
+
+func (t *testScheduledTransferOperation) TestPendingTransfer() {
+	fact := NewScheduledTransferOperationFact(base.Height(3), []ScheduledTransfer{
+		t.newScheduledTransfer(NewTestAddress(), NewTestAddress()),
+	})
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "should be executed or refunded")
+}
+
+func (t *testScheduledTransferOperation) TestEmptyTransfers() {
+	err := NewScheduledTransferOperationFact(base.Height(3), nil).IsValid(nil)
+	t.Contains(err.Error(), "empty transfers")
+}
+
+func TestScheduledTransferOperation(t *testing.T) {
+	suite.Run(t, new(testScheduledTransferOperation))
+}
+
+func testScheduledTransferOperationEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		fact := NewScheduledTransferOperationFact(base.Height(3), []ScheduledTransfer{
+			NewScheduledTransfer(
+				valuehash.RandomSHA256(),
+				NewTestAddress(),
+				NewTestAddress(),
+				NewAmount(NewBig(10), CurrencyID("SHOWME")),
+				base.Height(3),
+			).Execute(),
+		})
+
+		return NewScheduledTransferOperation(fact)
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(ScheduledTransferOperation)
+		cb := b.(ScheduledTransferOperation)
+		fact := ca.Fact().(ScheduledTransferOperationFact)
+		ufact := cb.Fact().(ScheduledTransferOperationFact)
+
+		t.Equal(fact.token, ufact.token)
+
+		t.Equal(len(fact.Transfers()), len(ufact.Transfers()))
+
+		for i := range fact.Transfers() {
+			sa := fact.Transfers()[i]
+			sb := ufact.Transfers()[i]
+
+			t.True(sa.ID().Equal(sb.ID()))
+			t.True(sa.Amount().Equal(sb.Amount()))
+			t.Equal(sa.Status(), sb.Status())
+		}
+	}
+
+	return t
+}
+
+func TestScheduledTransferOperationEncodeJSON(t *testing.T) {
+	suite.Run(t, testScheduledTransferOperationEncode(jsonenc.NewEncoder()))
+}
+
+func TestScheduledTransferOperationEncodeBSON(t *testing.T) {
+	suite.Run(t, testScheduledTransferOperationEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,162 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testScheduledTransfer struct {
+	suite.Suite
+}
+
+func (t *testScheduledTransfer) newScheduledTransfer(height base.Height) ScheduledTransfer {
+	return NewScheduledTransfer(
+		valuehash.RandomSHA256(),
+		NewTestAddress(),
+		NewTestAddress(),
+		NewAmount(NewBig(10), CurrencyID("SHOWME")),
+		height,
+	)
+}
+
+func (t *testScheduledTransfer) TestNew() {
+	sc := t.newScheduledTransfer(base.Height(33))
+	t.NoError(sc.IsValid(nil))
+	t.Equal(ScheduledTransferStatusPending, sc.Status())
+
+	t.Equal(ScheduledTransferStatusExecuted, sc.Execute().Status())
+	t.Equal(ScheduledTransferStatusCancelled, sc.Cancel().Status())
+	t.Equal(ScheduledTransferStatusRefunded, sc.Refund().Status())
+	t.Equal(ScheduledTransferStatusPending, sc.Status())
+}
+
+func (t *testScheduledTransfer) TestSameReceiver() {
+	a := NewTestAddress()
+	err := NewScheduledTransfer(
+		valuehash.RandomSHA256(), a, a, NewAmount(NewBig(10), CurrencyID("SHOWME")), base.Height(33),
+	).IsValid(nil)
+	t.Contains(err.Error(), "receiver is same with sender")
+}
+
+func (t *testScheduledTransfer) TestQueue() {
+	a := NewScheduleItem(base.Height(3), valuehash.RandomSHA256())
+	b := NewScheduleItem(base.Height(1), valuehash.RandomSHA256())
+	c := NewScheduleItem(base.Height(2), valuehash.RandomSHA256())
+
+	sq := NewScheduleQueue([]ScheduleItem{a, b})
+	t.NoError(sq.IsValid(nil))
+	t.Equal(base.Height(1), sq.Items()[0].Height())
+
+	sq = sq.Add(c, a)
+	t.NoError(sq.IsValid(nil))
+	t.Equal(3, len(sq.Items()))
+	t.Equal(base.Height(2), sq.Items()[1].Height())
+
+	due := sq.Due(base.Height(2))
+	t.Equal(2, len(due))
+	t.True(b.ID().Equal(due[0].ID()))
+	t.True(c.ID().Equal(due[1].ID()))
+
+	t.Empty(sq.Due(base.NilHeight))
+
+	sq = sq.Remove(b.ID(), c.ID())
+	t.Equal(1, len(sq.Items()))
+	t.True(a.ID().Equal(sq.Items()[0].ID()))
+
+	t.True(sq.Remove(a.ID()).IsEmpty())
+}
+
+func (t *testScheduledTransfer) TestQueueDuplicated() {
+	a := NewScheduleItem(base.Height(3), valuehash.RandomSHA256())
+
+	err := ScheduleQueue{items: []ScheduleItem{a, a}}.IsValid(nil)
+	t.Contains(err.Error(), "duplicated scheduled transfer")
+}
+
+func TestScheduledTransfer(t *testing.T) {
+	suite.Run(t, new(testScheduledTransfer))
+}
+
+func testScheduledTransferEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		sc := NewScheduledTransfer(
+			valuehash.RandomSHA256(),
+			NewTestAddress(),
+			NewTestAddress(),
+			NewAmount(NewBig(10), CurrencyID("SHOWME")),
+			base.Height(33),
+		).Execute()
+		t.NoError(sc.IsValid(nil))
+
+		return sc
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(ScheduledTransfer)
+		tb := b.(ScheduledTransfer)
+
+		t.True(ta.ID().Equal(tb.ID()))
+		t.True(ta.Sender().Equal(tb.Sender()))
+		t.True(ta.Receiver().Equal(tb.Receiver()))
+		t.True(ta.Amount().Equal(tb.Amount()))
+		t.Equal(ta.Height(), tb.Height())
+		t.Equal(ta.Status(), tb.Status())
+	}
+
+	return t
+}
+
+func TestScheduledTransferEncodeJSON(t *testing.T) {
+	suite.Run(t, testScheduledTransferEncode(jsonenc.NewEncoder()))
+}
+
+func TestScheduledTransferEncodeBSON(t *testing.T) {
+	suite.Run(t, testScheduledTransferEncode(bsonenc.NewEncoder()))
+}
+
+func testScheduleQueueEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		sq := NewScheduleQueue([]ScheduleItem{
+			NewScheduleItem(base.Height(3), valuehash.RandomSHA256()),
+			NewScheduleItem(base.Height(1), valuehash.RandomSHA256()),
+		})
+		t.NoError(sq.IsValid(nil))
+
+		return sq
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(ScheduleQueue)
+		tb := b.(ScheduleQueue)
+
+		t.Equal(len(ta.Items()), len(tb.Items()))
+
+		for i := range ta.Items() {
+			t.Equal(ta.Items()[i].Height(), tb.Items()[i].Height())
+			t.True(ta.Items()[i].ID().Equal(tb.Items()[i].ID()))
+		}
+	}
+
+	return t
+}
+
+func TestScheduleQueueEncodeJSON(t *testing.T) {
+	suite.Run(t, testScheduleQueueEncode(jsonenc.NewEncoder()))
+}
+
+func TestScheduleQueueEncodeBSON(t *testing.T) {
+	suite.Run(t, testScheduleQueueEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,143 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	SequenceType = hint.MustNewType(0xa0, 0x86, "mitum-currency-sequence")
+	SequenceHint = hint.MustHint(SequenceType, "0.0.1")
+)
+
+// Sequence keeps the next sequence of account. The fact with sequence should
+// have the next sequence of account; after the fact is processed, the next
+// sequence is increased, so the fact can not be processed again. The account
+// without Sequence state expects 1.
+type Sequence struct {
+	account base.Address
+	next    uint64
+}
+
+func NewSequence(a base.Address) Sequence {
+	return Sequence{account: a, next: 1}
+}
+
+func (sq Sequence) Hint() hint.Hint {
+	return SequenceHint
+}
+
+func (sq Sequence) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		sq.account.Bytes(),
+		util.Uint64ToBytes(sq.next),
+	)
+}
+
+func (sq Sequence) Hash() valuehash.Hash {
+	return sq.GenerateHash()
+}
+
+func (sq Sequence) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(sq.Bytes())
+}
+
+func (sq Sequence) IsValid([]byte) error {
+	if err := sq.account.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid account: %w", err)
+	}
+
+	if sq.next < 1 {
+		return xerrors.Errorf("next sequence should be over zero")
+	}
+
+	return nil
+}
+
+func (sq Sequence) Account() base.Address {
+	return sq.account
+}
+
+// Next returns the sequence, which the next fact of account should have.
+func (sq Sequence) Next() uint64 {
+	return sq.next
+}
+
+// Use checks the given sequence and returns the increased Sequence.
+func (sq Sequence) Use(s uint64) (Sequence, error) {
+	if s != sq.next {
+		return Sequence{}, xerrors.Errorf("wrong sequence, %d; expected %d", s, sq.next)
+	}
+
+	sq.next++
+
+	return sq, nil
+}
+
+func sequenceBytes(s uint64) []byte {
+	if s < 1 {
+		return nil
+	}
+
+	return optionalFieldBytes(factFieldSequence, util.Uint64ToBytes(s))
+}
+
+// sequenceRequiredVersion is the version of fact hint, since which the fact
+// should have the sequence. The facts of the previous versions do not have
+// the sequence.
+var sequenceRequiredVersion = util.Version("0.0.2")
+
+func isSequenceRequired(ht hint.Hint) bool {
+	return ht.Version().IsCompatible(sequenceRequiredVersion) == nil
+}
+
+func isValidSequence(ht hint.Hint, s uint64) error {
+	if s < 1 && isSequenceRequired(ht) {
+		return xerrors.Errorf("empty sequence; sequence is required since %s", sequenceRequiredVersion)
+	}
+
+	return nil
+}
+
+// checkSequence checks the sequence of fact with the Sequence state of account
+// and returns the updated state. The fact of the previous version without
+// sequence is not checked and nil state is returned.
+func checkSequence(
+	ht hint.Hint,
+	a base.Address,
+	s uint64,
+	getState func(key string) (state.State, bool, error),
+) (state.State, error) {
+	if s < 1 {
+		if isSequenceRequired(ht) {
+			return nil, util.IgnoreError.Errorf("empty sequence of account, %q", a)
+		}
+
+		return nil, nil
+	}
+
+	st, found, err := getState(StateKeySequence(a))
+	if err != nil {
+		return nil, err
+	}
+
+	sq := NewSequence(a)
+	if found {
+		if i, err := StateSequenceValue(st); err != nil {
+			return nil, util.IgnoreError.Wrap(err)
+		} else {
+			sq = i
+		}
+	}
+
+	if usq, err := sq.Use(s); err != nil {
+		return nil, util.IgnoreError.Errorf("account, %q: %w", a, err)
+	} else {
+		return SetStateSequenceValue(st, usq)
+	}
+}
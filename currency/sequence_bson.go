@@ -0,0 +1,32 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (sq Sequence) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sq.Hint()),
+		bson.M{
+			"account": sq.account,
+			"next":    sq.next,
+		}),
+	)
+}
+
+type SequenceBSONUnpacker struct {
+	AC base.AddressDecoder `bson:"account"`
+	NX uint64              `bson:"next"`
+}
+
+func (sq *Sequence) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usq SequenceBSONUnpacker
+	if err := enc.Unmarshal(b, &usq); err != nil {
+		return err
+	}
+
+	return sq.unpack(enc, usq.AC, usq.NX)
+}
@@ -0,0 +1,22 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (sq *Sequence) unpack(
+	enc encoder.Encoder,
+	bAccount base.AddressDecoder,
+	next uint64,
+) error {
+	if a, err := bAccount.Encode(enc); err != nil {
+		return err
+	} else {
+		sq.account = a
+	}
+
+	sq.next = next
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type SequenceJSONPacker struct {
+	jsonenc.HintedHead
+	AC base.Address `json:"account"`
+	NX uint64       `json:"next"`
+}
+
+func (sq Sequence) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SequenceJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sq.Hint()),
+		AC:         sq.account,
+		NX:         sq.next,
+	})
+}
+
+type SequenceJSONUnpacker struct {
+	AC base.AddressDecoder `json:"account"`
+	NX uint64              `json:"next"`
+}
+
+func (sq *Sequence) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usq SequenceJSONUnpacker
+	if err := enc.Unmarshal(b, &usq); err != nil {
+		return err
+	}
+
+	return sq.unpack(enc, usq.AC, usq.NX)
+}
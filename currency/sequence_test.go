@@ -0,0 +1,75 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testSequence struct {
+	suite.Suite
+}
+
+func (t *testSequence) TestNew() {
+	a := NewTestAddress()
+
+	sq := NewSequence(a)
+	t.NoError(sq.IsValid(nil))
+
+	t.True(a.Equal(sq.Account()))
+	t.Equal(uint64(1), sq.Next())
+}
+
+func (t *testSequence) TestUse() {
+	sq := NewSequence(NewTestAddress())
+
+	usq, err := sq.Use(1)
+	t.NoError(err)
+	t.Equal(uint64(2), usq.Next())
+	t.Equal(uint64(1), sq.Next())
+
+	_, err = usq.Use(1)
+	t.Contains(err.Error(), "wrong sequence, 1; expected 2")
+
+	_, err = usq.Use(3)
+	t.Contains(err.Error(), "wrong sequence, 3; expected 2")
+}
+
+func TestSequence(t *testing.T) {
+	suite.Run(t, new(testSequence))
+}
+
+func testSequenceEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		sq, err := NewSequence(NewTestAddress()).Use(1)
+		t.NoError(err)
+		t.NoError(sq.IsValid(nil))
+
+		return sq
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Sequence)
+		tb := b.(Sequence)
+
+		t.True(ta.Account().Equal(tb.Account()))
+		t.Equal(ta.Next(), tb.Next())
+	}
+
+	return t
+}
+
+func TestSequenceEncodeJSON(t *testing.T) {
+	suite.Run(t, testSequenceEncode(jsonenc.NewEncoder()))
+}
+
+func TestSequenceEncodeBSON(t *testing.T) {
+	suite.Run(t, testSequenceEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,162 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	SetSpendingLimitFactType = hint.MustNewType(0xa0, 0x74, "mitum-currency-set-spending-limit-operation-fact")
+	SetSpendingLimitFactHint = hint.MustHint(SetSpendingLimitFactType, "0.0.1")
+	SetSpendingLimitType     = hint.MustNewType(0xa0, 0x75, "mitum-currency-set-spending-limit-operation")
+	SetSpendingLimitHint     = hint.MustHint(SetSpendingLimitType, "0.0.1")
+)
+
+// SetSpendingLimitFact sets the SpendingLimitRule of sender for currency. The
+// fee is charged to sender in currency.
+type SetSpendingLimitFact struct {
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	currency CurrencyID
+	rule     SpendingLimitRule
+}
+
+func NewSetSpendingLimitFact(
+	token []byte,
+	sender base.Address,
+	currency CurrencyID,
+	rule SpendingLimitRule,
+) SetSpendingLimitFact {
+	fact := SetSpendingLimitFact{
+		token:    token,
+		sender:   sender,
+		currency: currency,
+		rule:     rule,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact SetSpendingLimitFact) Hint() hint.Hint {
+	return SetSpendingLimitFactHint
+}
+
+func (fact SetSpendingLimitFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact SetSpendingLimitFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SetSpendingLimitFact) Token() []byte {
+	return fact.token
+}
+
+func (fact SetSpendingLimitFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.currency.Bytes(),
+		fact.rule.Bytes(),
+	)
+}
+
+func (fact SetSpendingLimitFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for SetSpendingLimitFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.currency,
+		fact.rule,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact SetSpendingLimitFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SetSpendingLimitFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact SetSpendingLimitFact) Rule() SpendingLimitRule {
+	return fact.rule
+}
+
+func (fact SetSpendingLimitFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type SetSpendingLimit struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewSetSpendingLimit(fact SetSpendingLimitFact, fs []operation.FactSign, memo string) (SetSpendingLimit, error) {
+	if bo, err := operation.NewBaseOperationFromFact(SetSpendingLimitHint, fact, fs); err != nil {
+		return SetSpendingLimit{}, err
+	} else {
+		op := SetSpendingLimit{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op SetSpendingLimit) Hint() hint.Hint {
+	return SetSpendingLimitHint
+}
+
+func (op SetSpendingLimit) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op SetSpendingLimit) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op SetSpendingLimit) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,70 @@
+package currency // nolint: dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type SetSpendingLimitFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash    `json:"hash"`
+	TK []byte            `json:"token"`
+	SD base.Address      `json:"sender"`
+	CR CurrencyID        `json:"currency"`
+	RL SpendingLimitRule `json:"rule"`
+}
+
+func (fact SetSpendingLimitFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SetSpendingLimitFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		CR:         fact.currency,
+		RL:         fact.rule,
+	})
+}
+
+type SetSpendingLimitFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	CR string              `json:"currency"`
+	RL SpendingLimitRule   `json:"rule"`
+}
+
+func (fact *SetSpendingLimitFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact SetSpendingLimitFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.CR, ufact.RL)
+}
+
+func (op SetSpendingLimit) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *SetSpendingLimit) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = SetSpendingLimit{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op SetSpendingLimit) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type SetSpendingLimitProcessor struct {
+	cp *CurrencyPool
+	SetSpendingLimit
+	height base.Height
+	ss     state.State
+	sl     SpendingLimit
+	sb     AmountState
+	fee    Big
+}
+
+func NewSetSpendingLimitProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(SetSpendingLimit); !ok {
+			return nil, xerrors.Errorf("not SetSpendingLimit, %T", op)
+		} else {
+			return &SetSpendingLimitProcessor{
+				cp:               cp,
+				SetSpendingLimit: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *SetSpendingLimitProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *SetSpendingLimitProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(SetSpendingLimitFact)
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if opp.cp != nil {
+		if !opp.cp.Exists(fact.currency) {
+			return nil, util.IgnoreError.Errorf("currency not registered, %q", fact.currency)
+		}
+	}
+
+	// NOTE the tighter rule is set immediately, but the looser rule waits the
+	// delay of the current rule.
+	switch st, found, err := getState(StateKeySpendingLimit(fact.sender, fact.currency)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		opp.ss = st
+		opp.sl = NewSpendingLimit(fact.sender, fact.currency, fact.rule)
+	default:
+		if sl, err := StateSpendingLimitValue(st); err != nil {
+			return nil, util.IgnoreError.Wrap(err)
+		} else {
+			opp.ss = st
+			opp.sl = sl.SetRule(fact.rule, opp.height)
+		}
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	if sb, fee, err := loadOperationFee(
+		opp.cp, fact.sender, fact.currency, SetSpendingLimitType, opp.height, getState,
+	); err != nil {
+		return nil, err
+	} else {
+		opp.sb = sb
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *SetSpendingLimitProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(SetSpendingLimitFact)
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+	if st, err := SetStateSpendingLimitValue(opp.ss, opp.sl); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), st, opp.sb)
+	}
+}
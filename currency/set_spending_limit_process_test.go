@@ -0,0 +1,189 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testSetSpendingLimitOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testSetSpendingLimitOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testSetSpendingLimitOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(SetSpendingLimit{}, NewSetSpendingLimitProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testSetSpendingLimitOperations) newSetSpendingLimit(
+	sender base.Address,
+	keys []key.Privatekey,
+	rule SpendingLimitRule,
+) SetSpendingLimit {
+	token := util.UUID().Bytes()
+	fact := NewSetSpendingLimitFact(token, sender, t.cid, rule)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewSetSpendingLimit(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testSetSpendingLimitOperations) spendingLimit(pool *storage.Statepool, a base.Address) SpendingLimit {
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySpendingLimit(a, t.cid) {
+			sst = st.GetState()
+		}
+	}
+
+	t.NotNil(sst)
+
+	sl, err := StateSpendingLimitValue(sst)
+	t.NoError(err)
+
+	return sl
+}
+
+func (t *testSetSpendingLimitOperations) TestNew() {
+	fa, st0 := t.newAccount(true, nil)
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	rule := NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(3))
+	op := t.newSetSpendingLimit(sa.Address, sa.Privs(), rule)
+	t.NoError(opr.Process(op))
+
+	var bst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(sa.Address, t.cid) {
+			bst = st.GetState()
+		}
+	}
+
+	bstv, _ := StateBalanceValue(bst)
+	t.True(bstv.Big().Equal(NewBig(8)))
+	t.True(bst.(AmountState).Fee().Equal(fee))
+
+	sl := t.spendingLimit(pool, sa.Address)
+	t.True(sa.Address.Equal(sl.Account()))
+	t.Equal(rule.Bytes(), sl.Rule().Bytes())
+	t.False(sl.HasPending())
+}
+
+func (t *testSetSpendingLimitOperations) TestTighter() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	old := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(3)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, []state.State{dst, t.newSpendingLimitState(old)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	rule := NewSpendingLimitRule(NewBig(3), base.Height(10), base.Height(3))
+	t.NoError(opr.Process(t.newSetSpendingLimit(sa.Address, sa.Privs(), rule)))
+
+	sl := t.spendingLimit(pool, sa.Address)
+	t.Equal(rule.Bytes(), sl.Rule().Bytes())
+	t.False(sl.HasPending())
+}
+
+func (t *testSetSpendingLimitOperations) TestLooserDelayed() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	oldRule := NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(3))
+	old := NewSpendingLimit(sa.Address, t.cid, oldRule)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, []state.State{dst, t.newSpendingLimitState(old)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	rule := NewSpendingLimitRule(NewBig(100), base.Height(10), base.Height(3))
+	t.NoError(opr.Process(t.newSetSpendingLimit(sa.Address, sa.Privs(), rule)))
+
+	sl := t.spendingLimit(pool, sa.Address)
+	t.Equal(oldRule.Bytes(), sl.Rule().Bytes())
+	t.True(sl.HasPending())
+
+	pending, effective := sl.Pending()
+	t.Equal(rule.Bytes(), pending.Bytes())
+	t.Equal(pool.Height()+oldRule.Delay(), effective)
+}
+
+func (t *testSetSpendingLimitOperations) TestNotSignedBySender() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	rule := NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(3))
+	op := t.newSetSpendingLimit(sa.Address, ba.Privs(), rule)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func TestSetSpendingLimitOperations(t *testing.T) {
+	suite.Run(t, new(testSetSpendingLimitOperations))
+}
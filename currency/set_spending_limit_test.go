@@ -0,0 +1,103 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testSetSpendingLimit struct {
+	baseTest
+}
+
+func (t *testSetSpendingLimit) newOperation(sender base.Address, rule SpendingLimitRule) SetSpendingLimit {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewSetSpendingLimitFact(token, sender, t.cid, rule)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewSetSpendingLimit(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testSetSpendingLimit) TestNew() {
+	op := t.newOperation(NewTestAddress(), NewSpendingLimitRule(NewBig(10), base.Height(5), base.Height(3)))
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testSetSpendingLimit) TestInvalidRule() {
+	op := t.newOperation(NewTestAddress(), NewSpendingLimitRule(NewBig(10), base.Height(0), base.Height(3)))
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "window should be over zero")
+}
+
+func TestSetSpendingLimit(t *testing.T) {
+	suite.Run(t, new(testSetSpendingLimit))
+}
+
+func testSetSpendingLimitEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		rule := NewSpendingLimitRule(NewBig(10), base.Height(5), base.Height(3))
+		fact := NewSetSpendingLimitFact(token, NewTestAddress(), CurrencyID("SHOWME"), rule)
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewSetSpendingLimit(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(SetSpendingLimit)
+		tb := b.(SetSpendingLimit)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(SetSpendingLimitFact)
+		ufact := tb.Fact().(SetSpendingLimitFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.Equal(fact.currency, ufact.currency)
+		t.Equal(fact.rule.Bytes(), ufact.rule.Bytes())
+	}
+
+	return t
+}
+
+func TestSetSpendingLimitEncodeJSON(t *testing.T) {
+	suite.Run(t, testSetSpendingLimitEncode(jsonenc.NewEncoder()))
+}
+
+func TestSetSpendingLimitEncodeBSON(t *testing.T) {
+	suite.Run(t, testSetSpendingLimitEncode(bsonenc.NewEncoder()))
+}
@@ -33,7 +33,7 @@ func checkFactSignsByState(
 	getState func(key string) (state.State, bool, error),
 ) error {
 	var keys Keys
-	if st, err := existsState(StateKeyAccount(address), "keys of account", getState); err != nil {
+	if st, err := existsAccountState(address, "keys of account", getState); err != nil {
 		return err
 	} else {
 		if ks, err := StateKeysValue(st); err != nil {
@@ -49,3 +49,72 @@ func checkFactSignsByState(
 
 	return nil
 }
+
+// checkFactSignsWithFeePayer checks the fact signs by the keys of sender and,
+// if given, fee payer. Every signer should belong to one of the accounts and
+// the signs of each account should pass it's threshold.
+func checkFactSignsWithFeePayer(
+	sender base.Address,
+	feePayer base.Address,
+	fs []operation.FactSign,
+	getState func(key string) (state.State, bool, error),
+) error {
+	if feePayer == nil {
+		return checkFactSignsByState(sender, fs, getState)
+	}
+
+	addresses := []base.Address{sender, feePayer}
+	keys := make([]Keys, len(addresses))
+	for i := range addresses {
+		if st, err := existsAccountState(addresses[i], "keys of account", getState); err != nil {
+			return err
+		} else if ks, err := StateKeysValue(st); err != nil {
+			return util.IgnoreError.Wrap(err)
+		} else {
+			keys[i] = ks
+		}
+	}
+
+	for i := range fs {
+		var found bool
+		for j := range keys {
+			if _, found = keys[j].Key(fs[i].Signer()); found {
+				break
+			}
+		}
+
+		if !found {
+			return util.IgnoreError.Errorf("unknown key found, %s", fs[i].Signer())
+		}
+	}
+
+	for i := range keys {
+		var sum uint
+		for j := range fs {
+			if ky, found := keys[i].Key(fs[j].Signer()); found {
+				sum += ky.Weight()
+			}
+		}
+
+		if sum < keys[i].Threshold() {
+			return util.IgnoreError.Errorf(
+				"not passed threshold of %s, sum=%d < threshold=%d", addresses[i], sum, keys[i].Threshold())
+		}
+	}
+
+	return nil
+}
+
+// splitFactSigns splits the fact signs into the signs of keys and the others.
+func splitFactSigns(fs []operation.FactSign, keys Keys) ([]operation.FactSign, []operation.FactSign) {
+	var in, out []operation.FactSign
+	for i := range fs {
+		if _, found := keys.Key(fs[i].Signer()); found {
+			in = append(in, fs[i])
+		} else {
+			out = append(out, fs[i])
+		}
+	}
+
+	return in, out
+}
@@ -0,0 +1,291 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	SpendingLimitType = hint.MustNewType(0xa0, 0x73, "mitum-currency-spending-limit")
+	SpendingLimitHint = hint.MustHint(SpendingLimitType, "0.0.1")
+)
+
+// SpendingLimitRule limits the outflow of account to amount per window in
+// blocks. Loosening the rule takes effect after delay in blocks.
+type SpendingLimitRule struct {
+	amount Big
+	window base.Height
+	delay  base.Height
+}
+
+func NewSpendingLimitRule(amount Big, window, delay base.Height) SpendingLimitRule {
+	return SpendingLimitRule{amount: amount, window: window, delay: delay}
+}
+
+func (sr SpendingLimitRule) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		sr.amount.Bytes(),
+		sr.window.Bytes(),
+		sr.delay.Bytes(),
+	)
+}
+
+func (sr SpendingLimitRule) IsValid([]byte) error {
+	if !sr.amount.OverNil() {
+		return xerrors.Errorf("spending limit amount should be over nil")
+	}
+
+	if sr.window < 1 {
+		return xerrors.Errorf("spending limit window should be over zero, %v", sr.window)
+	}
+
+	if sr.delay < 0 {
+		return xerrors.Errorf("spending limit delay should not be negative, %v", sr.delay)
+	}
+
+	return nil
+}
+
+func (sr SpendingLimitRule) Amount() Big {
+	return sr.amount
+}
+
+func (sr SpendingLimitRule) Window() base.Height {
+	return sr.window
+}
+
+func (sr SpendingLimitRule) Delay() base.Height {
+	return sr.delay
+}
+
+// IsLooser checks the rule allows more outflow or shorter delay than the
+// given rule.
+func (sr SpendingLimitRule) IsLooser(b SpendingLimitRule) bool {
+	return sr.amount.Compare(b.amount) > 0 || sr.window < b.window || sr.delay < b.delay
+}
+
+// SpendingLimit is the spending limit of account for currency. It keeps the
+// outflow since the start of current window; when the window is passed, the
+// outflow is counted again from zero. The looser rule by SetSpendingLimit is
+// kept as pending until the effective height.
+type SpendingLimit struct {
+	account   base.Address
+	currency  CurrencyID
+	rule      SpendingLimitRule
+	pending   SpendingLimitRule
+	effective base.Height
+	start     base.Height
+	spent     Big
+}
+
+func NewSpendingLimit(account base.Address, cid CurrencyID, rule SpendingLimitRule) SpendingLimit {
+	return SpendingLimit{
+		account:   account,
+		currency:  cid,
+		rule:      rule,
+		pending:   NewSpendingLimitRule(ZeroBig, 0, 0),
+		effective: base.NilHeight,
+		start:     base.NilHeight,
+		spent:     ZeroBig,
+	}
+}
+
+func (sl SpendingLimit) Hint() hint.Hint {
+	return SpendingLimitHint
+}
+
+func (sl SpendingLimit) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		sl.account.Bytes(),
+		sl.currency.Bytes(),
+		sl.rule.Bytes(),
+		sl.pending.Bytes(),
+		sl.effective.Bytes(),
+		sl.start.Bytes(),
+		sl.spent.Bytes(),
+	)
+}
+
+func (sl SpendingLimit) Hash() valuehash.Hash {
+	return sl.GenerateHash()
+}
+
+func (sl SpendingLimit) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(sl.Bytes())
+}
+
+func (sl SpendingLimit) IsValid([]byte) error {
+	if err := isvalid.Check([]isvalid.IsValider{sl.account, sl.currency, sl.rule}, nil, false); err != nil {
+		return xerrors.Errorf("invalid SpendingLimit: %w", err)
+	}
+
+	if sl.HasPending() {
+		if err := sl.pending.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid pending rule: %w", err)
+		}
+	}
+
+	if !sl.spent.OverNil() {
+		return xerrors.Errorf("spent amount should be over nil")
+	}
+
+	return nil
+}
+
+func (sl SpendingLimit) Account() base.Address {
+	return sl.account
+}
+
+func (sl SpendingLimit) Currency() CurrencyID {
+	return sl.currency
+}
+
+func (sl SpendingLimit) Rule() SpendingLimitRule {
+	return sl.rule
+}
+
+func (sl SpendingLimit) HasPending() bool {
+	return sl.effective != base.NilHeight
+}
+
+// Pending returns the looser rule and the height when it takes effect.
+func (sl SpendingLimit) Pending() (SpendingLimitRule, base.Height) {
+	return sl.pending, sl.effective
+}
+
+// Start is the height when the current window is started.
+func (sl SpendingLimit) Start() base.Height {
+	return sl.start
+}
+
+// Spent returns the outflow in the current window.
+func (sl SpendingLimit) Spent() Big {
+	return sl.spent
+}
+
+// At returns the SpendingLimit at the given height; the pending rule, which
+// becomes effective, is applied and the passed window is closed.
+func (sl SpendingLimit) At(height base.Height) SpendingLimit {
+	if sl.HasPending() && height >= sl.effective {
+		sl.rule = sl.pending
+		sl.pending = NewSpendingLimitRule(ZeroBig, 0, 0)
+		sl.effective = base.NilHeight
+	}
+
+	if sl.start != base.NilHeight && height >= sl.start+sl.rule.window {
+		sl.start = base.NilHeight
+		sl.spent = ZeroBig
+	}
+
+	return sl
+}
+
+// SetRule sets the new rule at the given height. The tighter rule takes
+// effect immediately, but the looser rule takes effect after the delay of the
+// current rule.
+func (sl SpendingLimit) SetRule(rule SpendingLimitRule, height base.Height) SpendingLimit {
+	sl = sl.At(height)
+
+	if rule.IsLooser(sl.rule) {
+		sl.pending = rule
+		sl.effective = height + sl.rule.delay
+
+		return sl.At(height)
+	}
+
+	sl.rule = rule
+	sl.pending = NewSpendingLimitRule(ZeroBig, 0, 0)
+	sl.effective = base.NilHeight
+
+	return sl
+}
+
+// Spend adds the outflow at the given height. If the outflow in the window
+// exceeds the amount of rule, it fails.
+func (sl SpendingLimit) Spend(big Big, height base.Height) (SpendingLimit, error) {
+	sl = sl.At(height)
+
+	spent := sl.spent.Add(big)
+	if spent.Compare(sl.rule.amount) > 0 {
+		return SpendingLimit{}, xerrors.Errorf(
+			"spending limit exceeded, %v + %v > %v in %v blocks", sl.spent, big, sl.rule.amount, sl.rule.window)
+	}
+
+	if sl.start == base.NilHeight {
+		sl.start = height
+	}
+
+	sl.spent = spent
+
+	return sl, nil
+}
+
+// spendingLimitState is the SpendingLimit state of account, which is spent by
+// the operation.
+type spendingLimitState struct {
+	st state.State
+	sl SpendingLimit
+}
+
+// checkSpendingLimit checks the outflow of account does not exceed the
+// SpendingLimit of each currency. The updated SpendingLimit states are
+// returned.
+func checkSpendingLimit(
+	a base.Address,
+	outflow map[CurrencyID]Big,
+	height base.Height,
+	getState func(key string) (state.State, bool, error),
+) ([]spendingLimitState, error) {
+	var sls []spendingLimitState
+	for cid := range outflow {
+		var st state.State
+		switch i, found, err := getState(StateKeySpendingLimit(a, cid)); {
+		case err != nil:
+			return nil, err
+		case !found:
+			continue
+		default:
+			st = i
+		}
+
+		if sl, err := StateSpendingLimitValue(st); err != nil {
+			return nil, util.IgnoreError.Wrap(err)
+		} else if usl, err := sl.Spend(outflow[cid], height); err != nil {
+			return nil, util.IgnoreError.Errorf("account, %q: %w", a, err)
+		} else {
+			sls = append(sls, spendingLimitState{st: st, sl: usl})
+		}
+	}
+
+	return sls, nil
+}
+
+// requiredOutflow returns the amounts of required, which account sends; the fee
+// is not counted by SpendingLimit.
+func requiredOutflow(required map[CurrencyID][2]Big) map[CurrencyID]Big {
+	outflow := map[CurrencyID]Big{}
+	for cid := range required {
+		outflow[cid] = required[cid][0].Sub(required[cid][1])
+	}
+
+	return outflow
+}
+
+func setSpendingLimitStates(sls []spendingLimitState) ([]state.State, error) {
+	sts := make([]state.State, len(sls))
+	for i := range sls {
+		if st, err := SetStateSpendingLimitValue(sls[i].st, sls[i].sl); err != nil {
+			return nil, err
+		} else {
+			sts[i] = st
+		}
+	}
+
+	return sts, nil
+}
@@ -0,0 +1,67 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (sr SpendingLimitRule) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"amount": sr.amount,
+		"window": sr.window,
+		"delay":  sr.delay,
+	})
+}
+
+type SpendingLimitRuleBSONUnpacker struct {
+	AM Big         `bson:"amount"`
+	WD base.Height `bson:"window"`
+	DL base.Height `bson:"delay"`
+}
+
+func (sr *SpendingLimitRule) UnmarshalBSON(b []byte) error {
+	var usr SpendingLimitRuleBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &usr); err != nil {
+		return err
+	}
+
+	*sr = NewSpendingLimitRule(usr.AM, usr.WD, usr.DL)
+
+	return nil
+}
+
+func (sl SpendingLimit) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sl.Hint()),
+		bson.M{
+			"account":   sl.account,
+			"currency":  sl.currency,
+			"rule":      sl.rule,
+			"pending":   sl.pending,
+			"effective": sl.effective,
+			"start":     sl.start,
+			"spent":     sl.spent,
+		}),
+	)
+}
+
+type SpendingLimitBSONUnpacker struct {
+	AC base.AddressDecoder `bson:"account"`
+	CR string              `bson:"currency"`
+	RL SpendingLimitRule   `bson:"rule"`
+	PD SpendingLimitRule   `bson:"pending"`
+	EF base.Height         `bson:"effective"`
+	ST base.Height         `bson:"start"`
+	SP Big                 `bson:"spent"`
+}
+
+func (sl *SpendingLimit) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usl SpendingLimitBSONUnpacker
+	if err := enc.Unmarshal(b, &usl); err != nil {
+		return err
+	}
+
+	return sl.unpack(enc, usl.AC, usl.CR, usl.RL, usl.PD, usl.EF, usl.ST, usl.SP)
+}
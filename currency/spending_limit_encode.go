@@ -0,0 +1,32 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (sl *SpendingLimit) unpack(
+	enc encoder.Encoder,
+	bAccount base.AddressDecoder,
+	cid string,
+	rule SpendingLimitRule,
+	pending SpendingLimitRule,
+	effective base.Height,
+	start base.Height,
+	spent Big,
+) error {
+	if a, err := bAccount.Encode(enc); err != nil {
+		return err
+	} else {
+		sl.account = a
+	}
+
+	sl.currency = CurrencyID(cid)
+	sl.rule = rule
+	sl.pending = pending
+	sl.effective = effective
+	sl.start = start
+	sl.spent = spent
+
+	return nil
+}
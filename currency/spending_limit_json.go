@@ -0,0 +1,74 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type SpendingLimitRuleJSONPacker struct {
+	AM Big         `json:"amount"`
+	WD base.Height `json:"window"`
+	DL base.Height `json:"delay"`
+}
+
+func (sr SpendingLimitRule) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SpendingLimitRuleJSONPacker{
+		AM: sr.amount,
+		WD: sr.window,
+		DL: sr.delay,
+	})
+}
+
+func (sr *SpendingLimitRule) UnmarshalJSON(b []byte) error {
+	var usr SpendingLimitRuleJSONPacker
+	if err := jsonenc.Unmarshal(b, &usr); err != nil {
+		return err
+	}
+
+	*sr = NewSpendingLimitRule(usr.AM, usr.WD, usr.DL)
+
+	return nil
+}
+
+type SpendingLimitJSONPacker struct {
+	jsonenc.HintedHead
+	AC base.Address      `json:"account"`
+	CR CurrencyID        `json:"currency"`
+	RL SpendingLimitRule `json:"rule"`
+	PD SpendingLimitRule `json:"pending"`
+	EF base.Height       `json:"effective"`
+	ST base.Height       `json:"start"`
+	SP Big               `json:"spent"`
+}
+
+func (sl SpendingLimit) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SpendingLimitJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sl.Hint()),
+		AC:         sl.account,
+		CR:         sl.currency,
+		RL:         sl.rule,
+		PD:         sl.pending,
+		EF:         sl.effective,
+		ST:         sl.start,
+		SP:         sl.spent,
+	})
+}
+
+type SpendingLimitJSONUnpacker struct {
+	AC base.AddressDecoder `json:"account"`
+	CR string              `json:"currency"`
+	RL SpendingLimitRule   `json:"rule"`
+	PD SpendingLimitRule   `json:"pending"`
+	EF base.Height         `json:"effective"`
+	ST base.Height         `json:"start"`
+	SP Big                 `json:"spent"`
+}
+
+func (sl *SpendingLimit) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usl SpendingLimitJSONUnpacker
+	if err := enc.Unmarshal(b, &usl); err != nil {
+		return err
+	}
+
+	return sl.unpack(enc, usl.AC, usl.CR, usl.RL, usl.PD, usl.EF, usl.ST, usl.SP)
+}
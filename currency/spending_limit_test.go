@@ -0,0 +1,155 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testSpendingLimit struct {
+	suite.Suite
+	cid CurrencyID
+}
+
+func (t *testSpendingLimit) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testSpendingLimit) TestNew() {
+	a := NewTestAddress()
+	rule := NewSpendingLimitRule(NewBig(10), base.Height(5), base.Height(3))
+
+	sl := NewSpendingLimit(a, t.cid, rule)
+	t.NoError(sl.IsValid(nil))
+
+	t.True(a.Equal(sl.Account()))
+	t.Equal(t.cid, sl.Currency())
+	t.True(sl.Rule().Amount().Equal(NewBig(10)))
+	t.False(sl.HasPending())
+	t.True(sl.Spent().IsZero())
+}
+
+func (t *testSpendingLimit) TestInvalidRule() {
+	err := NewSpendingLimitRule(NewBig(-1), base.Height(5), base.Height(3)).IsValid(nil)
+	t.Contains(err.Error(), "should be over nil")
+
+	err = NewSpendingLimitRule(NewBig(10), base.Height(0), base.Height(3)).IsValid(nil)
+	t.Contains(err.Error(), "window should be over zero")
+
+	err = NewSpendingLimitRule(NewBig(10), base.Height(5), base.Height(-1)).IsValid(nil)
+	t.Contains(err.Error(), "delay should not be negative")
+}
+
+func (t *testSpendingLimit) TestSpend() {
+	sl := NewSpendingLimit(NewTestAddress(), t.cid, NewSpendingLimitRule(NewBig(10), base.Height(5), base.Height(0)))
+
+	sl, err := sl.Spend(NewBig(6), base.Height(10))
+	t.NoError(err)
+	t.True(sl.Spent().Equal(NewBig(6)))
+	t.Equal(base.Height(10), sl.Start())
+
+	_, err = sl.Spend(NewBig(5), base.Height(14))
+	t.Contains(err.Error(), "spending limit exceeded")
+
+	sl, err = sl.Spend(NewBig(4), base.Height(14))
+	t.NoError(err)
+	t.True(sl.Spent().Equal(NewBig(10)))
+
+	// NOTE new window
+	sl, err = sl.Spend(NewBig(5), base.Height(15))
+	t.NoError(err)
+	t.True(sl.Spent().Equal(NewBig(5)))
+	t.Equal(base.Height(15), sl.Start())
+}
+
+func (t *testSpendingLimit) TestTighter() {
+	sl := NewSpendingLimit(NewTestAddress(), t.cid, NewSpendingLimitRule(NewBig(10), base.Height(5), base.Height(3)))
+
+	sl = sl.SetRule(NewSpendingLimitRule(NewBig(4), base.Height(5), base.Height(3)), base.Height(10))
+	t.False(sl.HasPending())
+	t.True(sl.Rule().Amount().Equal(NewBig(4)))
+}
+
+func (t *testSpendingLimit) TestLooser() {
+	sl := NewSpendingLimit(NewTestAddress(), t.cid, NewSpendingLimitRule(NewBig(10), base.Height(5), base.Height(3)))
+
+	sl = sl.SetRule(NewSpendingLimitRule(NewBig(20), base.Height(5), base.Height(3)), base.Height(10))
+	t.True(sl.HasPending())
+	t.True(sl.Rule().Amount().Equal(NewBig(10)))
+
+	pending, effective := sl.Pending()
+	t.True(pending.Amount().Equal(NewBig(20)))
+	t.Equal(base.Height(13), effective)
+
+	_, err := sl.Spend(NewBig(11), base.Height(12))
+	t.Contains(err.Error(), "spending limit exceeded")
+
+	sl, err = sl.Spend(NewBig(11), base.Height(13))
+	t.NoError(err)
+	t.False(sl.HasPending())
+	t.True(sl.Rule().Amount().Equal(NewBig(20)))
+}
+
+func (t *testSpendingLimit) TestShorterDelayIsLooser() {
+	sl := NewSpendingLimit(NewTestAddress(), t.cid, NewSpendingLimitRule(NewBig(10), base.Height(5), base.Height(3)))
+
+	sl = sl.SetRule(NewSpendingLimitRule(NewBig(10), base.Height(5), base.Height(0)), base.Height(10))
+	t.True(sl.HasPending())
+	t.Equal(base.Height(3), sl.Rule().Delay())
+}
+
+func TestSpendingLimit(t *testing.T) {
+	suite.Run(t, new(testSpendingLimit))
+}
+
+func testSpendingLimitEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		sl := NewSpendingLimit(
+			NewTestAddress(),
+			CurrencyID("SHOWME"),
+			NewSpendingLimitRule(NewBig(10), base.Height(5), base.Height(3)),
+		)
+		sl = sl.SetRule(NewSpendingLimitRule(NewBig(20), base.Height(5), base.Height(3)), base.Height(10))
+
+		sl, err := sl.Spend(NewBig(3), base.Height(10))
+		t.NoError(err)
+		t.NoError(sl.IsValid(nil))
+
+		return sl
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(SpendingLimit)
+		tb := b.(SpendingLimit)
+
+		t.True(ta.Account().Equal(tb.Account()))
+		t.Equal(ta.Currency(), tb.Currency())
+		t.Equal(ta.Rule().Bytes(), tb.Rule().Bytes())
+
+		pa, ea := ta.Pending()
+		pb, eb := tb.Pending()
+		t.Equal(pa.Bytes(), pb.Bytes())
+		t.Equal(ea, eb)
+
+		t.Equal(ta.Start(), tb.Start())
+		t.True(ta.Spent().Equal(tb.Spent()))
+	}
+
+	return t
+}
+
+func TestSpendingLimitEncodeJSON(t *testing.T) {
+	suite.Run(t, testSpendingLimitEncode(jsonenc.NewEncoder()))
+}
+
+func TestSpendingLimitEncodeBSON(t *testing.T) {
+	suite.Run(t, testSpendingLimitEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,221 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	StandingOrderType = hint.MustNewType(0xa0, 0x7f, "mitum-currency-standing-order")
+	StandingOrderHint = hint.MustHint(StandingOrderType, "0.0.1")
+)
+
+type StandingOrderStatus string
+
+const (
+	StandingOrderStatusActive    StandingOrderStatus = "active"
+	StandingOrderStatusCompleted StandingOrderStatus = "completed"
+	StandingOrderStatusCancelled StandingOrderStatus = "cancelled"
+)
+
+func (ss StandingOrderStatus) Bytes() []byte {
+	return []byte(ss)
+}
+
+func (ss StandingOrderStatus) String() string {
+	return string(ss)
+}
+
+func (ss StandingOrderStatus) IsValid([]byte) error {
+	switch ss {
+	case StandingOrderStatusActive,
+		StandingOrderStatusCompleted,
+		StandingOrderStatusCancelled:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown standing order status, %q", ss)
+	}
+}
+
+// StandingOrder pays amount from payer to payee at every interval. Each round
+// is paid or skipped; the round is skipped when payer can not pay it at the
+// height, for example by insufficient balance. After the repetitions of rounds,
+// StandingOrder is completed. The id of StandingOrder is the fact hash of
+// CreateStandingOrder.
+type StandingOrder struct {
+	id          valuehash.Hash
+	payer       base.Address
+	payee       base.Address
+	amount      Amount
+	interval    base.Height
+	repetitions uint
+	next        base.Height
+	paid        uint
+	skipped     uint
+	status      StandingOrderStatus
+}
+
+func NewStandingOrder(
+	id valuehash.Hash,
+	payer, payee base.Address,
+	amount Amount,
+	interval base.Height,
+	repetitions uint,
+	next base.Height,
+) StandingOrder {
+	return StandingOrder{
+		id:          id,
+		payer:       payer,
+		payee:       payee,
+		amount:      amount,
+		interval:    interval,
+		repetitions: repetitions,
+		next:        next,
+		status:      StandingOrderStatusActive,
+	}
+}
+
+func (so StandingOrder) Hint() hint.Hint {
+	return StandingOrderHint
+}
+
+func (so StandingOrder) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		so.id.Bytes(),
+		so.payer.Bytes(),
+		so.payee.Bytes(),
+		so.amount.Bytes(),
+		so.interval.Bytes(),
+		util.UintToBytes(so.repetitions),
+		so.next.Bytes(),
+		util.UintToBytes(so.paid),
+		util.UintToBytes(so.skipped),
+		so.status.Bytes(),
+	)
+}
+
+func (so StandingOrder) Hash() valuehash.Hash {
+	return so.GenerateHash()
+}
+
+func (so StandingOrder) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(so.Bytes())
+}
+
+func (so StandingOrder) IsValid([]byte) error {
+	if err := isvalid.Check([]isvalid.IsValider{
+		so.id,
+		so.payer,
+		so.payee,
+		so.amount,
+		so.interval,
+		so.next,
+		so.status,
+	}, nil, false); err != nil {
+		return xerrors.Errorf("invalid StandingOrder: %w", err)
+	}
+
+	if so.payer.Equal(so.payee) {
+		return xerrors.Errorf("payee is same with payer, %q", so.payer)
+	}
+
+	if !so.amount.Big().OverZero() {
+		return xerrors.Errorf("amount should be over zero")
+	}
+
+	if so.interval < 1 {
+		return xerrors.Errorf("interval should be over zero, %v", so.interval)
+	}
+
+	if so.repetitions < 1 {
+		return xerrors.Errorf("repetitions should be over zero")
+	}
+
+	if so.paid+so.skipped > so.repetitions {
+		return xerrors.Errorf("rounds over repetitions, %d > %d", so.paid+so.skipped, so.repetitions)
+	}
+
+	return nil
+}
+
+func (so StandingOrder) ID() valuehash.Hash {
+	return so.id
+}
+
+func (so StandingOrder) Payer() base.Address {
+	return so.payer
+}
+
+func (so StandingOrder) Payee() base.Address {
+	return so.payee
+}
+
+func (so StandingOrder) Amount() Amount {
+	return so.amount
+}
+
+func (so StandingOrder) Currency() CurrencyID {
+	return so.amount.Currency()
+}
+
+func (so StandingOrder) Interval() base.Height {
+	return so.interval
+}
+
+func (so StandingOrder) Repetitions() uint {
+	return so.repetitions
+}
+
+// Next is the height of next round.
+func (so StandingOrder) Next() base.Height {
+	return so.next
+}
+
+// Paid is the number of paid rounds.
+func (so StandingOrder) Paid() uint {
+	return so.paid
+}
+
+// Skipped is the number of skipped rounds.
+func (so StandingOrder) Skipped() uint {
+	return so.skipped
+}
+
+func (so StandingOrder) Status() StandingOrderStatus {
+	return so.status
+}
+
+func (so StandingOrder) Pay() StandingOrder {
+	so.paid++
+
+	return so.round()
+}
+
+func (so StandingOrder) Skip() StandingOrder {
+	so.skipped++
+
+	return so.round()
+}
+
+func (so StandingOrder) Cancel() StandingOrder {
+	so.status = StandingOrderStatusCancelled
+
+	return so
+}
+
+func (so StandingOrder) round() StandingOrder {
+	if so.paid+so.skipped >= so.repetitions {
+		so.status = StandingOrderStatusCompleted
+
+		return so
+	}
+
+	so.next += so.interval
+
+	return so
+}
@@ -0,0 +1,49 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (so StandingOrder) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(so.Hint()),
+		bson.M{
+			"id":          so.id,
+			"payer":       so.payer,
+			"payee":       so.payee,
+			"amount":      so.amount,
+			"interval":    so.interval,
+			"repetitions": so.repetitions,
+			"next":        so.next,
+			"paid":        so.paid,
+			"skipped":     so.skipped,
+			"status":      so.status,
+		}),
+	)
+}
+
+type StandingOrderBSONUnpacker struct {
+	ID valuehash.Bytes     `bson:"id"`
+	PR base.AddressDecoder `bson:"payer"`
+	PE base.AddressDecoder `bson:"payee"`
+	AM bson.Raw            `bson:"amount"`
+	IN base.Height         `bson:"interval"`
+	RP uint                `bson:"repetitions"`
+	NX base.Height         `bson:"next"`
+	PD uint                `bson:"paid"`
+	SK uint                `bson:"skipped"`
+	ST StandingOrderStatus `bson:"status"`
+}
+
+func (so *StandingOrder) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uso StandingOrderBSONUnpacker
+	if err := enc.Unmarshal(b, &uso); err != nil {
+		return err
+	}
+
+	return so.unpack(enc, uso.ID, uso.PR, uso.PE, uso.AM, uso.IN, uso.RP, uso.NX, uso.PD, uso.SK, uso.ST)
+}
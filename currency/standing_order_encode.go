@@ -0,0 +1,49 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (so *StandingOrder) unpack(
+	enc encoder.Encoder,
+	id valuehash.Hash,
+	bPayer base.AddressDecoder,
+	bPayee base.AddressDecoder,
+	bam []byte,
+	interval base.Height,
+	repetitions uint,
+	next base.Height,
+	paid uint,
+	skipped uint,
+	status StandingOrderStatus,
+) error {
+	if a, err := bPayer.Encode(enc); err != nil {
+		return err
+	} else {
+		so.payer = a
+	}
+
+	if a, err := bPayee.Encode(enc); err != nil {
+		return err
+	} else {
+		so.payee = a
+	}
+
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		so.amount = am
+	}
+
+	so.id = id
+	so.interval = interval
+	so.repetitions = repetitions
+	so.next = next
+	so.paid = paid
+	so.skipped = skipped
+	so.status = status
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type StandingOrderJSONPacker struct {
+	jsonenc.HintedHead
+	ID valuehash.Hash      `json:"id"`
+	PR base.Address        `json:"payer"`
+	PE base.Address        `json:"payee"`
+	AM Amount              `json:"amount"`
+	IN base.Height         `json:"interval"`
+	RP uint                `json:"repetitions"`
+	NX base.Height         `json:"next"`
+	PD uint                `json:"paid"`
+	SK uint                `json:"skipped"`
+	ST StandingOrderStatus `json:"status"`
+}
+
+func (so StandingOrder) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(StandingOrderJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(so.Hint()),
+		ID:         so.id,
+		PR:         so.payer,
+		PE:         so.payee,
+		AM:         so.amount,
+		IN:         so.interval,
+		RP:         so.repetitions,
+		NX:         so.next,
+		PD:         so.paid,
+		SK:         so.skipped,
+		ST:         so.status,
+	})
+}
+
+type StandingOrderJSONUnpacker struct {
+	ID valuehash.Bytes     `json:"id"`
+	PR base.AddressDecoder `json:"payer"`
+	PE base.AddressDecoder `json:"payee"`
+	AM json.RawMessage     `json:"amount"`
+	IN base.Height         `json:"interval"`
+	RP uint                `json:"repetitions"`
+	NX base.Height         `json:"next"`
+	PD uint                `json:"paid"`
+	SK uint                `json:"skipped"`
+	ST StandingOrderStatus `json:"status"`
+}
+
+func (so *StandingOrder) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uso StandingOrderJSONUnpacker
+	if err := enc.Unmarshal(b, &uso); err != nil {
+		return err
+	}
+
+	return so.unpack(enc, uso.ID, uso.PR, uso.PE, uso.AM, uso.IN, uso.RP, uso.NX, uso.PD, uso.SK, uso.ST)
+}
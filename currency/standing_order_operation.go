@@ -0,0 +1,341 @@
+package currency
+
+import (
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	StandingOrderOperationFactType = hint.MustNewType(0xa0, 0x84, "mitum-currency-standing-order-operation-fact")
+	StandingOrderOperationFactHint = hint.MustHint(StandingOrderOperationFactType, "0.0.1")
+	StandingOrderOperationType     = hint.MustNewType(0xa0, 0x85, "mitum-currency-standing-order-operation")
+	StandingOrderOperationHint     = hint.MustHint(StandingOrderOperationType, "0.0.1")
+)
+
+// StandingOrderOperationFact has the rounds of StandingOrders, which reach the
+// height in the block. The StandingOrders in paid are paid to payee and the
+// StandingOrders in skipped are not paid. Each StandingOrder is the updated one
+// after the round.
+type StandingOrderOperationFact struct {
+	h       valuehash.Hash
+	token   []byte
+	paid    []StandingOrder
+	skipped []StandingOrder
+}
+
+func NewStandingOrderOperationFact(
+	height base.Height,
+	paid []StandingOrder,
+	skipped []StandingOrder,
+) StandingOrderOperationFact {
+	fact := StandingOrderOperationFact{
+		token:   height.Bytes(), // for unique token
+		paid:    paid,
+		skipped: skipped,
+	}
+	fact.h = valuehash.NewSHA256(fact.Bytes())
+
+	return fact
+}
+
+func (fact StandingOrderOperationFact) Hint() hint.Hint {
+	return StandingOrderOperationFactHint
+}
+
+func (fact StandingOrderOperationFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact StandingOrderOperationFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.paid)+len(fact.skipped)+1)
+	bs[0] = fact.token
+
+	for i := range fact.paid {
+		bs[i+1] = fact.paid[i].Bytes()
+	}
+
+	for i := range fact.skipped {
+		bs[len(fact.paid)+i+1] = fact.skipped[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fact StandingOrderOperationFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for StandingOrderOperationFact")
+	}
+
+	if err := fact.h.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(fact.paid) < 1 && len(fact.skipped) < 1 {
+		return xerrors.Errorf("empty rounds for StandingOrderOperationFact")
+	}
+
+	founds := map[string]struct{}{}
+	for _, sos := range [][]StandingOrder{fact.paid, fact.skipped} {
+		for i := range sos {
+			so := sos[i]
+			if err := so.IsValid(nil); err != nil {
+				return err
+			}
+
+			if so.Status() == StandingOrderStatusCancelled {
+				return xerrors.Errorf("cancelled standing order, %q found", so.ID())
+			}
+
+			if _, found := founds[so.ID().String()]; found {
+				return xerrors.Errorf("duplicated standing order, %q found", so.ID())
+			}
+
+			founds[so.ID().String()] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (fact StandingOrderOperationFact) Token() []byte {
+	return fact.token
+}
+
+func (fact StandingOrderOperationFact) Paid() []StandingOrder {
+	return fact.paid
+}
+
+func (fact StandingOrderOperationFact) Skipped() []StandingOrder {
+	return fact.skipped
+}
+
+// Addresses returns the payers and payees of both paid and skipped
+// StandingOrders, so the skipped round also can be found in the operations of
+// payer and payee.
+func (fact StandingOrderOperationFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+	founds := map[string]struct{}{}
+	for _, sos := range [][]StandingOrder{fact.paid, fact.skipped} {
+		for i := range sos {
+			for _, a := range []base.Address{sos[i].Payer(), sos[i].Payee()} {
+				if _, found := founds[a.String()]; found {
+					continue
+				}
+
+				founds[a.String()] = struct{}{}
+				as = append(as, a)
+			}
+		}
+	}
+
+	return as, nil
+}
+
+// StandingOrderOperation is created by OperationProcessor at the closing of
+// block like FeeOperation; it does not need the signs.
+type StandingOrderOperation struct {
+	fact StandingOrderOperationFact
+	h    valuehash.Hash
+}
+
+func NewStandingOrderOperation(fact StandingOrderOperationFact) StandingOrderOperation {
+	op := StandingOrderOperation{fact: fact}
+	op.h = op.GenerateHash()
+
+	return op
+}
+
+func (op StandingOrderOperation) Hint() hint.Hint {
+	return StandingOrderOperationHint
+}
+
+func (op StandingOrderOperation) Fact() base.Fact {
+	return op.fact
+}
+
+func (op StandingOrderOperation) Hash() valuehash.Hash {
+	return op.h
+}
+
+func (op StandingOrderOperation) Signs() []operation.FactSign {
+	return nil
+}
+
+func (op StandingOrderOperation) IsValid([]byte) error {
+	if err := op.Hint().IsValid(nil); err != nil {
+		return err
+	}
+
+	if l := len(op.fact.Token()); l < 1 {
+		return isvalid.InvalidError.Errorf("StandingOrderOperation has empty token")
+	} else if l > operation.MaxTokenSize {
+		return isvalid.InvalidError.Errorf(
+			"StandingOrderOperation token size too large: %d > %d", l, operation.MaxTokenSize)
+	}
+
+	if err := op.Fact().IsValid(nil); err != nil {
+		return err
+	}
+
+	if !op.Hash().Equal(op.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong StandingOrderOperation hash")
+	}
+
+	return nil
+}
+
+func (op StandingOrderOperation) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(op.Fact().Hash().Bytes())
+}
+
+func (op StandingOrderOperation) AddFactSigns(...operation.FactSign) (operation.FactSignUpdater, error) {
+	return nil, nil
+}
+
+func (op StandingOrderOperation) LastSignedAt() time.Time {
+	return time.Time{}
+}
+
+func (op StandingOrderOperation) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type StandingOrderOperationProcessor struct {
+	StandingOrderOperation
+	height base.Height
+}
+
+func NewStandingOrderOperationProcessor(op StandingOrderOperation, height base.Height) state.Processor {
+	return &StandingOrderOperationProcessor{
+		StandingOrderOperation: op,
+		height:                 height,
+	}
+}
+
+func (opp *StandingOrderOperationProcessor) Process(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(StandingOrderOperationFact)
+
+	var sts []state.State // nolint:prealloc
+	for _, sos := range [][]StandingOrder{fact.paid, fact.skipped} {
+		for i := range sos {
+			if st, _, err := loadActiveStandingOrder(sos[i].ID(), getState); err != nil {
+				return err
+			} else if nst, err := SetStateStandingOrderValue(st, sos[i]); err != nil {
+				return err
+			} else {
+				sts = append(sts, nst)
+			}
+		}
+	}
+
+	balances := map[string]AmountState{}
+	var keys []string
+	outflows := map[string]map[CurrencyID]Big{}
+	var payers []base.Address
+	for i := range fact.paid {
+		so := fact.paid[i]
+
+		for _, a := range []base.Address{so.Payer(), so.Payee()} {
+			k := StateKeyBalance(a, so.Currency())
+			if _, found := balances[k]; found {
+				continue
+			}
+
+			if st, _, err := getState(k); err != nil {
+				return err
+			} else {
+				balances[k] = NewAmountState(st, so.Currency())
+				keys = append(keys, k)
+			}
+		}
+
+		balances[StateKeyBalance(so.Payer(), so.Currency())] = balances[StateKeyBalance(so.Payer(), so.Currency())].
+			Sub(so.Amount().Big())
+		balances[StateKeyBalance(so.Payee(), so.Currency())] = balances[StateKeyBalance(so.Payee(), so.Currency())].
+			Add(so.Amount().Big())
+
+		if _, found := outflows[so.Payer().String()]; !found {
+			outflows[so.Payer().String()] = map[CurrencyID]Big{}
+			payers = append(payers, so.Payer())
+		}
+
+		outflows[so.Payer().String()][so.Currency()] = addBig(
+			outflows[so.Payer().String()], so.Currency(), so.Amount().Big(),
+		)
+	}
+
+	for i := range keys {
+		sts = append(sts, balances[keys[i]])
+	}
+
+	for i := range payers {
+		if sls, err := checkSpendingLimit(payers[i], outflows[payers[i].String()], opp.height, getState); err != nil {
+			return err
+		} else if j, err := setSpendingLimitStates(sls); err != nil {
+			return err
+		} else {
+			sts = append(sts, j...)
+		}
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// checkStandingOrderPayment checks payer can pay the round of StandingOrder.
+// spent is the amount, which payer already pays by the other StandingOrders
+// in the same block.
+func checkStandingOrderPayment(
+	cp *CurrencyPool,
+	so StandingOrder,
+	spent Big,
+	height base.Height,
+	getState func(key string) (state.State, bool, error),
+) error {
+	cid := so.Currency()
+
+	if _, err := existsAccountState(so.Payer(), "payer", getState); err != nil {
+		return err
+	}
+
+	if _, err := existsAccountState(so.Payee(), "payee", getState); err != nil {
+		return err
+	}
+
+	if err := checkNotFrozen(so.Payer(), cid, false, getState); err != nil {
+		return err
+	} else if err := checkNotFrozen(so.Payee(), cid, true, getState); err != nil {
+		return err
+	}
+
+	if err := checkTransferRestriction(cp, so.Payer(), cid, getState); err != nil {
+		return err
+	} else if err := checkTransferRestriction(cp, so.Payee(), cid, getState); err != nil {
+		return err
+	}
+
+	total := spent.Add(so.Amount().Big())
+	if _, err := CheckEnoughBalance(
+		so.Payer(), map[CurrencyID][2]Big{cid: {total, ZeroBig}}, height, getState,
+	); err != nil {
+		return err
+	}
+
+	_, err := checkSpendingLimit(so.Payer(), map[CurrencyID]Big{cid: total}, height, getState)
+
+	return err
+}
@@ -0,0 +1,69 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact StandingOrderOperationFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":    fact.h,
+				"token":   fact.token,
+				"paid":    fact.paid,
+				"skipped": fact.skipped,
+			}))
+}
+
+type StandingOrderOperationFactBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	TK []byte          `bson:"token"`
+	PD []bson.Raw      `bson:"paid"`
+	SK []bson.Raw      `bson:"skipped"`
+}
+
+func (fact *StandingOrderOperationFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uft StandingOrderOperationFactBSONUnpacker
+	if err := enc.Unmarshal(b, &uft); err != nil {
+		return err
+	}
+
+	bpd := make([][]byte, len(uft.PD))
+	for i := range uft.PD {
+		bpd[i] = uft.PD[i]
+	}
+
+	bsk := make([][]byte, len(uft.SK))
+	for i := range uft.SK {
+		bsk[i] = uft.SK[i]
+	}
+
+	return fact.unpack(enc, uft.H, uft.TK, bpd, bsk)
+}
+
+func (op StandingOrderOperation) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(op.Hint()),
+		bson.M{
+			"hash": op.h,
+			"fact": op.fact,
+		},
+	))
+}
+
+type StandingOrderOperationBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	FC bson.Raw        `bson:"fact"`
+}
+
+func (op *StandingOrderOperation) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var upo StandingOrderOperationBSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	return op.unpack(enc, upo.H, upo.FC)
+}
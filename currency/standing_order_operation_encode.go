@@ -0,0 +1,63 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *StandingOrderOperationFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bpd [][]byte,
+	bsk [][]byte,
+) error {
+	if sos, err := decodeStandingOrders(enc, bpd); err != nil {
+		return err
+	} else {
+		fact.paid = sos
+	}
+
+	if sos, err := decodeStandingOrders(enc, bsk); err != nil {
+		return err
+	} else {
+		fact.skipped = sos
+	}
+
+	fact.h = h
+	fact.token = token
+
+	return nil
+}
+
+func (op *StandingOrderOperation) unpack(enc encoder.Encoder, h valuehash.Hash, bfact []byte) error {
+	if hinter, err := base.DecodeFact(enc, bfact); err != nil {
+		return err
+	} else if fact, ok := hinter.(StandingOrderOperationFact); !ok {
+		return xerrors.Errorf("not StandingOrderOperationFact, %T", hinter)
+	} else {
+		op.fact = fact
+	}
+
+	op.h = h
+
+	return nil
+}
+
+func decodeStandingOrders(enc encoder.Encoder, bs [][]byte) ([]StandingOrder, error) {
+	sos := make([]StandingOrder, len(bs))
+	for i := range bs {
+		if j, err := enc.DecodeByHint(bs[i]); err != nil {
+			return nil, err
+		} else if so, ok := j.(StandingOrder); !ok {
+			return nil, xerrors.Errorf("not StandingOrder, %T", j)
+		} else {
+			sos[i] = so
+		}
+	}
+
+	return sos, nil
+}
@@ -0,0 +1,80 @@
+package currency
+
+import (
+	"encoding/json"
+
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type StandingOrderOperationFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash  `json:"hash"`
+	TK []byte          `json:"token"`
+	PD []StandingOrder `json:"paid"`
+	SK []StandingOrder `json:"skipped"`
+}
+
+func (fact StandingOrderOperationFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(StandingOrderOperationFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		PD:         fact.paid,
+		SK:         fact.skipped,
+	})
+}
+
+type StandingOrderOperationFactJSONUnpacker struct {
+	H  valuehash.Bytes   `json:"hash"`
+	TK []byte            `json:"token"`
+	PD []json.RawMessage `json:"paid"`
+	SK []json.RawMessage `json:"skipped"`
+}
+
+func (fact *StandingOrderOperationFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uft StandingOrderOperationFactJSONUnpacker
+	if err := enc.Unmarshal(b, &uft); err != nil {
+		return err
+	}
+
+	bpd := make([][]byte, len(uft.PD))
+	for i := range uft.PD {
+		bpd[i] = uft.PD[i]
+	}
+
+	bsk := make([][]byte, len(uft.SK))
+	for i := range uft.SK {
+		bsk[i] = uft.SK[i]
+	}
+
+	return fact.unpack(enc, uft.H, uft.TK, bpd, bsk)
+}
+
+type StandingOrderOperationJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash             `json:"hash"`
+	FT StandingOrderOperationFact `json:"fact"`
+}
+
+func (op StandingOrderOperation) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(StandingOrderOperationJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(op.Hint()),
+		H:          op.h,
+		FT:         op.fact,
+	})
+}
+
+type StandingOrderOperationJSONUnpacker struct {
+	H  valuehash.Bytes `json:"hash"`
+	FT json.RawMessage `json:"fact"`
+}
+
+func (op *StandingOrderOperation) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var upo StandingOrderOperationJSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	return op.unpack(enc, upo.H, upo.FT)
+}
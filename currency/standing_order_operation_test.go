@@ -0,0 +1,146 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testStandingOrderOperation struct {
+	baseTest
+}
+
+func (t *testStandingOrderOperation) newStandingOrder(payer, payee base.Address) StandingOrder {
+	return NewStandingOrder(
+		valuehash.RandomSHA256(),
+		payer,
+		payee,
+		NewAmount(NewBig(10), CurrencyID("SHOWME")),
+		base.Height(3),
+		3,
+		base.Height(3),
+	)
+}
+
+func (t *testStandingOrderOperation) TestNew() {
+	a := NewTestAddress()
+	b := NewTestAddress()
+	c := NewTestAddress()
+
+	fact := NewStandingOrderOperationFact(
+		base.Height(3),
+		[]StandingOrder{t.newStandingOrder(a, b).Pay()},
+		[]StandingOrder{t.newStandingOrder(b, c).Skip()},
+	)
+
+	op := NewStandingOrderOperation(fact)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	as, err := fact.Addresses()
+	t.NoError(err)
+	t.Equal(3, len(as))
+	t.True(a.Equal(as[0]))
+	t.True(b.Equal(as[1]))
+	t.True(c.Equal(as[2]))
+}
+
+func (t *testStandingOrderOperation) TestCancelled() {
+	fact := NewStandingOrderOperationFact(base.Height(3), []StandingOrder{
+		t.newStandingOrder(NewTestAddress(), NewTestAddress()).Cancel(),
+	}, nil)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "cancelled standing order")
+}
+
+func (t *testStandingOrderOperation) TestDuplicated() {
+	so := t.newStandingOrder(NewTestAddress(), NewTestAddress())
+
+	fact := NewStandingOrderOperationFact(base.Height(3), []StandingOrder{so.Pay()}, []StandingOrder{so.Skip()})
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "duplicated standing order")
+}
+
+func (t *testStandingOrderOperation) TestEmptyRounds() {
+	err := NewStandingOrderOperationFact(base.Height(3), nil, nil).IsValid(nil)
+	t.Contains(err.Error(), "empty rounds")
+}
+
+func TestStandingOrderOperation(t *testing.T) {
+	suite.Run(t, new(testStandingOrderOperation))
+}
+
+func testStandingOrderOperationEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		newStandingOrder := func() StandingOrder {
+			return NewStandingOrder(
+				valuehash.RandomSHA256(),
+				NewTestAddress(),
+				NewTestAddress(),
+				NewAmount(NewBig(10), CurrencyID("SHOWME")),
+				base.Height(3),
+				3,
+				base.Height(3),
+			)
+		}
+
+		fact := NewStandingOrderOperationFact(
+			base.Height(3),
+			[]StandingOrder{newStandingOrder().Pay()},
+			[]StandingOrder{newStandingOrder().Skip()},
+		)
+
+		return NewStandingOrderOperation(fact)
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(StandingOrderOperation)
+		cb := b.(StandingOrderOperation)
+		fact := ca.Fact().(StandingOrderOperationFact)
+		ufact := cb.Fact().(StandingOrderOperationFact)
+
+		t.Equal(fact.token, ufact.token)
+
+		for _, sos := range [][2][]StandingOrder{
+			{fact.Paid(), ufact.Paid()},
+			{fact.Skipped(), ufact.Skipped()},
+		} {
+			t.Equal(len(sos[0]), len(sos[1]))
+
+			for i := range sos[0] {
+				sa := sos[0][i]
+				sb := sos[1][i]
+
+				t.True(sa.ID().Equal(sb.ID()))
+				t.True(sa.Amount().Equal(sb.Amount()))
+				t.Equal(sa.Paid(), sb.Paid())
+				t.Equal(sa.Skipped(), sb.Skipped())
+				t.Equal(sa.Status(), sb.Status())
+			}
+		}
+	}
+
+	return t
+}
+
+func TestStandingOrderOperationEncodeJSON(t *testing.T) {
+	suite.Run(t, testStandingOrderOperationEncode(jsonenc.NewEncoder()))
+}
+
+func TestStandingOrderOperationEncodeBSON(t *testing.T) {
+	suite.Run(t, testStandingOrderOperationEncode(bsonenc.NewEncoder()))
+}
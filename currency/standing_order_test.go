@@ -0,0 +1,121 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testStandingOrder struct {
+	suite.Suite
+}
+
+func (t *testStandingOrder) newStandingOrder(repetitions uint) StandingOrder {
+	return NewStandingOrder(
+		valuehash.RandomSHA256(),
+		NewTestAddress(),
+		NewTestAddress(),
+		NewAmount(NewBig(10), CurrencyID("SHOWME")),
+		base.Height(3),
+		repetitions,
+		base.Height(33),
+	)
+}
+
+func (t *testStandingOrder) TestNew() {
+	so := t.newStandingOrder(2)
+	t.NoError(so.IsValid(nil))
+	t.Equal(StandingOrderStatusActive, so.Status())
+
+	so = so.Pay()
+	t.NoError(so.IsValid(nil))
+	t.Equal(StandingOrderStatusActive, so.Status())
+	t.Equal(uint(1), so.Paid())
+	t.Equal(base.Height(36), so.Next())
+
+	so = so.Skip()
+	t.NoError(so.IsValid(nil))
+	t.Equal(StandingOrderStatusCompleted, so.Status())
+	t.Equal(uint(1), so.Paid())
+	t.Equal(uint(1), so.Skipped())
+	t.Equal(base.Height(36), so.Next())
+
+	t.Equal(StandingOrderStatusCancelled, t.newStandingOrder(2).Cancel().Status())
+}
+
+func (t *testStandingOrder) TestSamePayee() {
+	a := NewTestAddress()
+	err := NewStandingOrder(
+		valuehash.RandomSHA256(), a, a, NewAmount(NewBig(10), CurrencyID("SHOWME")), base.Height(3), 2, base.Height(33),
+	).IsValid(nil)
+	t.Contains(err.Error(), "payee is same with payer")
+}
+
+func (t *testStandingOrder) TestZeroRepetitions() {
+	err := t.newStandingOrder(0).IsValid(nil)
+	t.Contains(err.Error(), "repetitions should be over zero")
+}
+
+func (t *testStandingOrder) TestOverRepetitions() {
+	so := t.newStandingOrder(1).Pay()
+	so.skipped = 1
+
+	err := so.IsValid(nil)
+	t.Contains(err.Error(), "rounds over repetitions")
+}
+
+func TestStandingOrder(t *testing.T) {
+	suite.Run(t, new(testStandingOrder))
+}
+
+func testStandingOrderEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		so := NewStandingOrder(
+			valuehash.RandomSHA256(),
+			NewTestAddress(),
+			NewTestAddress(),
+			NewAmount(NewBig(10), CurrencyID("SHOWME")),
+			base.Height(3),
+			3,
+			base.Height(33),
+		).Pay().Skip()
+		t.NoError(so.IsValid(nil))
+
+		return so
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(StandingOrder)
+		tb := b.(StandingOrder)
+
+		t.True(ta.ID().Equal(tb.ID()))
+		t.True(ta.Payer().Equal(tb.Payer()))
+		t.True(ta.Payee().Equal(tb.Payee()))
+		t.True(ta.Amount().Equal(tb.Amount()))
+		t.Equal(ta.Interval(), tb.Interval())
+		t.Equal(ta.Repetitions(), tb.Repetitions())
+		t.Equal(ta.Next(), tb.Next())
+		t.Equal(ta.Paid(), tb.Paid())
+		t.Equal(ta.Skipped(), tb.Skipped())
+		t.Equal(ta.Status(), tb.Status())
+	}
+
+	return t
+}
+
+func TestStandingOrderEncodeJSON(t *testing.T) {
+	suite.Run(t, testStandingOrderEncode(jsonenc.NewEncoder()))
+}
+
+func TestStandingOrderEncodeBSON(t *testing.T) {
+	suite.Run(t, testStandingOrderEncode(bsonenc.NewEncoder()))
+}
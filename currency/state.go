@@ -10,12 +10,31 @@ import (
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/storage"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 var (
-	StateKeyAccountSuffix        = ":account"
-	StateKeyBalanceSuffix        = ":balance"
-	StateKeyCurrencyDesignPrefix = "currencydesign:"
+	StateKeyAccountSuffix           = ":account"
+	StateKeyBalanceSuffix           = ":balance"
+	StateKeyAllowanceSuffix         = ":allowance"
+	StateKeyVestingSuffix           = ":vesting"
+	StateKeyRecoveryConfigSuffix    = ":recoveryconfig"
+	StateKeyRecoverySuffix          = ":recovery"
+	StateKeyAccountAliasSuffix      = ":alias"
+	StateKeyFreezeSuffix            = ":freeze"
+	StateKeyTransferListSuffix      = ":transferlist"
+	StateKeySpendingLimitSuffix     = ":spendinglimit"
+	StateKeySequenceSuffix          = ":sequence"
+	StateKeyCommitmentsSuffix       = ":commitments"
+	StateKeyCurrencyDesignPrefix    = "currencydesign:"
+	StateKeyCurrencySupplyPrefix    = "currencysupply:"
+	StateKeyLockPrefix              = "lock:"
+	StateKeyProposalPrefix          = "proposal:"
+	StateKeyAliasPrefix             = "alias:"
+	StateKeyScheduledTransferPrefix = "scheduledtransfer:"
+	StateKeyScheduleQueue           = "schedulequeue"
+	StateKeyStandingOrderPrefix     = "standingorder:"
+	StateKeyStandingOrderQueue      = "standingorderqueue"
 )
 
 func StateAddressKeyPrefix(a base.Address) string {
@@ -117,6 +136,314 @@ func SetStateBalanceValue(st state.State, v Amount) (state.State, error) {
 	}
 }
 
+func StateKeyAllowance(owner, spender base.Address, cid CurrencyID) string {
+	return fmt.Sprintf("%s-%s%s", StateBalanceKeyPrefix(owner, cid), StateAddressKeyPrefix(spender), StateKeyAllowanceSuffix)
+}
+
+func IsStateAllowanceKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAllowanceSuffix)
+}
+
+func StateAllowanceValue(st state.State) (Allowance, error) {
+	v := st.Value()
+	if v == nil {
+		return Allowance{}, storage.NotFoundError.Errorf("allowance not found in State")
+	}
+
+	if s, ok := v.Interface().(Allowance); !ok {
+		return Allowance{}, xerrors.Errorf("invalid allowance value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateAllowanceValue(st state.State, v Allowance) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeyVesting(a base.Address, cid CurrencyID) string {
+	return fmt.Sprintf("%s%s", StateBalanceKeyPrefix(a, cid), StateKeyVestingSuffix)
+}
+
+func IsStateVestingKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyVestingSuffix)
+}
+
+func StateVestingValue(st state.State) (Vesting, error) {
+	v := st.Value()
+	if v == nil {
+		return Vesting{}, storage.NotFoundError.Errorf("vesting not found in State")
+	}
+
+	if s, ok := v.Interface().(Vesting); !ok {
+		return Vesting{}, xerrors.Errorf("invalid vesting value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateVestingValue(st state.State, v Vesting) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeyRecoveryConfig(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyRecoveryConfigSuffix)
+}
+
+func IsStateRecoveryConfigKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyRecoveryConfigSuffix)
+}
+
+func StateRecoveryConfigValue(st state.State) (RecoveryConfig, error) {
+	v := st.Value()
+	if v == nil {
+		return RecoveryConfig{}, storage.NotFoundError.Errorf("recovery config not found in State")
+	}
+
+	if s, ok := v.Interface().(RecoveryConfig); !ok {
+		return RecoveryConfig{}, xerrors.Errorf("invalid recovery config value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateRecoveryConfigValue(st state.State, v RecoveryConfig) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeyRecovery(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyRecoverySuffix)
+}
+
+func IsStateRecoveryKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyRecoverySuffix)
+}
+
+func StateRecoveryValue(st state.State) (Recovery, error) {
+	v := st.Value()
+	if v == nil {
+		return Recovery{}, storage.NotFoundError.Errorf("recovery not found in State")
+	}
+
+	if s, ok := v.Interface().(Recovery); !ok {
+		return Recovery{}, xerrors.Errorf("invalid recovery value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateRecoveryValue(st state.State, v Recovery) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeyAccountAlias(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyAccountAliasSuffix)
+}
+
+func IsStateAccountAliasKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAccountAliasSuffix)
+}
+
+func StateKeyAlias(al Alias) string {
+	return fmt.Sprintf("%s%s", StateKeyAliasPrefix, al.Raw())
+}
+
+func IsStateAliasKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyAliasPrefix)
+}
+
+// StateAccountAliasValue returns AccountAlias from the state of alias or the
+// state of account alias; both have the same value.
+func StateAccountAliasValue(st state.State) (AccountAlias, error) {
+	v := st.Value()
+	if v == nil {
+		return AccountAlias{}, storage.NotFoundError.Errorf("account alias not found in State")
+	}
+
+	if s, ok := v.Interface().(AccountAlias); !ok {
+		return AccountAlias{}, xerrors.Errorf("invalid account alias value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateAccountAliasValue(st state.State, v AccountAlias) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+// StateKeyFreeze returns the state key of the freeze of account in currency.
+// With empty currency, it is the key of the freeze of every currency.
+func StateKeyFreeze(a base.Address, cid CurrencyID) string {
+	if len(cid) < 1 {
+		return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyFreezeSuffix)
+	}
+
+	return fmt.Sprintf("%s%s", StateBalanceKeyPrefix(a, cid), StateKeyFreezeSuffix)
+}
+
+func IsStateFreezeKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyFreezeSuffix)
+}
+
+func StateFreezeValue(st state.State) (Freeze, error) {
+	v := st.Value()
+	if v == nil {
+		return Freeze{}, storage.NotFoundError.Errorf("freeze not found in State")
+	}
+
+	if s, ok := v.Interface().(Freeze); !ok {
+		return Freeze{}, xerrors.Errorf("invalid freeze value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateFreezeValue(st state.State, v Freeze) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+// StateKeyTransferList returns the state key of the membership of account in
+// the transfer list of currency.
+func StateKeyTransferList(a base.Address, cid CurrencyID) string {
+	return fmt.Sprintf("%s%s", StateBalanceKeyPrefix(a, cid), StateKeyTransferListSuffix)
+}
+
+func IsStateTransferListKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyTransferListSuffix)
+}
+
+func StateTransferListValue(st state.State) (TransferListMember, error) {
+	v := st.Value()
+	if v == nil {
+		return TransferListMember{}, storage.NotFoundError.Errorf("transfer list member not found in State")
+	}
+
+	if s, ok := v.Interface().(TransferListMember); !ok {
+		return TransferListMember{}, xerrors.Errorf("invalid transfer list member value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateTransferListValue(st state.State, v TransferListMember) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeySpendingLimit(a base.Address, cid CurrencyID) string {
+	return fmt.Sprintf("%s%s", StateBalanceKeyPrefix(a, cid), StateKeySpendingLimitSuffix)
+}
+
+func IsStateSpendingLimitKey(key string) bool {
+	return strings.HasSuffix(key, StateKeySpendingLimitSuffix)
+}
+
+func StateSpendingLimitValue(st state.State) (SpendingLimit, error) {
+	v := st.Value()
+	if v == nil {
+		return SpendingLimit{}, storage.NotFoundError.Errorf("spending limit not found in State")
+	}
+
+	if s, ok := v.Interface().(SpendingLimit); !ok {
+		return SpendingLimit{}, xerrors.Errorf("invalid spending limit value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateSpendingLimitValue(st state.State, v SpendingLimit) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeySequence(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeySequenceSuffix)
+}
+
+func IsStateSequenceKey(key string) bool {
+	return strings.HasSuffix(key, StateKeySequenceSuffix)
+}
+
+func StateSequenceValue(st state.State) (Sequence, error) {
+	v := st.Value()
+	if v == nil {
+		return Sequence{}, storage.NotFoundError.Errorf("sequence not found in State")
+	}
+
+	if s, ok := v.Interface().(Sequence); !ok {
+		return Sequence{}, xerrors.Errorf("invalid sequence value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateSequenceValue(st state.State, v Sequence) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeyCommitments(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyCommitmentsSuffix)
+}
+
+func IsStateCommitmentsKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyCommitmentsSuffix)
+}
+
+func StateCommitmentsValue(st state.State) (Commitments, error) {
+	v := st.Value()
+	if v == nil {
+		return Commitments{}, storage.NotFoundError.Errorf("commitments not found in State")
+	}
+
+	if s, ok := v.Interface().(Commitments); !ok {
+		return Commitments{}, xerrors.Errorf("invalid commitments value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateCommitmentsValue(st state.State, v Commitments) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
 func IsStateCurrencyDesignKey(key string) bool {
 	return strings.HasPrefix(key, StateKeyCurrencyDesignPrefix)
 }
@@ -146,17 +473,175 @@ func SetStateCurrencyDesignValue(st state.State, v CurrencyDesign) (state.State,
 	}
 }
 
-func checkExistsState(
-	key string,
-	getState func(key string) (state.State, bool, error),
-) error {
-	switch _, found, err := getState(key); {
-	case err != nil:
-		return err
-	case !found:
-		return util.IgnoreError.Errorf("state, %q does not exist", key)
-	default:
-		return nil
+func IsStateCurrencySupplyKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyCurrencySupplyPrefix)
+}
+
+func StateKeyCurrencySupply(cid CurrencyID) string {
+	return fmt.Sprintf("%s%s", StateKeyCurrencySupplyPrefix, cid)
+}
+
+func StateCurrencySupplyValue(st state.State) (CurrencySupply, error) {
+	v := st.Value()
+	if v == nil {
+		return CurrencySupply{}, storage.NotFoundError.Errorf("currency supply not found in State")
+	}
+
+	if s, ok := v.Interface().(CurrencySupply); !ok {
+		return CurrencySupply{}, xerrors.Errorf("invalid currency supply value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateCurrencySupplyValue(st state.State, v CurrencySupply) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func IsStateLockKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyLockPrefix)
+}
+
+func StateKeyLock(id valuehash.Hash) string {
+	return fmt.Sprintf("%s%s", StateKeyLockPrefix, id)
+}
+
+func StateLockValue(st state.State) (Lock, error) {
+	v := st.Value()
+	if v == nil {
+		return Lock{}, storage.NotFoundError.Errorf("lock not found in State")
+	}
+
+	if s, ok := v.Interface().(Lock); !ok {
+		return Lock{}, xerrors.Errorf("invalid lock value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateLockValue(st state.State, v Lock) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func IsStateProposalKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyProposalPrefix)
+}
+
+func StateKeyProposal(id valuehash.Hash) string {
+	return fmt.Sprintf("%s%s", StateKeyProposalPrefix, id)
+}
+
+func StateProposalValue(st state.State) (Proposal, error) {
+	v := st.Value()
+	if v == nil {
+		return Proposal{}, storage.NotFoundError.Errorf("proposal not found in State")
+	}
+
+	if s, ok := v.Interface().(Proposal); !ok {
+		return Proposal{}, xerrors.Errorf("invalid proposal value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateProposalValue(st state.State, v Proposal) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func IsStateScheduledTransferKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyScheduledTransferPrefix)
+}
+
+func StateKeyScheduledTransfer(id valuehash.Hash) string {
+	return fmt.Sprintf("%s%s", StateKeyScheduledTransferPrefix, id)
+}
+
+func StateScheduledTransferValue(st state.State) (ScheduledTransfer, error) {
+	v := st.Value()
+	if v == nil {
+		return ScheduledTransfer{}, storage.NotFoundError.Errorf("scheduled transfer not found in State")
+	}
+
+	if s, ok := v.Interface().(ScheduledTransfer); !ok {
+		return ScheduledTransfer{}, xerrors.Errorf("invalid scheduled transfer value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateScheduledTransferValue(st state.State, v ScheduledTransfer) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+// IsStateScheduleQueueKey checks the key is one of the ScheduleQueue states;
+// ScheduledTransfers and StandingOrders are queued separately.
+func IsStateScheduleQueueKey(key string) bool {
+	return key == StateKeyScheduleQueue || key == StateKeyStandingOrderQueue
+}
+
+func StateScheduleQueueValue(st state.State) (ScheduleQueue, error) {
+	v := st.Value()
+	if v == nil {
+		return ScheduleQueue{}, storage.NotFoundError.Errorf("schedule queue not found in State")
+	}
+
+	if s, ok := v.Interface().(ScheduleQueue); !ok {
+		return ScheduleQueue{}, xerrors.Errorf("invalid schedule queue value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateScheduleQueueValue(st state.State, v ScheduleQueue) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func IsStateStandingOrderKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyStandingOrderPrefix)
+}
+
+func StateKeyStandingOrder(id valuehash.Hash) string {
+	return fmt.Sprintf("%s%s", StateKeyStandingOrderPrefix, id)
+}
+
+func StateStandingOrderValue(st state.State) (StandingOrder, error) {
+	v := st.Value()
+	if v == nil {
+		return StandingOrder{}, storage.NotFoundError.Errorf("standing order not found in State")
+	}
+
+	if s, ok := v.Interface().(StandingOrder); !ok {
+		return StandingOrder{}, xerrors.Errorf("invalid standing order value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateStandingOrderValue(st state.State, v StandingOrder) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
 	}
 }
 
@@ -175,6 +660,27 @@ func existsState(
 	}
 }
 
+// existsAccountState checks the account exists and it is not closed.
+func existsAccountState(
+	a base.Address,
+	name string,
+	getState func(key string) (state.State, bool, error),
+) (state.State, error) {
+	st, err := existsState(StateKeyAccount(a), name, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ac, err := LoadStateAccountValue(st); {
+	case err != nil:
+		return nil, util.IgnoreError.Wrap(err)
+	case ac.IsClosed():
+		return nil, util.IgnoreError.Errorf("%s, %q closed", name, a)
+	default:
+		return st, nil
+	}
+}
+
 func notExistsState(
 	k,
 	name string,
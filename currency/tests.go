@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/block"
 	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/prprocessor"
@@ -68,11 +69,90 @@ func (t *baseTest) SetupSuite() {
 	_ = t.Encs.AddHinter(KeyUpdater{})
 	_ = t.Encs.AddHinter(FeeOperationFact{})
 	_ = t.Encs.AddHinter(FeeOperation{})
+	_ = t.Encs.AddHinter(FeePayout{})
+	_ = t.Encs.AddHinter(FeeShare{})
 	_ = t.Encs.AddHinter(Account{})
 	_ = t.Encs.AddHinter(CurrencyDesign{})
 	_ = t.Encs.AddHinter(CurrencyPolicyUpdaterFact{})
 	_ = t.Encs.AddHinter(CurrencyPolicyUpdater{})
 	_ = t.Encs.AddHinter(CurrencyPolicy{})
+	_ = t.Encs.AddHinter(MintItem{})
+	_ = t.Encs.AddHinter(CurrencyMintFact{})
+	_ = t.Encs.AddHinter(CurrencyMint{})
+	_ = t.Encs.AddHinter(BurnFact{})
+	_ = t.Encs.AddHinter(Burn{})
+	_ = t.Encs.AddHinter(CurrencySupply{})
+	_ = t.Encs.AddHinter(Allowance{})
+	_ = t.Encs.AddHinter(ApproveFact{})
+	_ = t.Encs.AddHinter(Approve{})
+	_ = t.Encs.AddHinter(TransferFromFact{})
+	_ = t.Encs.AddHinter(TransferFrom{})
+	_ = t.Encs.AddHinter(Lock{})
+	_ = t.Encs.AddHinter(LockTransferFact{})
+	_ = t.Encs.AddHinter(LockTransfer{})
+	_ = t.Encs.AddHinter(ClaimTransferFact{})
+	_ = t.Encs.AddHinter(ClaimTransfer{})
+	_ = t.Encs.AddHinter(RefundTransferFact{})
+	_ = t.Encs.AddHinter(RefundTransfer{})
+	_ = t.Encs.AddHinter(VestingRelease{})
+	_ = t.Encs.AddHinter(Vesting{})
+	_ = t.Encs.AddHinter(CreateVestingAccountsItem{})
+	_ = t.Encs.AddHinter(CreateVestingAccountsFact{})
+	_ = t.Encs.AddHinter(CreateVestingAccounts{})
+	_ = t.Encs.AddHinter(Proposal{})
+	_ = t.Encs.AddHinter(ProposeOperationFact{})
+	_ = t.Encs.AddHinter(ProposeOperation{})
+	_ = t.Encs.AddHinter(ApproveOperationFact{})
+	_ = t.Encs.AddHinter(ApproveOperation{})
+	_ = t.Encs.AddHinter(RecoveryConfig{})
+	_ = t.Encs.AddHinter(Recovery{})
+	_ = t.Encs.AddHinter(RecoveryUpdaterFact{})
+	_ = t.Encs.AddHinter(RecoveryUpdater{})
+	_ = t.Encs.AddHinter(RecoverAccountFact{})
+	_ = t.Encs.AddHinter(RecoverAccount{})
+	_ = t.Encs.AddHinter(CancelRecoveryFact{})
+	_ = t.Encs.AddHinter(CancelRecovery{})
+	_ = t.Encs.AddHinter(Alias(""))
+	_ = t.Encs.AddHinter(AccountAlias{})
+	_ = t.Encs.AddHinter(RegisterAliasFact{})
+	_ = t.Encs.AddHinter(RegisterAlias{})
+	_ = t.Encs.AddHinter(TransferAliasFact{})
+	_ = t.Encs.AddHinter(TransferAlias{})
+	_ = t.Encs.AddHinter(ReleaseAliasFact{})
+	_ = t.Encs.AddHinter(ReleaseAlias{})
+	_ = t.Encs.AddHinter(CloseAccountFact{})
+	_ = t.Encs.AddHinter(CloseAccount{})
+	_ = t.Encs.AddHinter(ExchangeFact{})
+	_ = t.Encs.AddHinter(Exchange{})
+	_ = t.Encs.AddHinter(Freeze{})
+	_ = t.Encs.AddHinter(FreezeAccountFact{})
+	_ = t.Encs.AddHinter(FreezeAccount{})
+	_ = t.Encs.AddHinter(UnfreezeAccountFact{})
+	_ = t.Encs.AddHinter(UnfreezeAccount{})
+	_ = t.Encs.AddHinter(TransferListMember{})
+	_ = t.Encs.AddHinter(TransferListUpdaterFact{})
+	_ = t.Encs.AddHinter(TransferListUpdater{})
+	_ = t.Encs.AddHinter(SpendingLimit{})
+	_ = t.Encs.AddHinter(SetSpendingLimitFact{})
+	_ = t.Encs.AddHinter(SetSpendingLimit{})
+	_ = t.Encs.AddHinter(ScheduledTransfer{})
+	_ = t.Encs.AddHinter(ScheduleItem{})
+	_ = t.Encs.AddHinter(ScheduleQueue{})
+	_ = t.Encs.AddHinter(ScheduleTransferFact{})
+	_ = t.Encs.AddHinter(ScheduleTransfer{})
+	_ = t.Encs.AddHinter(CancelScheduledTransferFact{})
+	_ = t.Encs.AddHinter(CancelScheduledTransfer{})
+	_ = t.Encs.AddHinter(ScheduledTransferOperationFact{})
+	_ = t.Encs.AddHinter(ScheduledTransferOperation{})
+	_ = t.Encs.AddHinter(StandingOrder{})
+	_ = t.Encs.AddHinter(CreateStandingOrderFact{})
+	_ = t.Encs.AddHinter(CreateStandingOrder{})
+	_ = t.Encs.AddHinter(CancelStandingOrderFact{})
+	_ = t.Encs.AddHinter(CancelStandingOrder{})
+	_ = t.Encs.AddHinter(StandingOrderOperationFact{})
+	_ = t.Encs.AddHinter(StandingOrderOperation{})
+	_ = t.Encs.AddHinter(Sequence{})
+	_ = t.Encs.AddHinter(Commitments{})
 
 	t.cid = CurrencyID("SEEME")
 }
@@ -113,6 +193,51 @@ func (t *baseTestOperationProcessor) statepool(s ...[]state.State) (*storage.Sta
 	return pool, opr
 }
 
+// statepoolAt creates the Statepool, which processes the block of the given
+// height.
+func (t *baseTestOperationProcessor) statepoolAt(height base.Height, s ...[]state.State) *storage.Statepool {
+	base := map[string]state.State{}
+	for _, l := range s {
+		for _, st := range l {
+			base[st.Key()] = st
+		}
+	}
+
+	pool, err := storage.NewStatepoolWithBase(heightStorage{Storage: t.Storage(nil, nil), height: height}, base)
+	t.NoError(err)
+	t.Equal(height, pool.Height())
+
+	return pool
+}
+
+// heightStorage pretends the last block of the storage is right before the
+// height.
+type heightStorage struct { // nolint: unused
+	storage.Storage
+	height base.Height
+}
+
+func (st heightStorage) LastManifest() (block.Manifest, bool, error) {
+	return heightManifest{height: st.height - 1}, true, nil
+}
+
+type heightManifest struct { // nolint: unused
+	block.Manifest
+	height base.Height
+}
+
+func (m heightManifest) Height() base.Height {
+	return m.height
+}
+
+// height returns the height of block, which the statepool of test will
+// process.
+func (t *baseTestOperationProcessor) height() base.Height {
+	pool, _ := t.statepool()
+
+	return pool.Height()
+}
+
 func (t *baseTestOperationProcessor) newStateKeys(a base.Address, keys Keys) state.State {
 	key := StateKeyAccount(a)
 
@@ -152,6 +277,49 @@ func (t *baseTestOperationProcessor) newAccount(exists bool, amounts []Amount) (
 	return ac, sts
 }
 
+// newClosedAccount creates the account, which is closed by CloseAccount.
+func (t *baseTestOperationProcessor) newClosedAccount(amounts []Amount) (*account, []state.State) {
+	ac, sts := t.newAccount(true, amounts)
+
+	a, err := LoadStateAccountValue(sts[0])
+	t.NoError(err)
+
+	nst, err := SetStateAccountValue(sts[0], a.Close())
+	t.NoError(err)
+
+	sts[0] = nst
+
+	return ac, sts
+}
+
+// newMultiKeysAccount creates the account, which has the keys of the given
+// weights.
+func (t *baseTestOperationProcessor) newMultiKeysAccount(
+	weights []uint,
+	threshold uint,
+	amounts []Amount,
+) (base.Address, []key.Privatekey, []state.State) {
+	privs := make([]key.Privatekey, len(weights))
+	ks := make([]Key, len(weights))
+	for i := range weights {
+		privs[i] = key.MustNewBTCPrivatekey()
+		ks[i] = t.newKey(privs[i].Publickey(), weights[i])
+	}
+
+	keys, err := NewKeys(ks, threshold)
+	t.NoError(err)
+
+	a, err := NewAddressFromKeys(keys)
+	t.NoError(err)
+
+	sts := []state.State{t.newStateKeys(a, keys)}
+	for _, am := range amounts {
+		sts = append(sts, t.newStateAmount(a, am))
+	}
+
+	return a, privs, sts
+}
+
 func (t *baseTestOperationProcessor) newStateAmount(a base.Address, amount Amount) state.State {
 	key := StateKeyBalance(a, amount.Currency())
 	value, _ := state.NewHintedValue(amount)
@@ -171,7 +339,13 @@ func (t *baseTestOperationProcessor) newStateBalance(a base.Address, big Big, ci
 }
 
 func (t *baseTestOperationProcessor) newCurrencyDesignState(cid CurrencyID, big Big, genesisAccount base.Address, feeer Feeer) state.State {
-	de := NewCurrencyDesign(NewAmount(big, cid), genesisAccount, NewCurrencyPolicy(ZeroBig, feeer))
+	return t.newCurrencyDesignStateByPolicy(cid, big, genesisAccount, NewCurrencyPolicy(ZeroBig, feeer))
+}
+
+func (t *baseTestOperationProcessor) newCurrencyDesignStateByPolicy(
+	cid CurrencyID, big Big, genesisAccount base.Address, po CurrencyPolicy,
+) state.State {
+	de := NewCurrencyDesign(NewAmount(big, cid), genesisAccount, po)
 
 	st, err := state.NewStateV0(StateKeyCurrencyDesign(cid), nil, base.NilHeight)
 	t.NoError(err)
@@ -182,6 +356,166 @@ func (t *baseTestOperationProcessor) newCurrencyDesignState(cid CurrencyID, big
 	return nst
 }
 
+func (t *baseTestOperationProcessor) newCurrencySupplyState(sp CurrencySupply) state.State {
+	st, err := state.NewStateV0(StateKeyCurrencySupply(sp.Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateCurrencySupplyValue(st, sp)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newAllowanceState(al Allowance) state.State {
+	st, err := state.NewStateV0(StateKeyAllowance(al.Owner(), al.Spender(), al.Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateAllowanceValue(st, al)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newLockState(lk Lock) state.State {
+	st, err := state.NewStateV0(StateKeyLock(lk.ID()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateLockValue(st, lk)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newVestingState(a base.Address, vs Vesting) state.State {
+	st, err := state.NewStateV0(StateKeyVesting(a, vs.Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateVestingValue(st, vs)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newProposalState(pr Proposal) state.State {
+	st, err := state.NewStateV0(StateKeyProposal(pr.ID()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateProposalValue(st, pr)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newRecoveryConfigState(a base.Address, rc RecoveryConfig) state.State {
+	st, err := state.NewStateV0(StateKeyRecoveryConfig(a), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateRecoveryConfigValue(st, rc)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newRecoveryState(rv Recovery) state.State {
+	st, err := state.NewStateV0(StateKeyRecovery(rv.Target()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateRecoveryValue(st, rv)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newFreezeState(fz Freeze) state.State {
+	st, err := state.NewStateV0(StateKeyFreeze(fz.Target(), fz.Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateFreezeValue(st, fz)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newTransferListState(tm TransferListMember) state.State {
+	st, err := state.NewStateV0(StateKeyTransferList(tm.Account(), tm.Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateTransferListValue(st, tm)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newSpendingLimitState(sl SpendingLimit) state.State {
+	st, err := state.NewStateV0(StateKeySpendingLimit(sl.Account(), sl.Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateSpendingLimitValue(st, sl)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newSequenceState(sq Sequence) state.State {
+	st, err := state.NewStateV0(StateKeySequence(sq.Account()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateSequenceValue(st, sq)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newScheduledTransferState(sc ScheduledTransfer) state.State {
+	st, err := state.NewStateV0(StateKeyScheduledTransfer(sc.ID()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateScheduledTransferValue(st, sc)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newScheduleQueueState(sq ScheduleQueue) state.State {
+	st, err := state.NewStateV0(StateKeyScheduleQueue, nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateScheduleQueueValue(st, sq)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newCommitmentsState(cm Commitments) state.State {
+	st, err := state.NewStateV0(StateKeyCommitments(cm.Account()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateCommitmentsValue(st, cm)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newStandingOrderState(so StandingOrder) state.State {
+	st, err := state.NewStateV0(StateKeyStandingOrder(so.ID()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateStandingOrderValue(st, so)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newStandingOrderQueueState(sq ScheduleQueue) state.State {
+	st, err := state.NewStateV0(StateKeyStandingOrderQueue, nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateScheduleQueueValue(st, sq)
+	t.NoError(err)
+
+	return nst
+}
+
 func NewTestAddress() base.Address {
 	k, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), 100)
 	if err != nil {
@@ -200,3 +534,20 @@ func NewTestAddress() base.Address {
 
 	return a
 }
+
+// newAccountAliasStates returns the state of alias and the state of account
+// alias.
+func (t *baseTestOperationProcessor) newAccountAliasStates(aa AccountAlias) []state.State {
+	sts := make([]state.State, 2)
+	for i, k := range []string{StateKeyAlias(aa.Alias()), StateKeyAccountAlias(aa.Account())} {
+		st, err := state.NewStateV0(k, nil, base.NilHeight)
+		t.NoError(err)
+
+		nst, err := SetStateAccountAliasValue(st, aa)
+		t.NoError(err)
+
+		sts[i] = nst
+	}
+
+	return sts
+}
@@ -0,0 +1,179 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	TransferAliasFactType = hint.MustNewType(0xa0, 0x63, "mitum-currency-transfer-alias-operation-fact")
+	TransferAliasFactHint = hint.MustHint(TransferAliasFactType, "0.0.1")
+	TransferAliasType     = hint.MustNewType(0xa0, 0x64, "mitum-currency-transfer-alias-operation")
+	TransferAliasHint     = hint.MustHint(TransferAliasType, "0.0.1")
+)
+
+// TransferAliasFact moves the alias of sender to receiver. Receiver should not
+// have alias. The fee is charged to sender.
+type TransferAliasFact struct {
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	alias    Alias
+	receiver base.Address
+	currency CurrencyID
+}
+
+func NewTransferAliasFact(
+	token []byte,
+	sender base.Address,
+	alias Alias,
+	receiver base.Address,
+	currency CurrencyID,
+) TransferAliasFact {
+	fact := TransferAliasFact{
+		token:    token,
+		sender:   sender,
+		alias:    alias,
+		receiver: receiver,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact TransferAliasFact) Hint() hint.Hint {
+	return TransferAliasFactHint
+}
+
+func (fact TransferAliasFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact TransferAliasFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferAliasFact) Token() []byte {
+	return fact.token
+}
+
+func (fact TransferAliasFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.alias.Bytes(),
+		fact.receiver.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact TransferAliasFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for TransferAliasFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.alias,
+		fact.receiver,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.receiver) {
+		return xerrors.Errorf("receiver is same with sender, %q", fact.sender)
+	}
+
+	if _, ok := fact.receiver.(Alias); ok {
+		return xerrors.Errorf("receiver should not be alias, %q", fact.receiver)
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact TransferAliasFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact TransferAliasFact) Alias() Alias {
+	return fact.alias
+}
+
+func (fact TransferAliasFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact TransferAliasFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact TransferAliasFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.receiver}, nil
+}
+
+type TransferAlias struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewTransferAlias(fact TransferAliasFact, fs []operation.FactSign, memo string) (TransferAlias, error) {
+	if bo, err := operation.NewBaseOperationFromFact(TransferAliasHint, fact, fs); err != nil {
+		return TransferAlias{}, err
+	} else {
+		op := TransferAlias{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op TransferAlias) Hint() hint.Hint {
+	return TransferAliasHint
+}
+
+func (op TransferAlias) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op TransferAlias) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op TransferAlias) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
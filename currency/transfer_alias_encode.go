@@ -0,0 +1,36 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *TransferAliasFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	alias string,
+	bReceiver base.AddressDecoder,
+	cr string,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.sender = a
+	}
+
+	if a, err := bReceiver.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.receiver = a
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.alias = Alias(alias)
+	fact.currency = CurrencyID(cr)
+
+	return nil
+}
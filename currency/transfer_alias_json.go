@@ -0,0 +1,73 @@
+package currency // nolint: dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type TransferAliasFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	AL string         `json:"alias"`
+	RC base.Address   `json:"receiver"`
+	CR CurrencyID     `json:"currency"`
+}
+
+func (fact TransferAliasFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(TransferAliasFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		AL:         fact.alias.Raw(),
+		RC:         fact.receiver,
+		CR:         fact.currency,
+	})
+}
+
+type TransferAliasFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	AL string              `json:"alias"`
+	RC base.AddressDecoder `json:"receiver"`
+	CR string              `json:"currency"`
+}
+
+func (fact *TransferAliasFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact TransferAliasFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.AL, ufact.RC, ufact.CR)
+}
+
+func (op TransferAlias) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *TransferAlias) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = TransferAlias{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op TransferAlias) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type TransferAliasProcessor struct {
+	cp *CurrencyPool
+	TransferAlias
+	proposable
+	height base.Height
+	sa     state.State // NOTE state of alias
+	ss     state.State // NOTE state of alias of sender
+	sr     state.State // NOTE state of alias of receiver
+	aa     AccountAlias
+	sb     AmountState
+	fee    Big
+}
+
+func NewTransferAliasProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(TransferAlias); !ok {
+			return nil, xerrors.Errorf("not TransferAlias, %T", op)
+		} else {
+			return &TransferAliasProcessor{
+				cp:            cp,
+				TransferAlias: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *TransferAliasProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *TransferAliasProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(TransferAliasFact)
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsAccountState(fact.receiver, "receiver", getState); err != nil {
+		return nil, err
+	}
+
+	if st, aa, err := loadOwnedAlias(fact.alias, fact.sender, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sa = st
+		opp.aa = aa
+	}
+
+	if st, _, err := getState(StateKeyAccountAlias(fact.sender)); err != nil {
+		return nil, err
+	} else {
+		opp.ss = st
+	}
+
+	switch st, aa, found, err := loadAccountAlias(StateKeyAccountAlias(fact.receiver), getState); {
+	case err != nil:
+		return nil, err
+	case found:
+		return nil, util.IgnoreError.Errorf("receiver already has alias, %q", aa.Alias().Raw())
+	default:
+		opp.sr = st
+	}
+
+	if err := opp.checkFactSigns(fact.sender, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	if sb, fee, err := loadOperationFee(
+		opp.cp, fact.sender, fact.currency, TransferAliasType, opp.height, getState,
+	); err != nil {
+		return nil, err
+	} else {
+		opp.sb = sb
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *TransferAliasProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(TransferAliasFact)
+
+	naa := NewAccountAlias(fact.alias, fact.receiver)
+
+	sts := make([]state.State, 4)
+	for i, v := range []struct {
+		st state.State
+		aa AccountAlias
+	}{
+		{opp.sa, naa},
+		{opp.sr, naa},
+		{opp.ss, opp.aa.Release()},
+	} {
+		if j, err := SetStateAccountAliasValue(v.st, v.aa); err != nil {
+			return err
+		} else {
+			sts[i] = j
+		}
+	}
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+	sts[3] = opp.sb
+
+	return setState(fact.Hash(), sts...)
+}
+
+// loadOwnedAlias loads the registered alias, which should be owned by owner.
+func loadOwnedAlias(
+	alias Alias,
+	owner base.Address,
+	getState func(key string) (state.State, bool, error),
+) (state.State, AccountAlias, error) {
+	switch st, aa, found, err := loadAccountAlias(StateKeyAlias(alias), getState); {
+	case err != nil:
+		return nil, AccountAlias{}, err
+	case !found:
+		return nil, AccountAlias{}, util.IgnoreError.Errorf("alias, %q not registered", alias.Raw())
+	case !aa.Account().Equal(owner):
+		return nil, AccountAlias{}, util.IgnoreError.Errorf("alias, %q not owned by %q", alias.Raw(), owner)
+	default:
+		return st, aa, nil
+	}
+}
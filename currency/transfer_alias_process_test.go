@@ -0,0 +1,217 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testTransferAliasOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testTransferAliasOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testTransferAliasOperations) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(TransferAlias{}, NewTransferAliasProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testTransferAliasOperations) newTransferAlias(
+	sender base.Address,
+	keys []key.Privatekey,
+	alias Alias,
+	receiver base.Address,
+) TransferAlias {
+	token := util.UUID().Bytes()
+	fact := NewTransferAliasFact(token, sender, alias, receiver, t.cid)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewTransferAlias(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testTransferAliasOperations) TestNew() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("showme"), sa.Address)
+	pool, _ := t.statepool(st0, st1, append(t.newAccountAliasStates(aa), dst))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferAlias(sa.Address, sa.Privs(), Alias("showme"), ra.Address)
+	t.NoError(opr.Process(op))
+
+	var ast, sst, rst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyAlias(Alias("showme")):
+			ast = st.GetState()
+		case StateKeyAccountAlias(sa.Address):
+			sst = st.GetState()
+		case StateKeyAccountAlias(ra.Address):
+			rst = st.GetState()
+		}
+	}
+
+	for _, st := range []state.State{ast, rst} {
+		uaa, err := StateAccountAliasValue(st)
+		t.NoError(err)
+		t.True(uaa.Registered())
+		t.True(uaa.Account().Equal(ra.Address))
+	}
+
+	saa, err := StateAccountAliasValue(sst)
+	t.NoError(err)
+	t.False(saa.Registered())
+	t.True(saa.Account().Equal(sa.Address))
+}
+
+func (t *testTransferAliasOperations) TestSenderFrozen() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("showme"), sa.Address)
+	pool, _ := t.statepool(st0, st1, append(t.newAccountAliasStates(aa), dst, t.newFreezeState(NewFreeze(sa.Address, "", false))))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferAlias(sa.Address, sa.Privs(), Alias("showme"), ra.Address)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testTransferAliasOperations) TestNotRegistered() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	pool, _ := t.statepool(st0, st1, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferAlias(sa.Address, sa.Privs(), Alias("showme"), ra.Address)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not registered")
+}
+
+func (t *testTransferAliasOperations) TestNotOwned() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("showme"), NewTestAddress())
+	pool, _ := t.statepool(st0, st1, append(t.newAccountAliasStates(aa), dst))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferAlias(sa.Address, sa.Privs(), Alias("showme"), ra.Address)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not owned by")
+}
+
+func (t *testTransferAliasOperations) TestReceiverNotExist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("showme"), sa.Address)
+	pool, _ := t.statepool(st0, append(t.newAccountAliasStates(aa), dst))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferAlias(sa.Address, sa.Privs(), Alias("showme"), NewTestAddress())
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "receiver does not exist")
+}
+
+func (t *testTransferAliasOperations) TestReceiverHasAlias() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+
+	aa := NewAccountAlias(Alias("showme"), sa.Address)
+	raa := NewAccountAlias(Alias("findme"), ra.Address)
+
+	sts := append(t.newAccountAliasStates(aa), t.newAccountAliasStates(raa)...)
+	pool, _ := t.statepool(st0, st1, append(sts, dst))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferAlias(sa.Address, sa.Privs(), Alias("showme"), ra.Address)
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "receiver already has alias")
+}
+
+func TestTransferAliasOperations(t *testing.T) {
+	suite.Run(t, new(testTransferAliasOperations))
+}
@@ -0,0 +1,115 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testTransferAlias struct {
+	baseTest
+}
+
+func (t *testTransferAlias) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewTransferAliasFact(token, NewTestAddress(), Alias("showme"), NewTestAddress(), t.cid)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewTransferAlias(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testTransferAlias) TestEmptyToken() {
+	fact := NewTransferAliasFact(nil, NewTestAddress(), Alias("showme"), NewTestAddress(), t.cid)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty token")
+}
+
+func (t *testTransferAlias) TestSameWithSender() {
+	sender := NewTestAddress()
+
+	token := util.UUID().Bytes()
+	fact := NewTransferAliasFact(token, sender, Alias("showme"), sender, t.cid)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "receiver is same with sender")
+}
+
+func (t *testTransferAlias) TestAliasReceiver() {
+	token := util.UUID().Bytes()
+	fact := NewTransferAliasFact(token, NewTestAddress(), Alias("showme"), Alias("findme"), t.cid)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "receiver should not be alias")
+}
+
+func TestTransferAlias(t *testing.T) {
+	suite.Run(t, new(testTransferAlias))
+}
+
+func testTransferAliasEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewTransferAliasFact(token, NewTestAddress(), Alias("showme"), NewTestAddress(), CurrencyID("SHOWME"))
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewTransferAlias(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(TransferAlias)
+		tb := b.(TransferAlias)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(TransferAliasFact)
+		ufact := tb.Fact().(TransferAliasFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.Equal(fact.alias, ufact.alias)
+		t.True(fact.receiver.Equal(ufact.receiver))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestTransferAliasEncodeJSON(t *testing.T) {
+	suite.Run(t, testTransferAliasEncode(jsonenc.NewEncoder()))
+}
+
+func TestTransferAliasEncodeBSON(t *testing.T) {
+	suite.Run(t, testTransferAliasEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,192 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	TransferFromFactType = hint.MustNewType(0xa0, 0x44, "mitum-currency-transfer-from-operation-fact")
+	TransferFromFactHint = hint.MustHint(TransferFromFactType, "0.0.1")
+	TransferFromType     = hint.MustNewType(0xa0, 0x45, "mitum-currency-transfer-from-operation")
+	TransferFromHint     = hint.MustHint(TransferFromType, "0.0.1")
+)
+
+// TransferFromFact transfers the amount from the balance of owner to receiver
+// within the allowance of sender. The sender is the spender of allowance and
+// pays the fee.
+type TransferFromFact struct {
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	owner    base.Address
+	receiver base.Address
+	amount   Amount
+}
+
+func NewTransferFromFact(
+	token []byte,
+	sender, owner, receiver base.Address,
+	amount Amount,
+) TransferFromFact {
+	fact := TransferFromFact{
+		token:    token,
+		sender:   sender,
+		owner:    owner,
+		receiver: receiver,
+		amount:   amount,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact TransferFromFact) Hint() hint.Hint {
+	return TransferFromFactHint
+}
+
+func (fact TransferFromFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact TransferFromFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferFromFact) Token() []byte {
+	return fact.token
+}
+
+func (fact TransferFromFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.owner.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+	)
+}
+
+func (fact TransferFromFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for TransferFromFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.owner,
+		fact.receiver,
+		fact.amount,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.owner) {
+		return xerrors.Errorf("owner is same with sender, %q", fact.owner)
+	} else if fact.receiver.Equal(fact.owner) {
+		return xerrors.Errorf("receiver is same with owner, %q", fact.owner)
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return xerrors.Errorf("amount should be over zero")
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+// Sender returns the spender of allowance.
+func (fact TransferFromFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact TransferFromFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact TransferFromFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact TransferFromFact) Amount() Amount {
+	return fact.amount
+}
+
+func (fact TransferFromFact) Amounts() []Amount {
+	return []Amount{fact.amount}
+}
+
+func (fact TransferFromFact) Rebuild() TransferFromFact {
+	fact.amount = fact.amount.WithBig(fact.amount.Big())
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact TransferFromFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.owner, fact.receiver}, nil
+}
+
+type TransferFrom struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewTransferFrom(fact TransferFromFact, fs []operation.FactSign, memo string) (TransferFrom, error) {
+	if bo, err := operation.NewBaseOperationFromFact(TransferFromHint, fact, fs); err != nil {
+		return TransferFrom{}, err
+	} else {
+		op := TransferFrom{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op TransferFrom) Hint() hint.Hint {
+	return TransferFromHint
+}
+
+func (op TransferFrom) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op TransferFrom) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op TransferFrom) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
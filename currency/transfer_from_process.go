@@ -0,0 +1,149 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op TransferFrom) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type TransferFromProcessor struct {
+	cp *CurrencyPool
+	TransferFrom
+	proposable
+	height   base.Height
+	sa       state.State
+	al       Allowance
+	sb       map[CurrencyID]AmountState
+	pb       map[CurrencyID]AmountState
+	rb       AmountState
+	required map[CurrencyID][2]Big
+	sl       []spendingLimitState
+}
+
+func NewTransferFromProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(TransferFrom); !ok {
+			return nil, xerrors.Errorf("not TransferFrom, %T", op)
+		} else {
+			return &TransferFromProcessor{
+				cp:           cp,
+				TransferFrom: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *TransferFromProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *TransferFromProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(TransferFromFact)
+	cid := fact.amount.Currency()
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsAccountState(fact.owner, "owner", getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsAccountState(fact.receiver, "receiver", getState); err != nil {
+		return nil, err
+	}
+
+	if opp.cp != nil && !opp.cp.Exists(cid) {
+		return nil, util.IgnoreError.Errorf("currency not registered, %q", cid)
+	}
+
+	if err := checkTransferRestriction(opp.cp, fact.owner, cid, getState); err != nil {
+		return nil, err
+	} else if err := checkTransferRestriction(opp.cp, fact.receiver, cid, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkNotFrozen(fact.receiver, cid, true, getState); err != nil {
+		return nil, err
+	}
+
+	if st, err := existsState(StateKeyAllowance(fact.owner, fact.sender, cid), "allowance", getState); err != nil {
+		return nil, err
+	} else if al, err := StateAllowanceValue(st); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else if al.Amount().Big().Compare(fact.amount.Big()) < 0 {
+		return nil, util.IgnoreError.Errorf("insufficient allowance, %v < %v", al.Amount().Big(), fact.amount.Big())
+	} else {
+		opp.sa = st
+		opp.al = al
+	}
+
+	// NOTE the amount is charged to owner and the fee to sender
+	if required, err := CalculateItemsFee(opp.cp, TransferFromType, []AmountsItem{fact}); err != nil {
+		return nil, util.IgnoreError.Wrap(err)
+	} else if err := checkNotFrozenByRequired(fact.owner, fact.sender, required, getState); err != nil {
+		return nil, err
+	} else if sb, pb, err := CheckEnoughBalanceWithFeePayer(fact.owner, fact.sender, required, opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+		opp.pb = pb
+	}
+
+	if sl, err := checkSpendingLimit(fact.owner, requiredOutflow(opp.required), opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sl = sl
+	}
+
+	if st, _, err := getState(StateKeyBalance(fact.receiver, cid)); err != nil {
+		return nil, err
+	} else {
+		opp.rb = NewAmountState(st, cid)
+	}
+
+	if err := opp.checkFactSigns(fact.sender, nil, opp.Signs(), getState); err != nil {
+		return nil, util.IgnoreError.Errorf("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *TransferFromProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(TransferFromFact)
+
+	var sts []state.State
+	if st, err := SetStateAllowanceValue(opp.sa, opp.al.WithBig(opp.al.Amount().Big().Sub(fact.amount.Big()))); err != nil {
+		return err
+	} else {
+		sts = append(sts, st, opp.rb.Add(fact.amount.Big()))
+	}
+
+	sts = append(sts, debitRequired(opp.sb, opp.pb, opp.required)...)
+
+	if sls, err := setSpendingLimitStates(opp.sl); err != nil {
+		return err
+	} else {
+		sts = append(sts, sls...)
+	}
+
+	return setState(fact.Hash(), sts...)
+}
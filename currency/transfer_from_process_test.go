@@ -0,0 +1,383 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testTransferFromOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testTransferFromOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testTransferFromOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(TransferFrom{}, NewTransferFromProcessor(cp))
+	t.NoError(err)
+
+	copr, err = copr.(*OperationProcessor).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testTransferFromOperations) newTransferFrom(
+	sender, owner, receiver base.Address,
+	keys []key.Privatekey,
+	amount Amount,
+) TransferFrom {
+	token := util.UUID().Bytes()
+	fact := NewTransferFromFact(token, sender, owner, receiver, amount)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewTransferFrom(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testTransferFromOperations) TestNew() {
+	fa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(0), t.cid)})
+	oa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	ra, st3 := t.newAccount(true, nil)
+
+	ast := t.newAllowanceState(NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid)))
+
+	fee := NewBig(2)
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))
+	pool, _ := t.statepool(st0, st1, st2, st3, []state.State{ast, dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	amount := NewBig(15)
+	op := t.newTransferFrom(sa.Address, oa.Address, ra.Address, sa.Privs(), NewAmount(amount, t.cid))
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	var ost, sst, rst, fst, alst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(oa.Address, t.cid):
+			ost = st.GetState()
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyBalance(fa.Address, t.cid):
+			fst = st.GetState()
+		case StateKeyAllowance(oa.Address, sa.Address, t.cid):
+			alst = st.GetState()
+		}
+	}
+
+	ostv, _ := StateBalanceValue(ost)
+	t.True(ostv.Big().Equal(NewBig(33).Sub(amount)))
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(5).Sub(fee)))
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(amount))
+
+	fstv, _ := StateBalanceValue(fst)
+	t.True(fstv.Big().Equal(fee))
+
+	al, err := StateAllowanceValue(alst)
+	t.NoError(err)
+	t.True(al.Amount().Big().Equal(NewBig(5)))
+}
+
+func (t *testTransferFromOperations) TestAllowanceNotFound() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferFrom(sa.Address, oa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "allowance does not exist")
+}
+
+func (t *testTransferFromOperations) TestInsufficientAllowance() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	ast := t.newAllowanceState(NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(9), t.cid)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, st2, []state.State{ast, dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferFrom(sa.Address, oa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient allowance")
+}
+
+func (t *testTransferFromOperations) TestInsufficientBalanceOfOwner() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	ast := t.newAllowanceState(NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, st2, []state.State{ast, dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferFrom(sa.Address, oa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testTransferFromOperations) TestNotSignedBySpender() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	ast := t.newAllowanceState(NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, st2, []state.State{ast, dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferFrom(sa.Address, oa.Address, ra.Address, oa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func (t *testTransferFromOperations) TestOwnerFrozen() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	ast := t.newAllowanceState(NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, st2, []state.State{ast, dst, t.newFreezeState(NewFreeze(oa.Address, t.cid, false))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferFrom(sa.Address, oa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testTransferFromOperations) TestReceiverFrozen() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	ast := t.newAllowanceState(NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, st2, []state.State{ast, dst, t.newFreezeState(NewFreeze(ra.Address, t.cid, true))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferFrom(sa.Address, oa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testTransferFromOperations) TestDenylist() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	ast := t.newAllowanceState(NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid)))
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetTransferRestriction(TransferRestrictionDenylist)
+	dst := t.newCurrencyDesignStateByPolicy(t.cid, NewBig(99), NewTestAddress(), po)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	for _, a := range []base.Address{oa.Address, ra.Address} {
+		tst := t.newTransferListState(NewTransferListMember(a, t.cid, true))
+		pool, _ := t.statepool(st0, st1, st2, []state.State{ast, dst, tst})
+
+		opr := t.processor(cp, pool)
+
+		op := t.newTransferFrom(sa.Address, oa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid))
+
+		err := opr.Process(op)
+		t.True(xerrors.Is(err, util.IgnoreError))
+		t.Contains(err.Error(), "in denylist")
+	}
+}
+
+func (t *testTransferFromOperations) TestSpendingLimitOfOwner() {
+	fa, st0 := t.newAccount(true, nil)
+	oa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	ra, st3 := t.newAccount(true, nil)
+
+	ast := t.newAllowanceState(NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid)))
+	sl := NewSpendingLimit(oa.Address, t.cid, NewSpendingLimitRule(NewBig(20), base.Height(10), base.Height(0)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(2)))
+	pool, _ := t.statepool(st0, st1, st2, st3, []state.State{ast, dst, t.newSpendingLimitState(sl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferFrom(sa.Address, oa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(15), t.cid))
+	t.NoError(opr.Process(op))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeySpendingLimit(oa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeySpendingLimit(sa.Address, t.cid):
+			t.Fail("spending limit of sender should not be updated")
+		}
+	}
+
+	usl, err := StateSpendingLimitValue(sst)
+	t.NoError(err)
+	t.True(usl.Spent().Equal(NewBig(15)))
+}
+
+func (t *testTransferFromOperations) TestSpendingLimitOfOwnerExceeded() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	ast := t.newAllowanceState(NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid)))
+	sl := NewSpendingLimit(oa.Address, t.cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(0)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, st2, []state.State{ast, dst, t.newSpendingLimitState(sl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newTransferFrom(sa.Address, oa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "spending limit exceeded")
+}
+
+func (t *testTransferFromOperations) TestOwnerSendsInSameProposal() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	ast := t.newAllowanceState(NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid)))
+
+	dst := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())
+	pool, _ := t.statepool(st0, st1, st2, []state.State{ast, dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	var tf Transfers
+	{
+		fact := NewTransfersFact(
+			util.UUID().Bytes(),
+			oa.Address,
+			[]TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(30), t.cid))},
+		).SetSequence(1)
+
+		var fs []operation.FactSign
+		for _, pk := range oa.Privs() {
+			sig, err := operation.NewFactSignature(pk, fact, nil)
+			t.NoError(err)
+
+			fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+		}
+
+		i, err := NewTransfers(fact, fs, "")
+		t.NoError(err)
+		tf = i
+	}
+
+	t.NoError(opr.Process(tf))
+
+	op := t.newTransferFrom(sa.Address, oa.Address, ra.Address, sa.Privs(), NewAmount(NewBig(10), t.cid))
+
+	err := opr.Process(op)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "violates only one sender")
+}
+
+func TestTransferFromOperations(t *testing.T) {
+	suite.Run(t, new(testTransferFromOperations))
+}
@@ -0,0 +1,135 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testTransferFrom struct {
+	baseTest
+}
+
+func (t *testTransferFrom) newOperation(sender, owner, receiver base.Address, amount Amount) TransferFrom {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewTransferFromFact(token, sender, owner, receiver, amount)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewTransferFrom(fact, fs, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testTransferFrom) TestNew() {
+	sender := NewTestAddress()
+	owner := NewTestAddress()
+	receiver := NewTestAddress()
+	op := t.newOperation(sender, owner, receiver, NewAmount(NewBig(33), CurrencyID("SHOWME")))
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	as, err := op.Fact().(TransferFromFact).Addresses()
+	t.NoError(err)
+	t.Equal(3, len(as))
+	t.True(sender.Equal(as[0]))
+	t.True(owner.Equal(as[1]))
+	t.True(receiver.Equal(as[2]))
+}
+
+func (t *testTransferFrom) TestZeroAmount() {
+	op := t.newOperation(NewTestAddress(), NewTestAddress(), NewTestAddress(), NewZeroAmount(CurrencyID("SHOWME")))
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "amount should be over zero")
+}
+
+func (t *testTransferFrom) TestSameOwner() {
+	sender := NewTestAddress()
+	op := t.newOperation(sender, sender, NewTestAddress(), NewAmount(NewBig(33), CurrencyID("SHOWME")))
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "owner is same with sender")
+}
+
+func (t *testTransferFrom) TestReceiverSameWithOwner() {
+	owner := NewTestAddress()
+	op := t.newOperation(NewTestAddress(), owner, owner, NewAmount(NewBig(33), CurrencyID("SHOWME")))
+
+	err := op.IsValid(nil)
+	t.Contains(err.Error(), "receiver is same with owner")
+}
+
+func TestTransferFrom(t *testing.T) {
+	suite.Run(t, new(testTransferFrom))
+}
+
+func testTransferFromEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewTransferFromFact(
+			token,
+			NewTestAddress(),
+			NewTestAddress(),
+			NewTestAddress(),
+			NewAmount(NewBig(33), CurrencyID("SHOWME")),
+		)
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewTransferFrom(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(TransferFrom)
+		tb := b.(TransferFrom)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(TransferFromFact)
+		ufact := tb.Fact().(TransferFromFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.owner.Equal(ufact.owner))
+		t.True(fact.receiver.Equal(ufact.receiver))
+		t.True(fact.amount.Equal(ufact.amount))
+	}
+
+	return t
+}
+
+func TestTransferFromEncodeJSON(t *testing.T) {
+	suite.Run(t, testTransferFromEncode(jsonenc.NewEncoder()))
+}
+
+func TestTransferFromEncodeBSON(t *testing.T) {
+	suite.Run(t, testTransferFromEncode(bsonenc.NewEncoder()))
+}
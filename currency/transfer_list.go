@@ -0,0 +1,138 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	TransferListMemberType = hint.MustNewType(0xa0, 0x70, "mitum-currency-transfer-list-member")
+	TransferListMemberHint = hint.MustHint(TransferListMemberType, "0.0.1")
+)
+
+// TransferRestriction is the mode of the transfer list of currency. With
+// allowlist, only the listed accounts can send and receive the currency; with
+// denylist, the listed accounts can not.
+type TransferRestriction string
+
+const (
+	TransferRestrictionOpen      TransferRestriction = "open"
+	TransferRestrictionAllowlist TransferRestriction = "allowlist"
+	TransferRestrictionDenylist  TransferRestriction = "denylist"
+)
+
+func (tr TransferRestriction) Bytes() []byte {
+	return []byte(tr)
+}
+
+func (tr TransferRestriction) String() string {
+	return string(tr)
+}
+
+func (tr TransferRestriction) IsValid([]byte) error {
+	switch tr {
+	case TransferRestrictionOpen, TransferRestrictionAllowlist, TransferRestrictionDenylist:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown transfer restriction, %q", tr)
+	}
+}
+
+// TransferListMember is the membership of account in the transfer list of
+// currency. TransferListUpdater of suffrage adds or removes it.
+type TransferListMember struct {
+	account  base.Address
+	currency CurrencyID
+	listed   bool
+}
+
+func NewTransferListMember(account base.Address, cid CurrencyID, listed bool) TransferListMember {
+	return TransferListMember{account: account, currency: cid, listed: listed}
+}
+
+func (tm TransferListMember) Hint() hint.Hint {
+	return TransferListMemberHint
+}
+
+func (tm TransferListMember) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		tm.account.Bytes(),
+		tm.currency.Bytes(),
+		util.BoolToBytes(tm.listed),
+	)
+}
+
+func (tm TransferListMember) Hash() valuehash.Hash {
+	return tm.GenerateHash()
+}
+
+func (tm TransferListMember) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(tm.Bytes())
+}
+
+func (tm TransferListMember) IsValid([]byte) error {
+	if err := isvalid.Check([]isvalid.IsValider{tm.account, tm.currency}, nil, false); err != nil {
+		return xerrors.Errorf("invalid TransferListMember: %w", err)
+	}
+
+	return nil
+}
+
+func (tm TransferListMember) Account() base.Address {
+	return tm.account
+}
+
+func (tm TransferListMember) Currency() CurrencyID {
+	return tm.currency
+}
+
+func (tm TransferListMember) Listed() bool {
+	return tm.listed
+}
+
+// checkTransferRestriction checks the account can send or receive the currency
+// by the transfer restriction of currency policy.
+func checkTransferRestriction(
+	cp *CurrencyPool,
+	a base.Address,
+	cid CurrencyID,
+	getState func(key string) (state.State, bool, error),
+) error {
+	if cp == nil {
+		return nil
+	}
+
+	var tr TransferRestriction
+	if policy, found := cp.Policy(cid); !found {
+		return nil
+	} else if tr = policy.TransferRestriction(); tr == TransferRestrictionOpen {
+		return nil
+	}
+
+	var listed bool
+	switch st, found, err := getState(StateKeyTransferList(a, cid)); {
+	case err != nil:
+		return err
+	case found:
+		if tm, err := StateTransferListValue(st); err != nil {
+			return util.IgnoreError.Wrap(err)
+		} else {
+			listed = tm.Listed()
+		}
+	}
+
+	switch {
+	case tr == TransferRestrictionAllowlist && !listed:
+		return util.IgnoreError.Errorf("account, %q not in allowlist of %q", a, cid)
+	case tr == TransferRestrictionDenylist && listed:
+		return util.IgnoreError.Errorf("account, %q in denylist of %q", a, cid)
+	default:
+		return nil
+	}
+}
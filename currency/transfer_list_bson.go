@@ -0,0 +1,34 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (tm TransferListMember) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(tm.Hint()),
+		bson.M{
+			"account":  tm.account,
+			"currency": tm.currency,
+			"listed":   tm.listed,
+		}),
+	)
+}
+
+type TransferListMemberBSONUnpacker struct {
+	AC base.AddressDecoder `bson:"account"`
+	CR string              `bson:"currency"`
+	LS bool                `bson:"listed"`
+}
+
+func (tm *TransferListMember) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var utm TransferListMemberBSONUnpacker
+	if err := enc.Unmarshal(b, &utm); err != nil {
+		return err
+	}
+
+	return tm.unpack(enc, utm.AC, utm.CR, utm.LS)
+}
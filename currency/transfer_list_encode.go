@@ -0,0 +1,24 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (tm *TransferListMember) unpack(
+	enc encoder.Encoder,
+	bAccount base.AddressDecoder,
+	cid string,
+	listed bool,
+) error {
+	if a, err := bAccount.Encode(enc); err != nil {
+		return err
+	} else {
+		tm.account = a
+	}
+
+	tm.currency = CurrencyID(cid)
+	tm.listed = listed
+
+	return nil
+}
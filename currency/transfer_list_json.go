@@ -0,0 +1,37 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type TransferListMemberJSONPacker struct {
+	jsonenc.HintedHead
+	AC base.Address `json:"account"`
+	CR CurrencyID   `json:"currency"`
+	LS bool         `json:"listed"`
+}
+
+func (tm TransferListMember) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(TransferListMemberJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(tm.Hint()),
+		AC:         tm.account,
+		CR:         tm.currency,
+		LS:         tm.listed,
+	})
+}
+
+type TransferListMemberJSONUnpacker struct {
+	AC base.AddressDecoder `json:"account"`
+	CR string              `json:"currency"`
+	LS bool                `json:"listed"`
+}
+
+func (tm *TransferListMember) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var utm TransferListMemberJSONUnpacker
+	if err := enc.Unmarshal(b, &utm); err != nil {
+		return err
+	}
+
+	return tm.unpack(enc, utm.AC, utm.CR, utm.LS)
+}
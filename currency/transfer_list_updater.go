@@ -0,0 +1,186 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	TransferListUpdaterFactType = hint.MustNewType(0xa0, 0x71, "mitum-currency-transfer-list-updater-operation-fact")
+	TransferListUpdaterFactHint = hint.MustHint(TransferListUpdaterFactType, "0.0.1")
+	TransferListUpdaterType     = hint.MustNewType(0xa0, 0x72, "mitum-currency-transfer-list-updater-operation")
+	TransferListUpdaterHint     = hint.MustHint(TransferListUpdaterType, "0.0.1")
+)
+
+var maxTransferListAccounts uint = 10
+
+// TransferListUpdaterFact adds the accounts to the transfer list of currency
+// or removes them from it by listed. The accounts need not exist yet, so the
+// address of new account can be listed before CreateAccounts.
+type TransferListUpdaterFact struct {
+	h        valuehash.Hash
+	token    []byte
+	currency CurrencyID
+	accounts []base.Address
+	listed   bool
+}
+
+func NewTransferListUpdaterFact(
+	token []byte,
+	cid CurrencyID,
+	accounts []base.Address,
+	listed bool,
+) TransferListUpdaterFact {
+	fact := TransferListUpdaterFact{
+		token:    token,
+		currency: cid,
+		accounts: accounts,
+		listed:   listed,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact TransferListUpdaterFact) Hint() hint.Hint {
+	return TransferListUpdaterFactHint
+}
+
+func (fact TransferListUpdaterFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact TransferListUpdaterFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferListUpdaterFact) Token() []byte {
+	return fact.token
+}
+
+func (fact TransferListUpdaterFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.accounts)+3)
+	bs[0] = fact.token
+	bs[1] = fact.currency.Bytes()
+	bs[2] = util.BoolToBytes(fact.listed)
+
+	for i := range fact.accounts {
+		bs[i+3] = fact.accounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fact TransferListUpdaterFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for TransferListUpdaterFact")
+	} else if n := len(fact.accounts); n < 1 {
+		return xerrors.Errorf("empty accounts")
+	} else if n > int(maxTransferListAccounts) {
+		return xerrors.Errorf("accounts, %d over max, %d", n, maxTransferListAccounts)
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{fact.h, fact.currency}, nil, false); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for i := range fact.accounts {
+		a := fact.accounts[i]
+		if err := a.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid account: %w", err)
+		}
+
+		if _, found := founds[a.String()]; found {
+			return xerrors.Errorf("duplicated account found, %q", a)
+		}
+
+		founds[a.String()] = struct{}{}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact TransferListUpdaterFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact TransferListUpdaterFact) Accounts() []base.Address {
+	return fact.accounts
+}
+
+func (fact TransferListUpdaterFact) Listed() bool {
+	return fact.listed
+}
+
+func (fact TransferListUpdaterFact) Addresses() ([]base.Address, error) {
+	return fact.accounts, nil
+}
+
+type TransferListUpdater struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewTransferListUpdater(
+	fact TransferListUpdaterFact,
+	fs []operation.FactSign,
+	memo string,
+) (TransferListUpdater, error) {
+	if bo, err := operation.NewBaseOperationFromFact(TransferListUpdaterHint, fact, fs); err != nil {
+		return TransferListUpdater{}, err
+	} else {
+		op := TransferListUpdater{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op TransferListUpdater) Hint() hint.Hint {
+	return TransferListUpdaterHint
+}
+
+func (op TransferListUpdater) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op TransferListUpdater) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op TransferListUpdater) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
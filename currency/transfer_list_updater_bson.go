@@ -0,0 +1,65 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact TransferListUpdaterFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":     fact.h,
+				"token":    fact.token,
+				"currency": fact.currency,
+				"accounts": fact.accounts,
+				"listed":   fact.listed,
+			}))
+}
+
+type TransferListUpdaterFactBSONUnpacker struct {
+	H  valuehash.Bytes       `bson:"hash"`
+	TK []byte                `bson:"token"`
+	CR string                `bson:"currency"`
+	AS []base.AddressDecoder `bson:"accounts"`
+	LS bool                  `bson:"listed"`
+}
+
+func (fact *TransferListUpdaterFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufact TransferListUpdaterFactBSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.CR, ufact.AS, ufact.LS)
+}
+
+func (op TransferListUpdater) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(
+			op.BaseOperation.BSONM(),
+			bson.M{"memo": op.Memo},
+		))
+}
+
+func (op *TransferListUpdater) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = TransferListUpdater{BaseOperation: ubo}
+
+	var um MemoBSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *TransferListUpdaterFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	cid string,
+	bAccounts []base.AddressDecoder,
+	listed bool,
+) error {
+	accounts := make([]base.Address, len(bAccounts))
+	for i := range bAccounts {
+		if a, err := bAccounts[i].Encode(enc); err != nil {
+			return err
+		} else {
+			accounts[i] = a
+		}
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.currency = CurrencyID(cid)
+	fact.accounts = accounts
+	fact.listed = listed
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type TransferListUpdaterFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	CR CurrencyID     `json:"currency"`
+	AS []base.Address `json:"accounts"`
+	LS bool           `json:"listed"`
+}
+
+func (fact TransferListUpdaterFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(TransferListUpdaterFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		CR:         fact.currency,
+		AS:         fact.accounts,
+		LS:         fact.listed,
+	})
+}
+
+type TransferListUpdaterFactJSONUnpacker struct {
+	H  valuehash.Bytes       `json:"hash"`
+	TK []byte                `json:"token"`
+	CR string                `json:"currency"`
+	AS []base.AddressDecoder `json:"accounts"`
+	LS bool                  `json:"listed"`
+}
+
+func (fact *TransferListUpdaterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact TransferListUpdaterFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.CR, ufact.AS, ufact.LS)
+}
+
+func (op TransferListUpdater) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *TransferListUpdater) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = TransferListUpdater{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op TransferListUpdater) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type TransferListUpdaterProcessor struct {
+	TransferListUpdater
+	cp        *CurrencyPool
+	pubs      []key.Publickey
+	threshold base.Threshold
+	sts       []state.State
+}
+
+func NewTransferListUpdaterProcessor(
+	cp *CurrencyPool,
+	pubs []key.Publickey,
+	threshold base.Threshold,
+) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(TransferListUpdater); !ok {
+			return nil, xerrors.Errorf("not TransferListUpdater, %T", op)
+		} else {
+			return &TransferListUpdaterProcessor{
+				TransferListUpdater: i,
+				cp:                  cp,
+				pubs:                pubs,
+				threshold:           threshold,
+			}, nil
+		}
+	}
+}
+
+func (opp *TransferListUpdaterProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(TransferListUpdaterFact)
+
+	if len(opp.pubs) < 1 {
+		return nil, xerrors.Errorf("empty publickeys for operation signs")
+	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+		return nil, err
+	}
+
+	if opp.cp != nil && !opp.cp.Exists(fact.currency) {
+		return nil, util.IgnoreError.Errorf("currency not registered, %q", fact.currency)
+	}
+
+	sts := make([]state.State, len(fact.accounts))
+	for i := range fact.accounts {
+		if st, _, err := getState(StateKeyTransferList(fact.accounts[i], fact.currency)); err != nil {
+			return nil, err
+		} else {
+			sts[i] = st
+		}
+	}
+
+	opp.sts = sts
+
+	return opp, nil
+}
+
+func (opp *TransferListUpdaterProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(TransferListUpdaterFact)
+
+	sts := make([]state.State, len(opp.sts))
+	for i := range opp.sts {
+		tm := NewTransferListMember(fact.accounts[i], fact.currency, fact.listed)
+		if st, err := SetStateTransferListValue(opp.sts[i], tm); err != nil {
+			return util.IgnoreError.Wrap(err)
+		} else {
+			sts[i] = st
+		}
+	}
+
+	return setState(fact.Hash(), sts...)
+}
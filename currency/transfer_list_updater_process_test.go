@@ -0,0 +1,164 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+)
+
+type testTransferListUpdaterOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testTransferListUpdaterOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testTransferListUpdaterOperations) newOperation(
+	keys []key.Privatekey,
+	cid CurrencyID,
+	accounts []base.Address,
+	listed bool,
+) TransferListUpdater {
+	fact := NewTransferListUpdaterFact(util.UUID().Bytes(), cid, accounts, listed)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewTransferListUpdater(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testTransferListUpdaterOperations) processor(n int) ([]key.Privatekey, *OperationProcessor) {
+	privs := make([]key.Privatekey, n)
+	for i := 0; i < n; i++ {
+		privs[i] = key.MustNewBTCPrivatekey()
+	}
+
+	pubs := make([]key.Publickey, len(privs))
+	for i := range privs {
+		pubs[i] = privs[i].Publickey()
+	}
+	threshold, err := base.NewThreshold(uint(len(privs)), 100)
+	t.NoError(err)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := NewOperationProcessor(cp)
+	_, err = opr.SetProcessor(TransferListUpdater{}, NewTransferListUpdaterProcessor(cp, pubs, threshold))
+	t.NoError(err)
+
+	return privs, opr
+}
+
+func (t *testTransferListUpdaterOperations) TestNew() {
+	privs, copr := t.processor(3)
+
+	pool, _ := t.statepool()
+	opr := copr.New(pool)
+
+	// NOTE the accounts need not exist
+	as := []base.Address{NewTestAddress(), NewTestAddress()}
+
+	t.NoError(opr.Process(t.newOperation(privs, t.cid, as, true)))
+
+	founds := map[string]TransferListMember{}
+	for _, st := range pool.Updates() {
+		if !IsStateTransferListKey(st.Key()) {
+			continue
+		}
+
+		tm, err := StateTransferListValue(st.GetState())
+		t.NoError(err)
+
+		founds[st.Key()] = tm
+	}
+
+	t.Equal(len(as), len(founds))
+
+	for i := range as {
+		tm, found := founds[StateKeyTransferList(as[i], t.cid)]
+		t.True(found)
+		t.True(tm.Account().Equal(as[i]))
+		t.Equal(t.cid, tm.Currency())
+		t.True(tm.Listed())
+	}
+}
+
+func (t *testTransferListUpdaterOperations) TestRemove() {
+	privs, copr := t.processor(3)
+
+	a := NewTestAddress()
+
+	pool, _ := t.statepool([]state.State{t.newTransferListState(NewTransferListMember(a, t.cid, true))})
+	opr := copr.New(pool)
+
+	t.NoError(opr.Process(t.newOperation(privs, t.cid, []base.Address{a}, false)))
+
+	var ns state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyTransferList(a, t.cid) {
+			ns = st.GetState()
+		}
+	}
+
+	t.NotNil(ns)
+
+	tm, err := StateTransferListValue(ns)
+	t.NoError(err)
+	t.False(tm.Listed())
+}
+
+func (t *testTransferListUpdaterOperations) TestNotEnoughSigns() {
+	privs, copr := t.processor(3)
+
+	pool, _ := t.statepool()
+	opr := copr.New(pool)
+
+	err := opr.Process(t.newOperation(privs[:2], t.cid, []base.Address{NewTestAddress()}, true))
+	t.Contains(err.Error(), "not enough suffrage signs")
+}
+
+func (t *testTransferListUpdaterOperations) TestUnknownCurrency() {
+	privs, copr := t.processor(3)
+
+	pool, _ := t.statepool()
+	opr := copr.New(pool)
+
+	err := opr.Process(t.newOperation(privs, CurrencyID("FINDME"), []base.Address{NewTestAddress()}, true))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "currency not registered")
+}
+
+func (t *testTransferListUpdaterOperations) TestSameCurrencyInProposal() {
+	privs, copr := t.processor(3)
+
+	pool, _ := t.statepool()
+	opr := copr.New(pool)
+
+	t.NoError(opr.Process(t.newOperation(privs, t.cid, []base.Address{NewTestAddress()}, true)))
+
+	err := opr.Process(t.newOperation(privs, t.cid, []base.Address{NewTestAddress()}, true))
+	t.Contains(err.Error(), "duplicated currency id")
+}
+
+func TestTransferListUpdaterOperations(t *testing.T) {
+	suite.Run(t, new(testTransferListUpdaterOperations))
+}
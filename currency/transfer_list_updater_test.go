@@ -0,0 +1,131 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testTransferListUpdater struct {
+	baseTest
+}
+
+func (t *testTransferListUpdater) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	token := util.UUID().Bytes()
+	fact := NewTransferListUpdaterFact(token, CurrencyID("SHOWME"), []base.Address{NewTestAddress()}, true)
+
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+	op, err := NewTransferListUpdater(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testTransferListUpdater) TestEmptyToken() {
+	fact := NewTransferListUpdaterFact(nil, CurrencyID("SHOWME"), []base.Address{NewTestAddress()}, true)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty token")
+}
+
+func (t *testTransferListUpdater) TestEmptyAccounts() {
+	fact := NewTransferListUpdaterFact(util.UUID().Bytes(), CurrencyID("SHOWME"), nil, true)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty accounts")
+}
+
+func (t *testTransferListUpdater) TestOverMaxAccounts() {
+	as := make([]base.Address, maxTransferListAccounts+1)
+	for i := range as {
+		as[i] = NewTestAddress()
+	}
+
+	fact := NewTransferListUpdaterFact(util.UUID().Bytes(), CurrencyID("SHOWME"), as, true)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "over max")
+}
+
+func (t *testTransferListUpdater) TestDuplicatedAccounts() {
+	a := NewTestAddress()
+	fact := NewTransferListUpdaterFact(util.UUID().Bytes(), CurrencyID("SHOWME"), []base.Address{a, a}, true)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "duplicated account found")
+}
+
+func TestTransferListUpdater(t *testing.T) {
+	suite.Run(t, new(testTransferListUpdater))
+}
+
+func testTransferListUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		token := util.UUID().Bytes()
+		fact := NewTransferListUpdaterFact(
+			token,
+			CurrencyID("SHOWME"),
+			[]base.Address{NewTestAddress(), NewTestAddress()},
+			true,
+		)
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs := []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}
+
+		op, err := NewTransferListUpdater(fact, fs, "findme")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(TransferListUpdater)
+		tb := b.(TransferListUpdater)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(TransferListUpdaterFact)
+		ufact := tb.Fact().(TransferListUpdaterFact)
+
+		t.Equal(fact.currency, ufact.currency)
+		t.Equal(fact.listed, ufact.listed)
+		t.Equal(len(fact.accounts), len(ufact.accounts))
+		for i := range fact.accounts {
+			t.True(fact.accounts[i].Equal(ufact.accounts[i]))
+		}
+	}
+
+	return t
+}
+
+func TestTransferListUpdaterEncodeJSON(t *testing.T) {
+	suite.Run(t, testTransferListUpdaterEncode(jsonenc.NewEncoder()))
+}
+
+func TestTransferListUpdaterEncodeBSON(t *testing.T) {
+	suite.Run(t, testTransferListUpdaterEncode(bsonenc.NewEncoder()))
+}
@@ -13,7 +13,7 @@ import (
 
 var (
 	TransfersFactType = hint.MustNewType(0xa0, 0x01, "mitum-currency-transfers-operation-fact")
-	TransfersFactHint = hint.MustHint(TransfersFactType, "0.0.1")
+	TransfersFactHint = hint.MustHint(TransfersFactType, "0.0.2")
 	TransfersType     = hint.MustNewType(0xa0, 0x02, "mitum-currency-transfers-operation")
 	TransfersHint     = hint.MustHint(TransfersType, "0.0.1")
 )
@@ -26,18 +26,24 @@ type TransfersItem interface {
 	AmountsItem
 	Bytes() []byte
 	Receiver() base.Address
+	FeeCurrency() CurrencyID
 	Rebuild() TransfersItem
 }
 
 type TransfersFact struct {
-	h      valuehash.Hash
-	token  []byte
-	sender base.Address
-	items  []TransfersItem
+	hint             hint.Hint
+	h                valuehash.Hash
+	token            []byte
+	sender           base.Address
+	items            []TransfersItem
+	feePayer         base.Address
+	sequence         uint64
+	validUntilHeight base.Height
 }
 
 func NewTransfersFact(token []byte, sender base.Address, items []TransfersItem) TransfersFact {
 	fact := TransfersFact{
+		hint:   TransfersFactHint,
 		token:  token,
 		sender: sender,
 		items:  items,
@@ -47,8 +53,14 @@ func NewTransfersFact(token []byte, sender base.Address, items []TransfersItem)
 	return fact
 }
 
+// Hint returns the hint of fact; the decoded fact of the previous version keeps
+// its hint.
 func (fact TransfersFact) Hint() hint.Hint {
-	return TransfersFactHint
+	if len(fact.hint.Version()) < 1 {
+		return TransfersFactHint
+	}
+
+	return fact.hint
 }
 
 func (fact TransfersFact) Hash() valuehash.Hash {
@@ -73,6 +85,9 @@ func (fact TransfersFact) Bytes() []byte {
 		fact.token,
 		fact.sender.Bytes(),
 		util.ConcatBytesSlice(its...),
+		feePayerBytes(fact.feePayer),
+		sequenceBytes(fact.sequence),
+		validUntilBytes(fact.validUntilHeight),
 	)
 }
 
@@ -89,6 +104,18 @@ func (fact TransfersFact) IsValid([]byte) error {
 		return err
 	}
 
+	if err := isValidFeePayer(fact.sender, fact.feePayer); err != nil {
+		return err
+	}
+
+	if err := isValidSequence(fact.Hint(), fact.sequence); err != nil {
+		return err
+	}
+
+	if err := isValidValidUntil(fact.validUntilHeight); err != nil {
+		return err
+	}
+
 	foundReceivers := map[string]struct{}{}
 	for i := range fact.items {
 		it := fact.items[i]
@@ -122,6 +149,48 @@ func (fact TransfersFact) Items() []TransfersItem {
 	return fact.items
 }
 
+// FeePayer returns the account which pays the fee instead of sender. If nil,
+// sender pays the fee.
+func (fact TransfersFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+// SetFeePayer sets the fee payer and regenerates the fact hash.
+func (fact TransfersFact) SetFeePayer(feePayer base.Address) TransfersFact {
+	fact.feePayer = feePayer
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+// Sequence returns the sequence of sender. If 0, the sequence of sender is not
+// checked and not increased.
+func (fact TransfersFact) Sequence() uint64 {
+	return fact.sequence
+}
+
+// SetSequence sets the sequence and regenerates the fact hash.
+func (fact TransfersFact) SetSequence(s uint64) TransfersFact {
+	fact.sequence = s
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+// ValidUntilHeight returns the last height, at which the fact can be processed.
+// If 0, the fact does not expire by height.
+func (fact TransfersFact) ValidUntilHeight() base.Height {
+	return fact.validUntilHeight
+}
+
+// SetValidUntil sets the valid until height and regenerates the fact hash.
+func (fact TransfersFact) SetValidUntil(height base.Height) TransfersFact {
+	fact.validUntilHeight = height
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
 func (fact TransfersFact) Rebulild() TransfersFact {
 	items := make([]TransfersItem, len(fact.items))
 	for i := range fact.items {
@@ -143,6 +212,10 @@ func (fact TransfersFact) Addresses() ([]base.Address, error) {
 
 	as[len(fact.items)] = fact.Sender()
 
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
 	return as, nil
 }
 
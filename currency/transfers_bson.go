@@ -10,14 +10,26 @@ import (
 )
 
 func (fact TransfersFact) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
-			bson.M{
-				"hash":   fact.h,
-				"token":  fact.token,
-				"sender": fact.sender,
-				"items":  fact.items,
-			}))
+	m := bson.M{
+		"hash":   fact.h,
+		"token":  fact.token,
+		"sender": fact.sender,
+		"items":  fact.items,
+	}
+
+	if fact.feePayer != nil {
+		m["fee_payer"] = fact.feePayer
+	}
+
+	if fact.sequence > 0 {
+		m["sequence"] = fact.sequence
+	}
+
+	if fact.validUntilHeight > 0 {
+		m["valid_until_height"] = fact.validUntilHeight
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
 }
 
 type TransfersFactBSONUnpacker struct {
@@ -25,9 +37,17 @@ type TransfersFactBSONUnpacker struct {
 	TK []byte              `bson:"token"`
 	SD base.AddressDecoder `bson:"sender"`
 	IT []bson.Raw          `bson:"items"`
+	FP base.AddressDecoder `bson:"fee_payer,omitempty"`
+	SQ uint64              `bson:"sequence,omitempty"`
+	VH base.Height         `bson:"valid_until_height,omitempty"`
 }
 
 func (fact *TransfersFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var ufact TransfersFactBSONUnpacker
 	if err := enc.Unmarshal(b, &ufact); err != nil {
 		return err
@@ -38,7 +58,7 @@ func (fact *TransfersFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		its[i] = ufact.IT[i]
 	}
 
-	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, its)
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.SD, its, ufact.FP, ufact.SQ, ufact.VH)
 }
 
 func (op Transfers) MarshalBSON() ([]byte, error) {
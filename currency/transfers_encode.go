@@ -12,6 +12,7 @@ func (it *BaseTransfersItem) unpack(
 	ht hint.Hint,
 	bReceiver base.AddressDecoder,
 	bam [][]byte,
+	fc string,
 ) error {
 	it.hint = ht
 
@@ -31,16 +32,21 @@ func (it *BaseTransfersItem) unpack(
 	}
 
 	it.amounts = am
+	it.feeCurrency = CurrencyID(fc)
 
 	return nil
 }
 
 func (fact *TransfersFact) unpack(
 	enc encoder.Encoder,
+	ht hint.Hint,
 	h valuehash.Hash,
 	token []byte,
 	bSender base.AddressDecoder,
 	bitems [][]byte,
+	bFeePayer base.AddressDecoder,
+	sequence uint64,
+	validUntilHeight base.Height,
 ) error {
 	var sender base.Address
 	if a, err := bSender.Encode(enc); err != nil {
@@ -58,6 +64,16 @@ func (fact *TransfersFact) unpack(
 		}
 	}
 
+	if a, err := bFeePayer.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.feePayer = a
+	}
+
+	fact.sequence = sequence
+	fact.validUntilHeight = validUntilHeight
+
+	fact.hint = ht
 	fact.h = h
 	fact.token = token
 	fact.sender = sender
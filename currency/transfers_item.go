@@ -8,9 +8,10 @@ import (
 )
 
 type BaseTransfersItem struct {
-	hint     hint.Hint
-	receiver base.Address
-	amounts  []Amount
+	hint        hint.Hint
+	receiver    base.Address
+	amounts     []Amount
+	feeCurrency CurrencyID
 }
 
 func NewBaseTransfersItem(ht hint.Hint, receiver base.Address, amounts []Amount) BaseTransfersItem {
@@ -33,6 +34,10 @@ func (it BaseTransfersItem) Bytes() []byte {
 		bs[i+1] = it.amounts[i].Bytes()
 	}
 
+	if len(it.feeCurrency) > 0 {
+		bs = append(bs, it.feeCurrency.Bytes())
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -61,6 +66,12 @@ func (it BaseTransfersItem) IsValid([]byte) error {
 		}
 	}
 
+	if len(it.feeCurrency) > 0 {
+		if err := it.feeCurrency.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid fee currency: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -72,6 +83,18 @@ func (it BaseTransfersItem) Amounts() []Amount {
 	return it.amounts
 }
 
+// FeeCurrency returns the currency, which pays the fee of amounts. If empty,
+// the fee is paid by the currency of each amount.
+func (it BaseTransfersItem) FeeCurrency() CurrencyID {
+	return it.feeCurrency
+}
+
+func (it BaseTransfersItem) SetFeeCurrency(cid CurrencyID) TransfersItem {
+	it.feeCurrency = cid
+
+	return it
+}
+
 func (it BaseTransfersItem) Rebuild() TransfersItem {
 	ams := make([]Amount, len(it.amounts))
 	for i := range it.amounts {
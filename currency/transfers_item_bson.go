@@ -8,18 +8,22 @@ import (
 )
 
 func (it BaseTransfersItem) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
-			bson.M{
-				"receiver": it.receiver,
-				"amounts":  it.amounts,
-			}),
-	)
+	m := bson.M{
+		"receiver": it.receiver,
+		"amounts":  it.amounts,
+	}
+
+	if len(it.feeCurrency) > 0 {
+		m["fee_currency"] = it.feeCurrency
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()), m))
 }
 
 type BaseTransfersItemBSONUnpacker struct {
 	RC base.AddressDecoder `bson:"receiver"`
 	AM []bson.Raw          `bson:"amounts"`
+	FC string              `bson:"fee_currency,omitempty"`
 }
 
 func (it *BaseTransfersItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -38,5 +42,5 @@ func (it *BaseTransfersItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		bam[i] = uit.AM[i]
 	}
 
-	return it.unpack(enc, ht.H, uit.RC, bam)
+	return it.unpack(enc, ht.H, uit.RC, bam, uit.FC)
 }
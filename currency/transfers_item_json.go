@@ -11,6 +11,7 @@ type TransfersItemJSONPacker struct {
 	jsonenc.HintedHead
 	RC base.Address `json:"receiver"`
 	AM []Amount     `json:"amounts"`
+	FC CurrencyID   `json:"fee_currency,omitempty"`
 }
 
 func (it BaseTransfersItem) MarshalJSON() ([]byte, error) {
@@ -18,12 +19,14 @@ func (it BaseTransfersItem) MarshalJSON() ([]byte, error) {
 		HintedHead: jsonenc.NewHintedHead(it.Hint()),
 		RC:         it.receiver,
 		AM:         it.amounts,
+		FC:         it.feeCurrency,
 	})
 }
 
 type BaseTransfersItemJSONUnpacker struct {
 	RC base.AddressDecoder `json:"receiver"`
 	AM []json.RawMessage   `json:"amounts"`
+	FC string              `json:"fee_currency,omitempty"`
 }
 
 func (it *BaseTransfersItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -42,5 +45,5 @@ func (it *BaseTransfersItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		bam[i] = uit.AM[i]
 	}
 
-	return it.unpack(enc, ht.H, uit.RC, bam)
+	return it.unpack(enc, ht.H, uit.RC, bam, uit.FC)
 }
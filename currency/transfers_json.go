@@ -15,6 +15,9 @@ type TransferFactJSONPacker struct {
 	TK []byte          `json:"token"`
 	SD base.Address    `json:"sender"`
 	IT []TransfersItem `json:"items"`
+	FP base.Address    `json:"fee_payer,omitempty"`
+	SQ uint64          `json:"sequence,omitempty"`
+	VH base.Height     `json:"valid_until_height,omitempty"`
 }
 
 func (fact TransfersFact) MarshalJSON() ([]byte, error) {
@@ -24,15 +27,26 @@ func (fact TransfersFact) MarshalJSON() ([]byte, error) {
 		TK:         fact.token,
 		SD:         fact.sender,
 		IT:         fact.items,
+		FP:         fact.feePayer,
+		SQ:         fact.sequence,
+		VH:         fact.validUntilHeight,
 	})
 }
 
 func (fact *TransfersFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var ufact struct {
 		H  valuehash.Bytes     `json:"hash"`
 		TK []byte              `json:"token"`
 		SD base.AddressDecoder `json:"sender"`
 		IT []json.RawMessage   `json:"items"`
+		FP base.AddressDecoder `json:"fee_payer,omitempty"`
+		SQ uint64              `json:"sequence,omitempty"`
+		VH base.Height         `json:"valid_until_height,omitempty"`
 	}
 	if err := jsonenc.Unmarshal(b, &ufact); err != nil {
 		return err
@@ -43,7 +57,7 @@ func (fact *TransfersFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		its[i] = ufact.IT[i]
 	}
 
-	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, its)
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.SD, its, ufact.FP, ufact.SQ, ufact.VH)
 }
 
 func (op Transfers) MarshalJSON() ([]byte, error) {
@@ -36,6 +36,12 @@ func (it TransfersItemMultiAmounts) IsValid([]byte) error {
 	return nil
 }
 
+func (it TransfersItemMultiAmounts) SetFeeCurrency(cid CurrencyID) TransfersItem {
+	it.BaseTransfersItem = it.BaseTransfersItem.SetFeeCurrency(cid).(BaseTransfersItem)
+
+	return it
+}
+
 func (it TransfersItemMultiAmounts) Rebuild() TransfersItem {
 	it.BaseTransfersItem = it.BaseTransfersItem.Rebuild().(BaseTransfersItem)
 
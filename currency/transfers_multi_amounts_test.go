@@ -25,7 +25,7 @@ func (t *testTransfersItemMultiAmounts) TestNew() {
 	token := util.UUID().Bytes()
 	ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
 	items := []TransfersItem{NewTransfersItemMultiAmounts(r, ams)}
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -60,7 +60,7 @@ func (t *testTransfersItemMultiAmounts) TestZeroBig() {
 	err := items[0].IsValid(nil)
 	t.Contains(err.Error(), "amount should be over zero")
 
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	pk := key.MustNewBTCPrivatekey()
 	sig, err := operation.NewFactSignature(pk, fact, nil)
@@ -91,7 +91,7 @@ func (t *testTransfersItemMultiAmounts) TestOverMaxAmounts() {
 	err := items[0].IsValid(nil)
 	t.Contains(err.Error(), "amounts over allowed")
 
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	pk := key.MustNewBTCPrivatekey()
 	sig, err := operation.NewFactSignature(pk, fact, nil)
@@ -122,7 +122,7 @@ func (t *testTransfersItemMultiAmounts) TestDuplicatedCurrency() {
 	err := items[0].IsValid(nil)
 	t.Contains(err.Error(), "duplicated currency found")
 
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	pk := key.MustNewBTCPrivatekey()
 	sig, err := operation.NewFactSignature(pk, fact, nil)
@@ -147,7 +147,7 @@ func (t *testTransfersItemMultiAmounts) TestEmptyAmounts() {
 	err := items[0].IsValid(nil)
 	t.Contains(err.Error(), "empty amounts")
 
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	pk := key.MustNewBTCPrivatekey()
 	sig, err := operation.NewFactSignature(pk, fact, nil)
@@ -179,7 +179,7 @@ func testTransfersItemMultiAmountsEncode(enc encoder.Encoder) suite.TestingSuite
 			NewTransfersItemMultiAmounts(r, []Amount{NewAmount(NewBig(33), CurrencyID("SHOWME"))}),
 			NewTransfersItemMultiAmounts(r, []Amount{NewAmount(NewBig(44), CurrencyID("FINDME"))}),
 		}
-		fact := NewTransfersFact(token, s, items)
+		fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 		var fs []operation.FactSign
 
@@ -1,6 +1,7 @@
 package currency
 
 import (
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/valuehash"
@@ -16,10 +17,12 @@ func (op Transfers) Process(
 }
 
 type TransfersItemProcessor struct {
-	cp *CurrencyPool
-	h  valuehash.Hash
+	cp     *CurrencyPool
+	h      valuehash.Hash
+	sender base.Address
 
-	item TransfersItem
+	item     TransfersItem
+	receiver base.Address
 
 	rb map[CurrencyID]AmountState
 }
@@ -28,7 +31,13 @@ func (opp *TransfersItemProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	_ func(valuehash.Hash, ...state.State) error,
 ) error {
-	if _, err := existsState(StateKeyAccount(opp.item.Receiver()), "receiver", getState); err != nil {
+	if a, err := resolveAlias(opp.item.Receiver(), getState); err != nil {
+		return err
+	} else {
+		opp.receiver = a
+	}
+
+	if _, err := existsAccountState(opp.receiver, "receiver", getState); err != nil {
 		return err
 	}
 
@@ -42,7 +51,17 @@ func (opp *TransfersItemProcessor) PreProcess(
 			}
 		}
 
-		if st, _, err := getState(StateKeyBalance(opp.item.Receiver(), am.Currency())); err != nil {
+		if err := checkNotFrozen(opp.receiver, am.Currency(), true, getState); err != nil {
+			return err
+		}
+
+		if err := checkTransferRestriction(opp.cp, opp.sender, am.Currency(), getState); err != nil {
+			return err
+		} else if err := checkTransferRestriction(opp.cp, opp.receiver, am.Currency(), getState); err != nil {
+			return err
+		}
+
+		if st, _, err := getState(StateKeyBalance(opp.receiver, am.Currency())); err != nil {
 			return err
 		} else {
 			rb[am.Currency()] = NewAmountState(st, am.Currency())
@@ -70,9 +89,14 @@ func (opp *TransfersItemProcessor) Process(
 type TransfersProcessor struct {
 	cp *CurrencyPool
 	Transfers
+	proposable
+	height   base.Height
 	sb       map[CurrencyID]AmountState
+	pb       map[CurrencyID]AmountState
 	rb       []*TransfersItemProcessor
 	required map[CurrencyID][2]Big
+	sl       []spendingLimitState
+	sq       state.State
 }
 
 func NewTransfersProcessor(cp *CurrencyPool) GetNewProcessor {
@@ -88,36 +112,71 @@ func NewTransfersProcessor(cp *CurrencyPool) GetNewProcessor {
 	}
 }
 
+func (opp *TransfersProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
 func (opp *TransfersProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	setState func(valuehash.Hash, ...state.State) error,
 ) (state.Processor, error) {
 	fact := opp.Fact().(TransfersFact)
 
-	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+	if err := checkValidUntil(fact, opp.height); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsAccountState(fact.sender, "sender", getState); err != nil {
+		return nil, err
+	}
+
+	if st, err := checkSequence(fact.Hint(), fact.sender, fact.sequence, getState); err != nil {
 		return nil, err
+	} else {
+		opp.sq = st
 	}
 
 	if required, err := opp.calculateItemsFee(); err != nil {
 		return nil, util.IgnoreError.Wrap(err)
-	} else if sb, err := CheckEnoughBalance(fact.sender, required, getState); err != nil {
+	} else if err := checkNotFrozenByRequired(fact.sender, fact.feePayer, required, getState); err != nil {
+		return nil, err
+	} else if sb, pb, err := CheckEnoughBalanceWithFeePayer(fact.sender, fact.feePayer, required, opp.height, getState); err != nil {
 		return nil, err
 	} else {
 		opp.required = required
 		opp.sb = sb
+		opp.pb = pb
+	}
+
+	if sl, err := checkSpendingLimit(fact.sender, requiredOutflow(opp.required), opp.height, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sl = sl
 	}
 
 	rb := make([]*TransfersItemProcessor, len(fact.items))
+	receivers := map[string]struct{}{}
 	for i := range fact.items {
-		c := &TransfersItemProcessor{cp: opp.cp, h: opp.Hash(), item: fact.items[i]}
+		c := &TransfersItemProcessor{cp: opp.cp, h: opp.Hash(), sender: fact.sender, item: fact.items[i]}
 		if err := c.PreProcess(getState, setState); err != nil {
 			return nil, util.IgnoreError.Wrap(err)
 		}
 
+		// NOTE the alias of receiver may point the sender or the other receiver
+		k := StateAddressKeyPrefix(c.receiver)
+		switch _, found := receivers[k]; {
+		case found:
+			return nil, util.IgnoreError.Errorf("duplicated receiver found, %s", c.receiver)
+		case fact.sender.Equal(c.receiver):
+			return nil, util.IgnoreError.Errorf("receiver is same with sender, %q", fact.sender)
+		default:
+			receivers[k] = struct{}{}
+		}
+
 		rb[i] = c
 	}
 
-	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+	if err := opp.checkFactSigns(fact.sender, fact.feePayer, opp.Signs(), getState); err != nil {
 		return nil, xerrors.Errorf("invalid signing: %w", err)
 	}
 
@@ -141,14 +200,33 @@ func (opp *TransfersProcessor) Process(
 		}
 	}
 
-	for k := range opp.required {
-		rq := opp.required[k]
-		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	if pts, err := opp.pendingStates(); err != nil {
+		return err
+	} else {
+		sts = append(sts, pts...)
 	}
 
 	return setState(fact.Hash(), sts...)
 }
 
+// pendingStates returns the balances, SpendingLimits and sequence of sender,
+// which will be set by Process.
+func (opp *TransfersProcessor) pendingStates() ([]state.State, error) {
+	sts := debitRequired(opp.sb, opp.pb, opp.required)
+
+	if sls, err := setSpendingLimitStates(opp.sl); err != nil {
+		return nil, err
+	} else {
+		sts = append(sts, sls...)
+	}
+
+	if opp.sq != nil {
+		sts = append(sts, opp.sq)
+	}
+
+	return sts, nil
+}
+
 func (opp *TransfersProcessor) calculateItemsFee() (map[CurrencyID][2]Big, error) {
 	fact := opp.Fact().(TransfersFact)
 
@@ -157,5 +235,5 @@ func (opp *TransfersProcessor) calculateItemsFee() (map[CurrencyID][2]Big, error
 		items[i] = fact.items[i]
 	}
 
-	return CalculateItemsFee(opp.cp, items)
+	return CalculateItemsFee(opp.cp, TransfersType, items)
 }
@@ -47,9 +47,20 @@ func (t *testTransfersOperations) newTransfersItem(receiver base.Address, big Bi
 }
 
 func (t *testTransfersOperations) newTransfer(sender base.Address, keys []key.Privatekey, items []TransfersItem) Transfers {
+	return t.newTransferWithFeePayer(sender, nil, keys, items)
+}
+
+func (t *testTransfersOperations) newTransferWithFeePayer(
+	sender, feePayer base.Address,
+	keys []key.Privatekey,
+	items []TransfersItem,
+) Transfers {
 	token := util.UUID().Bytes()
-	fact := NewTransfersFact(token, sender, items)
 
+	return t.newTransferFromFact(NewTransfersFact(token, sender, items).SetSequence(1).SetFeePayer(feePayer), keys)
+}
+
+func (t *testTransfersOperations) newTransferFromFact(fact TransfersFact, keys []key.Privatekey) Transfers {
 	var fs []operation.FactSign
 	for _, pk := range keys {
 		sig, err := operation.NewFactSignature(pk, fact, nil)
@@ -103,14 +114,573 @@ func (t *testTransfersOperations) TestReceiverNotExist() {
 
 	err := opr.Process(tf)
 	t.True(xerrors.Is(err, util.IgnoreError))
-	t.Contains(err.Error(), "receiver does not exist")
+	t.Contains(err.Error(), "receiver does not exist")
+}
+
+func (t *testTransfersOperations) TestReceiverAlias() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	aa := NewAccountAlias(Alias("showme"), ra.Address)
+	pool, _ := t.statepool(st0, st1, t.newAccountAliasStates(aa))
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(Alias("showme"), NewBig(3))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	t.NoError(opr.Process(tf))
+
+	var rst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyBalance(Alias("showme"), t.cid):
+			t.Fail("balance of alias should not be updated")
+		}
+	}
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(NewBig(4)))
+}
+
+func (t *testTransfersOperations) TestReceiverAliasNotRegistered() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	aa := NewAccountAlias(Alias("showme"), ra.Address).Release()
+	pool, _ := t.statepool(st0, st1, t.newAccountAliasStates(aa))
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(Alias("showme"), NewBig(3))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not registered")
+}
+
+func (t *testTransfersOperations) TestReceiverAliasOfSender() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	aa := NewAccountAlias(Alias("showme"), sa.Address)
+	pool, _ := t.statepool(st0, t.newAccountAliasStates(aa))
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(Alias("showme"), NewBig(3))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "receiver is same with sender")
+}
+
+func (t *testTransfersOperations) TestReceiverAliasDuplicated() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	aa := NewAccountAlias(Alias("showme"), ra.Address)
+	pool, _ := t.statepool(st0, st1, t.newAccountAliasStates(aa))
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{
+		t.newTransfersItem(Alias("showme"), NewBig(3)),
+		t.newTransfersItem(ra.Address, NewBig(3)),
+	}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "duplicated receiver found")
+}
+
+func (t *testTransfersOperations) TestReceiverClosed() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newClosedAccount([]Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testTransfersOperations) TestSenderClosed() {
+	sa, st0 := t.newClosedAccount([]Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testTransfersOperations) TestSenderFrozen() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	fz := NewFreeze(sa.Address, "", false)
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newFreezeState(fz)})
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testTransfersOperations) TestSenderFrozenForOtherCurrency() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	fz := NewFreeze(sa.Address, CurrencyID("FINDME"), false)
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newFreezeState(fz)})
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	t.NoError(opr.Process(tf))
+}
+
+func (t *testTransfersOperations) TestSenderFrozenForCurrency() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	fz := NewFreeze(sa.Address, t.cid, false)
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newFreezeState(fz)})
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen for")
+}
+
+func (t *testTransfersOperations) TestReceiverFrozen() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	rb, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{
+		t.newFreezeState(NewFreeze(ra.Address, "", false)),
+		t.newFreezeState(NewFreeze(rb.Address, "", true)),
+	})
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	// NOTE frozen without receiving still can receive
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	t.NoError(opr.Process(t.newTransfer(sa.Address, sa.Privs(), items)))
+
+	opr = t.processor(cp, pool)
+
+	items = []TransfersItem{t.newTransfersItem(rb.Address, NewBig(3))}
+	err := opr.Process(t.newTransfer(sa.Address, sa.Privs(), items))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testTransfersOperations) TestAllowlist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	rb, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{
+		t.newTransferListState(NewTransferListMember(sa.Address, t.cid, true)),
+		t.newTransferListState(NewTransferListMember(ra.Address, t.cid, true)),
+	})
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetTransferRestriction(TransferRestrictionAllowlist)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateByPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	t.NoError(opr.Process(t.newTransfer(sa.Address, sa.Privs(), items)))
+
+	opr = t.processor(cp, pool)
+
+	items = []TransfersItem{t.newTransfersItem(rb.Address, NewBig(3))}
+	err := opr.Process(t.newTransfer(sa.Address, sa.Privs(), items))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not in allowlist")
+}
+
+func (t *testTransfersOperations) TestAllowlistSenderNotListed() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, []state.State{
+		t.newTransferListState(NewTransferListMember(sa.Address, t.cid, false)),
+		t.newTransferListState(NewTransferListMember(ra.Address, t.cid, true)),
+	})
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetTransferRestriction(TransferRestrictionAllowlist)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateByPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	err := opr.Process(t.newTransfer(sa.Address, sa.Privs(), items))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not in allowlist")
+}
+
+func (t *testTransfersOperations) TestDenylist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	rb, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{
+		t.newTransferListState(NewTransferListMember(rb.Address, t.cid, true)),
+	})
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetTransferRestriction(TransferRestrictionDenylist)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateByPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	t.NoError(opr.Process(t.newTransfer(sa.Address, sa.Privs(), items)))
+
+	opr = t.processor(cp, pool)
+
+	items = []TransfersItem{t.newTransfersItem(rb.Address, NewBig(3))}
+	err := opr.Process(t.newTransfer(sa.Address, sa.Privs(), items))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "in denylist")
+}
+
+func (t *testTransfersOperations) TestSpendingLimit() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(0)))
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newSpendingLimitState(sl)})
+	feeer := NewFixedFeeer(ra.Address, NewBig(1))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	// NOTE the fee is not counted
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(5))}
+	t.NoError(opr.Process(t.newTransfer(sa.Address, sa.Privs(), items)))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySpendingLimit(sa.Address, t.cid) {
+			sst = st.GetState()
+		}
+	}
+
+	usl, err := StateSpendingLimitValue(sst)
+	t.NoError(err)
+	t.True(usl.Spent().Equal(NewBig(5)))
+	t.Equal(pool.Height(), usl.Start())
+}
+
+func (t *testTransfersOperations) TestSpendingLimitExceeded() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(0)))
+	sl, err := sl.Spend(NewBig(3), t.height()-1)
+	t.NoError(err)
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newSpendingLimitState(sl)})
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	err = opr.Process(t.newTransfer(sa.Address, sa.Privs(), items))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "spending limit exceeded")
+}
+
+func (t *testTransfersOperations) TestSpendingLimitNewWindow() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(0)))
+	sl, err := sl.Spend(NewBig(5), t.height()-10)
+	t.NoError(err)
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newSpendingLimitState(sl)})
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	t.NoError(opr.Process(t.newTransfer(sa.Address, sa.Privs(), items)))
+}
+
+func (t *testTransfersOperations) TestSequence() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(1)
+	t.NoError(opr.Process(t.newTransferFromFact(fact, sa.Privs())))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySequence(sa.Address) {
+			sst = st.GetState()
+		}
+	}
+
+	sq, err := StateSequenceValue(sst)
+	t.NoError(err)
+	t.Equal(uint64(2), sq.Next())
+}
+
+func (t *testTransfersOperations) TestEmptySequence() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+
+	newTransfer := func(fact TransfersFact) Transfers {
+		sig, err := operation.NewFactSignature(sa.Privs()[0], fact, nil)
+		t.NoError(err)
+
+		op, err := NewTransfers(fact, []operation.FactSign{operation.NewBaseFactSign(sa.Privs()[0].Publickey(), sig)}, "")
+		t.NoError(err)
+
+		return op
+	}
+
+	err := opr.Process(newTransfer(NewTransfersFact(util.UUID().Bytes(), sa.Address, items)))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "empty sequence")
+
+	// NOTE the fact of the previous version is processed without sequence
+	fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, items)
+	fact.hint = hint.MustHint(TransfersFactType, "0.0.1")
+	t.NoError(opr.Process(newTransfer(fact)))
+
+	for _, st := range pool.Updates() {
+		t.NotEqual(StateKeySequence(sa.Address), st.Key())
+	}
+}
+
+func (t *testTransfersOperations) TestWrongSequence() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	sq, err := NewSequence(sa.Address).Use(1)
+	t.NoError(err)
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newSequenceState(sq)})
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	// NOTE the already used sequence can not be processed again
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(1)
+
+	err = opr.Process(t.newTransferFromFact(fact, sa.Privs()))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "wrong sequence, 1; expected 2")
+}
+
+func (t *testTransfersOperations) TestValidUntil() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+
+	// NOTE the fact can be processed at the valid until height
+	fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(1).SetValidUntil(pool.Height())
+	t.NoError(opr.Process(t.newTransferFromFact(fact, sa.Privs())))
+}
+
+func (t *testTransfersOperations) TestExpired() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(1).SetValidUntil(base.Height(33))
+
+	i, err := NewTransfersProcessor(cp)(t.newTransferFromFact(fact, sa.Privs()))
+	t.NoError(err)
+
+	opp := i.(*TransfersProcessor)
+	opp.setHeight(base.Height(34))
+
+	_, err = opp.PreProcess(pool.Get, pool.Set)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "fact expired at height")
+}
+
+func (t *testTransfersOperations) TestInsufficientBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(11))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testTransfersOperations) TestVestingLocked() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	vs := NewVesting(t.cid, []VestingRelease{
+		NewVestingRelease(t.height(), NewBig(2)),
+		NewVestingRelease(t.height()+1, NewBig(8)),
+	})
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newVestingState(sa.Address, vs)})
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient vested balance")
 }
 
-func (t *testTransfersOperations) TestInsufficientBalance() {
+func (t *testTransfersOperations) TestVestingReleased() {
 	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
 	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
 
-	pool, _ := t.statepool(st0, st1)
+	vs := NewVesting(t.cid, []VestingRelease{NewVestingRelease(t.height(), NewBig(10))})
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newVestingState(sa.Address, vs)})
 	feeer := NewFixedFeeer(sa.Address, ZeroBig)
 
 	cp := NewCurrencyPool()
@@ -118,12 +688,20 @@ func (t *testTransfersOperations) TestInsufficientBalance() {
 
 	opr := t.processor(cp, pool)
 
-	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(11))}
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(10))}
 	tf := t.newTransfer(sa.Address, sa.Privs(), items)
 
-	err := opr.Process(tf)
-	t.True(xerrors.Is(err, util.IgnoreError))
-	t.Contains(err.Error(), "insufficient balance")
+	t.NoError(opr.Process(tf))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(sa.Address, t.cid) {
+			sst = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().IsZero())
 }
 
 func (t *testTransfersOperations) TestSufficientBalance() {
@@ -210,7 +788,7 @@ func (t *testTransfersOperations) TestMultipleItemsWithFee() {
 		t.newTransfersItem(ra0.Address, sent),
 		t.newTransfersItem(ra1.Address, sent),
 	}
-	fact := NewTransfersFact(token, sa.Address, items)
+	fact := NewTransfersFact(token, sa.Address, items).SetSequence(1)
 	sig, err := operation.NewFactSignature(sa.Privs()[0], fact, nil)
 	t.NoError(err)
 	fs := []operation.FactSign{operation.NewBaseFactSign(sa.Privs()[0].Publickey(), sig)}
@@ -262,7 +840,7 @@ func (t *testTransfersOperations) TestInsufficientMultipleItemsWithFee() {
 		t.newTransfersItem(ra0.Address, sent),
 		t.newTransfersItem(ra1.Address, sent),
 	}
-	fact := NewTransfersFact(token, sa.Address, items)
+	fact := NewTransfersFact(token, sa.Address, items).SetSequence(1)
 	sig, err := operation.NewFactSignature(sa.Privs()[0], fact, nil)
 	t.NoError(err)
 	fs := []operation.FactSign{operation.NewBaseFactSign(sa.Privs()[0].Publickey(), sig)}
@@ -318,9 +896,127 @@ func (t *testTransfersOperations) TestSameSenders() {
 	t.NoError(opr.Process(tf0))
 
 	items = []TransfersItem{t.newTransfersItem(ra1.Address, NewBig(1))}
-	tf1 := t.newTransfer(sa.Address, sa.Privs(), items)
-	err := opr.Process(tf1)
-	t.Contains(err.Error(), "violates only one sender")
+	tf1 := t.newTransferFromFact(NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(2), sa.Privs())
+	t.NoError(opr.Process(tf1))
+
+	var sst, rst0, rst1 state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+			t.Equal(2, len(st.Operations()))
+		case StateKeyBalance(ra0.Address, t.cid):
+			rst0 = st.GetState()
+		case StateKeyBalance(ra1.Address, t.cid):
+			rst1 = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(1)))
+
+	rstv0, _ := StateBalanceValue(rst0)
+	t.True(rstv0.Big().Equal(NewBig(2)))
+
+	rstv1, _ := StateBalanceValue(rst1)
+	t.True(rstv1.Big().Equal(NewBig(2)))
+}
+
+func (t *testTransfersOperations) TestSameSendersInsufficientBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	feeer := NewFixedFeeer(sa.Address, NewBig(1))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(1))}
+	t.NoError(opr.Process(t.newTransfer(sa.Address, sa.Privs(), items)))
+
+	// NOTE the amount and fee of the previous operation are already spent
+	err := opr.Process(t.newTransferFromFact(NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(2), sa.Privs()))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testTransfersOperations) TestSameSendersSequence() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(1))}
+
+	// NOTE the next sequence can not be processed before the previous one
+	err := opr.Process(t.newTransferFromFact(NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(2), sa.Privs()))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "wrong sequence, 2; expected 1")
+
+	for i := uint64(1); i < 4; i++ {
+		fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(i)
+		t.NoError(opr.Process(t.newTransferFromFact(fact, sa.Privs())))
+	}
+
+	err = opr.Process(t.newTransferFromFact(NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(3), sa.Privs()))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "wrong sequence, 3; expected 4")
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySequence(sa.Address) {
+			sst = st.GetState()
+		}
+	}
+
+	sq, err := StateSequenceValue(sst)
+	t.NoError(err)
+	t.Equal(uint64(4), sq.Next())
+}
+
+func (t *testTransfersOperations) TestSameSendersSpendingLimit() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	sl := NewSpendingLimit(sa.Address, t.cid, NewSpendingLimitRule(NewBig(5), base.Height(10), base.Height(0)))
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newSpendingLimitState(sl)})
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	t.NoError(opr.Process(t.newTransfer(sa.Address, sa.Privs(), items)))
+
+	err := opr.Process(t.newTransferFromFact(NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(2), sa.Privs()))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "spending limit exceeded")
+
+	items = []TransfersItem{t.newTransfersItem(ra.Address, NewBig(2))}
+	t.NoError(opr.Process(t.newTransferFromFact(NewTransfersFact(util.UUID().Bytes(), sa.Address, items).SetSequence(2), sa.Privs())))
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeySpendingLimit(sa.Address, t.cid) {
+			sst = st.GetState()
+		}
+	}
+
+	usl, err := StateSpendingLimitValue(sst)
+	t.NoError(err)
+	t.True(usl.Spent().Equal(NewBig(5)))
 }
 
 func (t *testTransfersOperations) TestUnderThreshold() {
@@ -384,6 +1080,245 @@ func (t *testTransfersOperations) TestUnknownKey() {
 	t.Contains(err.Error(), "unknown key found")
 }
 
+func (t *testTransfersOperations) TestFeePayer() {
+	faBalance := NewAmount(NewBig(22), t.cid)
+	saBalance := NewAmount(NewBig(33), t.cid)
+	paBalance := NewAmount(NewBig(10), t.cid)
+	raBalance := NewAmount(NewBig(1), t.cid)
+	fa, st0 := t.newAccount(true, []Amount{faBalance})
+	sa, st1 := t.newAccount(true, []Amount{saBalance})
+	pa, st2 := t.newAccount(true, []Amount{paBalance})
+	ra, st3 := t.newAccount(true, []Amount{raBalance})
+
+	pool, _ := t.statepool(st0, st1, st2, st3)
+
+	fee := NewBig(2)
+	feeer := NewFixedFeeer(fa.Address, fee)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	sent := saBalance.Big()
+
+	tf := t.newTransferWithFeePayer(
+		sa.Address, pa.Address,
+		append(sa.Privs(), pa.Privs()...),
+		[]TransfersItem{t.newTransfersItem(ra.Address, sent)},
+	)
+
+	t.NoError(opr.Process(tf))
+	t.NoError(opr.Close())
+
+	var sst, pst, rst, fst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(pa.Address, t.cid):
+			pst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyBalance(fa.Address, t.cid):
+			fst = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(ZeroBig))
+
+	pstv, _ := StateBalanceValue(pst)
+	t.True(pstv.Big().Equal(paBalance.Big().Sub(fee)))
+
+	rstv, _ := StateBalanceValue(rst)
+	t.True(rstv.Big().Equal(raBalance.Big().Add(sent)))
+
+	fstv, _ := StateBalanceValue(fst)
+	t.True(fstv.Big().Equal(faBalance.Big().Add(fee)))
+
+	var fo FeeOperation
+	for _, op := range pool.AddedOperations() {
+		if err := op.Hint().IsCompatible(FeeOperationHint); err == nil {
+			fo = op.(FeeOperation)
+		}
+	}
+
+	fof := fo.Fact().(FeeOperationFact)
+	t.Equal(fee, fof.Amounts()[0].Big())
+}
+
+func (t *testTransfersOperations) TestFeePayerInsufficientBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	pa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	ra, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2)
+	feeer := NewFixedFeeer(sa.Address, NewBig(2))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	tf := t.newTransferWithFeePayer(
+		sa.Address, pa.Address,
+		append(sa.Privs(), pa.Privs()...),
+		[]TransfersItem{t.newTransfersItem(ra.Address, NewBig(10))},
+	)
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testTransfersOperations) TestFeePayerNotSigned() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	pa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2)
+	feeer := NewFixedFeeer(sa.Address, NewBig(2))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	tf := t.newTransferWithFeePayer(
+		sa.Address, pa.Address,
+		sa.Privs(),
+		[]TransfersItem{t.newTransfersItem(ra.Address, NewBig(10))},
+	)
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "not passed threshold")
+}
+
+func (t *testTransfersOperations) TestFeePayerSameSenders() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	pa, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	tf0 := t.newTransferWithFeePayer(
+		sa.Address, pa.Address,
+		append(sa.Privs(), pa.Privs()...),
+		[]TransfersItem{t.newTransfersItem(ra.Address, NewBig(1))},
+	)
+	t.NoError(opr.Process(tf0))
+
+	tf1 := t.newTransfer(pa.Address, pa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(1))})
+	t.NoError(opr.Process(tf1))
+
+	var sst, pst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(pa.Address, t.cid):
+			pst = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(NewBig(32)))
+
+	pstv, _ := StateBalanceValue(pst)
+	t.True(pstv.Big().Equal(NewBig(9)))
+}
+
+func (t *testTransfersOperations) TestFeeCurrency() {
+	fcid := CurrencyID("FEE")
+
+	saBalance := []Amount{NewAmount(NewBig(10), t.cid), NewAmount(NewBig(5), fcid)}
+	sa, st0 := t.newAccount(true, saBalance)
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	fa, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(0), fcid)})
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(4))).
+		SetFeeRates(map[CurrencyID]float64{fcid: 0.5})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateByPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	item := NewTransfersItemMultiAmounts(ra.Address, []Amount{NewAmount(NewBig(10), t.cid)}).SetFeeCurrency(fcid)
+	tf := t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{item})
+
+	t.NoError(opr.Process(tf))
+	t.NoError(opr.Close())
+
+	fee := NewBig(2)
+
+	var sst, fsst, fst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(sa.Address, fcid):
+			fsst = st.GetState()
+		case StateKeyBalance(fa.Address, fcid):
+			fst = st.GetState()
+		}
+	}
+
+	sstv, _ := StateBalanceValue(sst)
+	t.True(sstv.Big().Equal(ZeroBig))
+
+	fsstv, _ := StateBalanceValue(fsst)
+	t.True(fsstv.Big().Equal(saBalance[1].Big().Sub(fee)))
+
+	fstv, _ := StateBalanceValue(fst)
+	t.True(fstv.Big().Equal(fee))
+
+	var fo FeeOperation
+	for _, op := range pool.AddedOperations() {
+		if err := op.Hint().IsCompatible(FeeOperationHint); err == nil {
+			fo = op.(FeeOperation)
+		}
+	}
+
+	fof := fo.Fact().(FeeOperationFact)
+	t.Equal(1, len(fof.Amounts()))
+	t.Equal(fcid, fof.Amounts()[0].Currency())
+	t.Equal(fee, fof.Amounts()[0].Big())
+}
+
+func (t *testTransfersOperations) TestFeeCurrencyRateNotFound() {
+	fcid := CurrencyID("FEE")
+
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid), NewAmount(NewBig(5), fcid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, NewBig(4)))))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	item := NewTransfersItemMultiAmounts(ra.Address, []Amount{NewAmount(NewBig(1), t.cid)}).SetFeeCurrency(fcid)
+	tf := t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{item})
+
+	err := opr.Process(tf)
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "fee rate of \"FEE\" not found")
+}
+
 type acerr struct {
 	err error
 	ac  interface{}
@@ -34,6 +34,12 @@ func (it TransfersItemSingleAmount) IsValid([]byte) error {
 	return nil
 }
 
+func (it TransfersItemSingleAmount) SetFeeCurrency(cid CurrencyID) TransfersItem {
+	it.BaseTransfersItem = it.BaseTransfersItem.SetFeeCurrency(cid).(BaseTransfersItem)
+
+	return it
+}
+
 func (it TransfersItemSingleAmount) Rebuild() TransfersItem {
 	it.BaseTransfersItem = it.BaseTransfersItem.Rebuild().(BaseTransfersItem)
 
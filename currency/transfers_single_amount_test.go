@@ -24,7 +24,7 @@ func (t *testTransfersItemSingleAmount) TestNew() {
 	token := util.UUID().Bytes()
 	am := NewAmount(NewBig(11), CurrencyID("SHOWME"))
 	items := []TransfersItem{NewTransfersItemSingleAmount(r, am)}
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -59,7 +59,7 @@ func (t *testTransfersItemSingleAmount) TestZeroBig() {
 	err := items[0].IsValid(nil)
 	t.Contains(err.Error(), "amount should be over zero")
 
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	pk := key.MustNewBTCPrivatekey()
 	sig, err := operation.NewFactSignature(pk, fact, nil)
@@ -93,7 +93,7 @@ func (t *testTransfersItemSingleAmount) TestOverMaxAmounts() {
 	err := items[0].IsValid(nil)
 	t.Contains(err.Error(), "only one amount allowed")
 
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	pk := key.MustNewBTCPrivatekey()
 	sig, err := operation.NewFactSignature(pk, fact, nil)
@@ -121,7 +121,7 @@ func (t *testTransfersItemSingleAmount) TestEmptyAmounts() {
 	err := items[0].IsValid(nil)
 	t.Contains(err.Error(), "empty amounts")
 
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	pk := key.MustNewBTCPrivatekey()
 	sig, err := operation.NewFactSignature(pk, fact, nil)
@@ -153,7 +153,7 @@ func testTransfersItemSingleAmountEncode(enc encoder.Encoder) suite.TestingSuite
 			NewTransfersItemSingleAmount(r, NewAmount(NewBig(33), CurrencyID("SHOWME"))),
 			NewTransfersItemSingleAmount(r, NewAmount(NewBig(44), CurrencyID("FINDME"))),
 		}
-		fact := NewTransfersFact(token, s, items)
+		fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 		var fs []operation.FactSign
 
@@ -1,6 +1,7 @@
 package currency
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
 )
 
 type testTransfers struct {
@@ -23,7 +25,7 @@ func (t *testTransfers) TestNew() {
 	token := util.UUID().Bytes()
 	am := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
 	items := []TransfersItem{NewTransfersItemMultiAmounts(r, am)}
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -59,7 +61,7 @@ func (t *testTransfers) TestDuplicatedReceivers() {
 		NewTransfersItemMultiAmounts(r, ams),
 		NewTransfersItemMultiAmounts(r, ams),
 	}
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	pk := key.MustNewBTCPrivatekey()
 	sig, err := operation.NewFactSignature(pk, fact, nil)
@@ -85,7 +87,7 @@ func (t *testTransfers) TestSameWithSender() {
 		NewTransfersItemMultiAmounts(r, ams),
 		NewTransfersItemMultiAmounts(s, ams),
 	}
-	fact := NewTransfersFact(token, s, items)
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	pk := key.MustNewBTCPrivatekey()
 	sig, err := operation.NewFactSignature(pk, fact, nil)
@@ -100,14 +102,114 @@ func (t *testTransfers) TestSameWithSender() {
 	t.Contains(err.Error(), "receiver is same with sender")
 }
 
-func (t *testTransfers) TestOverSizeMemo() {
+func (t *testTransfers) TestFeePayer() {
 	s := MustAddress(util.UUID().String())
 	r := MustAddress(util.UUID().String())
+	p := MustAddress(util.UUID().String())
 
 	token := util.UUID().Bytes()
+
+	ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
+	items := []TransfersItem{NewTransfersItemMultiAmounts(r, ams)}
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
+	pfact := fact.SetFeePayer(p)
+
+	t.NoError(pfact.IsValid(nil))
+	t.True(p.Equal(pfact.FeePayer()))
+	t.False(fact.Hash().Equal(pfact.Hash()))
+
+	as, err := pfact.Addresses()
+	t.NoError(err)
+	t.Equal(3, len(as))
+	t.True(p.Equal(as[2]))
+
+	err = fact.SetFeePayer(s).IsValid(nil)
+	t.Contains(err.Error(), "fee payer is same with sender")
+}
+
+func (t *testTransfers) TestSequence() {
+	s := MustAddress(util.UUID().String())
+	r := MustAddress(util.UUID().String())
+
+	token := util.UUID().Bytes()
+
 	ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
 	items := []TransfersItem{NewTransfersItemMultiAmounts(r, ams)}
 	fact := NewTransfersFact(token, s, items)
+	sfact := fact.SetSequence(3)
+
+	t.NoError(sfact.IsValid(nil))
+	t.Equal(uint64(3), sfact.Sequence())
+	t.Equal(uint64(0), fact.Sequence())
+	t.False(fact.Hash().Equal(sfact.Hash()))
+}
+
+func (t *testTransfers) TestEmptySequence() {
+	s := MustAddress(util.UUID().String())
+	r := MustAddress(util.UUID().String())
+
+	ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
+	items := []TransfersItem{NewTransfersItemMultiAmounts(r, ams)}
+	fact := NewTransfersFact(util.UUID().Bytes(), s, items)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty sequence")
+
+	// NOTE the fact of the previous version does not have sequence
+	fact.hint = hint.MustHint(TransfersFactType, "0.0.1")
+	t.NoError(fact.IsValid(nil))
+
+	// NOTE the fact of any version since sequence requires sequence
+	fact.hint = hint.MustHint(TransfersFactType, "0.0.3")
+	err = fact.IsValid(nil)
+	t.Contains(err.Error(), "empty sequence")
+}
+
+func (t *testTransfers) TestValidUntil() {
+	s := MustAddress(util.UUID().String())
+	r := MustAddress(util.UUID().String())
+
+	token := util.UUID().Bytes()
+
+	ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
+	items := []TransfersItem{NewTransfersItemMultiAmounts(r, ams)}
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
+
+	vfact := fact.SetValidUntil(base.Height(33))
+
+	t.NoError(vfact.IsValid(nil))
+	t.Equal(base.Height(33), vfact.ValidUntilHeight())
+	t.False(fact.Hash().Equal(vfact.Hash()))
+
+	err := fact.SetValidUntil(base.Height(-1)).IsValid(nil)
+	t.Contains(err.Error(), "valid until height should be over zero")
+}
+
+func (t *testTransfers) TestOptionalFieldsNotCollided() {
+	s := MustAddress(util.UUID().String())
+	r := MustAddress(util.UUID().String())
+
+	token := util.UUID().Bytes()
+
+	ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
+	items := []TransfersItem{NewTransfersItemMultiAmounts(r, ams)}
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
+
+	sfact := fact.SetSequence(33)
+	vfact := fact.SetValidUntil(base.Height(33))
+
+	t.True(bytes.Equal(util.Uint64ToBytes(33), base.Height(33).Bytes()))
+	t.False(sfact.Hash().Equal(vfact.Hash()))
+}
+
+func (t *testTransfers) TestOverSizeMemo() {
+	s := MustAddress(util.UUID().String())
+	r := MustAddress(util.UUID().String())
+
+	token := util.UUID().Bytes()
+	ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
+	items := []TransfersItem{NewTransfersItemMultiAmounts(r, ams)}
+	fact := NewTransfersFact(token, s, items).SetSequence(1)
 
 	var fs []operation.FactSign
 
@@ -0,0 +1,51 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+)
+
+// ValidUntilFact is the fact, which expires. The zero height means the fact
+// does not expire.
+type ValidUntilFact interface {
+	ValidUntilHeight() base.Height
+}
+
+func validUntilBytes(height base.Height) []byte {
+	if height < 1 {
+		return nil
+	}
+
+	return optionalFieldBytes(factFieldValidUntilHeight, height.Bytes())
+}
+
+func isValidValidUntil(height base.Height) error {
+	if height < 0 {
+		return xerrors.Errorf("valid until height should be over zero, %v", height)
+	}
+
+	return nil
+}
+
+// checkValidUntil checks the fact is not expired at the height; the fact can
+// be processed until the valid until height.
+func checkValidUntil(fact ValidUntilFact, height base.Height) error {
+	if vh := fact.ValidUntilHeight(); vh > 0 && height > vh {
+		return util.IgnoreError.Errorf("fact expired at height, %v; current height, %v", vh, height)
+	}
+
+	return nil
+}
+
+// CheckExpired checks the fact is not expired. The next block after the last
+// height should not be over the valid until height. The fact, which is not
+// ValidUntilFact, never expires.
+func CheckExpired(fact base.Fact, last base.Height) error {
+	if i, ok := fact.(ValidUntilFact); !ok {
+		return nil
+	} else {
+		return checkValidUntil(i, last+1)
+	}
+}
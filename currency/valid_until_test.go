@@ -0,0 +1,43 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testValidUntil struct {
+	suite.Suite
+}
+
+func (t *testValidUntil) newFact() TransfersFact {
+	ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
+	items := []TransfersItem{NewTransfersItemMultiAmounts(NewTestAddress(), ams)}
+
+	return NewTransfersFact(util.UUID().Bytes(), NewTestAddress(), items).SetSequence(1)
+}
+
+func (t *testValidUntil) TestNotExpire() {
+	fact := t.newFact()
+
+	t.NoError(CheckExpired(fact, base.Height(33)))
+}
+
+func (t *testValidUntil) TestHeight() {
+	fact := t.newFact().SetValidUntil(base.Height(33))
+
+	// NOTE the next block of the last height can process the fact
+	t.NoError(CheckExpired(fact, base.Height(32)))
+
+	err := CheckExpired(fact, base.Height(33))
+	t.True(xerrors.Is(err, util.IgnoreError))
+	t.Contains(err.Error(), "fact expired at height")
+}
+
+func TestValidUntil(t *testing.T) {
+	suite.Run(t, new(testValidUntil))
+}
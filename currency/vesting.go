@@ -0,0 +1,167 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	VestingReleaseType = hint.MustNewType(0xa0, 0x4d, "mitum-currency-vesting-release")
+	VestingReleaseHint = hint.MustHint(VestingReleaseType, "0.0.1")
+	VestingType        = hint.MustNewType(0xa0, 0x4e, "mitum-currency-vesting")
+	VestingHint        = hint.MustHint(VestingType, "0.0.1")
+)
+
+// VestingRelease is the amount, which becomes spendable from the height.
+type VestingRelease struct {
+	height base.Height
+	amount Big
+}
+
+func NewVestingRelease(height base.Height, amount Big) VestingRelease {
+	return VestingRelease{height: height, amount: amount}
+}
+
+func (vr VestingRelease) Hint() hint.Hint {
+	return VestingReleaseHint
+}
+
+func (vr VestingRelease) Bytes() []byte {
+	return util.ConcatBytesSlice(vr.height.Bytes(), vr.amount.Bytes())
+}
+
+func (vr VestingRelease) IsValid([]byte) error {
+	if err := vr.height.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid release height: %w", err)
+	}
+
+	if !vr.amount.OverZero() {
+		return xerrors.Errorf("release amount should be over zero")
+	}
+
+	return nil
+}
+
+func (vr VestingRelease) Height() base.Height {
+	return vr.height
+}
+
+func (vr VestingRelease) Amount() Big {
+	return vr.amount
+}
+
+// Vesting is the release schedule of the balance in currency. The amount of
+// release is locked until the height of release. Cliff is the first release and
+// linear vesting is the releases in the same interval.
+type Vesting struct {
+	currency CurrencyID
+	releases []VestingRelease
+}
+
+func NewVesting(cid CurrencyID, releases []VestingRelease) Vesting {
+	return Vesting{currency: cid, releases: releases}
+}
+
+func (vs Vesting) Hint() hint.Hint {
+	return VestingHint
+}
+
+func (vs Vesting) Hash() valuehash.Hash {
+	return vs.GenerateHash()
+}
+
+func (vs Vesting) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(vs.Bytes())
+}
+
+func (vs Vesting) Bytes() []byte {
+	bs := make([][]byte, len(vs.releases)+1)
+	bs[0] = vs.currency.Bytes()
+
+	for i := range vs.releases {
+		bs[i+1] = vs.releases[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (vs Vesting) IsValid([]byte) error {
+	if err := vs.currency.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid vesting currency: %w", err)
+	}
+
+	if len(vs.releases) < 1 {
+		return xerrors.Errorf("empty releases")
+	}
+
+	for i := range vs.releases {
+		vr := vs.releases[i]
+		if err := vr.IsValid(nil); err != nil {
+			return err
+		}
+
+		if i > 0 && vr.height <= vs.releases[i-1].height {
+			return xerrors.Errorf("release heights should be ascending, %v <= %v", vr.height, vs.releases[i-1].height)
+		}
+	}
+
+	return nil
+}
+
+func (vs Vesting) Currency() CurrencyID {
+	return vs.currency
+}
+
+func (vs Vesting) Releases() []VestingRelease {
+	return vs.releases
+}
+
+// Total returns the sum of all releases.
+func (vs Vesting) Total() Big {
+	total := ZeroBig
+	for i := range vs.releases {
+		total = total.Add(vs.releases[i].amount)
+	}
+
+	return total
+}
+
+// Locked returns the sum of releases, which are not yet released at the given
+// height.
+func (vs Vesting) Locked(height base.Height) Big {
+	locked := ZeroBig
+	for i := range vs.releases {
+		if vs.releases[i].height > height {
+			locked = locked.Add(vs.releases[i].amount)
+		}
+	}
+
+	return locked
+}
+
+// lockedByVesting returns the locked balance of holder by vesting at the given
+// height. Without vesting, it is zero.
+func lockedByVesting(
+	holder base.Address,
+	cid CurrencyID,
+	height base.Height,
+	getState func(key string) (state.State, bool, error),
+) (Big, error) {
+	switch st, found, err := getState(StateKeyVesting(holder, cid)); {
+	case err != nil:
+		return ZeroBig, err
+	case !found:
+		return ZeroBig, nil
+	default:
+		if vs, err := StateVestingValue(st); err != nil {
+			return ZeroBig, util.IgnoreError.Wrap(err)
+		} else {
+			return vs.Locked(height), nil
+		}
+	}
+}
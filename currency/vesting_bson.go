@@ -0,0 +1,64 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (vr VestingRelease) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(vr.Hint()),
+		bson.M{
+			"height": vr.height,
+			"amount": vr.amount,
+		}),
+	)
+}
+
+type VestingReleaseBSONUnpacker struct {
+	HT base.Height `bson:"height"`
+	AM Big         `bson:"amount"`
+}
+
+func (vr *VestingRelease) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uvr VestingReleaseBSONUnpacker
+	if err := enc.Unmarshal(b, &uvr); err != nil {
+		return err
+	}
+
+	vr.height = uvr.HT
+	vr.amount = uvr.AM
+
+	return nil
+}
+
+func (vs Vesting) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(vs.Hint()),
+		bson.M{
+			"currency": vs.currency,
+			"releases": vs.releases,
+		}),
+	)
+}
+
+type VestingBSONUnpacker struct {
+	CR string     `bson:"currency"`
+	RL []bson.Raw `bson:"releases"`
+}
+
+func (vs *Vesting) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uvs VestingBSONUnpacker
+	if err := enc.Unmarshal(b, &uvs); err != nil {
+		return err
+	}
+
+	brl := make([][]byte, len(uvs.RL))
+	for i := range uvs.RL {
+		brl[i] = uvs.RL[i]
+	}
+
+	return vs.unpack(enc, uvs.CR, brl)
+}
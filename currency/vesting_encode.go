@@ -0,0 +1,25 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (vs *Vesting) unpack(enc encoder.Encoder, cr string, brl [][]byte) error {
+	releases := make([]VestingRelease, len(brl))
+	for i := range brl {
+		if j, err := enc.DecodeByHint(brl[i]); err != nil {
+			return err
+		} else if vr, ok := j.(VestingRelease); !ok {
+			return xerrors.Errorf("not VestingRelease, %T", j)
+		} else {
+			releases[i] = vr
+		}
+	}
+
+	vs.currency = CurrencyID(cr)
+	vs.releases = releases
+
+	return nil
+}
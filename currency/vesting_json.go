@@ -0,0 +1,72 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type VestingReleaseJSONPacker struct {
+	jsonenc.HintedHead
+	HT base.Height `json:"height"`
+	AM Big         `json:"amount"`
+}
+
+func (vr VestingRelease) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(VestingReleaseJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(vr.Hint()),
+		HT:         vr.height,
+		AM:         vr.amount,
+	})
+}
+
+type VestingReleaseJSONUnpacker struct {
+	HT base.Height `json:"height"`
+	AM Big         `json:"amount"`
+}
+
+func (vr *VestingRelease) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uvr VestingReleaseJSONUnpacker
+	if err := enc.Unmarshal(b, &uvr); err != nil {
+		return err
+	}
+
+	vr.height = uvr.HT
+	vr.amount = uvr.AM
+
+	return nil
+}
+
+type VestingJSONPacker struct {
+	jsonenc.HintedHead
+	CR CurrencyID       `json:"currency"`
+	RL []VestingRelease `json:"releases"`
+}
+
+func (vs Vesting) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(VestingJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(vs.Hint()),
+		CR:         vs.currency,
+		RL:         vs.releases,
+	})
+}
+
+type VestingJSONUnpacker struct {
+	CR string            `json:"currency"`
+	RL []json.RawMessage `json:"releases"`
+}
+
+func (vs *Vesting) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uvs VestingJSONUnpacker
+	if err := enc.Unmarshal(b, &uvs); err != nil {
+		return err
+	}
+
+	brl := make([][]byte, len(uvs.RL))
+	for i := range uvs.RL {
+		brl[i] = uvs.RL[i]
+	}
+
+	return vs.unpack(enc, uvs.CR, brl)
+}
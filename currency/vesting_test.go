@@ -0,0 +1,99 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testVesting struct {
+	suite.Suite
+}
+
+func (t *testVesting) TestNew() {
+	vs := NewVesting(CurrencyID("SHOWME"), []VestingRelease{
+		NewVestingRelease(base.Height(10), NewBig(3)),
+		NewVestingRelease(base.Height(20), NewBig(5)),
+	})
+	t.NoError(vs.IsValid(nil))
+
+	t.True(vs.Total().Equal(NewBig(8)))
+}
+
+func (t *testVesting) TestLocked() {
+	vs := NewVesting(CurrencyID("SHOWME"), []VestingRelease{
+		NewVestingRelease(base.Height(10), NewBig(3)),
+		NewVestingRelease(base.Height(20), NewBig(5)),
+	})
+
+	t.True(vs.Locked(base.Height(9)).Equal(NewBig(8)))
+	t.True(vs.Locked(base.Height(10)).Equal(NewBig(5)))
+	t.True(vs.Locked(base.Height(19)).Equal(NewBig(5)))
+	t.True(vs.Locked(base.Height(20)).IsZero())
+}
+
+func (t *testVesting) TestEmptyReleases() {
+	err := NewVesting(CurrencyID("SHOWME"), nil).IsValid(nil)
+	t.Contains(err.Error(), "empty releases")
+}
+
+func (t *testVesting) TestNotAscending() {
+	err := NewVesting(CurrencyID("SHOWME"), []VestingRelease{
+		NewVestingRelease(base.Height(20), NewBig(3)),
+		NewVestingRelease(base.Height(20), NewBig(5)),
+	}).IsValid(nil)
+	t.Contains(err.Error(), "release heights should be ascending")
+}
+
+func (t *testVesting) TestZeroRelease() {
+	err := NewVesting(CurrencyID("SHOWME"), []VestingRelease{
+		NewVestingRelease(base.Height(10), ZeroBig),
+	}).IsValid(nil)
+	t.Contains(err.Error(), "release amount should be over zero")
+}
+
+func TestVesting(t *testing.T) {
+	suite.Run(t, new(testVesting))
+}
+
+func testVestingEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		vs := NewVesting(CurrencyID("SHOWME"), []VestingRelease{
+			NewVestingRelease(base.Height(10), NewBig(3)),
+			NewVestingRelease(base.Height(20), NewBig(5)),
+		})
+		t.NoError(vs.IsValid(nil))
+
+		return vs
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Vesting)
+		tb := b.(Vesting)
+
+		t.Equal(ta.Currency(), tb.Currency())
+		t.Equal(len(ta.Releases()), len(tb.Releases()))
+		for i := range ta.Releases() {
+			t.Equal(ta.Releases()[i].Height(), tb.Releases()[i].Height())
+			t.True(ta.Releases()[i].Amount().Equal(tb.Releases()[i].Amount()))
+		}
+	}
+
+	return t
+}
+
+func TestVestingEncodeJSON(t *testing.T) {
+	suite.Run(t, testVestingEncode(jsonenc.NewEncoder()))
+}
+
+func TestVestingEncodeBSON(t *testing.T) {
+	suite.Run(t, testVestingEncode(bsonenc.NewEncoder()))
+}
@@ -17,8 +17,12 @@ var (
 type AccountValue struct {
 	ac             currency.Account
 	balance        []currency.Amount
+	locked         []currency.Amount
+	frozen         []currency.Freeze
+	sequence       uint64
 	height         base.Height
 	previousHeight base.Height
+	closedHeight   base.Height
 }
 
 func NewAccountValue(st state.State) (AccountValue, error) {
@@ -32,11 +36,17 @@ func NewAccountValue(st state.State) (AccountValue, error) {
 		ac = a
 	}
 
-	return AccountValue{
+	va := AccountValue{
 		ac:             ac,
 		height:         st.Height(),
 		previousHeight: st.PreviousHeight(),
-	}, nil
+	}
+
+	if ac.IsClosed() {
+		va.closedHeight = st.Height()
+	}
+
+	return va, nil
 }
 
 func (va AccountValue) Hint() hint.Hint {
@@ -51,6 +61,50 @@ func (va AccountValue) Balance() []currency.Amount {
 	return va.balance
 }
 
+// Locked returns the balance, which is still locked by vesting.
+func (va AccountValue) Locked() []currency.Amount {
+	return va.locked
+}
+
+// Spendable returns the balance except the locked by vesting.
+func (va AccountValue) Spendable() []currency.Amount {
+	if len(va.locked) < 1 {
+		return va.balance
+	}
+
+	locked := map[currency.CurrencyID]currency.Big{}
+	for i := range va.locked {
+		locked[va.locked[i].Currency()] = va.locked[i].Big()
+	}
+
+	sp := make([]currency.Amount, len(va.balance))
+	for i := range va.balance {
+		am := va.balance[i]
+
+		big := am.Big()
+		if lb, found := locked[am.Currency()]; found {
+			if big = big.Sub(lb); !big.OverNil() {
+				big = currency.ZeroBig
+			}
+		}
+
+		sp[i] = am.WithBig(big)
+	}
+
+	return sp
+}
+
+// Frozen returns the freezes of account by FreezeAccount.
+func (va AccountValue) Frozen() []currency.Freeze {
+	return va.frozen
+}
+
+// Sequence returns the next sequence, which the next fact of account should
+// have.
+func (va AccountValue) Sequence() uint64 {
+	return va.sequence
+}
+
 func (va AccountValue) Height() base.Height {
 	return va.height
 }
@@ -71,8 +125,32 @@ func (va AccountValue) SetPreviousHeight(height base.Height) AccountValue {
 	return va
 }
 
+// ClosedHeight returns the height when the account is closed by CloseAccount.
+// If not closed, it is zero.
+func (va AccountValue) ClosedHeight() base.Height {
+	return va.closedHeight
+}
+
 func (va AccountValue) SetBalance(balance []currency.Amount) AccountValue {
 	va.balance = balance
 
 	return va
 }
+
+func (va AccountValue) SetLocked(locked []currency.Amount) AccountValue {
+	va.locked = locked
+
+	return va
+}
+
+func (va AccountValue) SetFrozen(frozen []currency.Freeze) AccountValue {
+	va.frozen = frozen
+
+	return va
+}
+
+func (va AccountValue) SetSequence(sequence uint64) AccountValue {
+	va.sequence = sequence
+
+	return va
+}
@@ -12,8 +12,12 @@ func (va AccountValue) MarshalBSON() ([]byte, error) {
 		bson.M{
 			"ac":              va.ac,
 			"balance":         va.balance,
+			"locked":          va.locked,
+			"frozen":          va.frozen,
+			"sequence":        va.sequence,
 			"height":          va.height,
 			"previous_height": va.previousHeight,
+			"closed_height":   va.closedHeight,
 		},
 	))
 }
@@ -21,8 +25,12 @@ func (va AccountValue) MarshalBSON() ([]byte, error) {
 type AccountValueBSONUnpacker struct {
 	AC bson.Raw    `bson:"ac"`
 	BL []bson.Raw  `bson:"balance"`
+	LK []bson.Raw  `bson:"locked"`
+	FZ []bson.Raw  `bson:"frozen"`
+	SQ uint64      `bson:"sequence"`
 	HT base.Height `bson:"height"`
 	PT base.Height `bson:"previous_height"`
+	CH base.Height `bson:"closed_height"`
 }
 
 func (va *AccountValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -36,5 +44,15 @@ func (va *AccountValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		bb[i] = uva.BL[i]
 	}
 
-	return va.unpack(enc, uva.AC, bb, uva.HT, uva.PT)
+	lb := make([][]byte, len(uva.LK))
+	for i := range uva.LK {
+		lb[i] = uva.LK[i]
+	}
+
+	fb := make([][]byte, len(uva.FZ))
+	for i := range uva.FZ {
+		fb[i] = uva.FZ[i]
+	}
+
+	return va.unpack(enc, uva.AC, bb, lb, fb, uva.SQ, uva.HT, uva.PT, uva.CH)
 }
@@ -6,7 +6,15 @@ import (
 	"github.com/spikeekips/mitum/util/encoder"
 )
 
-func (va *AccountValue) unpack(enc encoder.Encoder, bac []byte, bb [][]byte, height, previousHeight base.Height) error {
+func (va *AccountValue) unpack(
+	enc encoder.Encoder,
+	bac []byte,
+	bb [][]byte,
+	lb [][]byte,
+	fb [][]byte,
+	sequence uint64,
+	height, previousHeight, closedHeight base.Height,
+) error {
 	if bac != nil {
 		if i, err := currency.DecodeAccount(enc, bac); err != nil {
 			return err
@@ -24,9 +32,37 @@ func (va *AccountValue) unpack(enc encoder.Encoder, bac []byte, bb [][]byte, hei
 		}
 	}
 
+	if len(lb) > 0 {
+		locked := make([]currency.Amount, len(lb))
+		for i := range lb {
+			if j, err := currency.DecodeAmount(enc, lb[i]); err != nil {
+				return err
+			} else {
+				locked[i] = j
+			}
+		}
+
+		va.locked = locked
+	}
+
+	if len(fb) > 0 {
+		frozen := make([]currency.Freeze, len(fb))
+		for i := range fb {
+			if j, err := currency.DecodeFreeze(enc, fb[i]); err != nil {
+				return err
+			} else {
+				frozen[i] = j
+			}
+		}
+
+		va.frozen = frozen
+	}
+
 	va.balance = balance
+	va.sequence = sequence
 	va.height = height
 	va.previousHeight = previousHeight
+	va.closedHeight = closedHeight
 
 	return nil
 }
@@ -12,8 +12,13 @@ type AccountValueJSONPacker struct {
 	jsonenc.HintedHead
 	currency.AccountPackerJSON
 	BL []currency.Amount `json:"balance"`
+	LK []currency.Amount `json:"locked,omitempty"`
+	SP []currency.Amount `json:"spendable"`
+	FZ []currency.Freeze `json:"frozen,omitempty"`
+	SQ uint64            `json:"sequence"`
 	HT base.Height       `json:"height"`
 	PT base.Height       `json:"previous_height"`
+	CH base.Height       `json:"closed_height,omitempty"`
 }
 
 func (va AccountValue) MarshalJSON() ([]byte, error) {
@@ -21,15 +26,24 @@ func (va AccountValue) MarshalJSON() ([]byte, error) {
 		HintedHead:        jsonenc.NewHintedHead(va.Hint()),
 		AccountPackerJSON: va.ac.PackerJSON(),
 		BL:                va.balance,
+		LK:                va.locked,
+		SP:                va.Spendable(),
+		FZ:                va.frozen,
+		SQ:                va.sequence,
 		HT:                va.height,
 		PT:                va.previousHeight,
+		CH:                va.closedHeight,
 	})
 }
 
 type AccountValueJSONUnpacker struct {
 	BL []json.RawMessage `json:"balance"`
+	LK []json.RawMessage `json:"locked,omitempty"`
+	FZ []json.RawMessage `json:"frozen,omitempty"`
+	SQ uint64            `json:"sequence"`
 	HT base.Height       `json:"height"`
 	PT base.Height       `json:"previous_height"`
+	CH base.Height       `json:"closed_height,omitempty"`
 }
 
 func (va *AccountValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -43,8 +57,18 @@ func (va *AccountValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		bb[i] = uva.BL[i]
 	}
 
+	lb := make([][]byte, len(uva.LK))
+	for i := range uva.LK {
+		lb[i] = uva.LK[i]
+	}
+
+	fb := make([][]byte, len(uva.FZ))
+	for i := range uva.FZ {
+		fb[i] = uva.FZ[i]
+	}
+
 	ac := new(currency.Account)
-	if err := va.unpack(enc, nil, bb, uva.HT, uva.PT); err != nil {
+	if err := va.unpack(enc, nil, bb, lb, fb, uva.SQ, uva.HT, uva.PT, uva.CH); err != nil {
 		return err
 	} else if err := ac.UnpackJSON(b, enc); err != nil {
 		return err
@@ -13,6 +13,7 @@ import (
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/block"
+	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/storage"
 	"github.com/spikeekips/mitum/util/valuehash"
@@ -28,6 +29,14 @@ type BlockStorage struct {
 	operationModels []mongo.WriteModel
 	accountModels   []mongo.WriteModel
 	balanceModels   []mongo.WriteModel
+	allowanceModels []mongo.WriteModel
+	lockModels      []mongo.WriteModel
+	vestingModels   []mongo.WriteModel
+	freezeModels    []mongo.WriteModel
+	proposalModels  []mongo.WriteModel
+	aliasModels     []mongo.WriteModel
+	scheduleModels  []mongo.WriteModel
+	sequenceModels  []mongo.WriteModel
 	statesValue     *sync.Map
 }
 
@@ -96,6 +105,38 @@ func (bs *BlockStorage) Commit(ctx context.Context) error {
 		return err
 	}
 
+	if err := bs.writeModels(ctx, defaultColNameAllowance, bs.allowanceModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameLock, bs.lockModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameVesting, bs.vestingModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameFreeze, bs.freezeModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameProposal, bs.proposalModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameAlias, bs.aliasModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameSchedule, bs.scheduleModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameSequence, bs.sequenceModels); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -155,14 +196,48 @@ func (bs *BlockStorage) prepareOperations() error {
 			uint64(i),
 		); err != nil {
 			return err
+		} else if as, err := bs.aliasAccounts(op); err != nil {
+			return err
 		} else {
-			bs.operationModels[i] = mongo.NewInsertOneModel().SetDocument(doc)
+			bs.operationModels[i] = mongo.NewInsertOneModel().SetDocument(doc.addAddresses(as...))
 		}
 	}
 
 	return nil
 }
 
+// aliasAccounts resolves the aliases in the addresses of operation fact to
+// their accounts, which the operation is processed with.
+func (bs *BlockStorage) aliasAccounts(op operation.Operation) ([]base.Address, error) {
+	var as []base.Address
+	if ads, ok := op.Fact().(currency.Addresses); !ok {
+		return nil, nil
+	} else if i, err := ads.Addresses(); err != nil {
+		return nil, err
+	} else {
+		as = i
+	}
+
+	var accounts []base.Address
+	for i := range as {
+		al, ok := as[i].(currency.Alias)
+		if !ok {
+			continue
+		}
+
+		switch aa, found, err := bs.st.AliasBefore(al, bs.block.Height()); {
+		case err != nil:
+			return nil, err
+		case !found, !aa.Registered():
+			continue
+		default:
+			accounts = append(accounts, aa.Account())
+		}
+	}
+
+	return accounts, nil
+}
+
 func (bs *BlockStorage) prepareAccounts() error {
 	if len(bs.block.States()) < 1 {
 		return nil
@@ -170,6 +245,14 @@ func (bs *BlockStorage) prepareAccounts() error {
 
 	var accountModels []mongo.WriteModel
 	var balanceModels []mongo.WriteModel
+	var allowanceModels []mongo.WriteModel
+	var lockModels []mongo.WriteModel
+	var vestingModels []mongo.WriteModel
+	var freezeModels []mongo.WriteModel
+	var proposalModels []mongo.WriteModel
+	var aliasModels []mongo.WriteModel
+	var scheduleModels []mongo.WriteModel
+	var sequenceModels []mongo.WriteModel
 	for i := range bs.block.States() {
 		st := bs.block.States()[i]
 		switch {
@@ -185,6 +268,54 @@ func (bs *BlockStorage) prepareAccounts() error {
 			} else {
 				balanceModels = append(balanceModels, j...)
 			}
+		case currency.IsStateAllowanceKey(st.Key()):
+			if j, err := bs.handleAllowanceState(st); err != nil {
+				return err
+			} else {
+				allowanceModels = append(allowanceModels, j...)
+			}
+		case currency.IsStateLockKey(st.Key()):
+			if j, err := bs.handleLockState(st); err != nil {
+				return err
+			} else {
+				lockModels = append(lockModels, j...)
+			}
+		case currency.IsStateVestingKey(st.Key()):
+			if j, err := bs.handleVestingState(st); err != nil {
+				return err
+			} else {
+				vestingModels = append(vestingModels, j...)
+			}
+		case currency.IsStateFreezeKey(st.Key()):
+			if j, err := bs.handleFreezeState(st); err != nil {
+				return err
+			} else {
+				freezeModels = append(freezeModels, j...)
+			}
+		case currency.IsStateProposalKey(st.Key()):
+			if j, err := bs.handleProposalState(st); err != nil {
+				return err
+			} else {
+				proposalModels = append(proposalModels, j...)
+			}
+		case currency.IsStateAliasKey(st.Key()):
+			if j, err := bs.handleAliasState(st); err != nil {
+				return err
+			} else {
+				aliasModels = append(aliasModels, j...)
+			}
+		case currency.IsStateScheduledTransferKey(st.Key()):
+			if j, err := bs.handleScheduledTransferState(st); err != nil {
+				return err
+			} else {
+				scheduleModels = append(scheduleModels, j...)
+			}
+		case currency.IsStateSequenceKey(st.Key()):
+			if j, err := bs.handleSequenceState(st); err != nil {
+				return err
+			} else {
+				sequenceModels = append(sequenceModels, j...)
+			}
 		default:
 			continue
 		}
@@ -192,6 +323,14 @@ func (bs *BlockStorage) prepareAccounts() error {
 
 	bs.accountModels = accountModels
 	bs.balanceModels = balanceModels
+	bs.allowanceModels = allowanceModels
+	bs.lockModels = lockModels
+	bs.vestingModels = vestingModels
+	bs.freezeModels = freezeModels
+	bs.proposalModels = proposalModels
+	bs.aliasModels = aliasModels
+	bs.scheduleModels = scheduleModels
+	bs.sequenceModels = sequenceModels
 
 	return nil
 }
@@ -214,6 +353,70 @@ func (bs *BlockStorage) handleBalanceState(st state.State) ([]mongo.WriteModel,
 	}
 }
 
+func (bs *BlockStorage) handleAllowanceState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewAllowanceDoc(st, bs.st.storage.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockStorage) handleVestingState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewVestingDoc(st, bs.st.storage.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockStorage) handleFreezeState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewFreezeDoc(st, bs.st.storage.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockStorage) handleLockState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewLockDoc(st, bs.st.storage.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockStorage) handleProposalState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewProposalDoc(st, bs.st.storage.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockStorage) handleAliasState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewAliasDoc(st, bs.st.storage.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockStorage) handleScheduledTransferState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewScheduledTransferDoc(st, bs.st.storage.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockStorage) handleSequenceState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewSequenceDoc(st, bs.st.storage.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
 func (bs *BlockStorage) writeModels(ctx context.Context, col string, models []mongo.WriteModel) error {
 	started := time.Now()
 	defer func() {
@@ -263,6 +466,14 @@ func (bs *BlockStorage) close() error {
 	bs.operationModels = nil
 	bs.accountModels = nil
 	bs.balanceModels = nil
+	bs.allowanceModels = nil
+	bs.lockModels = nil
+	bs.vestingModels = nil
+	bs.freezeModels = nil
+	bs.proposalModels = nil
+	bs.aliasModels = nil
+	bs.scheduleModels = nil
+	bs.sequenceModels = nil
 
 	return bs.st.Close()
 }
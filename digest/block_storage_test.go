@@ -102,6 +102,58 @@ func (t *testStorage) TestBlockStorageWithOperations() {
 	}
 }
 
+func (t *testStorage) TestBlockStorageWithAliasReceiver() {
+	sender := currency.MustAddress(util.UUID().String())
+	receiver := currency.MustAddress(util.UUID().String())
+	other := currency.MustAddress(util.UUID().String())
+
+	al := currency.Alias("showme")
+
+	st, _ := t.Storage()
+
+	// NOTE alias is transferred to the other account in the same block
+	_ = t.insertAlias(st, base.Height(2), currency.NewAccountAlias(al, receiver))
+	_ = t.insertAlias(st, base.Height(3), currency.NewAccountAlias(al, other))
+
+	op := t.newTransfer(sender, al)
+
+	blk, err := block.NewBlockV0(
+		block.SuffrageInfoV0{},
+		base.Height(3),
+		base.Round(1),
+		valuehash.RandomSHA256(),
+		valuehash.RandomSHA256(),
+		valuehash.RandomSHA256(),
+		valuehash.RandomSHA256(),
+		localtime.Now(),
+	)
+	t.NoError(err)
+
+	bs, err := NewBlockStorage(st, blk.SetOperations([]operation.Operation{op}))
+	t.NoError(err)
+
+	t.NoError(bs.Prepare())
+	t.NoError(bs.Commit(context.Background()))
+
+	for _, a := range []base.Address{sender, al, receiver} {
+		var ops []string
+		t.NoError(st.OperationsByAddress(a, true, false, "", 0, func(_ valuehash.Hash, va OperationValue) (bool, error) {
+			ops = append(ops, va.Operation().Fact().Hash().String())
+
+			return true, nil
+		}))
+		t.Equal([]string{op.Fact().Hash().String()}, ops, "address=%s", a)
+	}
+
+	var ops []string
+	t.NoError(st.OperationsByAddress(other, true, false, "", 0, func(_ valuehash.Hash, va OperationValue) (bool, error) {
+		ops = append(ops, va.Operation().Fact().Hash().String())
+
+		return true, nil
+	}))
+	t.Empty(ops)
+}
+
 func (t *testStorage) TestBlockStorageWithStates() {
 	blk, err := block.NewBlockV0(
 		block.SuffrageInfoV0{},
@@ -2,6 +2,7 @@ package digest
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"time"
 
 	"github.com/spikeekips/mitum-currency/currency"
@@ -11,6 +12,7 @@ import (
 	"github.com/spikeekips/mitum/util/encoder"
 	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/localtime"
+	"github.com/spikeekips/mitum/util/valuehash"
 	"golang.org/x/xerrors"
 )
 
@@ -25,6 +27,12 @@ var (
 	templateBig              = currency.NewBig(-333)
 	templateSignedAtString   = "2020-10-08T07:53:26Z"
 	templateSignedAt         time.Time
+	templatePreimage         = []byte("hush")
+	templateHashlock         []byte
+	templateLock             = valuehash.NewSHA256([]byte("locked"))
+	templateExpiry           = base.NilHeight
+	templateReleaseHeight    = base.NilHeight
+	templateSequence         = uint64(1)
 )
 
 func init() {
@@ -35,6 +43,9 @@ func init() {
 	}
 
 	templateSignedAt, _ = time.Parse(time.RFC3339, templateSignedAtString)
+
+	h := sha256.Sum256(templatePreimage)
+	templateHashlock = h[:]
 }
 
 type Builder struct {
@@ -58,6 +69,22 @@ func (bl Builder) FactTemplate(ht hint.Hint) (Hal, error) {
 		return bl.templateCurrencyRegisterFact(), nil
 	case currency.CurrencyPolicyUpdaterType:
 		return bl.templateCurrencyPolicyUpdaterFact(), nil
+	case currency.CurrencyMintType:
+		return bl.templateCurrencyMintFact(), nil
+	case currency.BurnType:
+		return bl.templateBurnFact(), nil
+	case currency.ApproveType:
+		return bl.templateApproveFact(), nil
+	case currency.TransferFromType:
+		return bl.templateTransferFromFact(), nil
+	case currency.LockTransferType:
+		return bl.templateLockTransferFact(), nil
+	case currency.ClaimTransferType:
+		return bl.templateClaimTransferFact(), nil
+	case currency.RefundTransferType:
+		return bl.templateRefundTransferFact(), nil
+	case currency.CreateVestingAccountsType:
+		return bl.templateCreateVestingAccountsFact(), nil
 	default:
 		return nil, xerrors.Errorf("unknown operation, %v", ht.Verbose())
 	}
@@ -74,12 +101,13 @@ func (bl Builder) templateCreateAccountsFact() Hal {
 			nkeys,
 			currency.NewAmount(templateBig, templateCurrencyID),
 		)},
-	)
+	).SetSequence(templateSequence)
 
 	hal := NewBaseHal(fact, HalLink{})
 	return hal.AddExtras("default", map[string]interface{}{
 		"token":               templateToken,
 		"sender":              templateSender,
+		"sequence":            templateSequence,
 		"items.keys.keys.key": templatePublickey,
 		"items.big":           templateBig,
 		"currency":            templateCurrencyID,
@@ -95,12 +123,13 @@ func (bl Builder) templateKeyUpdaterFact() Hal {
 		templateSender,
 		nkeys,
 		templateCurrencyID,
-	)
+	).SetSequence(templateSequence)
 
 	hal := NewBaseHal(fact, HalLink{})
 	return hal.AddExtras("default", map[string]interface{}{
 		"token":         templateToken,
 		"target":        templateSender,
+		"sequence":      templateSequence,
 		"keys.keys.key": templatePublickey,
 		"currency":      templateCurrencyID,
 	})
@@ -114,13 +143,14 @@ func (bl Builder) templateTransfersFact() Hal {
 			templateReceiver,
 			currency.NewAmount(templateBig, templateCurrencyID),
 		)},
-	)
+	).SetSequence(templateSequence)
 
 	hal := NewBaseHal(fact, HalLink{})
 
 	return hal.AddExtras("default", map[string]interface{}{
 		"token":          templateToken,
 		"sender":         templateSender,
+		"sequence":       templateSequence,
 		"items.receiver": templateReceiver,
 		"items.big":      templateBig,
 		"items.currency": templateCurrencyID,
@@ -144,6 +174,7 @@ func (bl Builder) templateCurrencyRegisterFact() Hal {
 		"amount.currency":          templateCurrencyID,
 		"currency.genesis_account": templateReceiver,
 		"currency.policy.new_account_min_balance": templateBig,
+		"currency.policy.max_supply":              currency.ZeroBig,
 	})
 }
 
@@ -157,6 +188,167 @@ func (bl Builder) templateCurrencyPolicyUpdaterFact() Hal {
 		"token":                          templateToken,
 		"currency":                       templateCurrencyID,
 		"policy.new_account_min_balance": templateBig,
+		"policy.max_supply":              currency.ZeroBig,
+	})
+}
+
+func (bl Builder) templateCurrencyMintFact() Hal {
+	fact := currency.NewCurrencyMintFact(
+		templateToken,
+		[]currency.MintItem{currency.NewMintItem(
+			templateReceiver,
+			currency.NewAmount(templateBig, templateCurrencyID),
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":                 templateToken,
+		"items.receiver":        templateReceiver,
+		"items.amount.amount":   templateBig,
+		"items.amount.currency": templateCurrencyID,
+	})
+}
+
+func (bl Builder) templateBurnFact() Hal {
+	fact := currency.NewBurnFact(
+		templateToken,
+		templateSender,
+		[]currency.Amount{currency.NewAmount(templateBig, templateCurrencyID)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"amounts.amount":   templateBig,
+		"amounts.currency": templateCurrencyID,
+	})
+}
+
+func (bl Builder) templateApproveFact() Hal {
+	fact := currency.NewApproveFact(
+		templateToken,
+		templateSender,
+		templateReceiver,
+		currency.NewAmount(templateBig, templateCurrencyID),
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":           templateToken,
+		"owner":           templateSender,
+		"spender":         templateReceiver,
+		"amount.amount":   templateBig,
+		"amount.currency": templateCurrencyID,
+	})
+}
+
+func (bl Builder) templateTransferFromFact() Hal {
+	fact := currency.NewTransferFromFact(
+		templateToken,
+		templateSender,
+		templateSender,
+		templateReceiver,
+		currency.NewAmount(templateBig, templateCurrencyID),
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":           templateToken,
+		"sender":          templateSender,
+		"owner":           templateSender,
+		"receiver":        templateReceiver,
+		"amount.amount":   templateBig,
+		"amount.currency": templateCurrencyID,
+	})
+}
+
+func (bl Builder) templateLockTransferFact() Hal {
+	fact := currency.NewLockTransferFact(
+		templateToken,
+		templateSender,
+		templateReceiver,
+		currency.NewAmount(templateBig, templateCurrencyID),
+		templateHashlock,
+		templateExpiry,
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":           templateToken,
+		"sender":          templateSender,
+		"receiver":        templateReceiver,
+		"amount.amount":   templateBig,
+		"amount.currency": templateCurrencyID,
+		"hashlock":        templateHashlock,
+		"expiry":          templateExpiry,
+	})
+}
+
+func (bl Builder) templateClaimTransferFact() Hal {
+	fact := currency.NewClaimTransferFact(
+		templateToken,
+		templateReceiver,
+		templateLock,
+		templatePreimage,
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":    templateToken,
+		"sender":   templateReceiver,
+		"lock":     templateLock,
+		"preimage": templatePreimage,
+	})
+}
+
+func (bl Builder) templateRefundTransferFact() Hal {
+	fact := currency.NewRefundTransferFact(
+		templateToken,
+		templateSender,
+		templateLock,
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":  templateToken,
+		"sender": templateSender,
+		"lock":   templateLock,
+	})
+}
+
+func (bl Builder) templateCreateVestingAccountsFact() Hal {
+	nkey, _ := currency.NewKey(templatePublickey, 100)
+	nkeys, _ := currency.NewKeys([]currency.Key{nkey}, 100)
+
+	fact := currency.NewCreateVestingAccountsFact(
+		templateToken,
+		templateSender,
+		[]currency.CreateVestingAccountsItem{currency.NewCreateVestingAccountsItem(
+			nkeys,
+			currency.NewAmount(templateBig, templateCurrencyID),
+			[]currency.VestingRelease{currency.NewVestingRelease(templateReleaseHeight, templateBig)},
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":                 templateToken,
+		"sender":                templateSender,
+		"items.keys.keys.key":   templatePublickey,
+		"items.amount.amount":   templateBig,
+		"items.amount.currency": templateCurrencyID,
+		"items.releases.height": templateReleaseHeight,
+		"items.releases.amount": templateBig,
 	})
 }
 
@@ -181,6 +373,22 @@ func (bl Builder) BuildFact(b []byte) (Hal, error) {
 		return bl.buildFactCurrencyRegister(t)
 	case currency.CurrencyPolicyUpdaterFact:
 		return bl.buildFactCurrencyPolicyUpdater(t)
+	case currency.CurrencyMintFact:
+		return bl.buildFactCurrencyMint(t)
+	case currency.BurnFact:
+		return bl.buildFactBurn(t)
+	case currency.ApproveFact:
+		return bl.buildFactApprove(t)
+	case currency.TransferFromFact:
+		return bl.buildFactTransferFrom(t)
+	case currency.LockTransferFact:
+		return bl.buildFactLockTransfer(t)
+	case currency.ClaimTransferFact:
+		return bl.buildFactClaimTransfer(t)
+	case currency.RefundTransferFact:
+		return bl.buildFactRefundTransfer(t)
+	case currency.CreateVestingAccountsFact:
+		return bl.buildFactCreateVestingAccounts(t)
 	default:
 		return nil, xerrors.Errorf("unknown fact, %T", fact)
 	}
@@ -208,7 +416,10 @@ func (bl Builder) buildFactCreateAccounts(fact currency.CreateAccountsFact) (Hal
 		}
 	}
 
-	nfact := currency.NewCreateAccountsFact(token, fact.Sender(), items)
+	nfact := currency.NewCreateAccountsFact(token, fact.Sender(), items).
+		SetFeePayer(fact.FeePayer()).
+		SetSequence(fact.Sequence()).
+		SetValidUntil(fact.ValidUntilHeight())
 	nfact = nfact.Rebulild()
 	if err := bl.isValidFactCreateAccounts(nfact); err != nil {
 		return nil, err
@@ -251,7 +462,10 @@ func (bl Builder) buildFactKeyUpdater(fact currency.KeyUpdaterFact) (Hal, error)
 		ks = k
 	}
 
-	nfact := currency.NewKeyUpdaterFact(token, fact.Target(), ks, fact.Currency())
+	nfact := currency.NewKeyUpdaterFact(token, fact.Target(), ks, fact.Currency()).
+		SetFeePayer(fact.FeePayer()).
+		SetSequence(fact.Sequence()).
+		SetValidUntil(fact.ValidUntilHeight())
 	if err := bl.isValidFactKeyUpdater(nfact); err != nil {
 		return nil, err
 	}
@@ -286,7 +500,10 @@ func (bl Builder) buildFactTransfers(fact currency.TransfersFact) (Hal, error) {
 		token = t
 	}
 
-	nfact := currency.NewTransfersFact(token, fact.Sender(), fact.Items())
+	nfact := currency.NewTransfersFact(token, fact.Sender(), fact.Items()).
+		SetFeePayer(fact.FeePayer()).
+		SetSequence(fact.Sequence()).
+		SetValidUntil(fact.ValidUntilHeight())
 	nfact = nfact.Rebulild()
 	if err := bl.isValidFactTransfers(nfact); err != nil {
 		return nil, err
@@ -384,6 +601,78 @@ func (bl Builder) buildFactCurrencyPolicyUpdater(fact currency.CurrencyPolicyUpd
 		AddExtras("signature_base", operation.NewBytesForFactSignature(nfact, bl.networkID)), nil
 }
 
+func (bl Builder) buildFactCurrencyMint(fact currency.CurrencyMintFact) (Hal, error) {
+	var token []byte
+	if t, err := bl.checkToken(fact.Token()); err != nil {
+		return nil, err
+	} else {
+		token = t
+	}
+
+	nfact := currency.NewCurrencyMintFact(token, fact.Items())
+	nfact = nfact.Rebuild()
+	if err := bl.isValidFactCurrencyMint(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	if op, err := currency.NewCurrencyMint(
+		nfact,
+		[]operation.FactSign{
+			operation.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	); err != nil {
+		return nil, err
+	} else {
+		hal = hal.SetInterface(op)
+	}
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", operation.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactBurn(fact currency.BurnFact) (Hal, error) {
+	var token []byte
+	if t, err := bl.checkToken(fact.Token()); err != nil {
+		return nil, err
+	} else {
+		token = t
+	}
+
+	nfact := currency.NewBurnFact(token, fact.Sender(), fact.Amounts())
+	nfact = nfact.Rebuild()
+	if err := bl.isValidFactBurn(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	if op, err := currency.NewBurn(
+		nfact,
+		[]operation.FactSign{
+			operation.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	); err != nil {
+		return nil, err
+	} else {
+		hal = hal.SetInterface(op)
+	}
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", operation.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
 func (bl Builder) isValidFactCreateAccounts(fact currency.CreateAccountsFact) error {
 	if err := fact.IsValid(nil); err != nil {
 		return err
@@ -480,86 +769,495 @@ func (bl Builder) isValidFactCurrencyPolicyUpdater(fact currency.CurrencyPolicyU
 	return nil
 }
 
-func (bl Builder) BuildOperation(b []byte) (Hal, error) {
-	var op operation.Operation
-	if hinter, err := bl.enc.DecodeByHint(b); err != nil {
-		return nil, err
-	} else if f, ok := hinter.(operation.Operation); !ok {
-		return nil, xerrors.Errorf("not operation.Operation, %T", hinter)
-	} else {
-		op = f
-	}
-
-	var hal Hal
-	if err := func() error {
-		var err error
-		switch t := op.(type) {
-		case currency.CreateAccounts:
-			hal, err = bl.buildCreateAccounts(t)
-		case currency.KeyUpdater:
-			hal, err = bl.buildKeyUpdater(t)
-		case currency.Transfers:
-			hal, err = bl.buildTransfers(t)
-		case currency.CurrencyRegister:
-			hal, err = bl.buildCurrencyRegister(t)
-		case currency.CurrencyPolicyUpdater:
-			hal, err = bl.buildCurrencyPolicyUpdater(t)
-		default:
-			return xerrors.Errorf("unknown operation.Operation, %T", t)
-		}
-
+func (bl Builder) isValidFactCurrencyMint(fact currency.CurrencyMintFact) error {
+	if err := fact.IsValid(nil); err != nil {
 		return err
-	}(); err != nil {
-		return nil, err
 	}
 
-	nop := hal.Interface().(operation.Operation)
-	for i := range nop.Signs() {
-		fs := nop.Signs()[i]
-		if fs.Signer().Equal(templatePublickey) {
-			return nil, xerrors.Errorf("Please set publickey; signer is same with template default")
-		}
+	if bytes.Equal(fact.Token(), templateToken) {
+		return xerrors.Errorf("Please set token; token same with template default")
+	}
 
-		if fs.Signature().Equal(templateSignature) {
-			return nil, xerrors.Errorf("Please set signature; signature same with template default")
+	for i := range fact.Items() {
+		if fact.Items()[i].Receiver().Equal(templateReceiver) {
+			return xerrors.Errorf("Please set receiver; receiver is same with template default")
 		}
 	}
 
-	return hal, nil
+	return nil
 }
 
-func (bl Builder) buildCreateAccounts(op currency.CreateAccounts) (Hal, error) {
-	fs := bl.updateFactSigns(op.Signs())
-
-	if nop, err := currency.NewCreateAccounts(op.Fact().(currency.CreateAccountsFact), fs, op.Memo); err != nil {
-		return nil, err
-	} else if err := nop.IsValid(bl.networkID); err != nil {
-		return nil, err
-	} else if err := bl.isValidFactCreateAccounts(nop.Fact().(currency.CreateAccountsFact)); err != nil {
+func (bl Builder) buildFactApprove(fact currency.ApproveFact) (Hal, error) {
+	var token []byte
+	if t, err := bl.checkToken(fact.Token()); err != nil {
 		return nil, err
 	} else {
-		return NewBaseHal(nop, HalLink{}), nil
+		token = t
 	}
-}
-
-func (bl Builder) buildKeyUpdater(op currency.KeyUpdater) (Hal, error) {
-	fs := bl.updateFactSigns(op.Signs())
 
-	if nop, err := currency.NewKeyUpdater(op.Fact().(currency.KeyUpdaterFact), fs, op.Memo); err != nil {
-		return nil, err
-	} else if err := nop.IsValid(bl.networkID); err != nil {
+	nfact := currency.NewApproveFact(token, fact.Owner(), fact.Spender(), fact.Amount())
+	nfact = nfact.Rebuild()
+	if err := bl.isValidFactApprove(nfact); err != nil {
 		return nil, err
-	} else if err := bl.isValidFactKeyUpdater(nop.Fact().(currency.KeyUpdaterFact)); err != nil {
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	if op, err := currency.NewApprove(
+		nfact,
+		[]operation.FactSign{
+			operation.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	); err != nil {
 		return nil, err
 	} else {
-		return NewBaseHal(nop, HalLink{}), nil
+		hal = hal.SetInterface(op)
 	}
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", operation.NewBytesForFactSignature(nfact, bl.networkID)), nil
 }
 
-func (bl Builder) buildTransfers(op currency.Transfers) (Hal, error) {
-	fs := bl.updateFactSigns(op.Signs())
+func (bl Builder) buildFactTransferFrom(fact currency.TransferFromFact) (Hal, error) {
+	var token []byte
+	if t, err := bl.checkToken(fact.Token()); err != nil {
+		return nil, err
+	} else {
+		token = t
+	}
 
-	if nop, err := currency.NewTransfers(op.Fact().(currency.TransfersFact), fs, op.Memo); err != nil {
+	nfact := currency.NewTransferFromFact(token, fact.Sender(), fact.Owner(), fact.Receiver(), fact.Amount())
+	nfact = nfact.Rebuild()
+	if err := bl.isValidFactTransferFrom(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	if op, err := currency.NewTransferFrom(
+		nfact,
+		[]operation.FactSign{
+			operation.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	); err != nil {
+		return nil, err
+	} else {
+		hal = hal.SetInterface(op)
+	}
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", operation.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactLockTransfer(fact currency.LockTransferFact) (Hal, error) {
+	var token []byte
+	if t, err := bl.checkToken(fact.Token()); err != nil {
+		return nil, err
+	} else {
+		token = t
+	}
+
+	nfact := currency.NewLockTransferFact(
+		token, fact.Sender(), fact.Receiver(), fact.Amount(), fact.Hashlock(), fact.Expiry(),
+	)
+	nfact = nfact.Rebuild()
+	if err := bl.isValidFactLockTransfer(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	if op, err := currency.NewLockTransfer(
+		nfact,
+		[]operation.FactSign{
+			operation.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	); err != nil {
+		return nil, err
+	} else {
+		hal = hal.SetInterface(op)
+	}
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", operation.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactClaimTransfer(fact currency.ClaimTransferFact) (Hal, error) {
+	var token []byte
+	if t, err := bl.checkToken(fact.Token()); err != nil {
+		return nil, err
+	} else {
+		token = t
+	}
+
+	nfact := currency.NewClaimTransferFact(token, fact.Sender(), fact.Lock(), fact.Preimage())
+	if err := bl.isValidFactClaimTransfer(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	if op, err := currency.NewClaimTransfer(
+		nfact,
+		[]operation.FactSign{
+			operation.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	); err != nil {
+		return nil, err
+	} else {
+		hal = hal.SetInterface(op)
+	}
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", operation.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactRefundTransfer(fact currency.RefundTransferFact) (Hal, error) {
+	var token []byte
+	if t, err := bl.checkToken(fact.Token()); err != nil {
+		return nil, err
+	} else {
+		token = t
+	}
+
+	nfact := currency.NewRefundTransferFact(token, fact.Sender(), fact.Lock())
+	if err := bl.isValidFactRefundTransfer(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	if op, err := currency.NewRefundTransfer(
+		nfact,
+		[]operation.FactSign{
+			operation.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	); err != nil {
+		return nil, err
+	} else {
+		hal = hal.SetInterface(op)
+	}
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", operation.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactCreateVestingAccounts(fact currency.CreateVestingAccountsFact) (Hal, error) {
+	var token []byte
+	if t, err := bl.checkToken(fact.Token()); err != nil {
+		return nil, err
+	} else {
+		token = t
+	}
+
+	items := make([]currency.CreateVestingAccountsItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+		if ks, err := currency.NewKeys(item.Keys().Keys(), item.Keys().Threshold()); err != nil {
+			return nil, err
+		} else {
+			items[i] = currency.NewCreateVestingAccountsItem(ks, item.Amount(), item.Releases())
+		}
+	}
+
+	nfact := currency.NewCreateVestingAccountsFact(token, fact.Sender(), items)
+	if err := bl.isValidFactCreateVestingAccounts(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	if op, err := currency.NewCreateVestingAccounts(
+		nfact,
+		[]operation.FactSign{
+			operation.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	); err != nil {
+		return nil, err
+	} else {
+		hal = hal.SetInterface(op)
+	}
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", operation.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) isValidFactBurn(fact currency.BurnFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return xerrors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return xerrors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	return nil
+}
+
+func (bl Builder) isValidFactApprove(fact currency.ApproveFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return xerrors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Owner().Equal(templateSender) {
+		return xerrors.Errorf("Please set owner; owner is same with template default")
+	}
+
+	if fact.Spender().Equal(templateReceiver) {
+		return xerrors.Errorf("Please set spender; spender is same with template default")
+	}
+
+	return nil
+}
+
+func (bl Builder) isValidFactTransferFrom(fact currency.TransferFromFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return xerrors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return xerrors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	if fact.Owner().Equal(templateSender) {
+		return xerrors.Errorf("Please set owner; owner is same with template default")
+	}
+
+	if fact.Receiver().Equal(templateReceiver) {
+		return xerrors.Errorf("Please set receiver; receiver is same with template default")
+	}
+
+	return nil
+}
+
+func (bl Builder) isValidFactLockTransfer(fact currency.LockTransferFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return xerrors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return xerrors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	if fact.Receiver().Equal(templateReceiver) {
+		return xerrors.Errorf("Please set receiver; receiver is same with template default")
+	}
+
+	if bytes.Equal(fact.Hashlock(), templateHashlock) {
+		return xerrors.Errorf("Please set hashlock; hashlock is same with template default")
+	}
+
+	return nil
+}
+
+func (bl Builder) isValidFactClaimTransfer(fact currency.ClaimTransferFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return xerrors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateReceiver) {
+		return xerrors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	if fact.Lock().Equal(templateLock) {
+		return xerrors.Errorf("Please set lock; lock is same with template default")
+	}
+
+	if bytes.Equal(fact.Preimage(), templatePreimage) {
+		return xerrors.Errorf("Please set preimage; preimage is same with template default")
+	}
+
+	return nil
+}
+
+func (bl Builder) isValidFactRefundTransfer(fact currency.RefundTransferFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return xerrors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return xerrors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	if fact.Lock().Equal(templateLock) {
+		return xerrors.Errorf("Please set lock; lock is same with template default")
+	}
+
+	return nil
+}
+
+func (bl Builder) isValidFactCreateVestingAccounts(fact currency.CreateVestingAccountsFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return xerrors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return xerrors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if _, same := fact.Items()[i].Keys().Key(templatePublickey); same {
+			return xerrors.Errorf("Please set key; key is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (bl Builder) BuildOperation(b []byte) (Hal, error) {
+	var op operation.Operation
+	if hinter, err := bl.enc.DecodeByHint(b); err != nil {
+		return nil, err
+	} else if f, ok := hinter.(operation.Operation); !ok {
+		return nil, xerrors.Errorf("not operation.Operation, %T", hinter)
+	} else {
+		op = f
+	}
+
+	var hal Hal
+	if err := func() error {
+		var err error
+		switch t := op.(type) {
+		case currency.CreateAccounts:
+			hal, err = bl.buildCreateAccounts(t)
+		case currency.KeyUpdater:
+			hal, err = bl.buildKeyUpdater(t)
+		case currency.Transfers:
+			hal, err = bl.buildTransfers(t)
+		case currency.CurrencyRegister:
+			hal, err = bl.buildCurrencyRegister(t)
+		case currency.CurrencyPolicyUpdater:
+			hal, err = bl.buildCurrencyPolicyUpdater(t)
+		case currency.CurrencyMint:
+			hal, err = bl.buildCurrencyMint(t)
+		case currency.Burn:
+			hal, err = bl.buildBurn(t)
+		case currency.Approve:
+			hal, err = bl.buildApprove(t)
+		case currency.TransferFrom:
+			hal, err = bl.buildTransferFrom(t)
+		case currency.LockTransfer:
+			hal, err = bl.buildLockTransfer(t)
+		case currency.ClaimTransfer:
+			hal, err = bl.buildClaimTransfer(t)
+		case currency.RefundTransfer:
+			hal, err = bl.buildRefundTransfer(t)
+		case currency.CreateVestingAccounts:
+			hal, err = bl.buildCreateVestingAccounts(t)
+		default:
+			return xerrors.Errorf("unknown operation.Operation, %T", t)
+		}
+
+		return err
+	}(); err != nil {
+		return nil, err
+	}
+
+	nop := hal.Interface().(operation.Operation)
+	for i := range nop.Signs() {
+		fs := nop.Signs()[i]
+		if fs.Signer().Equal(templatePublickey) {
+			return nil, xerrors.Errorf("Please set publickey; signer is same with template default")
+		}
+
+		if fs.Signature().Equal(templateSignature) {
+			return nil, xerrors.Errorf("Please set signature; signature same with template default")
+		}
+	}
+
+	return hal, nil
+}
+
+func (bl Builder) buildCreateAccounts(op currency.CreateAccounts) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := currency.NewCreateAccounts(op.Fact().(currency.CreateAccountsFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactCreateAccounts(nop.Fact().(currency.CreateAccountsFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildKeyUpdater(op currency.KeyUpdater) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := currency.NewKeyUpdater(op.Fact().(currency.KeyUpdaterFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactKeyUpdater(nop.Fact().(currency.KeyUpdaterFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildTransfers(op currency.Transfers) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := currency.NewTransfers(op.Fact().(currency.TransfersFact), fs, op.Memo); err != nil {
 		return nil, err
 	} else if err := nop.IsValid(bl.networkID); err != nil {
 		return nil, err
@@ -602,6 +1300,120 @@ func (bl Builder) buildCurrencyPolicyUpdater(op currency.CurrencyPolicyUpdater)
 	}
 }
 
+func (bl Builder) buildCurrencyMint(op currency.CurrencyMint) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := currency.NewCurrencyMint(op.Fact().(currency.CurrencyMintFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactCurrencyMint(nop.Fact().(currency.CurrencyMintFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildBurn(op currency.Burn) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := currency.NewBurn(op.Fact().(currency.BurnFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactBurn(nop.Fact().(currency.BurnFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildApprove(op currency.Approve) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := currency.NewApprove(op.Fact().(currency.ApproveFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactApprove(nop.Fact().(currency.ApproveFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildTransferFrom(op currency.TransferFrom) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := currency.NewTransferFrom(op.Fact().(currency.TransferFromFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactTransferFrom(nop.Fact().(currency.TransferFromFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildLockTransfer(op currency.LockTransfer) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := currency.NewLockTransfer(op.Fact().(currency.LockTransferFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactLockTransfer(nop.Fact().(currency.LockTransferFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildClaimTransfer(op currency.ClaimTransfer) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := currency.NewClaimTransfer(op.Fact().(currency.ClaimTransferFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactClaimTransfer(nop.Fact().(currency.ClaimTransferFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildRefundTransfer(op currency.RefundTransfer) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := currency.NewRefundTransfer(op.Fact().(currency.RefundTransferFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactRefundTransfer(nop.Fact().(currency.RefundTransferFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildCreateVestingAccounts(op currency.CreateVestingAccounts) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := currency.NewCreateVestingAccounts(
+		op.Fact().(currency.CreateVestingAccountsFact), fs, op.Memo,
+	); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactCreateVestingAccounts(nop.Fact().(currency.CreateVestingAccountsFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
 // checkToken checks token is valid; empty token will be updated with current
 // time.
 func (bl Builder) checkToken(token []byte) ([]byte, error) {
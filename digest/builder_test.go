@@ -4,12 +4,15 @@ package digest
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/util"
@@ -377,6 +380,225 @@ func (t *testBuilder) TestBuildFactCurrencyPolicyUpdater() {
 	_ = t.buildOperation(uop, sb.([]byte))
 }
 
+func (t *testBuilder) TestFactTemplateCurrencyMint() {
+	bl := NewBuilder(t.JSONEnc, t.networkID)
+
+	hal, err := bl.FactTemplate(currency.CurrencyMint{}.Hint())
+	t.NoError(err)
+	t.NotEmpty(hal.Extras())
+
+	b, err := t.JSONEnc.Marshal(hal)
+	t.NoError(err)
+	uhal := t.decodeHal(b)
+
+	t.IsType(currency.CurrencyMintFact{}, uhal.Interface())
+}
+
+func (t *testBuilder) TestBuildFactCurrencyMint() {
+	bl := NewBuilder(t.JSONEnc, t.networkID)
+
+	hal, err := bl.FactTemplate(currency.CurrencyMint{}.Hint())
+	t.NoError(err)
+
+	b, err := t.JSONEnc.Marshal(hal)
+	t.NoError(err)
+	rhal := t.decodeHal(b)
+
+	templateTokenEncoded := base64.StdEncoding.EncodeToString(templateToken)
+
+	newReceiver := currency.Address("new-father")
+	newBig := currency.NewBig(99)
+	newToken := util.UUID().Bytes()
+	newTokenEncoded := base64.StdEncoding.EncodeToString(newToken)
+	newCurrencyID := currency.CurrencyID("XXX")
+
+	b = bytes.ReplaceAll(rhal.RawInterface(), []byte(templateReceiver.String()), []byte(newReceiver.String()))
+	b = bytes.ReplaceAll(b, []byte(templateBig.String()), []byte(newBig.String()))
+	b = bytes.ReplaceAll(b, []byte(templateTokenEncoded), []byte(newTokenEncoded))
+	b = bytes.ReplaceAll(b, []byte(templateCurrencyID), newCurrencyID.Bytes())
+
+	uhal, err := bl.BuildFact(b)
+	t.NoError(err)
+
+	uop, ok := uhal.Interface().(currency.CurrencyMint)
+	t.True(ok)
+	err = uop.IsValid(nil)
+	t.Contains(err.Error(), "malformed signature")
+
+	ufact := uop.Fact().(currency.CurrencyMintFact)
+
+	t.Equal(ufact.Token(), newToken)
+	t.Equal(1, len(ufact.Items()))
+	t.True(ufact.Items()[0].Receiver().Equal(newReceiver))
+	t.Equal(newBig, ufact.Items()[0].Amount().Big())
+	t.Equal(newCurrencyID, ufact.Items()[0].Amount().Currency())
+
+	sb, found := uhal.Extras()["signature_base"]
+	t.True(found)
+
+	_ = t.buildOperation(uop, sb.([]byte))
+}
+
+func (t *testBuilder) TestFactTemplateBurn() {
+	bl := NewBuilder(t.JSONEnc, t.networkID)
+
+	hal, err := bl.FactTemplate(currency.Burn{}.Hint())
+	t.NoError(err)
+	t.NotEmpty(hal.Extras())
+
+	b, err := t.JSONEnc.Marshal(hal)
+	t.NoError(err)
+	uhal := t.decodeHal(b)
+
+	t.IsType(currency.BurnFact{}, uhal.Interface())
+}
+
+func (t *testBuilder) TestBuildFactBurn() {
+	bl := NewBuilder(t.JSONEnc, t.networkID)
+
+	hal, err := bl.FactTemplate(currency.Burn{}.Hint())
+	t.NoError(err)
+
+	b, err := t.JSONEnc.Marshal(hal)
+	t.NoError(err)
+	rhal := t.decodeHal(b)
+
+	templateTokenEncoded := base64.StdEncoding.EncodeToString(templateToken)
+
+	newSender := currency.Address("new-mother")
+	newBig := currency.NewBig(99)
+	newToken := util.UUID().Bytes()
+	newTokenEncoded := base64.StdEncoding.EncodeToString(newToken)
+	newCurrencyID := currency.CurrencyID("XXX")
+
+	b = bytes.ReplaceAll(rhal.RawInterface(), []byte(templateSender.String()), []byte(newSender.String()))
+	b = bytes.ReplaceAll(b, []byte(templateBig.String()), []byte(newBig.String()))
+	b = bytes.ReplaceAll(b, []byte(templateTokenEncoded), []byte(newTokenEncoded))
+	b = bytes.ReplaceAll(b, []byte(templateCurrencyID), newCurrencyID.Bytes())
+
+	uhal, err := bl.BuildFact(b)
+	t.NoError(err)
+
+	uop, ok := uhal.Interface().(currency.Burn)
+	t.True(ok)
+	err = uop.IsValid(nil)
+	t.Contains(err.Error(), "malformed signature")
+
+	ufact := uop.Fact().(currency.BurnFact)
+
+	t.Equal(ufact.Token(), newToken)
+	t.True(ufact.Sender().Equal(newSender))
+	t.Equal(1, len(ufact.Amounts()))
+	t.Equal(newBig, ufact.Amounts()[0].Big())
+	t.Equal(newCurrencyID, ufact.Amounts()[0].Currency())
+
+	sb, found := uhal.Extras()["signature_base"]
+	t.True(found)
+
+	_ = t.buildOperation(uop, sb.([]byte))
+}
+
+func (t *testBuilder) TestBuildFactApprove() {
+	bl := NewBuilder(t.JSONEnc, t.networkID)
+
+	hal, err := bl.FactTemplate(currency.Approve{}.Hint())
+	t.NoError(err)
+	t.NotEmpty(hal.Extras())
+
+	b, err := t.JSONEnc.Marshal(hal)
+	t.NoError(err)
+	rhal := t.decodeHal(b)
+
+	templateTokenEncoded := base64.StdEncoding.EncodeToString(templateToken)
+
+	newOwner := currency.Address("new-mother")
+	newSpender := currency.Address("new-father")
+	newBig := currency.NewBig(99)
+	newToken := util.UUID().Bytes()
+	newTokenEncoded := base64.StdEncoding.EncodeToString(newToken)
+
+	b = bytes.ReplaceAll(rhal.RawInterface(), []byte(templateSender.String()), []byte(newOwner.String()))
+	b = bytes.ReplaceAll(b, []byte(templateReceiver.String()), []byte(newSpender.String()))
+	b = bytes.ReplaceAll(b, []byte(templateBig.String()), []byte(newBig.String()))
+	b = bytes.ReplaceAll(b, []byte(templateTokenEncoded), []byte(newTokenEncoded))
+
+	uhal, err := bl.BuildFact(b)
+	t.NoError(err)
+
+	uop, ok := uhal.Interface().(currency.Approve)
+	t.True(ok)
+	err = uop.IsValid(nil)
+	t.Contains(err.Error(), "malformed signature")
+
+	ufact := uop.Fact().(currency.ApproveFact)
+
+	t.Equal(ufact.Token(), newToken)
+	t.True(ufact.Owner().Equal(newOwner))
+	t.True(ufact.Spender().Equal(newSpender))
+	t.Equal(newBig, ufact.Amount().Big())
+
+	sb, found := uhal.Extras()["signature_base"]
+	t.True(found)
+
+	_ = t.buildOperation(uop, sb.([]byte))
+}
+
+func (t *testBuilder) TestBuildFactLockTransfer() {
+	bl := NewBuilder(t.JSONEnc, t.networkID)
+
+	hal, err := bl.FactTemplate(currency.LockTransfer{}.Hint())
+	t.NoError(err)
+	t.NotEmpty(hal.Extras())
+
+	b, err := t.JSONEnc.Marshal(hal)
+	t.NoError(err)
+	rhal := t.decodeHal(b)
+
+	templateTokenEncoded := base64.StdEncoding.EncodeToString(templateToken)
+	templateHashlockEncoded := base64.StdEncoding.EncodeToString(templateHashlock)
+
+	newSender := currency.Address("new-mother")
+	newReceiver := currency.Address("new-father")
+	newBig := currency.NewBig(99)
+	newToken := util.UUID().Bytes()
+	newTokenEncoded := base64.StdEncoding.EncodeToString(newToken)
+	newHashlock := sha256.Sum256(util.UUID().Bytes())
+	newHashlockEncoded := base64.StdEncoding.EncodeToString(newHashlock[:])
+	newExpiry := base.Height(40)
+
+	b = bytes.ReplaceAll(rhal.RawInterface(), []byte(templateSender.String()), []byte(newSender.String()))
+	b = bytes.ReplaceAll(b, []byte(templateReceiver.String()), []byte(newReceiver.String()))
+	b = bytes.ReplaceAll(b, []byte(templateBig.String()), []byte(newBig.String()))
+	b = bytes.ReplaceAll(b, []byte(templateTokenEncoded), []byte(newTokenEncoded))
+	b = bytes.ReplaceAll(b, []byte(templateHashlockEncoded), []byte(newHashlockEncoded))
+	b = bytes.ReplaceAll(b,
+		[]byte(fmt.Sprintf(`"expiry":%d`, templateExpiry)),
+		[]byte(fmt.Sprintf(`"expiry":%d`, newExpiry)),
+	)
+
+	uhal, err := bl.BuildFact(b)
+	t.NoError(err)
+
+	uop, ok := uhal.Interface().(currency.LockTransfer)
+	t.True(ok)
+	err = uop.IsValid(nil)
+	t.Contains(err.Error(), "malformed signature")
+
+	ufact := uop.Fact().(currency.LockTransferFact)
+
+	t.Equal(ufact.Token(), newToken)
+	t.True(ufact.Sender().Equal(newSender))
+	t.True(ufact.Receiver().Equal(newReceiver))
+	t.Equal(newBig, ufact.Amount().Big())
+	t.Equal(newHashlock[:], ufact.Hashlock())
+	t.Equal(newExpiry, ufact.Expiry())
+
+	sb, found := uhal.Extras()["signature_base"]
+	t.True(found)
+
+	_ = t.buildOperation(uop, sb.([]byte))
+}
+
 func (t *testBuilder) buildOperation(op operation.Operation, sb []byte) operation.Operation {
 	priv := key.MustNewBTCPrivatekey()
 	sig, err := priv.Sign(sb)
@@ -27,7 +27,10 @@ func LoadCurrenciesFromStorage(
 	var keys []string
 	for {
 		filter := util.NewBSONFilter("key", bson.M{
-			"$regex": fmt.Sprintf(`^%s`, regexp.QuoteMeta(currency.StateKeyCurrencyDesignPrefix)),
+			"$regex": fmt.Sprintf(`^(%s|%s)`,
+				regexp.QuoteMeta(currency.StateKeyCurrencyDesignPrefix),
+				regexp.QuoteMeta(currency.StateKeyCurrencySupplyPrefix),
+			),
 		}).Add("height", bson.M{"$gte": height})
 
 		var q primitive.D
@@ -51,6 +51,111 @@ func loadAccountValue(decoder func(interface{}) error, encs *encoder.Encoders) (
 	}
 }
 
+func loadAllowance(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, xerrors.Errorf("not state.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func loadLock(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, xerrors.Errorf("not state.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func loadVesting(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, xerrors.Errorf("not state.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func loadFreeze(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, xerrors.Errorf("not state.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func loadSequence(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, xerrors.Errorf("not state.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func loadProposal(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, xerrors.Errorf("not state.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func loadAlias(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, xerrors.Errorf("not state.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
 func loadBalance(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
 	var b bson.Raw
 	if err := decoder(&b); err != nil {
@@ -65,3 +170,18 @@ func loadBalance(decoder func(interface{}) error, encs *encoder.Encoders) (state
 		return st, nil
 	}
 }
+
+func loadScheduledTransfer(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, xerrors.Errorf("not state.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
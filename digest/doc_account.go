@@ -81,3 +81,335 @@ func (doc BalanceDoc) MarshalBSON() ([]byte, error) {
 
 	return bsonenc.Marshal(m)
 }
+
+type AllowanceDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	al currency.Allowance
+}
+
+// NewAllowanceDoc gets the State of Allowance
+func NewAllowanceDoc(st state.State, enc encoder.Encoder) (AllowanceDoc, error) {
+	var al currency.Allowance
+	if i, err := currency.StateAllowanceValue(st); err != nil {
+		return AllowanceDoc{}, xerrors.Errorf("AllowanceDoc needs Allowance state: %w", err)
+	} else {
+		al = i
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return AllowanceDoc{}, err
+	}
+
+	return AllowanceDoc{
+		BaseDoc: b,
+		st:      st,
+		al:      al,
+	}, nil
+}
+
+func (doc AllowanceDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["key"] = doc.st.Key()
+	m["address"] = currency.StateAddressKeyPrefix(doc.al.Owner())
+	m["spender"] = currency.StateAddressKeyPrefix(doc.al.Spender())
+	m["currency"] = doc.al.Currency().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type LockDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	lk currency.Lock
+}
+
+// NewLockDoc gets the State of Lock
+func NewLockDoc(st state.State, enc encoder.Encoder) (LockDoc, error) {
+	var lk currency.Lock
+	if i, err := currency.StateLockValue(st); err != nil {
+		return LockDoc{}, xerrors.Errorf("LockDoc needs Lock state: %w", err)
+	} else {
+		lk = i
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return LockDoc{}, err
+	}
+
+	return LockDoc{
+		BaseDoc: b,
+		st:      st,
+		lk:      lk,
+	}, nil
+}
+
+func (doc LockDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["key"] = doc.st.Key()
+	m["addresses"] = []string{
+		currency.StateAddressKeyPrefix(doc.lk.Sender()),
+		currency.StateAddressKeyPrefix(doc.lk.Receiver()),
+	}
+	m["status"] = doc.lk.Status().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type ScheduledTransferDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	sc currency.ScheduledTransfer
+}
+
+// NewScheduledTransferDoc gets the State of ScheduledTransfer
+func NewScheduledTransferDoc(st state.State, enc encoder.Encoder) (ScheduledTransferDoc, error) {
+	var sc currency.ScheduledTransfer
+	if i, err := currency.StateScheduledTransferValue(st); err != nil {
+		return ScheduledTransferDoc{}, xerrors.Errorf("ScheduledTransferDoc needs ScheduledTransfer state: %w", err)
+	} else {
+		sc = i
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return ScheduledTransferDoc{}, err
+	}
+
+	return ScheduledTransferDoc{
+		BaseDoc: b,
+		st:      st,
+		sc:      sc,
+	}, nil
+}
+
+func (doc ScheduledTransferDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["key"] = doc.st.Key()
+	m["addresses"] = []string{
+		currency.StateAddressKeyPrefix(doc.sc.Sender()),
+		currency.StateAddressKeyPrefix(doc.sc.Receiver()),
+	}
+	m["status"] = doc.sc.Status().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type VestingDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	vs currency.Vesting
+}
+
+// NewVestingDoc gets the State of Vesting
+func NewVestingDoc(st state.State, enc encoder.Encoder) (VestingDoc, error) {
+	var vs currency.Vesting
+	if i, err := currency.StateVestingValue(st); err != nil {
+		return VestingDoc{}, xerrors.Errorf("VestingDoc needs Vesting state: %w", err)
+	} else {
+		vs = i
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return VestingDoc{}, err
+	}
+
+	return VestingDoc{
+		BaseDoc: b,
+		st:      st,
+		vs:      vs,
+	}, nil
+}
+
+func (doc VestingDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	address := doc.st.Key()[:len(doc.st.Key())-len(currency.StateKeyVestingSuffix)-len(doc.vs.Currency())-1]
+	m["address"] = address
+	m["currency"] = doc.vs.Currency().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type FreezeDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	fz currency.Freeze
+}
+
+// NewFreezeDoc gets the State of Freeze
+func NewFreezeDoc(st state.State, enc encoder.Encoder) (FreezeDoc, error) {
+	var fz currency.Freeze
+	if i, err := currency.StateFreezeValue(st); err != nil {
+		return FreezeDoc{}, xerrors.Errorf("FreezeDoc needs Freeze state: %w", err)
+	} else {
+		fz = i
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return FreezeDoc{}, err
+	}
+
+	return FreezeDoc{
+		BaseDoc: b,
+		st:      st,
+		fz:      fz,
+	}, nil
+}
+
+func (doc FreezeDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["address"] = currency.StateAddressKeyPrefix(doc.fz.Target())
+	m["currency"] = doc.fz.Currency().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type SequenceDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	sq currency.Sequence
+}
+
+// NewSequenceDoc gets the State of Sequence
+func NewSequenceDoc(st state.State, enc encoder.Encoder) (SequenceDoc, error) {
+	var sq currency.Sequence
+	if i, err := currency.StateSequenceValue(st); err != nil {
+		return SequenceDoc{}, xerrors.Errorf("SequenceDoc needs Sequence state: %w", err)
+	} else {
+		sq = i
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return SequenceDoc{}, err
+	}
+
+	return SequenceDoc{
+		BaseDoc: b,
+		st:      st,
+		sq:      sq,
+	}, nil
+}
+
+func (doc SequenceDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["address"] = currency.StateAddressKeyPrefix(doc.sq.Account())
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type ProposalDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	pr currency.Proposal
+}
+
+// NewProposalDoc gets the State of Proposal
+func NewProposalDoc(st state.State, enc encoder.Encoder) (ProposalDoc, error) {
+	var pr currency.Proposal
+	if i, err := currency.StateProposalValue(st); err != nil {
+		return ProposalDoc{}, xerrors.Errorf("ProposalDoc needs Proposal state: %w", err)
+	} else {
+		pr = i
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return ProposalDoc{}, err
+	}
+
+	return ProposalDoc{
+		BaseDoc: b,
+		st:      st,
+		pr:      pr,
+	}, nil
+}
+
+func (doc ProposalDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["key"] = doc.st.Key()
+	m["address"] = currency.StateAddressKeyPrefix(doc.pr.Account())
+	m["status"] = doc.pr.Status().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type AliasDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	aa currency.AccountAlias
+}
+
+// NewAliasDoc gets the State of AccountAlias by alias
+func NewAliasDoc(st state.State, enc encoder.Encoder) (AliasDoc, error) {
+	var aa currency.AccountAlias
+	if i, err := currency.StateAccountAliasValue(st); err != nil {
+		return AliasDoc{}, xerrors.Errorf("AliasDoc needs AccountAlias state: %w", err)
+	} else {
+		aa = i
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return AliasDoc{}, err
+	}
+
+	return AliasDoc{
+		BaseDoc: b,
+		st:      st,
+		aa:      aa,
+	}, nil
+}
+
+func (doc AliasDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["alias"] = doc.aa.Alias().Raw()
+	m["address"] = currency.StateAddressKeyPrefix(doc.aa.Account())
+	m["status"] = doc.aa.Status().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
@@ -54,6 +54,27 @@ func NewOperationDoc(
 	}, nil
 }
 
+// addAddresses indexes the operation by the given addresses, like the account
+// of alias in fact, as well.
+func (doc OperationDoc) addAddresses(as ...base.Address) OperationDoc {
+	founds := map[string]struct{}{}
+	for i := range doc.addresses {
+		founds[doc.addresses[i]] = struct{}{}
+	}
+
+	for i := range as {
+		k := currency.StateAddressKeyPrefix(as[i])
+		if _, found := founds[k]; found {
+			continue
+		}
+
+		founds[k] = struct{}{}
+		doc.addresses = append(doc.addresses, k)
+	}
+
+	return doc
+}
+
 func (doc OperationDoc) MarshalBSON() ([]byte, error) {
 	m, err := doc.BaseDoc.M()
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util/valuehash"
 	"golang.org/x/xerrors"
@@ -20,13 +21,25 @@ func (hd *Handlers) handleAccount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// NOTE the alias can be transferred to the other account, so the account by
+	// alias is cached shortly.
+	expire := time.Hour * 30
+
 	var address base.Address
 	if a, err := base.DecodeAddressFromString(hd.enc, strings.TrimSpace(mux.Vars(r)["address"])); err != nil {
 		hd.problemWithError(w, err, http.StatusBadRequest)
 
+		return
+	} else if i, status, err := hd.resolveAlias(a); err != nil {
+		hd.problemWithError(w, err, status)
+
 		return
 	} else {
-		address = a
+		if _, ok := a.(currency.Alias); ok {
+			expire = time.Second * 2
+		}
+
+		address = i
 	}
 
 	switch va, found, err := hd.storage.Account(address); {
@@ -45,7 +58,7 @@ func (hd *Handlers) handleAccount(w http.ResponseWriter, r *http.Request) {
 			return
 		} else {
 			hd.writeHal(w, hal, http.StatusOK)
-			hd.writeCache(w, cacheKeyPath(r), time.Hour*30)
+			hd.writeCache(w, cacheKeyPath(r), expire)
 		}
 	}
 }
@@ -68,6 +81,30 @@ func (hd *Handlers) buildAccountHal(va AccountValue) (Hal, error) {
 			AddLink("operations:{offset,reverse}", NewHalLink(h+"?offset={offset}&reverse=1", nil).SetTemplated())
 	}
 
+	if h, err := hd.combineURL(HandlerPathAccountAllowances, "address", hinted); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("allowances", NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccountLocks, "address", hinted); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("locks", NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccountProposals, "address", hinted); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("proposals", NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccountSchedules, "address", hinted); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("schedules", NewHalLink(h, nil))
+	}
+
 	if h, err := hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String()); err != nil {
 		return nil, err
 	} else {
@@ -96,9 +133,13 @@ func (hd *Handlers) handleAccountOperations(w http.ResponseWriter, r *http.Reque
 	if a, err := base.DecodeAddressFromString(hd.enc, strings.TrimSpace(mux.Vars(r)["address"])); err != nil {
 		hd.problemWithError(w, err, http.StatusBadRequest)
 
+		return
+	} else if i, status, err := hd.resolveAlias(a); err != nil {
+		hd.problemWithError(w, err, status)
+
 		return
 	} else {
-		address = a
+		address = i
 	}
 
 	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
@@ -197,3 +238,255 @@ func (hd *Handlers) buildAccountOperationsHal(
 
 	return hal, nil
 }
+
+func (hd *Handlers) handleAccountAllowances(w http.ResponseWriter, r *http.Request) {
+	if err := loadFromCache(hd.cache, cacheKeyPath(r), w); err != nil {
+		hd.Log().Verbose().Err(err).Msg("failed to load cache")
+	} else {
+		hd.Log().Verbose().Msg("loaded from cache")
+
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(hd.enc, strings.TrimSpace(mux.Vars(r)["address"])); err != nil {
+		hd.problemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if i, status, err := hd.resolveAlias(a); err != nil {
+		hd.problemWithError(w, err, status)
+
+		return
+	} else {
+		address = i
+	}
+
+	var als []currency.Allowance
+	switch i, err := hd.storage.Allowances(address); {
+	case err != nil:
+		hd.problemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	case len(i) < 1:
+		hd.problemWithError(w, xerrors.Errorf("allowances not found"), http.StatusNotFound)
+
+		return
+	default:
+		als = i
+	}
+
+	if hal, err := hd.buildAccountAllowancesHal(address, als); err != nil {
+		hd.problemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	} else {
+		hd.writeHal(w, hal, http.StatusOK)
+		hd.writeCache(w, cacheKeyPath(r), time.Second*2)
+	}
+}
+
+func (hd *Handlers) buildAccountAllowancesHal(address base.Address, als []currency.Allowance) (Hal, error) {
+	var hal Hal
+	if h, err := hd.combineURL(HandlerPathAccountAllowances, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = NewBaseHal(als, NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccount, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("account", NewHalLink(h, nil))
+	}
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleAccountLocks(w http.ResponseWriter, r *http.Request) {
+	if err := loadFromCache(hd.cache, cacheKeyPath(r), w); err != nil {
+		hd.Log().Verbose().Err(err).Msg("failed to load cache")
+	} else {
+		hd.Log().Verbose().Msg("loaded from cache")
+
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(hd.enc, strings.TrimSpace(mux.Vars(r)["address"])); err != nil {
+		hd.problemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if i, status, err := hd.resolveAlias(a); err != nil {
+		hd.problemWithError(w, err, status)
+
+		return
+	} else {
+		address = i
+	}
+
+	var lks []currency.Lock
+	switch i, err := hd.storage.Locks(address); {
+	case err != nil:
+		hd.problemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	case len(i) < 1:
+		hd.problemWithError(w, xerrors.Errorf("locks not found"), http.StatusNotFound)
+
+		return
+	default:
+		lks = i
+	}
+
+	if hal, err := hd.buildAccountLocksHal(address, lks); err != nil {
+		hd.problemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	} else {
+		hd.writeHal(w, hal, http.StatusOK)
+		hd.writeCache(w, cacheKeyPath(r), time.Second*2)
+	}
+}
+
+func (hd *Handlers) buildAccountLocksHal(address base.Address, lks []currency.Lock) (Hal, error) {
+	var hal Hal
+	if h, err := hd.combineURL(HandlerPathAccountLocks, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = NewBaseHal(lks, NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccount, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("account", NewHalLink(h, nil))
+	}
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleAccountProposals(w http.ResponseWriter, r *http.Request) {
+	if err := loadFromCache(hd.cache, cacheKeyPath(r), w); err != nil {
+		hd.Log().Verbose().Err(err).Msg("failed to load cache")
+	} else {
+		hd.Log().Verbose().Msg("loaded from cache")
+
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(hd.enc, strings.TrimSpace(mux.Vars(r)["address"])); err != nil {
+		hd.problemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if i, status, err := hd.resolveAlias(a); err != nil {
+		hd.problemWithError(w, err, status)
+
+		return
+	} else {
+		address = i
+	}
+
+	var prs []currency.Proposal
+	switch i, err := hd.storage.Proposals(address); {
+	case err != nil:
+		hd.problemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	case len(i) < 1:
+		hd.problemWithError(w, xerrors.Errorf("proposals not found"), http.StatusNotFound)
+
+		return
+	default:
+		prs = i
+	}
+
+	if hal, err := hd.buildAccountProposalsHal(address, prs); err != nil {
+		hd.problemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	} else {
+		hd.writeHal(w, hal, http.StatusOK)
+		hd.writeCache(w, cacheKeyPath(r), time.Second*2)
+	}
+}
+
+func (hd *Handlers) buildAccountProposalsHal(address base.Address, prs []currency.Proposal) (Hal, error) {
+	var hal Hal
+	if h, err := hd.combineURL(HandlerPathAccountProposals, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = NewBaseHal(prs, NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccount, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("account", NewHalLink(h, nil))
+	}
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleAccountSchedules(w http.ResponseWriter, r *http.Request) {
+	if err := loadFromCache(hd.cache, cacheKeyPath(r), w); err != nil {
+		hd.Log().Verbose().Err(err).Msg("failed to load cache")
+	} else {
+		hd.Log().Verbose().Msg("loaded from cache")
+
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(hd.enc, strings.TrimSpace(mux.Vars(r)["address"])); err != nil {
+		hd.problemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if i, status, err := hd.resolveAlias(a); err != nil {
+		hd.problemWithError(w, err, status)
+
+		return
+	} else {
+		address = i
+	}
+
+	var scs []currency.ScheduledTransfer
+	switch i, err := hd.storage.ScheduledTransfers(address); {
+	case err != nil:
+		hd.problemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	case len(i) < 1:
+		hd.problemWithError(w, xerrors.Errorf("scheduled transfers not found"), http.StatusNotFound)
+
+		return
+	default:
+		scs = i
+	}
+
+	if hal, err := hd.buildAccountSchedulesHal(address, scs); err != nil {
+		hd.problemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	} else {
+		hd.writeHal(w, hal, http.StatusOK)
+		hd.writeCache(w, cacheKeyPath(r), time.Second*2)
+	}
+}
+
+func (hd *Handlers) buildAccountSchedulesHal(address base.Address, scs []currency.ScheduledTransfer) (Hal, error) {
+	var hal Hal
+	if h, err := hd.combineURL(HandlerPathAccountSchedules, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = NewBaseHal(scs, NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccount, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("account", NewHalLink(h, nil))
+	}
+
+	return hal, nil
+}
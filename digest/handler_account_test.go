@@ -3,6 +3,8 @@
 package digest
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"io"
 	"sort"
@@ -10,9 +12,12 @@ import (
 
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/util"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
 	"github.com/spikeekips/mitum/util/localtime"
+	"github.com/spikeekips/mitum/util/valuehash"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -80,6 +85,151 @@ func (t *testHandlerAccount) TestAccountNotFound() {
 	t.Contains(problem.Error(), "account not found")
 }
 
+func (t *testHandlerAccount) TestAccountAllowances() {
+	st, _ := t.Storage()
+
+	ac := t.newAccount()
+	spender := t.newAccount()
+	revoked := t.newAccount()
+
+	al := currency.NewAllowance(ac.Address(), spender.Address(), currency.NewAmount(currency.NewBig(10), t.cid))
+	_ = t.insertAllowance(st, base.Height(33), al)
+
+	// NOTE the latest allowance is returned
+	al = al.WithBig(currency.NewBig(7))
+	_ = t.insertAllowance(st, base.Height(34), al)
+
+	// NOTE revoked allowance is excluded
+	_ = t.insertAllowance(st, base.Height(33),
+		currency.NewAllowance(ac.Address(), revoked.Address(), currency.NewAmount(currency.NewBig(3), t.cid)))
+	_ = t.insertAllowance(st, base.Height(34),
+		currency.NewAllowance(ac.Address(), revoked.Address(), currency.NewZeroAmount(t.cid)))
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAccountAllowances).URLPath("address", ac.Address().String())
+	t.NoError(err)
+
+	accountLink, err := handlers.router.Get(HandlerPathAccount).URLPath("address", ac.Address().String())
+	t.NoError(err)
+
+	w := t.requestOK(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	hal := t.loadHal(b)
+
+	t.Equal(self.String(), hal.Links()["self"].Href())
+	t.Equal(accountLink.Path, hal.Links()["account"].Href())
+
+	var hals []json.RawMessage
+	t.NoError(jsonenc.Unmarshal(hal.RawInterface(), &hals))
+	t.Equal(1, len(hals))
+
+	hinter, err := t.JSONEnc.DecodeByHint(hals[0])
+	t.NoError(err)
+	ual, ok := hinter.(currency.Allowance)
+	t.True(ok)
+
+	t.True(al.Owner().Equal(ual.Owner()))
+	t.True(al.Spender().Equal(ual.Spender()))
+	t.True(al.Amount().Equal(ual.Amount()))
+}
+
+func (t *testHandlerAccount) TestAccountAllowancesNotFound() {
+	st, _ := t.Storage()
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAccountAllowances).URLPath("address", t.newAccount().Address().String())
+	t.NoError(err)
+
+	w := t.request404(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	var problem Problem
+	t.NoError(jsonenc.Unmarshal(b, &problem))
+	t.Contains(problem.Error(), "allowances not found")
+}
+
+func (t *testHandlerAccount) TestAccountLocks() {
+	st, _ := t.Storage()
+
+	sender := t.newAccount()
+	receiver := t.newAccount()
+
+	preimage := util.UUID().Bytes()
+	hashlock := sha256.Sum256(preimage)
+
+	lk := currency.NewLock(
+		valuehash.RandomSHA256(),
+		sender.Address(),
+		receiver.Address(),
+		currency.NewAmount(currency.NewBig(10), t.cid),
+		hashlock[:],
+		base.Height(40),
+	)
+	_ = t.insertLock(st, base.Height(33), lk)
+
+	// NOTE the latest lock is returned
+	lk = lk.Claim(preimage)
+	_ = t.insertLock(st, base.Height(34), lk)
+
+	handlers := t.handlers(st, DummyCache{})
+
+	for _, a := range []base.Address{sender.Address(), receiver.Address()} {
+		self, err := handlers.router.Get(HandlerPathAccountLocks).URLPath("address", a.String())
+		t.NoError(err)
+
+		accountLink, err := handlers.router.Get(HandlerPathAccount).URLPath("address", a.String())
+		t.NoError(err)
+
+		w := t.requestOK(handlers, "GET", self.Path, nil)
+
+		b, err := io.ReadAll(w.Result().Body)
+		t.NoError(err)
+
+		hal := t.loadHal(b)
+
+		t.Equal(self.String(), hal.Links()["self"].Href())
+		t.Equal(accountLink.Path, hal.Links()["account"].Href())
+
+		var hals []json.RawMessage
+		t.NoError(jsonenc.Unmarshal(hal.RawInterface(), &hals))
+		t.Equal(1, len(hals))
+
+		hinter, err := t.JSONEnc.DecodeByHint(hals[0])
+		t.NoError(err)
+		ulk, ok := hinter.(currency.Lock)
+		t.True(ok)
+
+		t.True(lk.ID().Equal(ulk.ID()))
+		t.Equal(currency.LockStatusClaimed, ulk.Status())
+		t.Equal(preimage, ulk.Preimage())
+	}
+}
+
+func (t *testHandlerAccount) TestAccountLocksNotFound() {
+	st, _ := t.Storage()
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAccountLocks).URLPath("address", t.newAccount().Address().String())
+	t.NoError(err)
+
+	w := t.request404(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	var problem Problem
+	t.NoError(jsonenc.Unmarshal(b, &problem))
+	t.Contains(problem.Error(), "locks not found")
+}
+
 func (t *testHandlerAccount) TestAccountOperations() {
 	st, _ := t.Storage()
 
@@ -303,6 +453,282 @@ func (t *testHandlerAccount) TestAccountOperationsPagingOverOffset() {
 	t.Contains(problem.Error(), "operations not found")
 }
 
+func (t *testHandlerAccount) newProposal(sender base.Address) currency.Proposal {
+	fact := currency.NewTransfersFact(
+		util.UUID().Bytes(),
+		sender,
+		[]currency.TransfersItem{currency.NewTransfersItemSingleAmount(
+			t.newAccount().Address(),
+			currency.NewAmount(currency.NewBig(10), t.cid),
+		)},
+	).SetSequence(1)
+
+	priv := key.MustNewBTCPrivatekey()
+	sig, err := operation.NewFactSignature(priv, fact, nil)
+	t.NoError(err)
+
+	return currency.NewProposal(
+		valuehash.RandomSHA256(),
+		sender,
+		fact,
+		[]operation.FactSign{operation.NewBaseFactSign(priv.Publickey(), sig)},
+	)
+}
+
+func (t *testHandlerAccount) TestAccountProposals() {
+	st, _ := t.Storage()
+
+	ac := t.newAccount()
+
+	pending := t.newProposal(ac.Address())
+	_ = t.insertProposal(st, base.Height(33), pending)
+
+	// NOTE executed proposal is not returned
+	executed := t.newProposal(ac.Address())
+	_ = t.insertProposal(st, base.Height(33), executed)
+	_ = t.insertProposal(st, base.Height(34), executed.Execute())
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAccountProposals).URLPath("address", ac.Address().String())
+	t.NoError(err)
+
+	accountLink, err := handlers.router.Get(HandlerPathAccount).URLPath("address", ac.Address().String())
+	t.NoError(err)
+
+	w := t.requestOK(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	hal := t.loadHal(b)
+
+	t.Equal(self.String(), hal.Links()["self"].Href())
+	t.Equal(accountLink.Path, hal.Links()["account"].Href())
+
+	var hals []json.RawMessage
+	t.NoError(jsonenc.Unmarshal(hal.RawInterface(), &hals))
+	t.Equal(1, len(hals))
+
+	hinter, err := t.JSONEnc.DecodeByHint(hals[0])
+	t.NoError(err)
+	upr, ok := hinter.(currency.Proposal)
+	t.True(ok)
+
+	t.True(pending.ID().Equal(upr.ID()))
+	t.Equal(currency.ProposalStatusPending, upr.Status())
+	t.True(pending.Fact().Hash().Equal(upr.Fact().Hash()))
+}
+
+func (t *testHandlerAccount) TestAccountProposalsNotFound() {
+	st, _ := t.Storage()
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAccountProposals).URLPath("address", t.newAccount().Address().String())
+	t.NoError(err)
+
+	w := t.request404(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	var problem Problem
+	t.NoError(jsonenc.Unmarshal(b, &problem))
+	t.Contains(problem.Error(), "proposals not found")
+}
+
+func (t *testHandlerAccount) newScheduledTransfer(sender base.Address) currency.ScheduledTransfer {
+	return currency.NewScheduledTransfer(
+		valuehash.RandomSHA256(),
+		sender,
+		t.newAccount().Address(),
+		currency.NewAmount(currency.NewBig(10), t.cid),
+		base.Height(99),
+	)
+}
+
+func (t *testHandlerAccount) TestAccountSchedules() {
+	st, _ := t.Storage()
+
+	ac := t.newAccount()
+
+	pending := t.newScheduledTransfer(ac.Address())
+	_ = t.insertScheduledTransfer(st, base.Height(33), pending)
+
+	// NOTE cancelled scheduled transfer is not returned
+	cancelled := t.newScheduledTransfer(ac.Address())
+	_ = t.insertScheduledTransfer(st, base.Height(33), cancelled)
+	_ = t.insertScheduledTransfer(st, base.Height(34), cancelled.Cancel())
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAccountSchedules).URLPath("address", pending.Receiver().String())
+	t.NoError(err)
+
+	accountLink, err := handlers.router.Get(HandlerPathAccount).URLPath("address", pending.Receiver().String())
+	t.NoError(err)
+
+	w := t.requestOK(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	hal := t.loadHal(b)
+
+	t.Equal(self.String(), hal.Links()["self"].Href())
+	t.Equal(accountLink.Path, hal.Links()["account"].Href())
+
+	var hals []json.RawMessage
+	t.NoError(jsonenc.Unmarshal(hal.RawInterface(), &hals))
+	t.Equal(1, len(hals))
+
+	hinter, err := t.JSONEnc.DecodeByHint(hals[0])
+	t.NoError(err)
+	usc, ok := hinter.(currency.ScheduledTransfer)
+	t.True(ok)
+
+	t.True(pending.ID().Equal(usc.ID()))
+	t.Equal(currency.ScheduledTransferStatusPending, usc.Status())
+	t.True(pending.Sender().Equal(usc.Sender()))
+}
+
+func (t *testHandlerAccount) TestAccountSchedulesNotFound() {
+	st, _ := t.Storage()
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAccountSchedules).URLPath("address", t.newAccount().Address().String())
+	t.NoError(err)
+
+	w := t.request404(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	var problem Problem
+	t.NoError(jsonenc.Unmarshal(b, &problem))
+	t.Contains(problem.Error(), "scheduled transfers not found")
+}
+
+func (t *testHandlerAccount) TestAccountByAlias() {
+	st, _ := t.Storage()
+
+	ac := t.newAccount()
+	height := base.Height(33)
+
+	am := currency.MustNewAmount(t.randomBig(), t.cid)
+
+	va, _ := t.insertAccount(st, height, ac, am)
+	_ = t.insertAlias(st, height, currency.NewAccountAlias(currency.Alias("showme"), ac.Address()))
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAccount).URLPath("address", currency.Alias("showme").String())
+	t.NoError(err)
+
+	accountLink, err := handlers.router.Get(HandlerPathAccount).URLPath("address", ac.Address().String())
+	t.NoError(err)
+
+	w := t.requestOK(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	hal := t.loadHal(b)
+
+	t.Equal(accountLink.Path, hal.Links()["self"].Href())
+
+	hinter, err := t.JSONEnc.DecodeByHint(hal.RawInterface())
+	t.NoError(err)
+	uva, ok := hinter.(AccountValue)
+	t.True(ok)
+
+	t.compareAccountValue(va, uva)
+}
+
+func (t *testHandlerAccount) TestAccountByReleasedAlias() {
+	st, _ := t.Storage()
+
+	ac := t.newAccount()
+	height := base.Height(33)
+
+	am := currency.MustNewAmount(t.randomBig(), t.cid)
+
+	_, _ = t.insertAccount(st, height, ac, am)
+
+	aa := currency.NewAccountAlias(currency.Alias("showme"), ac.Address())
+	_ = t.insertAlias(st, height, aa)
+	_ = t.insertAlias(st, height+1, aa.Release())
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAccount).URLPath("address", currency.Alias("showme").String())
+	t.NoError(err)
+
+	w := t.request404(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	var problem Problem
+	t.NoError(jsonenc.Unmarshal(b, &problem))
+	t.Contains(problem.Error(), "alias not found")
+}
+
+func (t *testHandlerAccount) TestAlias() {
+	st, _ := t.Storage()
+
+	ac := t.newAccount()
+	aa := currency.NewAccountAlias(currency.Alias("showme"), ac.Address())
+	_ = t.insertAlias(st, base.Height(33), aa)
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAlias).URLPath("alias", "showme")
+	t.NoError(err)
+
+	accountLink, err := handlers.router.Get(HandlerPathAccount).URLPath("address", ac.Address().String())
+	t.NoError(err)
+
+	w := t.requestOK(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	hal := t.loadHal(b)
+
+	t.Equal(self.String(), hal.Links()["self"].Href())
+	t.Equal(accountLink.Path, hal.Links()["account"].Href())
+
+	hinter, err := t.JSONEnc.DecodeByHint(hal.RawInterface())
+	t.NoError(err)
+	uaa, ok := hinter.(currency.AccountAlias)
+	t.True(ok)
+
+	t.Equal(aa.Alias(), uaa.Alias())
+	t.True(aa.Account().Equal(uaa.Account()))
+	t.True(uaa.Registered())
+}
+
+func (t *testHandlerAccount) TestAliasNotFound() {
+	st, _ := t.Storage()
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAlias).URLPath("alias", "showme")
+	t.NoError(err)
+
+	w := t.request404(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	var problem Problem
+	t.NoError(jsonenc.Unmarshal(b, &problem))
+	t.Contains(problem.Error(), "alias not found")
+}
+
 func TestHandlerAccount(t *testing.T) {
 	suite.Run(t, new(testHandlerAccount))
 }
@@ -0,0 +1,87 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"golang.org/x/xerrors"
+)
+
+func (hd *Handlers) handleAlias(w http.ResponseWriter, r *http.Request) {
+	if err := loadFromCache(hd.cache, cacheKeyPath(r), w); err != nil {
+		hd.Log().Verbose().Err(err).Msg("failed to load cache")
+	} else {
+		hd.Log().Verbose().Msg("loaded from cache")
+
+		return
+	}
+
+	al := currency.Alias(strings.TrimSpace(mux.Vars(r)["alias"]))
+	if err := al.IsValid(nil); err != nil {
+		hd.problemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	var aa currency.AccountAlias
+	switch i, found, err := hd.storage.Alias(al); {
+	case err != nil:
+		hd.problemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	case !found || !i.Registered():
+		hd.problemWithError(w, xerrors.Errorf("alias not found"), http.StatusNotFound)
+
+		return
+	default:
+		aa = i
+	}
+
+	if hal, err := hd.buildAliasHal(aa); err != nil {
+		hd.problemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	} else {
+		hd.writeHal(w, hal, http.StatusOK)
+		hd.writeCache(w, cacheKeyPath(r), time.Second*2)
+	}
+}
+
+func (hd *Handlers) buildAliasHal(aa currency.AccountAlias) (Hal, error) {
+	var hal Hal
+	if h, err := hd.combineURL(HandlerPathAlias, "alias", aa.Alias().Raw()); err != nil {
+		return nil, err
+	} else {
+		hal = NewBaseHal(aa, NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccount, "address", aa.Account().String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("account", NewHalLink(h, nil))
+	}
+
+	return hal, nil
+}
+
+// resolveAlias returns the account of alias with the http status for the
+// problem. The address, which is not alias, is returned as it is.
+func (hd *Handlers) resolveAlias(a base.Address) (base.Address, int, error) {
+	al, ok := a.(currency.Alias)
+	if !ok {
+		return a, http.StatusOK, nil
+	}
+
+	switch aa, found, err := hd.storage.Alias(al); {
+	case err != nil:
+		return nil, http.StatusInternalServerError, err
+	case !found || !aa.Registered():
+		return nil, http.StatusNotFound, xerrors.Errorf("alias not found")
+	default:
+		return aa.Account(), http.StatusOK, nil
+	}
+}
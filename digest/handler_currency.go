@@ -101,6 +101,10 @@ func (hd *Handlers) buildCurrency(de currency.CurrencyDesign, st state.State) (H
 
 	hal = hal.AddLink("currency:{currencyid}", NewHalLink(HandlerPathCurrency, nil).SetTemplated())
 
+	if sp, found := hd.cp.Supply(de.Currency()); found {
+		hal = hal.AddExtras("supply", sp)
+	}
+
 	if h, err := hd.combineURL(HandlerPathBlockByHeight, "height", st.Height().String()); err != nil {
 		return nil, err
 	} else {
@@ -96,6 +96,17 @@ func (t *testHandlerCurrency) TestCurrency() {
 		cp.Set(nst)
 	}
 
+	sp := currency.NewCurrencySupply(de.Currency(), de.Big()).SetHolding(currency.NewBig(30))
+	{
+		st, err := state.NewStateV0(currency.StateKeyCurrencySupply(de.Currency()), nil, base.Height(33))
+		t.NoError(err)
+
+		nst, err := currency.SetStateCurrencySupplyValue(st, sp)
+		t.NoError(err)
+
+		t.NoError(cp.Set(nst))
+	}
+
 	handlers := NewHandlers(t.networkID, t.Encs, t.JSONEnc, nil, DummyCache{}, cp)
 	t.NoError(handlers.Initialize())
 
@@ -117,6 +128,17 @@ func (t *testHandlerCurrency) TestCurrency() {
 	t.True(ok)
 
 	t.compareCurrencyDesign(de, ude)
+
+	b, err = t.JSONEnc.Marshal(hal.Extras()["supply"])
+	t.NoError(err)
+
+	hinter, err = t.JSONEnc.DecodeByHint(b)
+	t.NoError(err)
+	usp, ok := hinter.(currency.CurrencySupply)
+	t.True(ok)
+
+	t.True(sp.Total().Equal(usp.Total()))
+	t.True(sp.Circulating().Equal(usp.Circulating()))
 }
 
 func TestHandlerCurrency(t *testing.T) {
@@ -14,10 +14,18 @@ import (
 )
 
 var factTypesByHint = map[string]hint.Hinter{
-	"create-accounts":   currency.CreateAccounts{},
-	"key-updater":       currency.KeyUpdater{},
-	"transfers":         currency.Transfers{},
-	"currency-register": currency.CurrencyRegister{},
+	"create-accounts":         currency.CreateAccounts{},
+	"key-updater":             currency.KeyUpdater{},
+	"transfers":               currency.Transfers{},
+	"currency-register":       currency.CurrencyRegister{},
+	"currency-mint":           currency.CurrencyMint{},
+	"burn":                    currency.Burn{},
+	"approve":                 currency.Approve{},
+	"transfer-from":           currency.TransferFrom{},
+	"lock-transfer":           currency.LockTransfer{},
+	"claim-transfer":          currency.ClaimTransfer{},
+	"refund-transfer":         currency.RefundTransfer{},
+	"create-vesting-accounts": currency.CreateVestingAccounts{},
 }
 
 func (hd *Handlers) handleOperationBuild(w http.ResponseWriter, r *http.Request) {
@@ -7,6 +7,8 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/seal"
@@ -65,6 +67,8 @@ func (hd *Handlers) sendItem(v interface{}) (Hal, error) {
 		for i := range t.Operations() {
 			if err := t.Operations()[i].IsValid(hd.networkID); err != nil {
 				return nil, err
+			} else if err := hd.checkExpired(t.Operations()[i]); err != nil {
+				return nil, err
 			}
 		}
 
@@ -80,6 +84,8 @@ func (hd *Handlers) sendItem(v interface{}) (Hal, error) {
 	case operation.Operation:
 		if err := t.IsValid(hd.networkID); err != nil {
 			return nil, err
+		} else if err := hd.checkExpired(t); err != nil {
+			return nil, err
 		}
 	default:
 		return nil, xerrors.Errorf("unsupported message type, %T", v)
@@ -97,6 +103,8 @@ func (hd *Handlers) sendOperations(v []json.RawMessage) (Hal, error) {
 			return nil, xerrors.Errorf("unsupported message type, %T", hinter)
 		} else if err := op.IsValid(hd.networkID); err != nil {
 			return nil, err
+		} else if err := hd.checkExpired(op); err != nil {
+			return nil, err
 		} else {
 			ops[i] = op
 		}
@@ -105,6 +113,17 @@ func (hd *Handlers) sendOperations(v []json.RawMessage) (Hal, error) {
 	return hd.sendSeal((operation.BaseSeal{}).SetOperations(ops))
 }
 
+// checkExpired rejects the expired operation before sending it; the valid until
+// height is compared with the last block of digest.
+func (hd *Handlers) checkExpired(op operation.Operation) error {
+	last := base.NilHeight
+	if hd.storage != nil {
+		last = hd.storage.LastBlock()
+	}
+
+	return currency.CheckExpired(op.Fact(), last)
+}
+
 func (hd *Handlers) sendSeal(v interface{}) (Hal, error) {
 	if sl, err := hd.send(v); err != nil {
 		return nil, err
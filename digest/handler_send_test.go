@@ -3,9 +3,12 @@
 package digest
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/seal"
 	"github.com/spikeekips/mitum/util"
@@ -100,6 +103,50 @@ func (t *testHandlerSend) TestSendOperations() {
 	}
 }
 
+func (t *testHandlerSend) TestSendExpired() {
+	st, _ := t.Storage()
+	t.NoError(st.SetLastBlock(base.Height(33)))
+
+	handlers := t.handlers(st, DummyCache{})
+
+	var sent bool
+	handlers.SetSend(func(sl interface{}) (seal.Seal, error) {
+		sent = true
+
+		return nil, nil
+	})
+
+	self, err := handlers.router.Get(HandlerPathSend).URL()
+	t.NoError(err)
+
+	items := []currency.TransfersItem{currency.NewTransfersItemSingleAmount(
+		currency.MustAddress(util.UUID().String()),
+		currency.MustNewAmount(currency.NewBig(10), t.cid),
+	)}
+	fact := currency.NewTransfersFact(util.UUID().Bytes(), currency.MustAddress(util.UUID().String()), items).
+		SetSequence(1).
+		SetValidUntil(base.Height(33))
+
+	pk := key.MustNewEtherPrivatekey()
+	sig, err := operation.NewFactSignature(pk, fact, t.networkID)
+	t.NoError(err)
+
+	op, err := currency.NewTransfers(
+		fact,
+		[]operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)},
+		util.UUID().String(),
+	)
+	t.NoError(err)
+
+	b, err := jsonenc.Marshal(op)
+	t.NoError(err)
+
+	w := t.request(handlers, "POST", self.String(), b)
+	t.Equal(http.StatusBadRequest, w.Result().StatusCode)
+	t.Contains(w.Body.String(), "fact expired at height")
+	t.False(sent)
+}
+
 func TestHandlerSend(t *testing.T) {
 	suite.Run(t, new(testHandlerSend))
 }
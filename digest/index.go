@@ -39,6 +39,122 @@ var balanceIndexModels = []mongo.IndexModel{
 	},
 }
 
+var allowanceIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_allowance"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "key", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_allowance_key"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_allowance_height"),
+	},
+}
+
+var vestingIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "currency", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_vesting_currency"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_vesting_height"),
+	},
+}
+
+var freezeIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "currency", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_freeze_currency"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_freeze_height"),
+	},
+}
+
+var lockIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_lock"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "key", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_lock_key"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_lock_height"),
+	},
+}
+
+var scheduleIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_schedule"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "key", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_schedule_key"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_schedule_height"),
+	},
+}
+
+var proposalIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_proposal"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "key", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_proposal_key"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_proposal_height"),
+	},
+}
+
+var aliasIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "alias", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_alias"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_alias_address"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_alias_height"),
+	},
+}
+
 var operationIndexModels = []mongo.IndexModel{
 	{
 		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "height", Value: 1}, bson.E{Key: "index", Value: 1}},
@@ -57,8 +173,29 @@ var operationIndexModels = []mongo.IndexModel{
 	},
 }
 
+var sequenceIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_sequence"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_sequence_height"),
+	},
+}
+
 var defaultIndexes = map[string] /* collection */ []mongo.IndexModel{
 	defaultColNameAccount:   accountIndexModels,
 	defaultColNameBalance:   balanceIndexModels,
+	defaultColNameAllowance: allowanceIndexModels,
+	defaultColNameLock:      lockIndexModels,
+	defaultColNameVesting:   vestingIndexModels,
+	defaultColNameFreeze:    freezeIndexModels,
+	defaultColNameProposal:  proposalIndexModels,
+	defaultColNameAlias:     aliasIndexModels,
+	defaultColNameSchedule:  scheduleIndexModels,
+	defaultColNameSequence:  sequenceIndexModels,
 	defaultColNameOperation: operationIndexModels,
 }
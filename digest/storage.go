@@ -28,6 +28,14 @@ var maxLimit int64 = 50
 var (
 	defaultColNameAccount   = "digest_ac"
 	defaultColNameBalance   = "digest_bl"
+	defaultColNameAllowance = "digest_al"
+	defaultColNameLock      = "digest_lk"
+	defaultColNameVesting   = "digest_vs"
+	defaultColNameFreeze    = "digest_fz"
+	defaultColNameProposal  = "digest_pr"
+	defaultColNameAlias     = "digest_as"
+	defaultColNameSchedule  = "digest_sc"
+	defaultColNameSequence  = "digest_sq"
 	defaultColNameOperation = "digest_op"
 )
 
@@ -177,6 +185,14 @@ func (st *Storage) clean() error {
 	for _, col := range []string{
 		defaultColNameAccount,
 		defaultColNameBalance,
+		defaultColNameAllowance,
+		defaultColNameLock,
+		defaultColNameVesting,
+		defaultColNameFreeze,
+		defaultColNameProposal,
+		defaultColNameAlias,
+		defaultColNameSchedule,
+		defaultColNameSequence,
 		defaultColNameOperation,
 	} {
 		if err := st.storage.Client().Collection(col).Drop(context.Background()); err != nil {
@@ -217,6 +233,14 @@ func (st *Storage) cleanByHeight(height base.Height) error {
 	for _, col := range []string{
 		defaultColNameAccount,
 		defaultColNameBalance,
+		defaultColNameAllowance,
+		defaultColNameLock,
+		defaultColNameVesting,
+		defaultColNameFreeze,
+		defaultColNameProposal,
+		defaultColNameAlias,
+		defaultColNameSchedule,
+		defaultColNameSequence,
 		defaultColNameOperation,
 	} {
 		res, err := st.storage.Client().Collection(col).BulkWrite(
@@ -459,6 +483,37 @@ func (st *Storage) Account(a base.Address) (AccountValue, bool /* exists */, err
 			SetPreviousHeight(previousHeight)
 	}
 
+	// NOTE load the locked balance by vesting at the last block
+	switch vss, err := st.Vestings(a); {
+	case err != nil:
+		return rs, false, err
+	default:
+		var locked []currency.Amount
+		for i := range vss {
+			if big := vss[i].Locked(st.LastBlock()); big.OverZero() {
+				locked = append(locked, currency.NewAmount(big, vss[i].Currency()))
+			}
+		}
+
+		rs = rs.SetLocked(locked)
+	}
+
+	// NOTE load the frozen status
+	switch fzs, err := st.Freezes(a); {
+	case err != nil:
+		return rs, false, err
+	default:
+		rs = rs.SetFrozen(fzs)
+	}
+
+	// NOTE load the next sequence
+	switch sq, err := st.Sequence(a); {
+	case err != nil:
+		return rs, false, err
+	default:
+		rs = rs.SetSequence(sq.Next())
+	}
+
 	return rs, true, nil
 }
 
@@ -523,6 +578,369 @@ func (st *Storage) balance(a base.Address) ([]currency.Amount, base.Height, base
 	return ams, lastHeight, previousHeight, nil
 }
 
+// Allowances returns the latest allowances of owner by spender and currency.
+// The revoked allowances, which are zero, are excluded.
+func (st *Storage) Allowances(owner base.Address) ([]currency.Allowance, error) {
+	var keys []string
+	var als []currency.Allowance
+	for {
+		filter := util.NewBSONFilter("address", currency.StateAddressKeyPrefix(owner))
+
+		var q primitive.D
+		if len(keys) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("key", bson.M{"$nin": keys}).D()
+		}
+
+		var sta state.State
+		if err := st.storage.Client().GetByFilter(
+			defaultColNameAllowance,
+			q,
+			func(res *mongo.SingleResult) error {
+				if i, err := loadAllowance(res.Decode, st.storage.Encoders()); err != nil {
+					return err
+				} else {
+					sta = i
+
+					return nil
+				}
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if xerrors.Is(err, storage.NotFoundError) {
+				break
+			}
+
+			return nil, err
+		}
+
+		keys = append(keys, sta.Key())
+
+		if i, err := currency.StateAllowanceValue(sta); err != nil {
+			return nil, err
+		} else if i.Amount().Big().OverZero() {
+			als = append(als, i)
+		}
+	}
+
+	return als, nil
+}
+
+// Vestings returns the vestings of address by currency.
+func (st *Storage) Vestings(a base.Address) ([]currency.Vesting, error) {
+	var cids []string
+	var vss []currency.Vesting
+	for {
+		filter := util.NewBSONFilter("address", currency.StateAddressKeyPrefix(a))
+
+		var q primitive.D
+		if len(cids) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("currency", bson.M{"$nin": cids}).D()
+		}
+
+		var sta state.State
+		if err := st.storage.Client().GetByFilter(
+			defaultColNameVesting,
+			q,
+			func(res *mongo.SingleResult) error {
+				if i, err := loadVesting(res.Decode, st.storage.Encoders()); err != nil {
+					return err
+				} else {
+					sta = i
+
+					return nil
+				}
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if xerrors.Is(err, storage.NotFoundError) {
+				break
+			}
+
+			return nil, err
+		}
+
+		if i, err := currency.StateVestingValue(sta); err != nil {
+			return nil, err
+		} else {
+			vss = append(vss, i)
+
+			cids = append(cids, i.Currency().String())
+		}
+	}
+
+	return vss, nil
+}
+
+// Freezes returns the frozen status of address by currency; the freeze for all
+// currencies has empty currency. The unfrozen ones are ignored.
+func (st *Storage) Freezes(a base.Address) ([]currency.Freeze, error) {
+	var cids []string
+	var fzs []currency.Freeze
+	for {
+		filter := util.NewBSONFilter("address", currency.StateAddressKeyPrefix(a))
+
+		var q primitive.D
+		if len(cids) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("currency", bson.M{"$nin": cids}).D()
+		}
+
+		var sta state.State
+		if err := st.storage.Client().GetByFilter(
+			defaultColNameFreeze,
+			q,
+			func(res *mongo.SingleResult) error {
+				if i, err := loadFreeze(res.Decode, st.storage.Encoders()); err != nil {
+					return err
+				} else {
+					sta = i
+
+					return nil
+				}
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if xerrors.Is(err, storage.NotFoundError) {
+				break
+			}
+
+			return nil, err
+		}
+
+		if i, err := currency.StateFreezeValue(sta); err != nil {
+			return nil, err
+		} else {
+			if i.IsFrozen() {
+				fzs = append(fzs, i)
+			}
+
+			cids = append(cids, i.Currency().String())
+		}
+	}
+
+	return fzs, nil
+}
+
+// Sequence returns the Sequence of address. If the address has never used the
+// sequence, the new Sequence is returned.
+func (st *Storage) Sequence(a base.Address) (currency.Sequence, error) {
+	var sta state.State
+	if err := st.storage.Client().GetByFilter(
+		defaultColNameSequence,
+		util.NewBSONFilter("address", currency.StateAddressKeyPrefix(a)).D(),
+		func(res *mongo.SingleResult) error {
+			if i, err := loadSequence(res.Decode, st.storage.Encoders()); err != nil {
+				return err
+			} else {
+				sta = i
+
+				return nil
+			}
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if xerrors.Is(err, storage.NotFoundError) {
+			return currency.NewSequence(a), nil
+		}
+
+		return currency.Sequence{}, err
+	}
+
+	return currency.StateSequenceValue(sta)
+}
+
+// Locks returns the latest locks, which address is the sender or receiver of.
+func (st *Storage) Locks(address base.Address) ([]currency.Lock, error) {
+	var keys []string
+	var lks []currency.Lock
+	for {
+		filter := util.NewBSONFilter("addresses", currency.StateAddressKeyPrefix(address))
+
+		var q primitive.D
+		if len(keys) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("key", bson.M{"$nin": keys}).D()
+		}
+
+		var sta state.State
+		if err := st.storage.Client().GetByFilter(
+			defaultColNameLock,
+			q,
+			func(res *mongo.SingleResult) error {
+				if i, err := loadLock(res.Decode, st.storage.Encoders()); err != nil {
+					return err
+				} else {
+					sta = i
+
+					return nil
+				}
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if xerrors.Is(err, storage.NotFoundError) {
+				break
+			}
+
+			return nil, err
+		}
+
+		keys = append(keys, sta.Key())
+
+		if i, err := currency.StateLockValue(sta); err != nil {
+			return nil, err
+		} else {
+			lks = append(lks, i)
+		}
+	}
+
+	return lks, nil
+}
+
+// Proposals returns the pending proposals of account.
+func (st *Storage) Proposals(address base.Address) ([]currency.Proposal, error) {
+	var keys []string
+	var prs []currency.Proposal
+	for {
+		filter := util.NewBSONFilter("address", currency.StateAddressKeyPrefix(address))
+
+		var q primitive.D
+		if len(keys) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("key", bson.M{"$nin": keys}).D()
+		}
+
+		var sta state.State
+		if err := st.storage.Client().GetByFilter(
+			defaultColNameProposal,
+			q,
+			func(res *mongo.SingleResult) error {
+				if i, err := loadProposal(res.Decode, st.storage.Encoders()); err != nil {
+					return err
+				} else {
+					sta = i
+
+					return nil
+				}
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if xerrors.Is(err, storage.NotFoundError) {
+				break
+			}
+
+			return nil, err
+		}
+
+		keys = append(keys, sta.Key())
+
+		if i, err := currency.StateProposalValue(sta); err != nil {
+			return nil, err
+		} else if i.Status() == currency.ProposalStatusPending {
+			prs = append(prs, i)
+		}
+	}
+
+	return prs, nil
+}
+
+// ScheduledTransfers returns the pending scheduled transfers, which address
+// is the sender or receiver of.
+func (st *Storage) ScheduledTransfers(address base.Address) ([]currency.ScheduledTransfer, error) {
+	var keys []string
+	var scs []currency.ScheduledTransfer
+	for {
+		filter := util.NewBSONFilter("addresses", currency.StateAddressKeyPrefix(address))
+
+		var q primitive.D
+		if len(keys) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("key", bson.M{"$nin": keys}).D()
+		}
+
+		var sta state.State
+		if err := st.storage.Client().GetByFilter(
+			defaultColNameSchedule,
+			q,
+			func(res *mongo.SingleResult) error {
+				if i, err := loadScheduledTransfer(res.Decode, st.storage.Encoders()); err != nil {
+					return err
+				} else {
+					sta = i
+
+					return nil
+				}
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if xerrors.Is(err, storage.NotFoundError) {
+				break
+			}
+
+			return nil, err
+		}
+
+		keys = append(keys, sta.Key())
+
+		if i, err := currency.StateScheduledTransferValue(sta); err != nil {
+			return nil, err
+		} else if i.Status() == currency.ScheduledTransferStatusPending {
+			scs = append(scs, i)
+		}
+	}
+
+	return scs, nil
+}
+
+// Alias returns the latest AccountAlias of alias. The released alias is also
+// returned.
+func (st *Storage) Alias(al currency.Alias) (currency.AccountAlias, bool, error) {
+	return st.alias(util.NewBSONFilter("alias", al.Raw()).D())
+}
+
+// AliasBefore returns the AccountAlias of alias before the height; the
+// operations of the block at the height are processed with it.
+func (st *Storage) AliasBefore(al currency.Alias, height base.Height) (currency.AccountAlias, bool, error) {
+	return st.alias(util.NewBSONFilter("alias", al.Raw()).Add("height", bson.M{"$lt": height}).D())
+}
+
+func (st *Storage) alias(filter bson.D) (currency.AccountAlias, bool, error) {
+	var sta state.State
+	if err := st.storage.Client().GetByFilter(
+		defaultColNameAlias,
+		filter,
+		func(res *mongo.SingleResult) error {
+			if i, err := loadAlias(res.Decode, st.storage.Encoders()); err != nil {
+				return err
+			} else {
+				sta = i
+
+				return nil
+			}
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if xerrors.Is(err, storage.NotFoundError) {
+			return currency.AccountAlias{}, false, nil
+		}
+
+		return currency.AccountAlias{}, false, err
+	}
+
+	if i, err := currency.StateAccountAliasValue(sta); err != nil {
+		return currency.AccountAlias{}, false, err
+	} else {
+		return i, true, nil
+	}
+}
+
 func loadLastBlock(st *Storage) (base.Height, bool, error) {
 	switch b, found, err := st.storage.Info(DigestStorageLastBlockKey); {
 	case err != nil:
@@ -526,6 +526,30 @@ func (t *testStorage) TestAccountsWithBadState() {
 	t.Contains(err.Error(), "not state for currency.Account")
 }
 
+func (t *testStorage) TestClosedAccount() {
+	st, _ := t.Storage()
+
+	height := base.Height(33)
+	ac := t.newAccount()
+
+	stA := t.newAccountState(ac.Close(), height)
+
+	va, err := NewAccountValue(stA)
+	t.NoError(err)
+	t.Equal(height, va.ClosedHeight())
+
+	docA, err := NewAccountDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAccount, docA)
+
+	urs, found, err := st.Account(ac.Address())
+	t.NoError(err)
+	t.True(found)
+
+	t.True(urs.Account().IsClosed())
+	t.Equal(height, urs.ClosedHeight())
+}
+
 func (t *testStorage) TestAccount() {
 	st, _ := t.Storage()
 
@@ -651,6 +675,122 @@ func (t *testStorage) TestAccountMultiCurrencies() {
 	t.compareAmount(amC, amE)
 }
 
+func (t *testStorage) TestAccountVestingLocked() {
+	st, _ := t.Storage()
+
+	height := base.Height(33)
+	ac := t.newAccount()
+
+	stA := t.newAccountState(ac, height)
+
+	va, err := NewAccountValue(stA)
+	t.NoError(err)
+
+	docA, err := NewAccountDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAccount, docA)
+
+	am := currency.MustNewAmount(currency.NewBig(100), t.cid)
+	stB := t.newBalanceState(ac, height, am)
+	docB, err := NewBalanceDoc(stB, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameBalance, docB)
+
+	vs := currency.NewVesting(t.cid, []currency.VestingRelease{
+		currency.NewVestingRelease(height+10, currency.NewBig(30)),
+		currency.NewVestingRelease(height+20, currency.NewBig(40)),
+	})
+	_ = t.insertVesting(st, height, ac.Address(), vs)
+
+	t.NoError(st.SetLastBlock(height + 10))
+
+	urs, found, err := st.Account(ac.Address())
+	t.NoError(err)
+	t.True(found)
+
+	t.Equal(1, len(urs.locked))
+	t.compareAmount(currency.MustNewAmount(currency.NewBig(40), t.cid), urs.locked[0])
+
+	spendable := urs.Spendable()
+	t.Equal(1, len(spendable))
+	t.compareAmount(currency.MustNewAmount(currency.NewBig(60), t.cid), spendable[0])
+}
+
+func (t *testStorage) TestAccountFrozen() {
+	st, _ := t.Storage()
+
+	height := base.Height(33)
+	ac := t.newAccount()
+
+	stA := t.newAccountState(ac, height)
+
+	va, err := NewAccountValue(stA)
+	t.NoError(err)
+
+	docA, err := NewAccountDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAccount, docA)
+
+	am := currency.MustNewAmount(currency.NewBig(100), t.cid)
+	stB := t.newBalanceState(ac, height, am)
+	docB, err := NewBalanceDoc(stB, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameBalance, docB)
+
+	fz := currency.NewFreeze(ac.Address(), "", true)
+	_ = t.insertFreeze(st, height, fz)
+
+	cfz := currency.NewFreeze(ac.Address(), t.cid, false)
+	_ = t.insertFreeze(st, height, cfz)
+	_ = t.insertFreeze(st, height+1, cfz.Unfreeze())
+
+	urs, found, err := st.Account(ac.Address())
+	t.NoError(err)
+	t.True(found)
+
+	t.Equal(1, len(urs.Frozen()))
+	t.True(urs.Frozen()[0].Target().Equal(ac.Address()))
+	t.Empty(urs.Frozen()[0].Currency())
+	t.True(urs.Frozen()[0].Receiving())
+}
+
+func (t *testStorage) TestAccountSequence() {
+	st, _ := t.Storage()
+
+	height := base.Height(33)
+	ac := t.newAccount()
+
+	va, err := NewAccountValue(t.newAccountState(ac, height))
+	t.NoError(err)
+
+	docA, err := NewAccountDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAccount, docA)
+
+	am := currency.MustNewAmount(currency.NewBig(100), t.cid)
+	docB, err := NewBalanceDoc(t.newBalanceState(ac, height, am), t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameBalance, docB)
+
+	urs, found, err := st.Account(ac.Address())
+	t.NoError(err)
+	t.True(found)
+	t.Equal(uint64(1), urs.Sequence())
+
+	sq, err := currency.NewSequence(ac.Address()).Use(1)
+	t.NoError(err)
+	_ = t.insertSequence(st, height, sq)
+
+	sq, err = sq.Use(2)
+	t.NoError(err)
+	_ = t.insertSequence(st, height+1, sq)
+
+	urs, found, err = st.Account(ac.Address())
+	t.NoError(err)
+	t.True(found)
+	t.Equal(uint64(3), urs.Sequence())
+}
+
 func (t *testStorage) TestOperations() {
 	st, _ := t.Storage()
 
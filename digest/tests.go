@@ -66,19 +66,49 @@ func (t *baseTest) SetupSuite() {
 	_ = t.Encs.AddHinter(OperationValue{})
 	_ = t.Encs.AddHinter(Problem{})
 	_ = t.Encs.AddHinter(currency.Account{})
+	_ = t.Encs.AddHinter(currency.AccountAlias{})
 	_ = t.Encs.AddHinter(currency.Address(""))
+	_ = t.Encs.AddHinter(currency.Alias(""))
+	_ = t.Encs.AddHinter(currency.Allowance{})
 	_ = t.Encs.AddHinter(currency.Amount{})
+	_ = t.Encs.AddHinter(currency.ApproveFact{})
+	_ = t.Encs.AddHinter(currency.ApproveOperationFact{})
+	_ = t.Encs.AddHinter(currency.ApproveOperation{})
+	_ = t.Encs.AddHinter(currency.Approve{})
+	_ = t.Encs.AddHinter(currency.ClaimTransferFact{})
+	_ = t.Encs.AddHinter(currency.ClaimTransfer{})
+	_ = t.Encs.AddHinter(currency.CloseAccountFact{})
+	_ = t.Encs.AddHinter(currency.CloseAccount{})
 	_ = t.Encs.AddHinter(currency.CreateAccountsFact{})
 	_ = t.Encs.AddHinter(currency.CreateAccountsItemMultiAmountsHinter)
 	_ = t.Encs.AddHinter(currency.CreateAccountsItemSingleAmountHinter)
 	_ = t.Encs.AddHinter(currency.CreateAccounts{})
+	_ = t.Encs.AddHinter(currency.CreateVestingAccountsFact{})
+	_ = t.Encs.AddHinter(currency.CreateVestingAccountsItem{})
+	_ = t.Encs.AddHinter(currency.CreateVestingAccounts{})
 	_ = t.Encs.AddHinter(currency.CurrencyDesign{})
+	_ = t.Encs.AddHinter(currency.CurrencyMintFact{})
+	_ = t.Encs.AddHinter(currency.CurrencyMint{})
+	_ = t.Encs.AddHinter(currency.ExchangeFact{})
+	_ = t.Encs.AddHinter(currency.Exchange{})
+	_ = t.Encs.AddHinter(currency.FreezeAccountFact{})
+	_ = t.Encs.AddHinter(currency.FreezeAccount{})
+	_ = t.Encs.AddHinter(currency.Freeze{})
+	_ = t.Encs.AddHinter(currency.BurnFact{})
+	_ = t.Encs.AddHinter(currency.Burn{})
+	_ = t.Encs.AddHinter(currency.CancelRecoveryFact{})
+	_ = t.Encs.AddHinter(currency.CancelRecovery{})
+	_ = t.Encs.AddHinter(currency.CancelScheduledTransferFact{})
+	_ = t.Encs.AddHinter(currency.CancelScheduledTransfer{})
+	_ = t.Encs.AddHinter(currency.CurrencySupply{})
 	_ = t.Encs.AddHinter(currency.CurrencyPolicyUpdaterFact{})
 	_ = t.Encs.AddHinter(currency.CurrencyPolicyUpdater{})
 	_ = t.Encs.AddHinter(currency.CurrencyRegisterFact{})
 	_ = t.Encs.AddHinter(currency.CurrencyRegister{})
 	_ = t.Encs.AddHinter(currency.FeeOperationFact{})
 	_ = t.Encs.AddHinter(currency.FeeOperation{})
+	_ = t.Encs.AddHinter(currency.FeePayout{})
+	_ = t.Encs.AddHinter(currency.FeeShare{})
 	_ = t.Encs.AddHinter(currency.FixedFeeer{})
 	_ = t.Encs.AddHinter(currency.GenesisCurrenciesFact{})
 	_ = t.Encs.AddHinter(currency.GenesisCurrencies{})
@@ -86,12 +116,62 @@ func (t *baseTest) SetupSuite() {
 	_ = t.Encs.AddHinter(currency.KeyUpdater{})
 	_ = t.Encs.AddHinter(currency.Keys{})
 	_ = t.Encs.AddHinter(currency.Key{})
+	_ = t.Encs.AddHinter(currency.LockTransferFact{})
+	_ = t.Encs.AddHinter(currency.LockTransfer{})
+	_ = t.Encs.AddHinter(currency.Lock{})
+	_ = t.Encs.AddHinter(currency.MintItem{})
 	_ = t.Encs.AddHinter(currency.NilFeeer{})
+	_ = t.Encs.AddHinter(currency.Proposal{})
+	_ = t.Encs.AddHinter(currency.ProposeOperationFact{})
+	_ = t.Encs.AddHinter(currency.ProposeOperation{})
 	_ = t.Encs.AddHinter(currency.RatioFeeer{})
+	_ = t.Encs.AddHinter(currency.RecoverAccountFact{})
+	_ = t.Encs.AddHinter(currency.RecoverAccount{})
+	_ = t.Encs.AddHinter(currency.RecoveryConfig{})
+	_ = t.Encs.AddHinter(currency.RecoveryUpdaterFact{})
+	_ = t.Encs.AddHinter(currency.RecoveryUpdater{})
+	_ = t.Encs.AddHinter(currency.Recovery{})
+	_ = t.Encs.AddHinter(currency.RefundTransferFact{})
+	_ = t.Encs.AddHinter(currency.RefundTransfer{})
+	_ = t.Encs.AddHinter(currency.RegisterAliasFact{})
+	_ = t.Encs.AddHinter(currency.RegisterAlias{})
+	_ = t.Encs.AddHinter(currency.ScheduleItem{})
+	_ = t.Encs.AddHinter(currency.ScheduleQueue{})
+	_ = t.Encs.AddHinter(currency.ScheduleTransferFact{})
+	_ = t.Encs.AddHinter(currency.ScheduleTransfer{})
+	_ = t.Encs.AddHinter(currency.ScheduledTransferOperationFact{})
+	_ = t.Encs.AddHinter(currency.ScheduledTransferOperation{})
+	_ = t.Encs.AddHinter(currency.ScheduledTransfer{})
+	_ = t.Encs.AddHinter(currency.StandingOrder{})
+	_ = t.Encs.AddHinter(currency.CreateStandingOrderFact{})
+	_ = t.Encs.AddHinter(currency.CreateStandingOrder{})
+	_ = t.Encs.AddHinter(currency.CancelStandingOrderFact{})
+	_ = t.Encs.AddHinter(currency.CancelStandingOrder{})
+	_ = t.Encs.AddHinter(currency.StandingOrderOperationFact{})
+	_ = t.Encs.AddHinter(currency.StandingOrderOperation{})
+	_ = t.Encs.AddHinter(currency.Sequence{})
+	_ = t.Encs.AddHinter(currency.Commitments{})
+	_ = t.Encs.AddHinter(currency.ReleaseAliasFact{})
+	_ = t.Encs.AddHinter(currency.ReleaseAlias{})
+	_ = t.Encs.AddHinter(currency.SetSpendingLimitFact{})
+	_ = t.Encs.AddHinter(currency.SetSpendingLimit{})
+	_ = t.Encs.AddHinter(currency.SpendingLimit{})
+	_ = t.Encs.AddHinter(currency.TieredFeeer{})
+	_ = t.Encs.AddHinter(currency.TransferAliasFact{})
+	_ = t.Encs.AddHinter(currency.TransferAlias{})
+	_ = t.Encs.AddHinter(currency.TransferFromFact{})
+	_ = t.Encs.AddHinter(currency.TransferFrom{})
 	_ = t.Encs.AddHinter(currency.TransfersFact{})
 	_ = t.Encs.AddHinter(currency.TransfersItemMultiAmountsHinter)
 	_ = t.Encs.AddHinter(currency.TransfersItemSingleAmountHinter)
 	_ = t.Encs.AddHinter(currency.Transfers{})
+	_ = t.Encs.AddHinter(currency.TransferListMember{})
+	_ = t.Encs.AddHinter(currency.TransferListUpdaterFact{})
+	_ = t.Encs.AddHinter(currency.TransferListUpdater{})
+	_ = t.Encs.AddHinter(currency.UnfreezeAccountFact{})
+	_ = t.Encs.AddHinter(currency.UnfreezeAccount{})
+	_ = t.Encs.AddHinter(currency.VestingRelease{})
+	_ = t.Encs.AddHinter(currency.Vesting{})
 	_ = t.Encs.AddHinter(currency.CurrencyPolicy{})
 	_ = t.Encs.AddHinter(key.BTCPublickeyHinter)
 	_ = t.Encs.AddHinter(operation.BaseFactSign{})
@@ -119,7 +199,7 @@ func (t *baseTest) newTransfer(sender, receiver base.Address) currency.Transfers
 		receiver,
 		currency.MustNewAmount(currency.NewBig(10), t.cid),
 	)}
-	fact := currency.NewTransfersFact(token, sender, items)
+	fact := currency.NewTransfersFact(token, sender, items).SetSequence(1)
 
 	pk := key.MustNewEtherPrivatekey()
 	sig, err := operation.NewFactSignature(pk, fact, t.networkID)
@@ -197,6 +277,203 @@ func (t *baseTest) newBalanceState(ac currency.Account, height base.Height, am c
 	return stu.GetState()
 }
 
+func (t *baseTest) newAllowanceState(height base.Height, al currency.Allowance) state.State {
+	key := currency.StateKeyAllowance(al.Owner(), al.Spender(), al.Currency())
+
+	stv0, err := state.NewStateV0(key, nil, height-1)
+	t.NoError(err)
+	st, err := currency.SetStateAllowanceValue(stv0, al)
+	t.NoError(err)
+
+	stu := state.NewStateUpdater(st)
+
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+	t.NoError(stu.AddOperation(valuehash.RandomSHA256()))
+	stu = stu.SetHeight(height)
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+
+	return stu.GetState()
+}
+
+func (t *baseTest) insertAllowance(st *Storage, height base.Height, al currency.Allowance) state.State {
+	s := t.newAllowanceState(height, al)
+	doc, err := NewAllowanceDoc(s, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAllowance, doc)
+
+	return s
+}
+
+func (t *baseTest) newLockState(height base.Height, lk currency.Lock) state.State {
+	stv0, err := state.NewStateV0(currency.StateKeyLock(lk.ID()), nil, height-1)
+	t.NoError(err)
+	st, err := currency.SetStateLockValue(stv0, lk)
+	t.NoError(err)
+
+	stu := state.NewStateUpdater(st)
+
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+	t.NoError(stu.AddOperation(valuehash.RandomSHA256()))
+	stu = stu.SetHeight(height)
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+
+	return stu.GetState()
+}
+
+func (t *baseTest) insertLock(st *Storage, height base.Height, lk currency.Lock) state.State {
+	s := t.newLockState(height, lk)
+	doc, err := NewLockDoc(s, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameLock, doc)
+
+	return s
+}
+
+func (t *baseTest) newVestingState(height base.Height, a base.Address, vs currency.Vesting) state.State {
+	stv0, err := state.NewStateV0(currency.StateKeyVesting(a, vs.Currency()), nil, height-1)
+	t.NoError(err)
+	st, err := currency.SetStateVestingValue(stv0, vs)
+	t.NoError(err)
+
+	stu := state.NewStateUpdater(st)
+
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+	t.NoError(stu.AddOperation(valuehash.RandomSHA256()))
+	stu = stu.SetHeight(height)
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+
+	return stu.GetState()
+}
+
+func (t *baseTest) insertVesting(st *Storage, height base.Height, a base.Address, vs currency.Vesting) state.State {
+	s := t.newVestingState(height, a, vs)
+	doc, err := NewVestingDoc(s, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameVesting, doc)
+
+	return s
+}
+
+func (t *baseTest) newFreezeState(height base.Height, fz currency.Freeze) state.State {
+	stv0, err := state.NewStateV0(currency.StateKeyFreeze(fz.Target(), fz.Currency()), nil, height-1)
+	t.NoError(err)
+	st, err := currency.SetStateFreezeValue(stv0, fz)
+	t.NoError(err)
+
+	stu := state.NewStateUpdater(st)
+
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+	t.NoError(stu.AddOperation(valuehash.RandomSHA256()))
+	stu = stu.SetHeight(height)
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+
+	return stu.GetState()
+}
+
+func (t *baseTest) insertFreeze(st *Storage, height base.Height, fz currency.Freeze) state.State {
+	s := t.newFreezeState(height, fz)
+	doc, err := NewFreezeDoc(s, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameFreeze, doc)
+
+	return s
+}
+
+func (t *baseTest) insertSequence(st *Storage, height base.Height, sq currency.Sequence) state.State {
+	stv0, err := state.NewStateV0(currency.StateKeySequence(sq.Account()), nil, height-1)
+	t.NoError(err)
+	s, err := currency.SetStateSequenceValue(stv0, sq)
+	t.NoError(err)
+
+	stu := state.NewStateUpdater(s)
+
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+	t.NoError(stu.AddOperation(valuehash.RandomSHA256()))
+	stu = stu.SetHeight(height)
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+
+	doc, err := NewSequenceDoc(stu.GetState(), t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameSequence, doc)
+
+	return stu.GetState()
+}
+
+func (t *baseTest) newProposalState(height base.Height, pr currency.Proposal) state.State {
+	stv0, err := state.NewStateV0(currency.StateKeyProposal(pr.ID()), nil, height-1)
+	t.NoError(err)
+	st, err := currency.SetStateProposalValue(stv0, pr)
+	t.NoError(err)
+
+	stu := state.NewStateUpdater(st)
+
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+	t.NoError(stu.AddOperation(valuehash.RandomSHA256()))
+	stu = stu.SetHeight(height)
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+
+	return stu.GetState()
+}
+
+func (t *baseTest) insertProposal(st *Storage, height base.Height, pr currency.Proposal) state.State {
+	s := t.newProposalState(height, pr)
+	doc, err := NewProposalDoc(s, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameProposal, doc)
+
+	return s
+}
+
+func (t *baseTest) newScheduledTransferState(height base.Height, sc currency.ScheduledTransfer) state.State {
+	stv0, err := state.NewStateV0(currency.StateKeyScheduledTransfer(sc.ID()), nil, height-1)
+	t.NoError(err)
+	st, err := currency.SetStateScheduledTransferValue(stv0, sc)
+	t.NoError(err)
+
+	stu := state.NewStateUpdater(st)
+
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+	t.NoError(stu.AddOperation(valuehash.RandomSHA256()))
+	stu = stu.SetHeight(height)
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+
+	return stu.GetState()
+}
+
+func (t *baseTest) insertScheduledTransfer(st *Storage, height base.Height, sc currency.ScheduledTransfer) state.State {
+	s := t.newScheduledTransferState(height, sc)
+	doc, err := NewScheduledTransferDoc(s, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameSchedule, doc)
+
+	return s
+}
+
+func (t *baseTest) newAliasState(height base.Height, aa currency.AccountAlias) state.State {
+	stv0, err := state.NewStateV0(currency.StateKeyAlias(aa.Alias()), nil, height-1)
+	t.NoError(err)
+	st, err := currency.SetStateAccountAliasValue(stv0, aa)
+	t.NoError(err)
+
+	stu := state.NewStateUpdater(st)
+
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+	t.NoError(stu.AddOperation(valuehash.RandomSHA256()))
+	stu = stu.SetHeight(height)
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+
+	return stu.GetState()
+}
+
+func (t *baseTest) insertAlias(st *Storage, height base.Height, aa currency.AccountAlias) state.State {
+	s := t.newAliasState(height, aa)
+	doc, err := NewAliasDoc(s, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAlias, doc)
+
+	return s
+}
+
 func (t *baseTest) insertDoc(st *Storage, col string, doc mongodbstorage.Doc) interface{} {
 	id, err := st.storage.Client().Add(col, doc)
 	t.NoError(err)
@@ -256,10 +533,17 @@ func (t *baseTest) compareAccountValue(a, b interface{}) {
 	t.compareAccount(ua.Account(), ub.Account())
 	t.Equal(ua.Height(), ub.Height())
 	t.Equal(ua.PreviousHeight(), ub.PreviousHeight())
+	t.Equal(ua.Account().IsClosed(), ub.Account().IsClosed())
+	t.Equal(ua.ClosedHeight(), ub.ClosedHeight())
 
 	for i := range ua.Balance() {
 		t.compareAmount(ua.Balance()[i], ub.Balance()[i])
 	}
+
+	t.Equal(len(ua.Frozen()), len(ub.Frozen()))
+	for i := range ua.Frozen() {
+		t.True(ua.Frozen()[i].Hash().Equal(ub.Frozen()[i].Hash()))
+	}
 }
 
 func (t *baseTest) compareOperationValue(a, b interface{}) {